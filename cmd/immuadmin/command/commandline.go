@@ -92,6 +92,8 @@ func (cl *commandline) Register(rootCmd *cobra.Command) *cobra.Command {
 	cl.stats(rootCmd)
 	cl.serverConfig(rootCmd)
 	cl.database(rootCmd)
+	cl.replication(rootCmd)
+	cl.role(rootCmd)
 	return rootCmd
 }
 
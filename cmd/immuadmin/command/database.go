@@ -17,11 +17,15 @@ limitations under the License.
 package immuadmin
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
 	c "github.com/codenotary/immudb/cmd/helper"
+	"github.com/codenotary/immudb/embedded/appendable"
 	"github.com/codenotary/immudb/embedded/store"
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/database"
@@ -50,6 +54,14 @@ func addDbUpdateFlags(c *cobra.Command) {
 	c.Flags().Bool("replication-allow-tx-discarding", replication.DefaultAllowTxDiscarding, "allow precommitted transactions to be discarded if the replica diverges from the primary")
 	c.Flags().Bool("replication-skip-integrity-check", replication.DefaultSkipIntegrityCheck, "disable integrity check when reading data during replication")
 	c.Flags().Bool("replication-wait-for-indexing", replication.DefaultWaitForIndexing, "wait for indexing to be up to date during replication")
+	c.Flags().Bool("replication-primary-tls", false, "enable TLS on the connection to the primary database")
+	c.Flags().String("replication-primary-tls-server-name", "", "set server name used to verify the certificate of the primary (default is the primary host)")
+	c.Flags().String("replication-primary-tls-ca-cert", "", "set path on the replica server to the CA certificate chain used to verify the certificate of the primary")
+	c.Flags().String("replication-primary-tls-cert", "", "set path on the replica server to the client certificate presented to the primary (mTLS)")
+	c.Flags().String("replication-primary-tls-key", "", "set path on the replica server to the private key of the client certificate presented to the primary (mTLS)")
+	c.Flags().StringArray("replication-key-prefix", nil, "replicate only key-value entries with this key prefix (can be specified multiple times, enables partial replication)")
+	c.Flags().StringArray("replication-sql-table", nil, "replicate only rows of this SQL table (can be specified multiple times, enables partial replication)")
+	c.Flags().Bool("replication-log-shipping", false, "ship transactions through the server log shipping storage (on a primary) or fetch them from it (on a replica)")
 	c.Flags().Uint32("write-tx-header-version", 1, "set write tx header version (use 0 for compatibility with immudb 1.1, 1 for immudb 1.2+)")
 	c.Flags().Uint32("max-commit-concurrency", store.DefaultMaxConcurrency, "set the maximum commit concurrency")
 	c.Flags().Duration("sync-frequency", store.DefaultSyncFrequency, "set the fsync frequency during commit process")
@@ -58,6 +70,8 @@ func addDbUpdateFlags(c *cobra.Command) {
 	c.Flags().Bool("autoload", true, "enable database autoloading")
 	c.Flags().Duration("retention-period", 0, "duration of time to retain data in storage")
 	c.Flags().Duration("truncation-frequency", database.DefaultTruncationFrequency, "set the truncation frequency for the database")
+	c.Flags().Bool("truncation-compact-live-values", false, "move values still in use during truncation so value-log files can be fully discarded")
+	c.Flags().Uint32("truncation-compaction-rate", 0, "maximum number of bytes per second moved during truncation (0 means unlimited)")
 
 	flagNameMapping := map[string]string{
 		"replication-enabled":           "replication-is-replica",
@@ -76,13 +90,22 @@ func addDbUpdateFlags(c *cobra.Command) {
 	})
 }
 
+// compression settings can only be specified when the database is created
+func addDbCompressionFlags(c *cobra.Command) {
+	c.Flags().String("compression-format", "none", "compression format of value logs (none, flate, gzip, lzw, zlib, zstd or snappy), values must not be embedded")
+	c.Flags().Uint32("compression-level", 0, "compression level, 0 selects the default level of the compression format")
+	c.Flags().String("compression-dictionary", "", "file containing the dictionary used with zstd compression")
+	c.Flags().String("compression-dictionary-samples", "", "directory containing sample values used to train the dictionary used with zstd compression")
+	c.Flags().Uint32("compression-dictionary-size", appendable.DefaultDictionarySize, "maximum size of the trained compression dictionary")
+}
+
 func (cl *commandline) database(cmd *cobra.Command) {
 	dbCmd := &cobra.Command{
 		Use:               "database",
 		Short:             "Issue all database commands",
 		Aliases:           []string{"d"},
 		PersistentPostRun: cl.disconnect,
-		ValidArgs:         []string{"list", "create", "load", "unload", "delete", "update", "use", "flush", "compact", "truncate"},
+		ValidArgs:         []string{"list", "create", "load", "unload", "delete", "update", "use", "flush", "compact", "truncate", "export", "import"},
 	}
 
 	listCmd := &cobra.Command{
@@ -149,6 +172,7 @@ func (cl *commandline) database(cmd *cobra.Command) {
 		Args: cobra.ExactArgs(1),
 	}
 	addDbUpdateFlags(createCmd)
+	addDbCompressionFlags(createCmd)
 
 	loadCmd := &cobra.Command{
 		Use:               "load",
@@ -367,6 +391,9 @@ func (cl *commandline) database(cmd *cobra.Command) {
 	dbCmd.AddCommand(flushCmd)
 	dbCmd.AddCommand(compactCmd)
 	dbCmd.AddCommand(truncateCmd)
+	dbCmd.AddCommand(cl.databaseFailover())
+	dbCmd.AddCommand(cl.documentsExport())
+	dbCmd.AddCommand(cl.documentsImport())
 
 	cmd.AddCommand(dbCmd)
 }
@@ -407,6 +434,17 @@ func prepareDatabaseNullableSettings(flags *pflag.FlagSet) (*schema.DatabaseNull
 		return nil, nil
 	}
 
+	condStringList := func(name string) (*schema.NullableStringList, error) {
+		if flags.Changed(name) {
+			val, err := flags.GetStringArray(name)
+			if err != nil {
+				return nil, err
+			}
+			return &schema.NullableStringList{Values: val}, nil
+		}
+		return nil, nil
+	}
+
 	condDuration := func(name string) (*schema.NullableMilliseconds, error) {
 		if flags.Changed(name) {
 			val, err := flags.GetDuration(name)
@@ -502,6 +540,46 @@ func prepareDatabaseNullableSettings(flags *pflag.FlagSet) (*schema.DatabaseNull
 		return nil, err
 	}
 
+	ret.ReplicationSettings.PrimaryTLS, err = condBool("replication-primary-tls")
+	if err != nil {
+		return nil, err
+	}
+
+	ret.ReplicationSettings.PrimaryTLSServerName, err = condString("replication-primary-tls-server-name")
+	if err != nil {
+		return nil, err
+	}
+
+	ret.ReplicationSettings.PrimaryTLSCACert, err = condString("replication-primary-tls-ca-cert")
+	if err != nil {
+		return nil, err
+	}
+
+	ret.ReplicationSettings.PrimaryTLSCert, err = condString("replication-primary-tls-cert")
+	if err != nil {
+		return nil, err
+	}
+
+	ret.ReplicationSettings.PrimaryTLSKey, err = condString("replication-primary-tls-key")
+	if err != nil {
+		return nil, err
+	}
+
+	ret.ReplicationSettings.KeyPrefixes, err = condStringList("replication-key-prefix")
+	if err != nil {
+		return nil, err
+	}
+
+	ret.ReplicationSettings.SqlTables, err = condStringList("replication-sql-table")
+	if err != nil {
+		return nil, err
+	}
+
+	ret.ReplicationSettings.LogShipping, err = condBool("replication-log-shipping")
+	if err != nil {
+		return nil, err
+	}
+
 	ret.WriteTxHeaderVersion, err = condUInt32("write-tx-header-version")
 	if err != nil {
 		return nil, err
@@ -542,21 +620,133 @@ func prepareDatabaseNullableSettings(flags *pflag.FlagSet) (*schema.DatabaseNull
 		return nil, err
 	}
 
-	if retentionPeriod != nil || truncationFrequency != nil {
+	compactLiveValues, err := condBool("truncation-compact-live-values")
+	if err != nil {
+		return nil, err
+	}
+
+	compactionMaxBytesPerSec, err := condUInt32("truncation-compaction-rate")
+	if err != nil {
+		return nil, err
+	}
+
+	if retentionPeriod != nil || truncationFrequency != nil || compactLiveValues != nil || compactionMaxBytesPerSec != nil {
 		ret.TruncationSettings = &schema.TruncationNullableSettings{
-			RetentionPeriod:     retentionPeriod,
-			TruncationFrequency: truncationFrequency,
+			RetentionPeriod:          retentionPeriod,
+			TruncationFrequency:      truncationFrequency,
+			CompactLiveValues:        compactLiveValues,
+			CompactionMaxBytesPerSec: compactionMaxBytesPerSec,
 		}
 	}
 
+	ret.CompressionSettings, err = prepareCompressionNullableSettings(flags, condString, condUInt32)
+	if err != nil {
+		return nil, err
+	}
+
 	return ret, nil
 }
 
+func prepareCompressionNullableSettings(
+	flags *pflag.FlagSet,
+	condString func(name string) (*schema.NullableString, error),
+	condUInt32 func(name string) (*schema.NullableUint32, error),
+) (*schema.CompressionNullableSettings, error) {
+	format, err := condString("compression-format")
+	if err != nil {
+		return nil, err
+	}
+
+	level, err := condUInt32("compression-level")
+	if err != nil {
+		return nil, err
+	}
+
+	var dictionary []byte
+
+	if flags.Changed("compression-dictionary") {
+		dictFile, err := flags.GetString("compression-dictionary")
+		if err != nil {
+			return nil, err
+		}
+
+		dictionary, err = os.ReadFile(dictFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if flags.Changed("compression-dictionary-samples") {
+		if dictionary != nil {
+			return nil, errors.New("compression-dictionary and compression-dictionary-samples can not be used together")
+		}
+
+		samplesDir, err := flags.GetString("compression-dictionary-samples")
+		if err != nil {
+			return nil, err
+		}
+
+		dictSize, err := flags.GetUint32("compression-dictionary-size")
+		if err != nil {
+			return nil, err
+		}
+
+		dictionary, err = trainCompressionDictionary(samplesDir, int(dictSize))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if format == nil && level == nil && dictionary == nil {
+		return nil, nil
+	}
+
+	return &schema.CompressionNullableSettings{
+		Format:     format,
+		Level:      level,
+		Dictionary: dictionary,
+	}, nil
+}
+
+// trainCompressionDictionary trains a dictionary using the content of each file in the directory as a sample
+func trainCompressionDictionary(samplesDir string, dictSize int) ([]byte, error) {
+	entries, err := os.ReadDir(samplesDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var samples [][]byte
+
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+
+		sample, err := os.ReadFile(filepath.Join(samplesDir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		samples = append(samples, sample)
+	}
+
+	dictionary, err := appendable.TrainDictionary(samples, dictSize)
+	if err != nil {
+		return nil, fmt.Errorf("unable to train compression dictionary: %w", err)
+	}
+
+	return dictionary, nil
+}
+
 func databaseNullableSettingsStr(settings *schema.DatabaseNullableSettings) string {
 	propertiesStr := []string{}
 
 	if settings.ReplicationSettings != nil {
 		propertiesStr = append(propertiesStr, fmt.Sprintf("replica: %v", settings.ReplicationSettings.Replica.GetValue()))
+
+		if settings.ReplicationSettings.PrimaryTLS != nil {
+			propertiesStr = append(propertiesStr, fmt.Sprintf("replication-primary-tls: %v", settings.ReplicationSettings.PrimaryTLS.GetValue()))
+		}
 	}
 
 	if settings.ExcludeCommitTime != nil {
@@ -571,6 +761,20 @@ func databaseNullableSettingsStr(settings *schema.DatabaseNullableSettings) stri
 		propertiesStr = append(propertiesStr, fmt.Sprintf("prealloc-files: %v", settings.PreallocFiles.GetValue()))
 	}
 
+	if settings.CompressionSettings != nil {
+		if settings.CompressionSettings.Format != nil {
+			propertiesStr = append(propertiesStr, fmt.Sprintf("compression-format: %s", settings.CompressionSettings.Format.GetValue()))
+		}
+
+		if settings.CompressionSettings.Level != nil {
+			propertiesStr = append(propertiesStr, fmt.Sprintf("compression-level: %d", settings.CompressionSettings.Level.GetValue()))
+		}
+
+		if len(settings.CompressionSettings.Dictionary) > 0 {
+			propertiesStr = append(propertiesStr, fmt.Sprintf("compression-dictionary-size: %d", len(settings.CompressionSettings.Dictionary)))
+		}
+	}
+
 	if settings.WriteTxHeaderVersion != nil {
 		propertiesStr = append(propertiesStr, fmt.Sprintf("write-tx-header-version: %d", settings.WriteTxHeaderVersion.GetValue()))
 	}
@@ -606,6 +810,14 @@ func databaseNullableSettingsStr(settings *schema.DatabaseNullableSettings) stri
 			freq := time.Duration(settings.TruncationSettings.GetTruncationFrequency().GetValue()) * time.Millisecond
 			propertiesStr = append(propertiesStr, fmt.Sprintf("truncation-frequency: %v", freq))
 		}
+
+		if settings.TruncationSettings.CompactLiveValues != nil {
+			propertiesStr = append(propertiesStr, fmt.Sprintf("truncation-compact-live-values: %v", settings.TruncationSettings.GetCompactLiveValues().GetValue()))
+		}
+
+		if settings.TruncationSettings.CompactionMaxBytesPerSec != nil {
+			propertiesStr = append(propertiesStr, fmt.Sprintf("truncation-compaction-rate: %d", settings.TruncationSettings.GetCompactionMaxBytesPerSec().GetValue()))
+		}
 	}
 
 	return strings.Join(propertiesStr, ", ")
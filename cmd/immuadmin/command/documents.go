@@ -0,0 +1,197 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immuadmin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/codenotary/immudb/pkg/api/protomodel"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+func (cl *commandline) documentsExport() *cobra.Command {
+	ccmd := &cobra.Command{
+		Use:               "export",
+		Short:             "Export the documents of a collection as newline-delimited JSON",
+		Example:           "export {database_name} {collection_name} --output {file} --tx {transaction_id}",
+		PersistentPreRunE: cl.ConfigChain(cl.connect),
+		PersistentPostRun: cl.disconnect,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			output, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+
+			txID, err := cmd.Flags().GetUint64("tx")
+			if err != nil {
+				return err
+			}
+
+			ctx, err := cl.databaseContext(args[0])
+			if err != nil {
+				return err
+			}
+
+			w := io.Writer(cmd.OutOrStdout())
+
+			if output != "-" {
+				f, err := os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+
+				w = f
+			}
+
+			exportedTxID, err := exportDocuments(ctx, cl.immuClient.GetDocumentServiceClient(), args[1], txID, w)
+			if err != nil {
+				return err
+			}
+
+			if output != "-" {
+				fmt.Fprintf(cmd.OutOrStdout(), "collection '%s' successfully exported as of transaction %d\n", args[1], exportedTxID)
+			}
+			return nil
+		},
+		Args: cobra.ExactArgs(2),
+	}
+	ccmd.Flags().StringP("output", "o", "-", "output file, \"-\" for stdout")
+	ccmd.Flags().Uint64("tx", 0, "export documents as of this transaction, the last committed one if not specified")
+
+	return ccmd
+}
+
+func (cl *commandline) documentsImport() *cobra.Command {
+	ccmd := &cobra.Command{
+		Use:               "import",
+		Short:             "Import newline-delimited JSON documents into a collection",
+		Example:           "import {database_name} {collection_name} --input {file}",
+		PersistentPreRunE: cl.ConfigChain(cl.connect),
+		PersistentPostRun: cl.disconnect,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			input, err := cmd.Flags().GetString("input")
+			if err != nil {
+				return err
+			}
+
+			ctx, err := cl.databaseContext(args[0])
+			if err != nil {
+				return err
+			}
+
+			r := io.Reader(cmd.InOrStdin())
+
+			if input != "-" {
+				f, err := os.Open(input)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+
+				r = f
+			}
+
+			res, err := importDocuments(ctx, cl.immuClient.GetDocumentServiceClient(), args[1], r, cl.options.StreamChunkSize)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%d documents successfully imported into collection '%s' (transactions %d to %d)\n",
+				res.DocumentCount, args[1], res.FirstTransactionId, res.LastTransactionId)
+			return nil
+		},
+		Args: cobra.ExactArgs(2),
+	}
+	ccmd.Flags().StringP("input", "i", "-", "input file, \"-\" for stdin")
+
+	return ccmd
+}
+
+func (cl *commandline) databaseContext(database string) (context.Context, error) {
+	udr, err := cl.immuClient.UseDatabase(cl.context, &schema.Database{DatabaseName: database})
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata.NewOutgoingContext(cl.context, metadata.Pairs("authorization", udr.GetToken())), nil
+}
+
+func exportDocuments(ctx context.Context, client protomodel.DocumentServiceClient, collectionName string, txID uint64, w io.Writer) (uint64, error) {
+	stream, err := client.ExportDocuments(ctx, &protomodel.ExportDocumentsRequest{
+		CollectionName: collectionName,
+		TransactionId:  txID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return txID, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		txID = res.TransactionId
+
+		_, err = w.Write(res.Ndjson)
+		if err != nil {
+			return 0, err
+		}
+	}
+}
+
+func importDocuments(ctx context.Context, client protomodel.DocumentServiceClient, collectionName string, r io.Reader, chunkSize int) (*protomodel.ImportDocumentsResponse, error) {
+	stream, err := client.ImportDocuments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stream.Send(&protomodel.ImportDocumentsRequest{CollectionName: collectionName})
+	if err != nil {
+		return nil, err
+	}
+
+	chunk := make([]byte, chunkSize)
+
+	for {
+		n, err := r.Read(chunk)
+		if n > 0 {
+			serr := stream.Send(&protomodel.ImportDocumentsRequest{Ndjson: chunk[:n]})
+			if serr != nil {
+				return nil, serr
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return stream.CloseAndRecv()
+}
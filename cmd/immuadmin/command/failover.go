@@ -0,0 +1,226 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immuadmin
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/codenotary/immudb/pkg/logger"
+	"github.com/codenotary/immudb/pkg/replication"
+	"github.com/spf13/cobra"
+)
+
+func (cl *commandline) databaseFailover() *cobra.Command {
+	ccmd := &cobra.Command{
+		Use:   "failover",
+		Short: "Promote the most up-to-date replica of a failed primary and repoint the remaining replicas to it",
+		Long: `Promote the most up-to-date replica of a failed primary and repoint the remaining replicas to it.
+
+The failed primary is fenced when still reachable. Replicas stop replicating from it before their state is compared,
+the replica with the highest precommitted transaction is promoted and precommitted transactions not confirmed
+by a quorum of replicas are discarded. Replicas and the fenced primary are then repointed to the promoted database.
+
+The promoted database starts a new replication epoch. A failed primary that was not reachable holds writes after
+restarting, until enough replicas of its own epoch are connected, and it's fenced as soon as a replica of the new
+epoch connects to it.
+
+Nodes are specified as database@host:port, the same credentials are used to connect to every node.`,
+		Example:           "failover --primary defaultdb@primary:3322 --replica replicadb@replica1:3322 --replica replicadb@replica2:3322 --sync-acks 1",
+		PersistentPreRunE: cl.ConfigChain(nil),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := cl.failoverOptions(cmd)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+
+			coordinator, err := replication.NewFailoverCoordinator(opts, logger.NewSimpleLogger("immuadmin", out))
+			if err != nil {
+				return err
+			}
+
+			report, err := coordinator.Run(cl.context)
+			printFailoverReport(out, report)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(out, "failover successfully completed\n")
+			return nil
+		},
+		Args: cobra.ExactArgs(0),
+	}
+	ccmd.Flags().String("primary", "", "failed primary database as database@host:port, fenced if still reachable")
+	ccmd.Flags().StringArray("replica", nil, "replica database as database@host:port (can be specified multiple times)")
+	ccmd.Flags().Uint32("sync-acks", 0, "number of replica acknowledgements the failed primary required before committing")
+	ccmd.Flags().String("username", "immudb", "username used to connect to every node")
+	ccmd.Flags().String("replication-username", "", "username used by replicas to connect to the promoted database (current replication credentials are kept when not specified)")
+	ccmd.Flags().String("replication-password", "", "password used by replicas to connect to the promoted database")
+	ccmd.Flags().Bool("replication-tls", false, "enable TLS on the connections of replicas to the promoted database (TLS settings used by the promoted replica are kept when not specified)")
+	ccmd.Flags().String("replication-tls-server-name", "", "server name used by replicas to verify the certificate of the promoted database (default is its host)")
+	ccmd.Flags().String("replication-tls-ca-cert", "", "CA certificate chain used by replicas to verify the certificate of the promoted database")
+	ccmd.Flags().String("replication-tls-cert", "", "client certificate presented by replicas to the promoted database (mTLS)")
+	ccmd.Flags().String("replication-tls-key", "", "private key of the client certificate presented by replicas to the promoted database (mTLS)")
+	ccmd.Flags().Duration("connect-timeout", replication.DefaultFailoverConnectTimeout, "time to wait for a node before considering it unreachable")
+	ccmd.Flags().Bool("force", false, "promote a replica even when committed transactions can not be determined (committed transactions may be lost)")
+
+	return ccmd
+}
+
+func (cl *commandline) failoverOptions(cmd *cobra.Command) (*replication.FailoverOptions, error) {
+	opts := replication.DefaultFailoverOptions().
+		WithMTLs(cl.options.MTLs).
+		WithMTLsOptions(cl.options.MTLsOptions)
+
+	primary, err := cmd.Flags().GetString("primary")
+	if err != nil {
+		return nil, err
+	}
+
+	if primary != "" {
+		node, err := replication.ParseFailoverNode(primary)
+		if err != nil {
+			return nil, err
+		}
+
+		opts.WithPrimary(node)
+	}
+
+	replicas, err := cmd.Flags().GetStringArray("replica")
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []*replication.FailoverNode
+
+	for _, r := range replicas {
+		node, err := replication.ParseFailoverNode(r)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	opts.WithReplicas(nodes)
+
+	syncAcks, err := cmd.Flags().GetUint32("sync-acks")
+	if err != nil {
+		return nil, err
+	}
+	opts.WithSyncAcks(int(syncAcks))
+
+	username, err := cmd.Flags().GetString("username")
+	if err != nil {
+		return nil, err
+	}
+
+	pass, err := cl.passwordReader.Read("Password:")
+	if err != nil {
+		return nil, err
+	}
+
+	opts.WithUsername(username).WithPassword(string(pass))
+
+	replicationUsername, err := cmd.Flags().GetString("replication-username")
+	if err != nil {
+		return nil, err
+	}
+
+	replicationPassword, err := cmd.Flags().GetString("replication-password")
+	if err != nil {
+		return nil, err
+	}
+
+	opts.WithReplicationUsername(replicationUsername).WithReplicationPassword(replicationPassword)
+
+	replicationTLS, err := cmd.Flags().GetBool("replication-tls")
+	if err != nil {
+		return nil, err
+	}
+
+	replicationTLSServerName, err := cmd.Flags().GetString("replication-tls-server-name")
+	if err != nil {
+		return nil, err
+	}
+
+	replicationTLSCACert, err := cmd.Flags().GetString("replication-tls-ca-cert")
+	if err != nil {
+		return nil, err
+	}
+
+	replicationTLSCert, err := cmd.Flags().GetString("replication-tls-cert")
+	if err != nil {
+		return nil, err
+	}
+
+	replicationTLSKey, err := cmd.Flags().GetString("replication-tls-key")
+	if err != nil {
+		return nil, err
+	}
+
+	opts.WithReplicationTLS(replicationTLS).
+		WithReplicationTLSServerName(replicationTLSServerName).
+		WithReplicationTLSCACert(replicationTLSCACert).
+		WithReplicationTLSCert(replicationTLSCert).
+		WithReplicationTLSKey(replicationTLSKey)
+
+	connectTimeout, err := cmd.Flags().GetDuration("connect-timeout")
+	if err != nil {
+		return nil, err
+	}
+	opts.WithConnectTimeout(connectTimeout)
+
+	force, err := cmd.Flags().GetBool("force")
+	if err != nil {
+		return nil, err
+	}
+	opts.WithForce(force)
+
+	return opts, opts.Validate()
+}
+
+func printFailoverReport(out io.Writer, report *replication.FailoverReport) {
+	if report == nil {
+		return
+	}
+
+	if report.PrimaryFenced {
+		fmt.Fprintf(out, "primary fenced\n")
+	}
+
+	for _, n := range report.Unreachable {
+		fmt.Fprintf(out, "unreachable:\t%s\n", n)
+	}
+
+	if report.NewPrimary != nil {
+		fmt.Fprintf(out, "new primary:\t%s\n", report.NewPrimary)
+		fmt.Fprintf(out, "committed tx:\t%d\n", report.CommittedTxID)
+		fmt.Fprintf(out, "discarded txs:\t%d\n", report.DiscardedTxs)
+		fmt.Fprintf(out, "epoch:\t\t%d\n", report.ReplicationEpoch)
+	}
+
+	for _, n := range report.Repointed {
+		fmt.Fprintf(out, "repointed:\t%s\n", n)
+	}
+
+	for _, n := range report.Failed {
+		fmt.Fprintf(out, "failed:\t\t%s\n", n)
+	}
+}
@@ -71,6 +71,7 @@ func newCommandlineHotBck(os immuos.OS) (*commandlineHotBck, error) {
 func (clb *commandlineHotBck) Register(rootCmd *cobra.Command) *cobra.Command {
 	clb.hotBackup(rootCmd)
 	clb.hotRestore(rootCmd)
+	clb.remoteBackup(rootCmd)
 	return rootCmd
 }
 
@@ -0,0 +1,358 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immuadmin
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/codenotary/immudb/embedded/remotestorage"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/backup"
+	"github.com/codenotary/immudb/pkg/server"
+	"github.com/codenotary/immudb/pkg/signer"
+	"github.com/codenotary/immudb/pkg/stream"
+)
+
+type remoteBackupParams struct {
+	url         string
+	accessKeyID string
+	secretKey   string
+	publicKey   *ecdsa.PublicKey
+}
+
+type remoteRestoreParams struct {
+	sourceDb string
+	toTx     uint64
+	toTime   time.Time
+	append   bool
+	replica  bool
+}
+
+func (cl *commandlineHotBck) remoteBackup(cmd *cobra.Command) {
+	ccmd := &cobra.Command{
+		Use:   "remote-backup",
+		Short: "Inspect and restore backups uploaded by the server to remote storage",
+		Long: "Inspect, verify and restore incremental backups periodically uploaded by the server " +
+			"to the remote storage set with the --backup-url server option.",
+	}
+	ccmd.PersistentFlags().String("url", "", "backup storage url, e.g. s3://bucket/prefix?endpoint=http://localhost:9000 or file:///mnt/backups")
+	ccmd.PersistentFlags().String("access-key-id", "", "backup storage access key id")
+	ccmd.PersistentFlags().String("secret-key", "", "backup storage secret key")
+	ccmd.PersistentFlags().String("public-key", "", "public key file used to verify the signature of backed up states (signatures are not checked when empty)")
+
+	cl.remoteBackupList(ccmd)
+	cl.remoteBackupVerify(ccmd)
+	cl.remoteBackupRestore(ccmd)
+
+	cmd.AddCommand(ccmd)
+	cl.cmd = cmd
+}
+
+func (cl *commandlineHotBck) remoteBackupList(cmd *cobra.Command) {
+	ccmd := &cobra.Command{
+		Use:   "list <db_name>",
+		Short: "List backup segments of a database",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			params, err := prepareRemoteBackupParams(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			storage, err := server.OpenRemoteStorageURL(params.url, params.accessKeyID, params.secretKey)
+			if err != nil {
+				return err
+			}
+
+			catalog, err := backup.ReadCatalog(cl.context, storage, args[0])
+			if err != nil {
+				return err
+			}
+
+			printCatalog(cmd, catalog)
+			return nil
+		},
+		Args: cobra.ExactArgs(1),
+	}
+	cmd.AddCommand(ccmd)
+}
+
+func (cl *commandlineHotBck) remoteBackupVerify(cmd *cobra.Command) {
+	ccmd := &cobra.Command{
+		Use:   "verify <db_name>",
+		Short: "Verify all backup segments of a database",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			params, err := prepareRemoteBackupParams(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			storage, err := server.OpenRemoteStorageURL(params.url, params.accessKeyID, params.secretKey)
+			if err != nil {
+				return err
+			}
+
+			catalog, err := backup.Verify(cl.context, storage, args[0], params.publicKey)
+			if err != nil {
+				return err
+			}
+
+			if len(catalog.Segments) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "No backup found for database '%s'\n", args[0])
+				return nil
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Backup of database '%s' verified, it contains transactions from 1 to %d\n",
+				args[0], catalog.LastTx())
+			return nil
+		},
+		Args: cobra.ExactArgs(1),
+	}
+	cmd.AddCommand(ccmd)
+}
+
+func (cl *commandlineHotBck) remoteBackupRestore(cmd *cobra.Command) {
+	ccmd := &cobra.Command{
+		Use:   "restore <db_name>",
+		Short: "Restore a database from remote backup segments",
+		Long: "Restore backed up transactions into a database without stopping the database engine. " +
+			"Every backup segment is verified before its transactions are restored. " +
+			"Restore can stop at a given transaction or point in time.",
+		PersistentPreRunE: cl.ConfigChain(cl.connect),
+		PersistentPostRun: cl.disconnect,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			params, err := prepareRemoteBackupParams(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			restoreParams, err := prepareRemoteRestoreParams(cmd.Flags(), args[0])
+			if err != nil {
+				return err
+			}
+
+			storage, err := server.OpenRemoteStorageURL(params.url, params.accessKeyID, params.secretKey)
+			if err != nil {
+				return err
+			}
+
+			return cl.runRemoteRestore(cmd, storage, args[0], params, restoreParams)
+		},
+		Args: cobra.ExactArgs(1),
+	}
+	ccmd.Flags().String("source-db", "", "name of the backed up database (defaults to the restored database)")
+	ccmd.Flags().Uint64("to-tx", 0, "restore transactions up to the given one (all backed up transactions are restored when zero)")
+	ccmd.Flags().String("to-time", "", "restore transactions committed up to the given time (RFC3339 format, e.g. 2023-01-02T15:04:05Z)")
+	ccmd.Flags().Bool("append", false, "appending to DB, if it already exists")
+	ccmd.Flags().Bool("force-replica", false, "switch database to replica mode for the duration of restore")
+	cmd.AddCommand(ccmd)
+}
+
+func prepareRemoteBackupParams(flags *pflag.FlagSet) (*remoteBackupParams, error) {
+	var params remoteBackupParams
+	var err error
+
+	params.url, err = flags.GetString("url")
+	if err != nil {
+		return nil, err
+	}
+	if params.url == "" {
+		return nil, errors.New("backup storage url must be specified with --url")
+	}
+	params.accessKeyID, err = flags.GetString("access-key-id")
+	if err != nil {
+		return nil, err
+	}
+	params.secretKey, err = flags.GetString("secret-key")
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeyFile, err := flags.GetString("public-key")
+	if err != nil {
+		return nil, err
+	}
+	if publicKeyFile != "" {
+		params.publicKey, err = signer.ParsePublicKeyFile(publicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &params, nil
+}
+
+func prepareRemoteRestoreParams(flags *pflag.FlagSet, dbName string) (*remoteRestoreParams, error) {
+	var params remoteRestoreParams
+	var err error
+
+	params.sourceDb, err = flags.GetString("source-db")
+	if err != nil {
+		return nil, err
+	}
+	if params.sourceDb == "" {
+		params.sourceDb = dbName
+	}
+	params.toTx, err = flags.GetUint64("to-tx")
+	if err != nil {
+		return nil, err
+	}
+
+	toTime, err := flags.GetString("to-time")
+	if err != nil {
+		return nil, err
+	}
+	if toTime != "" {
+		params.toTime, err = time.Parse(time.RFC3339, toTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --to-time value: %w", err)
+		}
+	}
+
+	if params.toTx > 0 && !params.toTime.IsZero() {
+		return nil, errors.New("don't use --to-tx and --to-time options together")
+	}
+
+	params.append, err = flags.GetBool("append")
+	if err != nil {
+		return nil, err
+	}
+	params.replica, err = flags.GetBool("force-replica")
+	if err != nil {
+		return nil, err
+	}
+
+	return &params, nil
+}
+
+func (cl *commandlineHotBck) runRemoteRestore(
+	cmd *cobra.Command,
+	storage remotestorage.Storage,
+	dbName string,
+	params *remoteBackupParams,
+	restoreParams *remoteRestoreParams,
+) (err error) {
+
+	opts := backup.DefaultRestoreOptions().
+		WithToTx(restoreParams.toTx).
+		WithToTime(restoreParams.toTime).
+		WithPublicKey(params.publicKey)
+
+	dbExist, err := cl.isDbExists(dbName)
+	if err != nil {
+		return err
+	}
+
+	replica := restoreParams.replica
+
+	var firstTx uint64 = 1
+
+	if dbExist {
+		lastTx, checksum, err := cl.useDb(dbName, replica)
+		if err != nil {
+			return err
+		}
+
+		if lastTx > 0 {
+			if !restoreParams.append {
+				return errors.New("cannot restore to non-empty database without --append flag")
+			}
+
+			var alh [sha256.Size]byte
+			copy(alh[:], checksum)
+
+			opts.WithLastTx(lastTx, alh)
+			firstTx = lastTx + 1
+		}
+	} else {
+		// db does not exist - create as replica and use it
+		err = cl.createDb(dbName)
+		if err != nil {
+			return err
+		}
+		replica = true
+	}
+
+	if replica {
+		defer func() {
+			err := cl.immuClient.UpdateDatabase(cl.context, &schema.DatabaseSettings{DatabaseName: dbName, Replica: false})
+			if err != nil {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Error switching off replica mode for db: %v", err)
+			}
+		}()
+	}
+
+	lastTx, err := backup.Restore(cl.context, storage, restoreParams.sourceDb, func(ctx context.Context, exportedTx []byte) (*schema.TxHeader, error) {
+		return cl.replicateExportedTx(exportedTx)
+	}, opts)
+	if err != nil {
+		return err
+	}
+
+	if lastTx < firstTx {
+		fmt.Fprintf(cmd.OutOrStdout(), "Target database is up-to-date, nothing restored\n")
+	} else if firstTx == lastTx {
+		fmt.Fprintf(cmd.OutOrStdout(), "Restored transaction %d\n", firstTx)
+	} else {
+		fmt.Fprintf(cmd.OutOrStdout(), "Restored transactions from %d to %d\n", firstTx, lastTx)
+	}
+
+	return nil
+}
+
+// replicateExportedTx streams an exported transaction to the database currently in use
+func (cl *commandlineHotBck) replicateExportedTx(exportedTx []byte) (*schema.TxHeader, error) {
+	replicateStream, err := cl.immuClient.ReplicateTx(cl.context)
+	if err != nil {
+		return nil, err
+	}
+
+	sender := stream.NewMsgSender(replicateStream, cl.options.StreamChunkSize)
+
+	err = sender.Send(bytes.NewReader(exportedTx), len(exportedTx), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return replicateStream.CloseAndRecv()
+}
+
+func printCatalog(cmd *cobra.Command, catalog *backup.Catalog) {
+	if len(catalog.Segments) == 0 {
+		fmt.Fprintf(cmd.OutOrStdout(), "No backup found for database '%s'\n", catalog.Database)
+		return
+	}
+
+	for _, seg := range catalog.Segments {
+		fmt.Fprintf(cmd.OutOrStdout(), "%s\ttxs %d..%d\t%s..%s\tsigned: %v\n",
+			seg.Name,
+			seg.FirstTx,
+			seg.LastTx,
+			time.Unix(seg.FirstTs, 0).UTC().Format(time.RFC3339),
+			time.Unix(seg.LastTs, 0).UTC().Format(time.RFC3339),
+			seg.Signed,
+		)
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immuadmin
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codenotary/immudb/cmd/helper"
+	"github.com/codenotary/immudb/pkg/backup"
+	"github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/server"
+	"github.com/codenotary/immudb/pkg/server/servertest"
+)
+
+func TestRemoteBackupRestore(t *testing.T) {
+	backupURL := "file://" + t.TempDir()
+
+	bs := servertest.NewBufconnServer(server.
+		DefaultOptions().
+		WithDir(t.TempDir()).
+		WithBackupOptions(server.DefaultBackupOptions().
+			WithURL(backupURL).
+			WithFrequency(100 * time.Millisecond).
+			WithMaxSegmentTxs(4),
+		),
+	)
+
+	bs.Start()
+	t.Cleanup(func() { bs.Stop() })
+
+	immuClient, err := bs.NewAuthenticatedClient(client.
+		DefaultOptions().
+		WithDir(t.TempDir()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { immuClient.CloseSession(context.Background()) })
+
+	cl := commandlineHotBck{}
+	cmd, _ := cl.NewCmd()
+
+	cmdl := commandlineHotBck{commandline: commandline{
+		config:     helper.Config{Name: "immuadmin"},
+		options:    immuClient.GetOptions(),
+		immuClient: immuClient,
+		context:    context.Background(),
+	}}
+	cmdl.hotRestore(cmd)
+	cmdl.remoteBackup(cmd)
+
+	output := bytes.NewBufferString("")
+	cmd.SetOut(output)
+	cmd.SetErr(output)
+
+	// disable connects/disconnects, cmd already contains connected immudb client
+	cmd.Commands()[0].PersistentPreRunE = nil
+	cmd.Commands()[0].PersistentPostRun = nil
+	for _, c := range cmd.Commands()[1].Commands() {
+		c.PersistentPreRunE = nil
+		c.PersistentPostRun = nil
+	}
+
+	cmd.SetArgs([]string{"hot-restore", "test1", "-i", "testdata/1-10.backup"})
+	err = cmd.Execute()
+	require.NoError(t, err)
+
+	storage, err := server.OpenRemoteStorageURL(backupURL, "", "")
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		catalog, err := backup.ReadCatalog(context.Background(), storage, "test1")
+		return err == nil && catalog.LastTx() == 10
+	}, 10*time.Second, 50*time.Millisecond)
+
+	_, err = ioutil.ReadAll(output)
+	require.NoError(t, err)
+
+	t.Run("list and verify", func(t *testing.T) {
+		cmd.SetArgs([]string{"remote-backup", "list", "test1", "--url", backupURL})
+		err := cmd.Execute()
+		require.NoError(t, err)
+
+		out, err := ioutil.ReadAll(output)
+		require.NoError(t, err)
+		assert.Contains(t, string(out), "txs 1..4")
+		assert.Contains(t, string(out), "txs 9..10")
+
+		cmd.SetArgs([]string{"remote-backup", "verify", "test1", "--url", backupURL})
+		err = cmd.Execute()
+		require.NoError(t, err)
+
+		out, err = ioutil.ReadAll(output)
+		require.NoError(t, err)
+		assert.Contains(t, string(out), "contains transactions from 1 to 10")
+	})
+
+	t.Run("point-in-time restore", func(t *testing.T) {
+		cmd.SetArgs([]string{"remote-backup", "restore", "test2", "--url", backupURL, "--source-db", "test1", "--to-tx", "6"})
+		err := cmd.Execute()
+		require.NoError(t, err)
+
+		out, err := ioutil.ReadAll(output)
+		require.NoError(t, err)
+		assert.Contains(t, string(out), "Restored transactions from 1 to 6")
+
+		// flag values are kept across executions of the same command
+		cmd.SetArgs([]string{"remote-backup", "restore", "test2", "--url", backupURL, "--source-db", "test1", "--to-tx", "0"})
+		err = cmd.Execute()
+		require.Error(t, err)
+
+		out, err = ioutil.ReadAll(output)
+		require.NoError(t, err)
+		assert.Contains(t, string(out), "Error: cannot restore to non-empty database without --append flag")
+
+		cmd.SetArgs([]string{"remote-backup", "restore", "test2", "--url", backupURL, "--source-db", "test1", "--to-tx", "0", "--append", "--force-replica"})
+		err = cmd.Execute()
+		require.NoError(t, err)
+
+		out, err = ioutil.ReadAll(output)
+		require.NoError(t, err)
+		assert.Contains(t, string(out), "Restored transactions from 7 to 10")
+
+		cmd.SetArgs([]string{"remote-backup", "restore", "test2", "--url", backupURL, "--source-db", "test1", "--to-tx", "0", "--append", "--force-replica"})
+		err = cmd.Execute()
+		require.NoError(t, err)
+
+		out, err = ioutil.ReadAll(output)
+		require.NoError(t, err)
+		assert.Contains(t, string(out), "Target database is up-to-date, nothing restored")
+	})
+
+	t.Run("restore beyond the backup", func(t *testing.T) {
+		cmd.SetArgs([]string{"remote-backup", "restore", "test3", "--url", backupURL, "--source-db", "test1", "--to-tx", "11"})
+		err := cmd.Execute()
+		require.ErrorIs(t, err, backup.ErrTxNotBackedUp)
+	})
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immuadmin
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	c "github.com/codenotary/immudb/cmd/helper"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/spf13/cobra"
+)
+
+func (cl *commandline) replication(cmd *cobra.Command) {
+	replicationCmd := &cobra.Command{
+		Use:               "replication",
+		Short:             "Issue all replication commands",
+		Aliases:           []string{"r"},
+		PersistentPostRun: cl.disconnect,
+		ValidArgs:         []string{"status"},
+	}
+
+	statusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the replication status of a database",
+		Long: `Show the replication status of a database.
+
+Replicas fetching transactions from the database are listed along with the last acknowledged transaction,
+the lag in transactions and seconds and the connection time.
+When the database is a replica, its primary, the replication state and the last replication error are shown.`,
+		Example:           "status {database_name}",
+		PersistentPreRunE: cl.ConfigChain(cl.connect),
+		PersistentPostRun: cl.disconnect,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			res, err := cl.immuClient.ReplicationStatus(cl.context, args[0])
+			if err != nil {
+				return err
+			}
+
+			printReplicationStatus(cmd.OutOrStdout(), res)
+			return nil
+		},
+		Args: cobra.ExactArgs(1),
+	}
+
+	replicationCmd.AddCommand(statusCmd)
+
+	cmd.AddCommand(replicationCmd)
+}
+
+func printReplicationStatus(out io.Writer, res *schema.ReplicationStatusResponse) {
+	fmt.Fprintf(out, "database:\t%s\n", res.Database)
+	fmt.Fprintf(out, "committed tx:\t%d\n", res.CommittedTxID)
+
+	if res.Replica && res.PrimaryStatus != nil {
+		st := res.PrimaryStatus
+
+		fmt.Fprintf(out, "primary:\t%s\n", st.Primary)
+		fmt.Fprintf(out, "state:\t\t%s\n", st.State)
+
+		if st.PrimaryCommittedTxID > 0 {
+			fmt.Fprintf(out, "primary tx:\t%d\n", st.PrimaryCommittedTxID)
+		}
+
+		if st.LastError != "" {
+			fmt.Fprintf(out, "last error:\t%s (%s)\n", st.LastError, formatUnixTime(st.LastErrorAt))
+		}
+	}
+
+	fmt.Fprintln(out)
+
+	c.PrintTable(
+		out,
+		[]string{"Replica", "Address", "Last Ack Tx", "Lag (txs)", "Lag (s)", "Connected At", "Mode"},
+		len(res.Replicas),
+		func(i int) []string {
+			r := res.Replicas[i]
+
+			name := r.Database
+			if r.UUID != "" {
+				name = fmt.Sprintf("%s@%s", r.Database, r.UUID)
+			}
+
+			mode := "async"
+			if r.SyncReplication {
+				mode = "sync"
+			}
+			if r.Partial {
+				mode += ", partial"
+			}
+
+			return []string{
+				name,
+				r.Address,
+				strconv.FormatUint(r.LastAckTxID, 10),
+				strconv.FormatUint(r.LagTxs, 10),
+				strconv.FormatFloat(r.LagSeconds, 'f', 1, 64),
+				formatUnixTime(r.ConnectedAt),
+				mode,
+			}
+		},
+		fmt.Sprintf("%d replica(s)", len(res.Replicas)),
+	)
+}
+
+func formatUnixTime(ts int64) string {
+	if ts == 0 {
+		return "-"
+	}
+
+	return time.Unix(ts, 0).Format(time.RFC3339)
+}
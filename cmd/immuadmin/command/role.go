@@ -0,0 +1,199 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immuadmin
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	c "github.com/codenotary/immudb/cmd/helper"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/spf13/cobra"
+)
+
+func (cl *commandline) role(cmd *cobra.Command) {
+	roleCmd := &cobra.Command{
+		Use:               "role command",
+		Short:             "Issue all role commands",
+		PersistentPreRunE: cl.ConfigChain(cl.connect),
+		PersistentPostRun: cl.disconnect,
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List all roles",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			roles, err := cl.immuClient.ListRoles(cl.context)
+			if err != nil {
+				return err
+			}
+
+			printRoles(cmd.OutOrStdout(), roles)
+			return nil
+		},
+		Args: cobra.NoArgs,
+	}
+
+	createCmd := &cobra.Command{
+		Use:   "create {role_name} {privilege}...",
+		Short: "Create a new role",
+		Long: `Create a new role granting the given privileges.
+
+Available privileges are read-kv, write-kv, sql-ddl, sql-select, sql-dml, read-documents,
+write-documents, manage-collections and admin.
+sql-select and sql-dml can be restricted to some tables with {privilege}:{table},{table}...`,
+		Example: `immuadmin role create reader read-kv sql-select
+immuadmin role create orders_writer sql-select sql-dml:orders,order_lines`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			privileges, err := parseRolePrivileges(args[1:])
+			if err != nil {
+				return err
+			}
+
+			err = cl.immuClient.CreateRole(cl.context, args[0], privileges)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "role '%s' successfully created\n", args[0])
+			return nil
+		},
+		Args: cobra.MinimumNArgs(2),
+	}
+
+	deleteCmd := &cobra.Command{
+		Use:     "delete {role_name}",
+		Short:   "Delete a role which is not granted to any user",
+		Example: "immuadmin role delete reader",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cl.immuClient.DeleteRole(cl.context, args[0])
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "role '%s' successfully deleted\n", args[0])
+			return nil
+		},
+		Args: cobra.ExactArgs(1),
+	}
+
+	grantCmd := &cobra.Command{
+		Use:     "grant {role_name} {username} {database}",
+		Short:   "Grant a role to a user on a database",
+		Example: "immuadmin role grant reader user1 mydb",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cl.immuClient.ChangeRole(cl.context, schema.PermissionAction_GRANT, args[1], args[2], args[0])
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "role '%s' granted to user '%s' on database '%s'\n", args[0], args[1], args[2])
+			return nil
+		},
+		Args: cobra.ExactArgs(3),
+	}
+
+	revokeCmd := &cobra.Command{
+		Use:     "revoke {role_name} {username} {database}",
+		Short:   "Revoke a role from a user on a database",
+		Example: "immuadmin role revoke reader user1 mydb",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cl.immuClient.ChangeRole(cl.context, schema.PermissionAction_REVOKE, args[1], args[2], args[0])
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "role '%s' revoked from user '%s' on database '%s'\n", args[0], args[1], args[2])
+			return nil
+		},
+		Args: cobra.ExactArgs(3),
+	}
+
+	roleCmd.AddCommand(listCmd)
+	roleCmd.AddCommand(createCmd)
+	roleCmd.AddCommand(deleteCmd)
+	roleCmd.AddCommand(grantCmd)
+	roleCmd.AddCommand(revokeCmd)
+
+	cmd.AddCommand(roleCmd)
+}
+
+// parseRolePrivileges parses privileges in the form {privilege}[:{table},{table}...]
+func parseRolePrivileges(args []string) ([]*schema.RolePrivilege, error) {
+	privileges := make([]*schema.RolePrivilege, 0, len(args))
+
+	for _, arg := range args {
+		parts := strings.SplitN(arg, ":", 2)
+		if parts[0] == "" {
+			return nil, fmt.Errorf("invalid privilege '%s'", arg)
+		}
+
+		p := &schema.RolePrivilege{Privilege: parts[0]}
+
+		if len(parts) == 2 {
+			for _, t := range strings.Split(parts[1], ",") {
+				if t == "" {
+					return nil, fmt.Errorf("invalid privilege '%s'", arg)
+				}
+				p.Tables = append(p.Tables, t)
+			}
+		}
+
+		privileges = append(privileges, p)
+	}
+
+	return privileges, nil
+}
+
+func printRoles(out io.Writer, roles *schema.RoleList) {
+	type row struct {
+		role, privilege, tables string
+	}
+
+	var rows []row
+
+	for _, r := range roles.Roles {
+		if len(r.Privileges) == 0 {
+			rows = append(rows, row{role: r.Name})
+		}
+
+		for i, p := range r.Privileges {
+			name := ""
+			if i == 0 {
+				name = r.Name
+			}
+
+			tables := "*"
+			if len(p.Tables) > 0 {
+				tables = strings.Join(p.Tables, ", ")
+			}
+
+			rows = append(rows, row{role: name, privilege: p.Privilege, tables: tables})
+		}
+	}
+
+	c.PrintTable(
+		out,
+		[]string{"Role", "Privilege", "Tables"},
+		len(rows),
+		func(i int) []string {
+			return []string{rows[i].role, rows[i].privilege, rows[i].tables}
+		},
+		fmt.Sprintf("%d role(s)", len(roles.Roles)),
+	)
+}
@@ -187,6 +187,14 @@ func (cl *commandline) userList(args []string) (string, error) {
 				updateMaxLen(maxColWidths, row)
 			}
 		}
+		// extra rows for roles granted on each db
+		for _, r := range user.GetRoles() {
+			row := make([]string, 6)
+			row[2] = r.Database
+			row[3] = "role " + r.Role
+			usersAndPermissions = append(usersAndPermissions, row)
+			updateMaxLen(maxColWidths, row)
+		}
 	}
 	var b bytes.Buffer
 	w := bufio.NewWriter(&b)
@@ -44,6 +44,11 @@ func (cl *Commandline) setupFlags(cmd *cobra.Command, options *server.Options) {
 	cmd.Flags().Bool("replication-allow-tx-discarding", options.ReplicationOptions.AllowTxDiscarding, "allow precommitted transactions to be discarded if the replica diverges from the primary")
 	cmd.Flags().Bool("replication-skip-integrity-check", options.ReplicationOptions.SkipIntegrityCheck, "disable integrity check when reading data during replication")
 	cmd.Flags().Bool("replication-wait-for-indexing", options.ReplicationOptions.WaitForIndexing, "wait for indexing to be up to date during replication")
+	cmd.Flags().Bool("replication-primary-tls", false, "enable TLS on the connection to the primary used for replication of systemdb and defaultdb")
+	cmd.Flags().String("replication-primary-tls-server-name", "", "server name used to verify the certificate of the primary (default is the primary host)")
+	cmd.Flags().String("replication-primary-tls-ca-cert", "", "CA certificate chain used to verify the certificate of the primary (default is the host's root CA set)")
+	cmd.Flags().String("replication-primary-tls-cert", "", "client certificate presented to the primary (mTLS)")
+	cmd.Flags().String("replication-primary-tls-key", "", "private key of the client certificate presented to the primary (mTLS)")
 
 	cmd.PersistentFlags().StringVar(&cl.config.CfgFn, "config", "", "config file (default path are configs or $HOME. Default filename is immudb.toml)")
 	cmd.Flags().String("pidfile", options.Pidfile, "pid path with filename e.g. /var/run/immudb.pid")
@@ -79,6 +84,48 @@ func (cl *Commandline) setupFlags(cmd *cobra.Command, options *server.Options) {
 	cmd.Flags().String("s3-location", "", "s3 location (region)")
 	cmd.Flags().String("s3-path-prefix", "", "s3 path prefix (multiple immudb instances can share the same bucket if they have different prefixes)")
 	cmd.Flags().Bool("s3-external-identifier", false, "use the remote identifier if there is no local identifier")
+	cmd.Flags().String("remote-storage-url", "", "remote storage url, the scheme selects the backend, e.g. s3://bucket/prefix?endpoint=http://localhost:9000, azblob://container/prefix, gs://bucket/prefix or file:///mnt/nfs/immudb")
+	cmd.Flags().String("remote-storage-access-key-id", "", "remote storage access key id (s3 access key id or azure account name)")
+	cmd.Flags().String("remote-storage-secret-key", "", "remote storage secret key (s3 secret key, azure account key or gcs access token)")
+	cmd.Flags().Int("remote-storage-local-chunks", 0, "number of most recent data chunks kept on local disk when using remote storage")
+	cmd.Flags().Int64("remote-storage-local-size", 0, "size in bytes of most recent data chunks kept on local disk when using remote storage")
+	cmd.Flags().Int64("remote-storage-cache-size", 0, "size in bytes of the local disk cache for older data chunks fetched from remote storage")
+	cmd.Flags().Int("remote-storage-prefetch-chunks", 0, "number of data chunks fetched ahead from remote storage on sequential reads")
+	cmd.Flags().String("backup-url", "", "remote storage url where incremental backups of databases are periodically uploaded, same format as remote-storage-url (backups are disabled when empty)")
+	cmd.Flags().String("backup-access-key-id", "", "backup storage access key id")
+	cmd.Flags().String("backup-secret-key", "", "backup storage secret key")
+	cmd.Flags().Duration("backup-frequency", options.BackupOptions.Frequency, "frequency of database backups")
+	cmd.Flags().Int("backup-max-segment-txs", options.BackupOptions.MaxSegmentTxs, "maximum number of transactions stored in a single backup segment")
+	cmd.Flags().String("log-shipping-url", "", "remote storage url where databases with log shipping enabled upload their transactions and log shipping replicas fetch them from, same format as remote-storage-url")
+	cmd.Flags().String("log-shipping-access-key-id", "", "log shipping storage access key id")
+	cmd.Flags().String("log-shipping-secret-key", "", "log shipping storage secret key")
+	cmd.Flags().Duration("log-shipping-frequency", options.LogShippingOptions.Frequency, "frequency of transaction uploads and replica polling")
+	cmd.Flags().Int("log-shipping-max-segment-txs", options.LogShippingOptions.MaxSegmentTxs, "maximum number of transactions stored in a single log shipping segment")
+	cmd.Flags().String("encryption-key-file", "", "file holding the keys database files are encrypted with, one <key id>:<hex encoded key> per line where the last one is used for new files (databases created without encryption can not be opened once it's enabled)")
+	cmd.Flags().String("encryption-key-env", "", "environment variable holding a comma separated list of <key id>:<hex encoded key> entries, used when encryption-key-file is not set")
+	cmd.Flags().Int("encryption-block-size", options.EncryptionOptions.BlockSize, "number of bytes encrypted and authenticated together")
+	cmd.Flags().String("jwt-jwks-file", "", "file containing the JSON Web Key Set used to validate externally issued tokens, accepted in place of passwords")
+	cmd.Flags().String("jwt-jwks-url", "", "url the JSON Web Key Set used to validate externally issued tokens is fetched from, e.g. the jwks_uri of an OpenID Connect provider")
+	cmd.Flags().Duration("jwt-jwks-refresh-interval", options.JWTOptions.JWKSRefreshInterval, "interval after which the JSON Web Key Set is fetched again from jwt-jwks-url")
+	cmd.Flags().String("jwt-issuer", "", "expected issuer (iss claim) of externally issued tokens")
+	cmd.Flags().String("jwt-audience", "", "expected audience (aud claim) of externally issued tokens")
+	cmd.Flags().String("jwt-username-claim", options.JWTOptions.UsernameClaim, "token claim holding the immudb username")
+	cmd.Flags().String("jwt-roles-claim", options.JWTOptions.RolesClaim, "token claim holding the groups mapped to permissions and roles")
+	cmd.Flags().String("jwt-role-mappings", "", "comma separated list of group:database:permission entries, where permission is read, readwrite, admin or a custom role (group::sysadmin grants the sysadmin permission)")
+	cmd.Flags().Duration("jwt-leeway", options.JWTOptions.Leeway, "clock skew tolerated when checking the validity period of externally issued tokens")
+	cmd.Flags().String("ldap-url", "", "url of the ldap directory users are authenticated against (ldap://host:389 or ldaps://host:636), users with a local account are only authenticated against the local store")
+	cmd.Flags().Bool("ldap-start-tls", false, "upgrade ldap connections to tls with the StartTLS operation")
+	cmd.Flags().String("ldap-ca-cert", "", "file containing the certificates of the authorities trusted to sign the ldap server certificate")
+	cmd.Flags().Bool("ldap-insecure-skip-verify", false, "skip the verification of the ldap server certificate")
+	cmd.Flags().String("ldap-bind-dn", "", "dn of the service account used to search users and groups, anonymous searches when empty")
+	cmd.Flags().String("ldap-bind-password", "", "password of the ldap service account")
+	cmd.Flags().String("ldap-user-base-dn", "", "base dn of the ldap user entries")
+	cmd.Flags().String("ldap-user-filter", options.LDAPOptions.UserFilter, "ldap filter matching the user entry, %s is replaced by the username")
+	cmd.Flags().String("ldap-group-base-dn", "", "base dn of the ldap group entries, group membership is not looked up when empty")
+	cmd.Flags().String("ldap-group-filter", options.LDAPOptions.GroupFilter, "ldap filter matching the groups of a user, %s is replaced by the user dn")
+	cmd.Flags().String("ldap-group-attribute", options.LDAPOptions.GroupAttribute, "attribute of the ldap group entries holding the group name")
+	cmd.Flags().String("ldap-role-mappings", "", "comma separated list of group:database:permission entries, where permission is read, readwrite, admin or a custom role (group::sysadmin grants the sysadmin permission)")
+	cmd.Flags().Duration("ldap-timeout", options.LDAPOptions.Timeout, "timeout of ldap requests")
 	cmd.Flags().Int("max-sessions", 100, "maximum number of simultaneously opened sessions")
 	cmd.Flags().Duration("max-session-inactivity-time", 3*time.Minute, "max session inactivity time is a duration after which an active session is declared inactive by the server. A session is kept active if server is still receiving requests from client (keep-alive or other methods)")
 	cmd.Flags().Duration("max-session-age-time", 0, "the current default value is infinity. max session age time is a duration after which session will be forcibly closed")
@@ -140,6 +187,48 @@ func setupDefaults(options *server.Options) {
 	viper.SetDefault("s3-location", "")
 	viper.SetDefault("s3-path-prefix", "")
 	viper.SetDefault("s3-external-identifier", false)
+	viper.SetDefault("remote-storage-url", "")
+	viper.SetDefault("remote-storage-access-key-id", "")
+	viper.SetDefault("remote-storage-secret-key", "")
+	viper.SetDefault("remote-storage-local-chunks", 0)
+	viper.SetDefault("remote-storage-local-size", 0)
+	viper.SetDefault("remote-storage-cache-size", 0)
+	viper.SetDefault("remote-storage-prefetch-chunks", 0)
+	viper.SetDefault("backup-url", "")
+	viper.SetDefault("backup-access-key-id", "")
+	viper.SetDefault("backup-secret-key", "")
+	viper.SetDefault("backup-frequency", options.BackupOptions.Frequency)
+	viper.SetDefault("backup-max-segment-txs", options.BackupOptions.MaxSegmentTxs)
+	viper.SetDefault("log-shipping-url", "")
+	viper.SetDefault("log-shipping-access-key-id", "")
+	viper.SetDefault("log-shipping-secret-key", "")
+	viper.SetDefault("log-shipping-frequency", options.LogShippingOptions.Frequency)
+	viper.SetDefault("log-shipping-max-segment-txs", options.LogShippingOptions.MaxSegmentTxs)
+	viper.SetDefault("encryption-key-file", "")
+	viper.SetDefault("encryption-key-env", "")
+	viper.SetDefault("encryption-block-size", options.EncryptionOptions.BlockSize)
+	viper.SetDefault("jwt-jwks-file", "")
+	viper.SetDefault("jwt-jwks-url", "")
+	viper.SetDefault("jwt-jwks-refresh-interval", options.JWTOptions.JWKSRefreshInterval)
+	viper.SetDefault("jwt-issuer", "")
+	viper.SetDefault("jwt-audience", "")
+	viper.SetDefault("jwt-username-claim", options.JWTOptions.UsernameClaim)
+	viper.SetDefault("jwt-roles-claim", options.JWTOptions.RolesClaim)
+	viper.SetDefault("jwt-role-mappings", "")
+	viper.SetDefault("jwt-leeway", options.JWTOptions.Leeway)
+	viper.SetDefault("ldap-url", "")
+	viper.SetDefault("ldap-start-tls", false)
+	viper.SetDefault("ldap-ca-cert", "")
+	viper.SetDefault("ldap-insecure-skip-verify", false)
+	viper.SetDefault("ldap-bind-dn", "")
+	viper.SetDefault("ldap-bind-password", "")
+	viper.SetDefault("ldap-user-base-dn", "")
+	viper.SetDefault("ldap-user-filter", options.LDAPOptions.UserFilter)
+	viper.SetDefault("ldap-group-base-dn", "")
+	viper.SetDefault("ldap-group-filter", options.LDAPOptions.GroupFilter)
+	viper.SetDefault("ldap-group-attribute", options.LDAPOptions.GroupAttribute)
+	viper.SetDefault("ldap-role-mappings", "")
+	viper.SetDefault("ldap-timeout", options.LDAPOptions.Timeout)
 	viper.SetDefault("max-sessions", 100)
 	viper.SetDefault("max-session-inactivity-time", 3*time.Minute)
 	viper.SetDefault("max-session-age-time", 0)
@@ -17,6 +17,7 @@ limitations under the License.
 package immudb
 
 import (
+	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/server"
 	"github.com/codenotary/immudb/pkg/server/sessions"
 	"github.com/spf13/viper"
@@ -44,7 +45,12 @@ func parseOptions() (options *server.Options, err error) {
 			WithReplicationCommitConcurrency(viper.GetInt("replication-commit-concurrency")).
 			WithAllowTxDiscarding(viper.GetBool("replication-allow-tx-discarding")).
 			WithSkipIntegrityCheck(viper.GetBool("replication-skip-integrity-check")).
-			WithWaitForIndexing(viper.GetBool("replication-wait-for-indexing"))
+			WithWaitForIndexing(viper.GetBool("replication-wait-for-indexing")).
+			WithPrimaryTLS(viper.GetBool("replication-primary-tls")).
+			WithPrimaryTLSServerName(viper.GetString("replication-primary-tls-server-name")).
+			WithPrimaryTLSCACert(viper.GetString("replication-primary-tls-ca-cert")).
+			WithPrimaryTLSCert(viper.GetString("replication-primary-tls-cert")).
+			WithPrimaryTLSKey(viper.GetString("replication-primary-tls-key"))
 	} else {
 		replicationOptions.
 			WithSyncAcks(viper.GetInt("replication-sync-acks"))
@@ -92,8 +98,18 @@ func parseOptions() (options *server.Options, err error) {
 	s3Location := viper.GetString("s3-location")
 	s3PathPrefix := viper.GetString("s3-path-prefix")
 	s3ExternalIdentifier := viper.GetBool("s3-external-identifier")
+	remoteStorageURL := viper.GetString("remote-storage-url")
+	remoteStorageAccessKeyID := viper.GetString("remote-storage-access-key-id")
+	remoteStorageSecretKey := viper.GetString("remote-storage-secret-key")
+	remoteStorageLocalChunks := viper.GetInt("remote-storage-local-chunks")
+	remoteStorageLocalSize := viper.GetInt64("remote-storage-local-size")
+	remoteStorageCacheSize := viper.GetInt64("remote-storage-cache-size")
+	remoteStoragePrefetchChunks := viper.GetInt("remote-storage-prefetch-chunks")
 
 	remoteStorageOptions := server.DefaultRemoteStorageOptions().
+		WithURL(remoteStorageURL).
+		WithAccessKeyID(remoteStorageAccessKeyID).
+		WithSecretKey(remoteStorageSecretKey).
 		WithS3Storage(s3Storage).
 		WithS3Endpoint(s3Endpoint).
 		WithS3AccessKeyID(s3AccessKeyID).
@@ -101,7 +117,40 @@ func parseOptions() (options *server.Options, err error) {
 		WithS3BucketName(s3BucketName).
 		WithS3Location(s3Location).
 		WithS3PathPrefix(s3PathPrefix).
-		WithS3ExternalIdentifier(s3ExternalIdentifier)
+		WithS3ExternalIdentifier(s3ExternalIdentifier).
+		WithLocalChunks(remoteStorageLocalChunks).
+		WithLocalSize(remoteStorageLocalSize).
+		WithCacheSize(remoteStorageCacheSize).
+		WithPrefetchChunks(remoteStoragePrefetchChunks)
+
+	backupOptions := server.DefaultBackupOptions().
+		WithURL(viper.GetString("backup-url")).
+		WithAccessKeyID(viper.GetString("backup-access-key-id")).
+		WithSecretKey(viper.GetString("backup-secret-key")).
+		WithFrequency(viper.GetDuration("backup-frequency")).
+		WithMaxSegmentTxs(viper.GetInt("backup-max-segment-txs"))
+
+	logShippingOptions := server.DefaultLogShippingOptions().
+		WithURL(viper.GetString("log-shipping-url")).
+		WithAccessKeyID(viper.GetString("log-shipping-access-key-id")).
+		WithSecretKey(viper.GetString("log-shipping-secret-key")).
+		WithFrequency(viper.GetDuration("log-shipping-frequency")).
+		WithMaxSegmentTxs(viper.GetInt("log-shipping-max-segment-txs"))
+
+	encryptionOptions := server.DefaultEncryptionOptions().
+		WithKeyFile(viper.GetString("encryption-key-file")).
+		WithKeyEnv(viper.GetString("encryption-key-env")).
+		WithBlockSize(viper.GetInt("encryption-block-size"))
+
+	jwtOptions, err := parseJWTOptions()
+	if err != nil {
+		return options, err
+	}
+
+	ldapOptions, err := parseLDAPOptions()
+	if err != nil {
+		return options, err
+	}
 
 	sessionOptions := sessions.DefaultOptions().
 		WithMaxSessions(viper.GetInt("max-sessions")).
@@ -135,6 +184,11 @@ func parseOptions() (options *server.Options, err error) {
 		WithSigningKey(signingKey).
 		WithSynced(synced).
 		WithRemoteStorageOptions(remoteStorageOptions).
+		WithBackupOptions(backupOptions).
+		WithLogShippingOptions(logShippingOptions).
+		WithEncryptionOptions(encryptionOptions).
+		WithJWTOptions(jwtOptions).
+		WithLDAPOptions(ldapOptions).
 		WithTokenExpiryTime(tokenExpTime).
 		WithMetricsServer(metricsServer).
 		WithMetricsServerPort(metricsServerPort).
@@ -149,3 +203,43 @@ func parseOptions() (options *server.Options, err error) {
 
 	return options, nil
 }
+
+func parseJWTOptions() (*auth.JWTOptions, error) {
+	roleMappings, err := auth.ParseRoleMappings(viper.GetString("jwt-role-mappings"))
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.DefaultJWTOptions().
+		WithJWKSFile(viper.GetString("jwt-jwks-file")).
+		WithJWKSURL(viper.GetString("jwt-jwks-url")).
+		WithJWKSRefreshInterval(viper.GetDuration("jwt-jwks-refresh-interval")).
+		WithIssuer(viper.GetString("jwt-issuer")).
+		WithAudience(viper.GetString("jwt-audience")).
+		WithUsernameClaim(viper.GetString("jwt-username-claim")).
+		WithRolesClaim(viper.GetString("jwt-roles-claim")).
+		WithRoleMappings(roleMappings).
+		WithLeeway(viper.GetDuration("jwt-leeway")), nil
+}
+
+func parseLDAPOptions() (*auth.LDAPOptions, error) {
+	roleMappings, err := auth.ParseRoleMappings(viper.GetString("ldap-role-mappings"))
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.DefaultLDAPOptions().
+		WithURL(viper.GetString("ldap-url")).
+		WithStartTLS(viper.GetBool("ldap-start-tls")).
+		WithCACert(viper.GetString("ldap-ca-cert")).
+		WithInsecureSkipVerify(viper.GetBool("ldap-insecure-skip-verify")).
+		WithBindDN(viper.GetString("ldap-bind-dn")).
+		WithBindPassword(viper.GetString("ldap-bind-password")).
+		WithUserBaseDN(viper.GetString("ldap-user-base-dn")).
+		WithUserFilter(viper.GetString("ldap-user-filter")).
+		WithGroupBaseDN(viper.GetString("ldap-group-base-dn")).
+		WithGroupFilter(viper.GetString("ldap-group-filter")).
+		WithGroupAttribute(viper.GetString("ldap-group-attribute")).
+		WithRoleMappings(roleMappings).
+		WithTimeout(viper.GetDuration("ldap-timeout")), nil
+}
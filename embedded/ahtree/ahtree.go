@@ -114,7 +114,8 @@ func Open(path string, opts *Options) (*AHtree, error) {
 		WithAutoSync(opts.autoSync).
 		WithFileSize(opts.fileSize).
 		WithFileMode(opts.fileMode).
-		WithMetadata(metadata.Bytes())
+		WithMetadata(metadata.Bytes()).
+		WithAppendableWrapper(opts.appWrapper)
 
 	appFactory := opts.appFactory
 	if appFactory == nil {
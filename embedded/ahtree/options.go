@@ -51,6 +51,7 @@ type Options struct {
 	fileMode os.FileMode
 
 	appFactory AppFactoryFunc
+	appWrapper multiapp.AppendableWrapper
 
 	dataCacheSlots    int
 	digestsCacheSlots int
@@ -178,3 +179,9 @@ func (opts *Options) WithAppFactory(appFactory AppFactoryFunc) *Options {
 	opts.appFactory = appFactory
 	return opts
 }
+
+// WithAppendableWrapper sets the wrapper of the appendables of the tree, e.g. to encrypt them
+func (opts *Options) WithAppendableWrapper(appWrapper multiapp.AppendableWrapper) *Options {
+	opts.appWrapper = appWrapper
+	return opts
+}
@@ -19,9 +19,15 @@ package appendable
 import (
 	"compress/flate"
 	"crypto/sha256"
+	"errors"
+	"fmt"
 	"io"
+	"strings"
 )
 
+var ErrIllegalArguments = errors.New("appendable: illegal arguments")
+var ErrUnsupportedCompressionFormat = fmt.Errorf("%w: unsupported compression format", ErrIllegalArguments)
+
 const DefaultCompressionFormat = NoCompression
 const DefaultCompressionLevel = BestSpeed
 
@@ -31,8 +37,20 @@ const (
 	GZipCompression
 	LZWCompression
 	ZLibCompression
+	ZstdCompression
+	SnappyCompression
 )
 
+var compressionFormatNames = []string{
+	NoCompression:     "none",
+	FlateCompression:  "flate",
+	GZipCompression:   "gzip",
+	LZWCompression:    "lzw",
+	ZLibCompression:   "zlib",
+	ZstdCompression:   "zstd",
+	SnappyCompression: "snappy",
+}
+
 const (
 	BestSpeed          = flate.BestSpeed
 	BestCompression    = flate.BestCompression
@@ -40,6 +58,26 @@ const (
 	HuffmanOnly        = flate.HuffmanOnly
 )
 
+// CompressionFormatName returns the name of the compression format
+func CompressionFormatName(compressionFormat int) string {
+	if compressionFormat < 0 || compressionFormat >= len(compressionFormatNames) {
+		return fmt.Sprintf("unknown(%d)", compressionFormat)
+	}
+
+	return compressionFormatNames[compressionFormat]
+}
+
+// CompressionFormatFromName returns the compression format with the given name, case insensitive
+func CompressionFormatFromName(name string) (int, error) {
+	for compressionFormat, formatName := range compressionFormatNames {
+		if strings.EqualFold(name, formatName) {
+			return compressionFormat, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: '%s'", ErrUnsupportedCompressionFormat, name)
+}
+
 type Appendable interface {
 	Metadata() []byte
 	Size() (int64, error)
@@ -0,0 +1,42 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appendable
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressionFormatNames(t *testing.T) {
+	for compressionFormat := NoCompression; compressionFormat <= SnappyCompression; compressionFormat++ {
+		name := CompressionFormatName(compressionFormat)
+
+		cf, err := CompressionFormatFromName(name)
+		require.NoError(t, err)
+		require.Equal(t, compressionFormat, cf)
+	}
+
+	cf, err := CompressionFormatFromName("ZSTD")
+	require.NoError(t, err)
+	require.Equal(t, ZstdCompression, cf)
+
+	_, err = CompressionFormatFromName("brotli")
+	require.ErrorIs(t, err, ErrUnsupportedCompressionFormat)
+
+	require.Equal(t, "unknown(-1)", CompressionFormatName(-1))
+}
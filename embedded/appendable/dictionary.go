@@ -0,0 +1,136 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appendable
+
+import (
+	"fmt"
+	"hash/crc32"
+	"sort"
+)
+
+const DefaultDictionarySize = 64 * 1024 // 64Kb
+const MaxDictionarySize = 1 << 20       // 1Mb
+
+const (
+	dictSegmentSize = 64
+	dictKmerSize    = 8
+)
+
+// TrainDictionary builds a raw content dictionary to be used with Zstd compression.
+//
+// Samples are split into segments which are scored by how frequently their content
+// appears across all the samples. The best scoring segments are selected until maxSize
+// is reached and placed at the end of the dictionary, closest to the data being compressed.
+func TrainDictionary(samples [][]byte, maxSize int) ([]byte, error) {
+	if len(samples) == 0 || maxSize <= 0 || maxSize > MaxDictionarySize {
+		return nil, ErrIllegalArguments
+	}
+
+	// number of samples in which each k-mer appears
+	freqs := make(map[string]int)
+
+	for _, sample := range samples {
+		seen := make(map[string]struct{})
+
+		for i := 0; i+dictKmerSize <= len(sample); i++ {
+			kmer := string(sample[i : i+dictKmerSize])
+
+			_, ok := seen[kmer]
+			if ok {
+				continue
+			}
+
+			seen[kmer] = struct{}{}
+			freqs[kmer]++
+		}
+	}
+
+	type segment struct {
+		content []byte
+		score   int
+	}
+
+	var segments []segment
+	selected := make(map[string]struct{})
+
+	for _, sample := range samples {
+		for off := 0; off < len(sample); off += dictSegmentSize {
+			content := sample[off:minInt(off+dictSegmentSize, len(sample))]
+
+			_, ok := selected[string(content)]
+			if ok {
+				continue
+			}
+
+			selected[string(content)] = struct{}{}
+
+			score := 0
+
+			for i := 0; i+dictKmerSize <= len(content); i++ {
+				freq := freqs[string(content[i:i+dictKmerSize])]
+				if freq > 1 {
+					score += freq
+				}
+			}
+
+			if score > 0 {
+				segments = append(segments, segment{content: content, score: score})
+			}
+		}
+	}
+
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("%w: samples do not share any content", ErrIllegalArguments)
+	}
+
+	sort.SliceStable(segments, func(i, j int) bool {
+		return segments[i].score > segments[j].score
+	})
+
+	size := 0
+	count := 0
+
+	for _, s := range segments {
+		if size+len(s.content) > maxSize {
+			break
+		}
+
+		size += len(s.content)
+		count++
+	}
+
+	dict := make([]byte, 0, size)
+
+	for i := count - 1; i >= 0; i-- {
+		dict = append(dict, segments[i].content...)
+	}
+
+	return dict, nil
+}
+
+// DictionaryID returns the id used to reference the dictionary from compressed content
+func DictionaryID(dict []byte) uint32 {
+	// ids lower than 32768 are reserved
+	return crc32.ChecksumIEEE(dict)%(1<<31-32768) + 32768
+}
+
+func minInt(a, b int) int {
+	if a <= b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appendable
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrainDictionary(t *testing.T) {
+	_, err := TrainDictionary(nil, DefaultDictionarySize)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = TrainDictionary([][]byte{{1}}, 0)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = TrainDictionary([][]byte{{1}}, MaxDictionarySize+1)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = TrainDictionary([][]byte{[]byte("abcdefghijkl"), []byte("mnopqrstuvwx")}, DefaultDictionarySize)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	var samples [][]byte
+	for i := 0; i < 100; i++ {
+		samples = append(samples, []byte(fmt.Sprintf(`{"id":%d,"status":"pending","description":"order number %d"}`, i, i)))
+	}
+
+	dict, err := TrainDictionary(samples, 256)
+	require.NoError(t, err)
+	require.NotEmpty(t, dict)
+	require.LessOrEqual(t, len(dict), 256)
+	require.True(t, bytes.Contains(dict, []byte(`"status":"pending"`)))
+
+	dict2, err := TrainDictionary(samples, 256)
+	require.NoError(t, err)
+	require.Equal(t, dict, dict2)
+}
+
+func TestDictionaryID(t *testing.T) {
+	id := DictionaryID([]byte("dictionary"))
+	require.GreaterOrEqual(t, id, uint32(32768))
+	require.Less(t, id, uint32(1<<31))
+	require.Equal(t, id, DictionaryID([]byte("dictionary")))
+	require.NotEqual(t, id, DictionaryID([]byte("another dictionary")))
+}
@@ -0,0 +1,635 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encrypted
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sync"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/multiapp"
+	"github.com/codenotary/immudb/embedded/appendable/singleapp"
+)
+
+var ErrIllegalArguments = errors.New("encrypted: illegal arguments")
+var ErrInvalidOptions = fmt.Errorf("%w: invalid options", ErrIllegalArguments)
+var ErrAlreadyClosed = errors.New("encrypted: already closed")
+var ErrCompressionNotSupported = errors.New("encrypted: compression is not supported")
+var ErrNotEncrypted = errors.New("encrypted: appendable is not encrypted")
+var ErrCorruptedData = errors.New("encrypted: corrupted data")
+var ErrNoKeyAvailable = errors.New("encrypted: no key available")
+var ErrKeyNotFound = errors.New("encrypted: key not found")
+var ErrInvalidKey = errors.New("encrypted: invalid key")
+
+const (
+	metaKeyID       = "ENCRYPTION_KEY_ID"
+	metaBlockSize   = "ENCRYPTION_BLOCK_SIZE"
+	metaWrappedMeta = "WRAPPED_METADATA"
+)
+
+var _ appendable.Appendable = (*EncryptedAppendable)(nil)
+
+// EncryptedAppendable encrypts the content of an underlying appendable with AES-GCM.
+//
+// Content is split into blocks of a fixed number of plaintext bytes, each one stored as
+// nonce | ciphertext | tag, so offsets are translated and random access is preserved.
+// Bytes are encrypted as soon as they're appended using the same keystream GCM would,
+// and the tag is appended once the block is full. Thus already written bytes are never
+// rewritten and full blocks are authenticated when read.
+type EncryptedAppendable struct {
+	app appendable.Appendable
+
+	keyID    string
+	metadata []byte
+
+	block cipher.Block
+	aead  cipher.AEAD
+
+	blockSize int
+	slotSize  int64
+
+	blockIdx int64         // index of the block being written
+	nonce    []byte        // nonce of the block being written, nil until its first byte is appended
+	stream   cipher.Stream // keystream of the block being written
+	tail     []byte        // plaintext of the block being written
+
+	cachedBlockIdx int64
+	cachedBlock    []byte
+
+	closed bool
+
+	mutex sync.Mutex
+}
+
+// WrapMetadata returns the metadata to be used when creating an appendable to be encrypted
+// with the given key. Existing appendables keep the metadata they were created with.
+func WrapMetadata(metadata []byte, keyID string, blockSize int) []byte {
+	m := appendable.NewMetadata(nil)
+	m.Put(metaKeyID, []byte(keyID))
+	m.PutInt(metaBlockSize, blockSize)
+	m.Put(metaWrappedMeta, metadata)
+
+	return m.Bytes()
+}
+
+// Open wraps an appendable created with the metadata returned by WrapMetadata.
+// The key used to encrypt the appendable is obtained from the key provider based on the key id
+// recorded in the metadata.
+func Open(app appendable.Appendable, keys KeyProvider) (*EncryptedAppendable, error) {
+	if app == nil || keys == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	if app.CompressionFormat() != appendable.NoCompression {
+		return nil, ErrCompressionNotSupported
+	}
+
+	m := appendable.NewMetadata(app.Metadata())
+
+	keyID, ok := m.Get(metaKeyID)
+	if !ok {
+		return nil, ErrNotEncrypted
+	}
+
+	blockSize, ok := m.GetInt(metaBlockSize)
+	if !ok || blockSize <= 0 || blockSize > MaxBlockSize {
+		return nil, fmt.Errorf("%w: invalid block size", ErrCorruptedData)
+	}
+
+	metadata, _ := m.Get(metaWrappedMeta)
+
+	key, err := keys.Key(string(keyID))
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidKey, err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &EncryptedAppendable{
+		app:            app,
+		keyID:          string(keyID),
+		metadata:       metadata,
+		block:          block,
+		aead:           aead,
+		blockSize:      blockSize,
+		slotSize:       int64(aead.NonceSize() + blockSize + aead.Overhead()),
+		tail:           make([]byte, 0, blockSize),
+		cachedBlockIdx: -1,
+	}
+
+	err = a.loadTail()
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// loadTail recovers the plaintext of the last block, which may not be full yet
+func (a *EncryptedAppendable) loadTail() error {
+	size, err := a.app.Size()
+	if err != nil {
+		return err
+	}
+
+	a.blockIdx = size / a.slotSize
+
+	written := int(size % a.slotSize)
+
+	if written <= a.aead.NonceSize() {
+		// a partially written nonce is overwritten by the next append
+		return nil
+	}
+
+	ctLen := written - a.aead.NonceSize()
+	if ctLen > a.blockSize {
+		// the tag was partially written, it's appended again by the next append
+		ctLen = a.blockSize
+	}
+
+	buf := make([]byte, a.aead.NonceSize()+ctLen)
+
+	_, err = a.app.ReadAt(buf, a.blockIdx*a.slotSize)
+	if err != nil {
+		return err
+	}
+
+	a.nonce = buf[:a.aead.NonceSize()]
+	a.stream = a.blockStream(a.nonce)
+
+	a.tail = a.tail[:ctLen]
+	a.stream.XORKeyStream(a.tail, buf[a.aead.NonceSize():])
+
+	return nil
+}
+
+// blockStream returns the keystream used by GCM to encrypt the block
+// i.e. AES-CTR starting from the counter following the one reserved for the tag
+func (a *EncryptedAppendable) blockStream(nonce []byte) cipher.Stream {
+	iv := make([]byte, aes.BlockSize)
+	copy(iv, nonce)
+	binary.BigEndian.PutUint32(iv[len(iv)-4:], 2)
+
+	return cipher.NewCTR(a.block, iv)
+}
+
+func blockAAD(blockIdx int64) []byte {
+	var aad [8]byte
+	binary.BigEndian.PutUint64(aad[:], uint64(blockIdx))
+	return aad[:]
+}
+
+// KeyID returns the id of the key used to encrypt the appendable
+func (a *EncryptedAppendable) KeyID() string {
+	return a.keyID
+}
+
+func (a *EncryptedAppendable) Metadata() []byte {
+	return a.metadata
+}
+
+func (a *EncryptedAppendable) CompressionFormat() int {
+	return appendable.NoCompression
+}
+
+func (a *EncryptedAppendable) CompressionLevel() int {
+	return a.app.CompressionLevel()
+}
+
+func (a *EncryptedAppendable) Copy(dstPath string) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.closed {
+		return ErrAlreadyClosed
+	}
+
+	return a.app.Copy(dstPath)
+}
+
+func (a *EncryptedAppendable) Size() (int64, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.closed {
+		return 0, ErrAlreadyClosed
+	}
+
+	return a.offset(), nil
+}
+
+func (a *EncryptedAppendable) Offset() int64 {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	return a.offset()
+}
+
+func (a *EncryptedAppendable) offset() int64 {
+	return a.blockIdx*int64(a.blockSize) + int64(len(a.tail))
+}
+
+// writeOffset returns the offset in the underlying appendable where the next byte is written
+func (a *EncryptedAppendable) writeOffset() int64 {
+	off := a.blockIdx * a.slotSize
+
+	if a.nonce != nil {
+		off += int64(a.aead.NonceSize() + len(a.tail))
+	}
+
+	return off
+}
+
+func (a *EncryptedAppendable) SetOffset(off int64) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.closed {
+		return ErrAlreadyClosed
+	}
+
+	if off < 0 || off > a.offset() {
+		return fmt.Errorf("%w: invalid offset %d", ErrIllegalArguments, off)
+	}
+
+	if off == a.offset() {
+		return nil
+	}
+
+	blockIdx := off / int64(a.blockSize)
+	blockOff := int(off % int64(a.blockSize))
+
+	var prefix []byte
+
+	if blockOff > 0 {
+		block, err := a.readBlock(blockIdx)
+		if err != nil {
+			return err
+		}
+
+		prefix = make([]byte, blockOff)
+		copy(prefix, block)
+	}
+
+	err := a.app.SetOffset(blockIdx * a.slotSize)
+	if err != nil {
+		return err
+	}
+
+	a.blockIdx = blockIdx
+	a.nonce = nil
+	a.stream = nil
+	a.tail = a.tail[:0]
+	a.cachedBlockIdx = -1
+
+	if blockOff == 0 {
+		return nil
+	}
+
+	// the remaining bytes of the block are encrypted again with a new nonce
+	_, _, err = a.append(prefix)
+	return err
+}
+
+func (a *EncryptedAppendable) DiscardUpto(off int64) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.closed {
+		return ErrAlreadyClosed
+	}
+
+	if off > a.offset() {
+		return fmt.Errorf("%w: discard beyond existent data boundaries", ErrIllegalArguments)
+	}
+
+	// only full blocks can be discarded
+	return a.app.DiscardUpto((off / int64(a.blockSize)) * a.slotSize)
+}
+
+func (a *EncryptedAppendable) Append(bs []byte) (off int64, n int, err error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.closed {
+		return 0, 0, ErrAlreadyClosed
+	}
+
+	if len(bs) == 0 {
+		return 0, 0, ErrIllegalArguments
+	}
+
+	return a.append(bs)
+}
+
+func (a *EncryptedAppendable) append(bs []byte) (off int64, n int, err error) {
+	if a.app.Offset() != a.writeOffset() {
+		// partially written data is discarded
+		err = a.app.SetOffset(a.writeOffset())
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	off = a.offset()
+
+	for n < len(bs) {
+		if len(a.tail) == a.blockSize {
+			err = a.sealBlock()
+			if err != nil {
+				return off, n, err
+			}
+		}
+
+		if a.nonce == nil {
+			err = a.startBlock()
+			if err != nil {
+				return off, n, err
+			}
+		}
+
+		d := minInt(a.blockSize-len(a.tail), len(bs)-n)
+
+		ct := make([]byte, d)
+		a.stream.XORKeyStream(ct, bs[n:n+d])
+
+		_, _, err = a.app.Append(ct)
+		if err != nil {
+			return off, n, err
+		}
+
+		a.tail = append(a.tail, bs[n:n+d]...)
+		n += d
+	}
+
+	if len(a.tail) == a.blockSize {
+		err = a.sealBlock()
+	}
+
+	return off, n, err
+}
+
+func (a *EncryptedAppendable) startBlock() error {
+	nonce := make([]byte, a.aead.NonceSize())
+
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = a.app.Append(nonce)
+	if err != nil {
+		return err
+	}
+
+	a.nonce = nonce
+	a.stream = a.blockStream(nonce)
+
+	return nil
+}
+
+// sealBlock appends the tag of the full block being written
+func (a *EncryptedAppendable) sealBlock() error {
+	sealed := a.aead.Seal(nil, a.nonce, a.tail, blockAAD(a.blockIdx))
+
+	_, _, err := a.app.Append(sealed[len(a.tail):])
+	if err != nil {
+		return err
+	}
+
+	a.blockIdx++
+	a.nonce = nil
+	a.stream = nil
+	a.tail = a.tail[:0]
+
+	return nil
+}
+
+// readBlock returns the plaintext of the block, authenticating it unless it's the one being written
+func (a *EncryptedAppendable) readBlock(blockIdx int64) ([]byte, error) {
+	if blockIdx == a.blockIdx {
+		return a.tail, nil
+	}
+
+	if blockIdx == a.cachedBlockIdx {
+		return a.cachedBlock, nil
+	}
+
+	slot := make([]byte, a.slotSize)
+
+	_, err := a.app.ReadAt(slot, blockIdx*a.slotSize)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceSize := a.aead.NonceSize()
+
+	block, err := a.aead.Open(slot[nonceSize:nonceSize], slot[:nonceSize], slot[nonceSize:], blockAAD(blockIdx))
+	if err != nil {
+		return nil, fmt.Errorf("%w: block %d could not be authenticated", ErrCorruptedData, blockIdx)
+	}
+
+	a.cachedBlockIdx = blockIdx
+	a.cachedBlock = block
+
+	return block, nil
+}
+
+func (a *EncryptedAppendable) ReadAt(bs []byte, off int64) (n int, err error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.closed {
+		return 0, ErrAlreadyClosed
+	}
+
+	if bs == nil || off < 0 {
+		return 0, ErrIllegalArguments
+	}
+
+	size := a.offset()
+
+	for n < len(bs) && off+int64(n) < size {
+		blockIdx := (off + int64(n)) / int64(a.blockSize)
+		blockOff := int((off + int64(n)) % int64(a.blockSize))
+
+		block, err := a.readBlock(blockIdx)
+		if err != nil {
+			return n, err
+		}
+
+		n += copy(bs[n:], block[blockOff:])
+	}
+
+	if n < len(bs) {
+		return n, io.EOF
+	}
+
+	return n, nil
+}
+
+func (a *EncryptedAppendable) Flush() error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.closed {
+		return ErrAlreadyClosed
+	}
+
+	return a.app.Flush()
+}
+
+func (a *EncryptedAppendable) Sync() error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.closed {
+		return ErrAlreadyClosed
+	}
+
+	return a.app.Sync()
+}
+
+func (a *EncryptedAppendable) SwitchToReadOnlyMode() error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.closed {
+		return ErrAlreadyClosed
+	}
+
+	return a.app.SwitchToReadOnlyMode()
+}
+
+func (a *EncryptedAppendable) Close() error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.closed {
+		return ErrAlreadyClosed
+	}
+
+	a.closed = true
+
+	return a.app.Close()
+}
+
+func minInt(a, b int) int {
+	if a <= b {
+		return a
+	}
+	return b
+}
+
+var _ multiapp.AppendableWrapper = (*appendableWrapper)(nil)
+
+// appendableWrapper encrypts every chunk of a multi-file appendable.
+// Newly created chunks are encrypted with the current key of the provider, so rotated keys
+// are used as soon as a new chunk is created, while existing chunks keep their own key.
+type appendableWrapper struct {
+	keys      KeyProvider
+	blockSize int
+}
+
+// NewAppendableWrapper returns a wrapper encrypting the chunks of multi-file appendables,
+// it's meant to be set with multiapp.Options.WithAppendableWrapper
+func NewAppendableWrapper(keys KeyProvider, opts *Options) (multiapp.AppendableWrapper, error) {
+	if keys == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	err := opts.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &appendableWrapper{
+		keys:      keys,
+		blockSize: opts.blockSize,
+	}, nil
+}
+
+func (w *appendableWrapper) WrapOptions(options *singleapp.Options) error {
+	if options.GetCompressionFormat() != appendable.NoCompression {
+		return ErrCompressionNotSupported
+	}
+
+	keyID, err := w.keys.CurrentKeyID()
+	if err != nil {
+		return err
+	}
+
+	options.WithMetadata(WrapMetadata(options.GetMetadata(), keyID, w.blockSize))
+
+	return nil
+}
+
+func (w *appendableWrapper) Wrap(app appendable.Appendable) (appendable.Appendable, error) {
+	encApp, err := Open(app, w.keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return encApp, nil
+}
+
+// NewMultiFileAppendableHooks returns hooks encrypting the chunks opened by the given ones
+func NewMultiFileAppendableHooks(hooks multiapp.MultiFileAppendableHooks, keys KeyProvider, opts *Options) (multiapp.MultiFileAppendableHooks, error) {
+	if hooks == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	wrapper, err := NewAppendableWrapper(keys, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return multiapp.WrapHooks(hooks, wrapper), nil
+}
+
+// OpenMultiFileAppendable opens a multi-file appendable where every chunk is encrypted
+func OpenMultiFileAppendable(path string, keys KeyProvider, opts *multiapp.Options, encOpts *Options) (*multiapp.MultiFileAppendable, error) {
+	hooks, err := NewMultiFileAppendableHooks(multiapp.NewDefaultMultiFileAppendableHooks(path), keys, encOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return multiapp.OpenWithHooks(path, hooks, opts)
+}
+
+// AppFactory returns a factory of encrypted multi-file appendables,
+// it can be used as the appendable factory of the store (store.Options.WithAppFactory)
+func AppFactory(keys KeyProvider, encOpts *Options) func(rootPath, subPath string, opts *multiapp.Options) (appendable.Appendable, error) {
+	return func(rootPath, subPath string, opts *multiapp.Options) (appendable.Appendable, error) {
+		return OpenMultiFileAppendable(filepath.Join(rootPath, subPath), keys, opts, encOpts)
+	}
+}
@@ -0,0 +1,333 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encrypted
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/multiapp"
+	"github.com/codenotary/immudb/embedded/appendable/singleapp"
+
+	"github.com/stretchr/testify/require"
+)
+
+func makeKeyRing(t *testing.T, keyIDs ...string) *KeyRing {
+	ring := NewKeyRing()
+
+	for _, keyID := range keyIDs {
+		key := make([]byte, 32)
+		rand.Read(key)
+
+		require.NoError(t, ring.AddKey(keyID, key))
+	}
+
+	return ring
+}
+
+func openSingleApp(t *testing.T, path string, keys KeyProvider, blockSize int) *EncryptedAppendable {
+	keyID, err := keys.CurrentKeyID()
+	require.NoError(t, err)
+
+	app, err := singleapp.Open(path, singleapp.DefaultOptions().WithMetadata(WrapMetadata([]byte("wrapped"), keyID, blockSize)))
+	require.NoError(t, err)
+
+	encApp, err := Open(app, keys)
+	require.NoError(t, err)
+
+	return encApp
+}
+
+func TestEncryptedAppendable(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata.aof")
+	keys := makeKeyRing(t, "key1")
+
+	a := openSingleApp(t, path, keys, 16)
+
+	require.Equal(t, "key1", a.KeyID())
+	require.Equal(t, []byte("wrapped"), a.Metadata())
+	require.Equal(t, appendable.NoCompression, a.CompressionFormat())
+	require.Equal(t, appendable.DefaultCompressionLevel, a.CompressionLevel())
+
+	_, _, err := a.Append(nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	var expected []byte
+
+	for i := 1; i <= 20; i++ {
+		bs := make([]byte, i*3)
+		rand.Read(bs)
+
+		off, n, err := a.Append(bs)
+		require.NoError(t, err)
+		require.Equal(t, int64(len(expected)), off)
+		require.Equal(t, len(bs), n)
+
+		expected = append(expected, bs...)
+
+		if i%5 == 0 {
+			require.NoError(t, a.Flush())
+		}
+	}
+
+	size, err := a.Size()
+	require.NoError(t, err)
+	require.Equal(t, int64(len(expected)), size)
+	require.Equal(t, int64(len(expected)), a.Offset())
+
+	checkContent := func(t *testing.T, a *EncryptedAppendable, expected []byte) {
+		for _, off := range []int{0, 1, 15, 16, 17, 100, len(expected) - 1} {
+			bs := make([]byte, len(expected)-off)
+
+			n, err := a.ReadAt(bs, int64(off))
+			require.NoError(t, err)
+			require.Equal(t, len(bs), n)
+			require.Equal(t, expected[off:], bs)
+		}
+
+		bs := make([]byte, 10)
+		n, err := a.ReadAt(bs, int64(len(expected)-5))
+		require.ErrorIs(t, err, io.EOF)
+		require.Equal(t, 5, n)
+	}
+
+	checkContent(t, a, expected)
+
+	require.NoError(t, a.Sync())
+	require.NoError(t, a.Close())
+
+	t.Run("stored content should be encrypted", func(t *testing.T) {
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.False(t, bytes.Contains(content, expected[:16]))
+	})
+
+	t.Run("content should be read after reopening", func(t *testing.T) {
+		a := openSingleApp(t, path, keys, 16)
+		defer a.Close()
+
+		checkContent(t, a, expected)
+
+		bs := []byte{1, 2, 3, 4, 5}
+
+		off, _, err := a.Append(bs)
+		require.NoError(t, err)
+		require.Equal(t, int64(len(expected)), off)
+
+		checkContent(t, a, append(expected, bs...))
+	})
+
+	t.Run("truncated content should be overwritten", func(t *testing.T) {
+		a := openSingleApp(t, path, keys, 16)
+		defer a.Close()
+
+		err := a.SetOffset(int64(len(expected) + 100))
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = a.SetOffset(100)
+		require.NoError(t, err)
+		require.Equal(t, int64(100), a.Offset())
+
+		bs := []byte{1, 2, 3, 4, 5}
+
+		off, _, err := a.Append(bs)
+		require.NoError(t, err)
+		require.Equal(t, int64(100), off)
+
+		checkContent(t, a, append(expected[:100:100], bs...))
+	})
+
+	t.Run("opening without the key should fail", func(t *testing.T) {
+		app, err := singleapp.Open(path, singleapp.DefaultOptions())
+		require.NoError(t, err)
+		defer app.Close()
+
+		_, err = Open(app, makeKeyRing(t, "key2"))
+		require.ErrorIs(t, err, ErrKeyNotFound)
+	})
+}
+
+func TestEncryptedAppendableTampering(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata.aof")
+	keys := makeKeyRing(t, "key1")
+
+	a := openSingleApp(t, path, keys, 16)
+
+	_, _, err := a.Append(make([]byte, 64))
+	require.NoError(t, err)
+	require.NoError(t, a.Close())
+
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	require.NoError(t, err)
+
+	info, err := f.Stat()
+	require.NoError(t, err)
+
+	// flip a byte of the ciphertext of the last block
+	_, err = f.WriteAt([]byte{0xff}, info.Size()-20)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	a = openSingleApp(t, path, keys, 16)
+	defer a.Close()
+
+	_, err = a.ReadAt(make([]byte, 16), 0)
+	require.NoError(t, err)
+
+	_, err = a.ReadAt(make([]byte, 16), 48)
+	require.ErrorIs(t, err, ErrCorruptedData)
+}
+
+func TestEncryptedAppendableGCMCompatibility(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata.aof")
+	keys := makeKeyRing(t, "key1")
+
+	a := openSingleApp(t, path, keys, 32)
+
+	plaintext := make([]byte, 32)
+	rand.Read(plaintext)
+
+	// the block is appended in several steps
+	_, _, err := a.Append(plaintext[:7])
+	require.NoError(t, err)
+	_, _, err = a.Append(plaintext[7:])
+	require.NoError(t, err)
+	require.NoError(t, a.Close())
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	slot := content[len(content)-(12+32+16):]
+
+	key, err := keys.Key("key1")
+	require.NoError(t, err)
+
+	block, err := aes.NewCipher(key)
+	require.NoError(t, err)
+
+	aead, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+
+	decrypted, err := aead.Open(nil, slot[:12], slot[12:], blockAAD(0))
+	require.NoError(t, err)
+	require.Equal(t, plaintext, decrypted)
+}
+
+func TestEncryptedAppendableInvalidOpening(t *testing.T) {
+	keys := makeKeyRing(t, "key1")
+
+	_, err := Open(nil, keys)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	app, err := singleapp.Open(filepath.Join(t.TempDir(), "plain.aof"), singleapp.DefaultOptions())
+	require.NoError(t, err)
+	defer app.Close()
+
+	_, err = Open(app, nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = Open(app, keys)
+	require.ErrorIs(t, err, ErrNotEncrypted)
+
+	compressedApp, err := singleapp.Open(filepath.Join(t.TempDir(), "compressed.aof"), singleapp.DefaultOptions().
+		WithCompressionFormat(appendable.FlateCompression).
+		WithMetadata(WrapMetadata(nil, "key1", DefaultBlockSize)))
+	require.NoError(t, err)
+	defer compressedApp.Close()
+
+	_, err = Open(compressedApp, keys)
+	require.ErrorIs(t, err, ErrCompressionNotSupported)
+}
+
+func TestEncryptedMultiFileAppendableKeyRotation(t *testing.T) {
+	path := t.TempDir()
+	keys := makeKeyRing(t, "key1")
+
+	opts := multiapp.DefaultOptions().
+		WithFileSize(100).
+		WithMetadata([]byte("metadata"))
+
+	encOpts := DefaultOptions().WithBlockSize(32)
+
+	a, err := OpenMultiFileAppendable(path, keys, opts, encOpts)
+	require.NoError(t, err)
+	require.Equal(t, []byte("metadata"), a.Metadata())
+
+	content := make([]byte, 250)
+	rand.Read(content)
+
+	_, _, err = a.Append(content)
+	require.NoError(t, err)
+	require.NoError(t, a.Close())
+
+	// the key is rotated, new chunks are encrypted with the new key
+	key2 := make([]byte, 16)
+	rand.Read(key2)
+	require.NoError(t, keys.AddKey("key2", key2))
+
+	factory := AppFactory(keys, encOpts)
+
+	app, err := factory(filepath.Dir(path), filepath.Base(path), opts)
+	require.NoError(t, err)
+
+	moreContent := make([]byte, 100)
+	rand.Read(moreContent)
+
+	off, _, err := app.Append(moreContent)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(content)), off)
+
+	bs := make([]byte, len(content)+len(moreContent))
+	_, err = app.ReadAt(bs, 0)
+	require.NoError(t, err)
+	require.Equal(t, append(content, moreContent...), bs)
+	require.NoError(t, app.Close())
+
+	for chunk, expectedKeyID := range []string{"key1", "key1", "key1", "key2"} {
+		chunkApp, err := singleapp.Open(filepath.Join(path, fmt.Sprintf("%08d.aof", chunk)), singleapp.DefaultOptions().WithReadOnly(true))
+		require.NoError(t, err)
+
+		encApp, err := Open(chunkApp, keys)
+		require.NoError(t, err)
+		require.Equal(t, expectedKeyID, encApp.KeyID())
+		require.NoError(t, encApp.Close())
+	}
+
+	t.Run("compression should not be supported", func(t *testing.T) {
+		_, err := OpenMultiFileAppendable(t.TempDir(), keys, multiapp.DefaultOptions().WithCompressionFormat(appendable.GZipCompression), encOpts)
+		require.ErrorIs(t, err, ErrCompressionNotSupported)
+	})
+
+	t.Run("invalid options should fail", func(t *testing.T) {
+		_, err := OpenMultiFileAppendable(t.TempDir(), keys, opts, DefaultOptions().WithBlockSize(0))
+		require.ErrorIs(t, err, ErrInvalidOptions)
+
+		_, err = NewMultiFileAppendableHooks(nil, keys, encOpts)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = NewAppendableWrapper(nil, encOpts)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+}
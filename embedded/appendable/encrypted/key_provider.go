@@ -0,0 +1,237 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encrypted
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// KeyProvider provides the AES keys used to encrypt appendables.
+// Keys are identified by an id which is recorded in the metadata of every encrypted appendable,
+// thus keys must remain available for as long as there are appendables encrypted with them.
+type KeyProvider interface {
+	// CurrentKeyID returns the id of the key used to encrypt newly created appendables
+	CurrentKeyID() (string, error)
+	// Key returns the key with the given id
+	Key(keyID string) ([]byte, error)
+}
+
+var _ KeyProvider = (*KeyRing)(nil)
+var _ KeyProvider = (*KMSKeyProvider)(nil)
+
+// KeyRing is an in-memory KeyProvider where the last added key is the current one
+type KeyRing struct {
+	keys         map[string][]byte
+	currentKeyID string
+
+	mutex sync.RWMutex
+}
+
+func NewKeyRing() *KeyRing {
+	return &KeyRing{
+		keys: make(map[string][]byte),
+	}
+}
+
+// AddKey adds a key to the ring and makes it the current one, so keys are rotated by adding new ones.
+// The key must be 16, 24 or 32 bytes long to select AES-128, AES-192 or AES-256.
+func (r *KeyRing) AddKey(keyID string, key []byte) error {
+	if keyID == "" {
+		return fmt.Errorf("%w: empty key id", ErrIllegalArguments)
+	}
+
+	err := validateKey(key)
+	if err != nil {
+		return err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	_, exists := r.keys[keyID]
+	if exists {
+		return fmt.Errorf("%w: key '%s' already exists", ErrIllegalArguments, keyID)
+	}
+
+	r.keys[keyID] = key
+	r.currentKeyID = keyID
+
+	return nil
+}
+
+func (r *KeyRing) CurrentKeyID() (string, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	if r.currentKeyID == "" {
+		return "", ErrNoKeyAvailable
+	}
+
+	return r.currentKeyID, nil
+}
+
+func (r *KeyRing) Key(keyID string) ([]byte, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	key, ok := r.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s'", ErrKeyNotFound, keyID)
+	}
+
+	return key, nil
+}
+
+// NewKeyFileProvider reads the keys from a file, one per line as "<key id>:<hex encoded key>".
+// Empty lines and lines starting with '#' are ignored. The last key in the file is the current one,
+// so keys are rotated by appending a new line to the file.
+func NewKeyFileProvider(path string) (*KeyRing, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseKeys(strings.Split(string(content), "\n"))
+}
+
+// NewEnvKeyProvider reads the keys from an environment variable, as a comma-separated list
+// of "<key id>:<hex encoded key>". The last key in the list is the current one.
+func NewEnvKeyProvider(envVar string) (*KeyRing, error) {
+	value, ok := os.LookupEnv(envVar)
+	if !ok {
+		return nil, fmt.Errorf("%w: environment variable '%s' is not set", ErrNoKeyAvailable, envVar)
+	}
+
+	return parseKeys(strings.Split(value, ","))
+}
+
+func parseKeys(entries []string) (*KeyRing, error) {
+	ring := NewKeyRing()
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+
+		if entry == "" || strings.HasPrefix(entry, "#") {
+			continue
+		}
+
+		parts := strings.SplitN(entry, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("%w: key entries must be formatted as '<key id>:<hex encoded key>'", ErrIllegalArguments)
+		}
+
+		keyID := strings.TrimSpace(parts[0])
+
+		key, err := hex.DecodeString(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, fmt.Errorf("%w: key '%s' is not hex encoded", ErrInvalidKey, keyID)
+		}
+
+		err = ring.AddKey(keyID, key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err := ring.CurrentKeyID()
+	if err != nil {
+		return nil, err
+	}
+
+	return ring, nil
+}
+
+// KMSClient decrypts data keys using master keys held by a key management service
+type KMSClient interface {
+	Decrypt(ctx context.Context, keyID string, encryptedKey []byte) ([]byte, error)
+}
+
+// KMSKeyProvider is a KeyProvider following the envelope encryption scheme used by key management services:
+// data keys are only kept encrypted and they're decrypted by the KMS the first time they're needed.
+type KMSKeyProvider struct {
+	client        KMSClient
+	encryptedKeys map[string][]byte
+	currentKeyID  string
+
+	keys  map[string][]byte
+	mutex sync.Mutex
+}
+
+func NewKMSKeyProvider(client KMSClient, encryptedKeys map[string][]byte, currentKeyID string) (*KMSKeyProvider, error) {
+	if client == nil {
+		return nil, fmt.Errorf("%w: nil kms client", ErrIllegalArguments)
+	}
+
+	_, ok := encryptedKeys[currentKeyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s'", ErrKeyNotFound, currentKeyID)
+	}
+
+	return &KMSKeyProvider{
+		client:        client,
+		encryptedKeys: encryptedKeys,
+		currentKeyID:  currentKeyID,
+		keys:          make(map[string][]byte),
+	}, nil
+}
+
+func (p *KMSKeyProvider) CurrentKeyID() (string, error) {
+	return p.currentKeyID, nil
+}
+
+func (p *KMSKeyProvider) Key(keyID string) ([]byte, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	key, ok := p.keys[keyID]
+	if ok {
+		return key, nil
+	}
+
+	encryptedKey, ok := p.encryptedKeys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s'", ErrKeyNotFound, keyID)
+	}
+
+	key, err := p.client.Decrypt(context.Background(), keyID, encryptedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	p.keys[keyID] = key
+
+	return key, nil
+}
+
+func validateKey(key []byte) error {
+	switch len(key) {
+	case 16, 24, 32:
+		return nil
+	}
+
+	return fmt.Errorf("%w: key must be 16, 24 or 32 bytes long", ErrInvalidKey)
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encrypted
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyRing(t *testing.T) {
+	ring := NewKeyRing()
+
+	_, err := ring.CurrentKeyID()
+	require.ErrorIs(t, err, ErrNoKeyAvailable)
+
+	err = ring.AddKey("", make([]byte, 32))
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = ring.AddKey("key1", make([]byte, 10))
+	require.ErrorIs(t, err, ErrInvalidKey)
+
+	err = ring.AddKey("key1", make([]byte, 16))
+	require.NoError(t, err)
+
+	err = ring.AddKey("key1", make([]byte, 16))
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = ring.AddKey("key2", bytes.Repeat([]byte{1}, 32))
+	require.NoError(t, err)
+
+	keyID, err := ring.CurrentKeyID()
+	require.NoError(t, err)
+	require.Equal(t, "key2", keyID)
+
+	key, err := ring.Key("key1")
+	require.NoError(t, err)
+	require.Len(t, key, 16)
+
+	_, err = ring.Key("key3")
+	require.ErrorIs(t, err, ErrKeyNotFound)
+}
+
+func TestKeyFileProvider(t *testing.T) {
+	key1 := hex.EncodeToString(bytes.Repeat([]byte{1}, 32))
+	key2 := hex.EncodeToString(bytes.Repeat([]byte{2}, 16))
+
+	writeKeyFile := func(t *testing.T, content string) string {
+		path := filepath.Join(t.TempDir(), "keys")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+		return path
+	}
+
+	t.Run("keys should be read from the file", func(t *testing.T) {
+		path := writeKeyFile(t, "# encryption keys\n\nkey1:"+key1+"\n key2 : "+key2+" \n")
+
+		ring, err := NewKeyFileProvider(path)
+		require.NoError(t, err)
+
+		keyID, err := ring.CurrentKeyID()
+		require.NoError(t, err)
+		require.Equal(t, "key2", keyID)
+
+		key, err := ring.Key("key1")
+		require.NoError(t, err)
+		require.Equal(t, bytes.Repeat([]byte{1}, 32), key)
+	})
+
+	t.Run("missing file should fail", func(t *testing.T) {
+		_, err := NewKeyFileProvider(filepath.Join(t.TempDir(), "missing"))
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("empty file should fail", func(t *testing.T) {
+		_, err := NewKeyFileProvider(writeKeyFile(t, "# no keys\n"))
+		require.ErrorIs(t, err, ErrNoKeyAvailable)
+	})
+
+	t.Run("malformed entries should fail", func(t *testing.T) {
+		_, err := NewKeyFileProvider(writeKeyFile(t, key1))
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = NewKeyFileProvider(writeKeyFile(t, "key1:nothex"))
+		require.ErrorIs(t, err, ErrInvalidKey)
+
+		_, err = NewKeyFileProvider(writeKeyFile(t, "key1:0102"))
+		require.ErrorIs(t, err, ErrInvalidKey)
+
+		_, err = NewKeyFileProvider(writeKeyFile(t, "key1:"+key1+"\nkey1:"+key2))
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+}
+
+func TestEnvKeyProvider(t *testing.T) {
+	key1 := hex.EncodeToString(bytes.Repeat([]byte{1}, 24))
+	key2 := hex.EncodeToString(bytes.Repeat([]byte{2}, 32))
+
+	t.Setenv("IMMUDB_TEST_ENCRYPTION_KEYS", "key1:"+key1+",key2:"+key2)
+
+	ring, err := NewEnvKeyProvider("IMMUDB_TEST_ENCRYPTION_KEYS")
+	require.NoError(t, err)
+
+	keyID, err := ring.CurrentKeyID()
+	require.NoError(t, err)
+	require.Equal(t, "key2", keyID)
+
+	key, err := ring.Key("key1")
+	require.NoError(t, err)
+	require.Len(t, key, 24)
+
+	_, err = NewEnvKeyProvider("IMMUDB_TEST_ENCRYPTION_KEYS_UNSET")
+	require.ErrorIs(t, err, ErrNoKeyAvailable)
+}
+
+type fakeKMSClient struct {
+	calls int
+}
+
+var errKMSUnavailable = errors.New("kms unavailable")
+
+// Decrypt mimics a KMS by simply reverting the bytes of the encrypted key
+func (c *fakeKMSClient) Decrypt(ctx context.Context, keyID string, encryptedKey []byte) ([]byte, error) {
+	c.calls++
+
+	if keyID == "unavailable" {
+		return nil, errKMSUnavailable
+	}
+
+	key := make([]byte, len(encryptedKey))
+	for i, b := range encryptedKey {
+		key[len(key)-1-i] = b
+	}
+
+	return key, nil
+}
+
+func TestKMSKeyProvider(t *testing.T) {
+	client := &fakeKMSClient{}
+
+	encryptedKeys := map[string][]byte{
+		"key1":        bytes.Repeat([]byte{1, 2}, 16),
+		"short":       {1, 2, 3},
+		"unavailable": bytes.Repeat([]byte{3}, 32),
+	}
+
+	_, err := NewKMSKeyProvider(nil, encryptedKeys, "key1")
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = NewKMSKeyProvider(client, encryptedKeys, "key2")
+	require.ErrorIs(t, err, ErrKeyNotFound)
+
+	p, err := NewKMSKeyProvider(client, encryptedKeys, "key1")
+	require.NoError(t, err)
+
+	keyID, err := p.CurrentKeyID()
+	require.NoError(t, err)
+	require.Equal(t, "key1", keyID)
+
+	for i := 0; i < 2; i++ {
+		key, err := p.Key("key1")
+		require.NoError(t, err)
+		require.Equal(t, bytes.Repeat([]byte{2, 1}, 16), key)
+	}
+
+	// decrypted keys are cached
+	require.Equal(t, 1, client.calls)
+
+	_, err = p.Key("key2")
+	require.ErrorIs(t, err, ErrKeyNotFound)
+
+	_, err = p.Key("short")
+	require.ErrorIs(t, err, ErrInvalidKey)
+
+	_, err = p.Key("unavailable")
+	require.ErrorIs(t, err, errKMSUnavailable)
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encrypted
+
+import "fmt"
+
+const DefaultBlockSize = 4096
+const MaxBlockSize = 1 << 20 // 1Mb
+
+type Options struct {
+	blockSize int
+}
+
+func DefaultOptions() *Options {
+	return &Options{
+		blockSize: DefaultBlockSize,
+	}
+}
+
+func (opts *Options) Validate() error {
+	if opts == nil {
+		return fmt.Errorf("%w: nil options", ErrInvalidOptions)
+	}
+
+	if opts.blockSize <= 0 || opts.blockSize > MaxBlockSize {
+		return fmt.Errorf("%w: invalid blockSize", ErrInvalidOptions)
+	}
+
+	return nil
+}
+
+// WithBlockSize sets the number of plaintext bytes encrypted and authenticated together.
+// It only applies to newly created appendables, existing ones keep the block size they were created with.
+func (opts *Options) WithBlockSize(blockSize int) *Options {
+	opts.blockSize = blockSize
+	return opts
+}
+
+func (opts *Options) GetBlockSize() int {
+	return opts.blockSize
+}
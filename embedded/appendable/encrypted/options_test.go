@@ -0,0 +1,38 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encrypted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptions(t *testing.T) {
+	var opts *Options
+	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
+
+	opts = DefaultOptions()
+	require.NoError(t, opts.Validate())
+	require.Equal(t, DefaultBlockSize, opts.GetBlockSize())
+
+	require.Equal(t, 512, opts.WithBlockSize(512).GetBlockSize())
+	require.NoError(t, opts.Validate())
+
+	require.ErrorIs(t, opts.WithBlockSize(0).Validate(), ErrInvalidOptions)
+	require.ErrorIs(t, opts.WithBlockSize(MaxBlockSize+1).Validate(), ErrInvalidOptions)
+}
@@ -57,10 +57,21 @@ type MultiFileAppendableHooks interface {
 	OpenInitialAppendable(opts *Options, singleAppOpts *singleapp.Options) (app appendable.Appendable, appID int64, err error)
 }
 
+// compressionDictionaryHolder is implemented by appendables compressing content with a dictionary
+type compressionDictionaryHolder interface {
+	CompressionDictionary() []byte
+}
+
 type DefaultMultiFileAppendableHooks struct {
 	path string
 }
 
+func NewDefaultMultiFileAppendableHooks(path string) *DefaultMultiFileAppendableHooks {
+	return &DefaultMultiFileAppendableHooks{
+		path: path,
+	}
+}
+
 func (d *DefaultMultiFileAppendableHooks) OpenInitialAppendable(opts *Options, singleAppOpts *singleapp.Options) (app appendable.Appendable, appID int64, err error) {
 	entries, err := os.ReadDir(d.path)
 	if err != nil {
@@ -109,19 +120,25 @@ type MultiFileAppendable struct {
 	readBufferSize int
 	prealloc       bool
 
+	compressionDictionary []byte
+
 	writeBuffer []byte // shared write-buffer only used by active appendable
 
 	closed bool
 
 	hooks MultiFileAppendableHooks
 
+	// reads in progress by appendable, cached appendables handed over to the hooks
+	// are not returned until their reads are completed
+	readers      map[appendable.Appendable]int
+	readersMutex sync.Mutex
+	readersDone  *sync.Cond
+
 	mutex sync.Mutex
 }
 
 func Open(path string, opts *Options) (*MultiFileAppendable, error) {
-	return OpenWithHooks(path, &DefaultMultiFileAppendableHooks{
-		path: path,
-	}, opts)
+	return OpenWithHooks(path, NewDefaultMultiFileAppendableHooks(path), opts)
 }
 
 func OpenWithHooks(path string, hooks MultiFileAppendableHooks, opts *Options) (*MultiFileAppendable, error) {
@@ -130,6 +147,10 @@ func OpenWithHooks(path string, hooks MultiFileAppendableHooks, opts *Options) (
 		return nil, err
 	}
 
+	if opts.appWrapper != nil {
+		hooks = WrapHooks(hooks, opts.appWrapper)
+	}
+
 	finfo, err := os.Stat(path)
 	if err != nil {
 		if !os.IsNotExist(err) || opts.readOnly {
@@ -167,6 +188,7 @@ func OpenWithHooks(path string, hooks MultiFileAppendableHooks, opts *Options) (
 		WithFileMode(opts.fileMode).
 		WithCompressionFormat(opts.compressionFormat).
 		WithCompresionLevel(opts.compressionLevel).
+		WithCompressionDictionary(opts.compressionDictionary).
 		WithReadBufferSize(opts.readBufferSize).
 		WithWriteBuffer(writeBuffer).
 		WithMetadata(m.Bytes())
@@ -187,7 +209,15 @@ func OpenWithHooks(path string, hooks MultiFileAppendableHooks, opts *Options) (
 
 	fileSize, _ := appendable.NewMetadata(currApp.Metadata()).GetInt(metaFileSize)
 
-	return &MultiFileAppendable{
+	var compressionDictionary []byte
+
+	// existing appendables keep the dictionary they were created with
+	dictApp, ok := currApp.(compressionDictionaryHolder)
+	if ok {
+		compressionDictionary = dictApp.CompressionDictionary()
+	}
+
+	mf := &MultiFileAppendable{
 		appendables:    appendableLRUCache{cache: cache},
 		currAppID:      currAppID,
 		currApp:        currApp,
@@ -203,7 +233,14 @@ func OpenWithHooks(path string, hooks MultiFileAppendableHooks, opts *Options) (
 		writeBuffer:    writeBuffer,
 		closed:         false,
 		hooks:          hooks,
-	}, nil
+		readers:        make(map[appendable.Appendable]int),
+
+		compressionDictionary: compressionDictionary,
+	}
+
+	mf.readersDone = sync.NewCond(&mf.readersMutex)
+
+	return mf, nil
 }
 
 func appendableName(appID int64, ext string) string {
@@ -391,6 +428,7 @@ func (mf *MultiFileAppendable) openAppendable(appname string, createIfNotExists,
 		WithReadBufferSize(mf.readBufferSize).
 		WithCompressionFormat(mf.currApp.CompressionFormat()).
 		WithCompresionLevel(mf.currApp.CompressionLevel()).
+		WithCompressionDictionary(mf.compressionDictionary).
 		WithMetadata(mf.currApp.Metadata())
 
 	if mf.prealloc {
@@ -530,6 +568,8 @@ func (mf *MultiFileAppendable) DiscardUpto(off int64) error {
 	return nil
 }
 
+// appendableFor returns the appendable holding the offset, it's registered as being read
+// until releaseReader is called
 func (mf *MultiFileAppendable) appendableFor(off int64) (appendable.Appendable, error) {
 	mf.mutex.Lock()
 	defer mf.mutex.Unlock()
@@ -542,10 +582,21 @@ func (mf *MultiFileAppendable) appendableFor(off int64) (appendable.Appendable,
 
 	if appID == mf.currAppID {
 		metricsCacheHit.Inc()
+
+		mf.readersMutex.Lock()
+		mf.readers[mf.currApp]++
+		mf.readersMutex.Unlock()
+
 		return mf.currApp, nil
 	}
 
+	// the appendable is registered as being read before it can be taken from the cache
+	mf.readersMutex.Lock()
 	app, err := mf.appendables.Get(appID)
+	if err == nil {
+		mf.readers[app]++
+	}
+	mf.readersMutex.Unlock()
 
 	if err != nil {
 		if !errors.Is(err, cache.ErrKeyNotFound) {
@@ -559,7 +610,13 @@ func (mf *MultiFileAppendable) appendableFor(off int64) (appendable.Appendable,
 			return nil, err
 		}
 
+		mf.readersMutex.Lock()
 		_, ejectedApp, err := mf.appendables.Put(appID, app)
+		if err == nil {
+			mf.readers[app]++
+		}
+		mf.readersMutex.Unlock()
+
 		if err != nil {
 			return nil, err
 		}
@@ -568,6 +625,7 @@ func (mf *MultiFileAppendable) appendableFor(off int64) (appendable.Appendable,
 			metricsCacheEvicted.Inc()
 			err = ejectedApp.Close()
 			if err != nil {
+				mf.releaseReader(app)
 				return nil, err
 			}
 		}
@@ -578,6 +636,26 @@ func (mf *MultiFileAppendable) appendableFor(off int64) (appendable.Appendable,
 	return app, nil
 }
 
+func (mf *MultiFileAppendable) releaseReader(app appendable.Appendable) {
+	mf.readersMutex.Lock()
+	defer mf.readersMutex.Unlock()
+
+	mf.readers[app]--
+
+	if mf.readers[app] == 0 {
+		delete(mf.readers, app)
+		mf.readersDone.Broadcast()
+	}
+}
+
+// waitForReaders waits until the reads in progress of the appendable instance are completed,
+// readersMutex must be held by the caller
+func (mf *MultiFileAppendable) waitForReaders(app appendable.Appendable) {
+	for mf.readers[app] > 0 {
+		mf.readersDone.Wait()
+	}
+}
+
 func (mf *MultiFileAppendable) ReadAt(bs []byte, off int64) (int, error) {
 	if len(bs) == 0 {
 		return 0, ErrIllegalArguments
@@ -603,6 +681,7 @@ func (mf *MultiFileAppendable) ReadAt(bs []byte, off int64) (int, error) {
 		}
 
 		rn, err := app.ReadAt(bs[r:], offr%int64(mf.fileSize))
+		mf.releaseReader(app)
 		r += rn
 
 		if errors.Is(err, io.EOF) {
@@ -712,8 +791,20 @@ func (mf *MultiFileAppendable) CurrApp() (appendable.Appendable, int64) {
 	return mf.currApp, mf.currAppID
 }
 
+// ReplaceCachedChunk replaces the appendable for the given chunk in the cache,
+// the replaced one is returned once its reads in progress are completed
 func (mf *MultiFileAppendable) ReplaceCachedChunk(appID int64, app appendable.Appendable) (appendable.Appendable, error) {
-	return mf.appendables.Replace(appID, app)
+	mf.readersMutex.Lock()
+	defer mf.readersMutex.Unlock()
+
+	replacedApp, err := mf.appendables.Replace(appID, app)
+	if err != nil {
+		return nil, err
+	}
+
+	mf.waitForReaders(replacedApp)
+
+	return replacedApp, nil
 }
 
 func minInt(a, b int) int {
@@ -722,3 +813,19 @@ func minInt(a, b int) int {
 	}
 	return b
 }
+
+// PopCachedChunk removes the appendable for the given chunk from the cache once its reads
+// in progress are completed, the caller becomes responsible for closing it
+func (mf *MultiFileAppendable) PopCachedChunk(appID int64) (appendable.Appendable, error) {
+	mf.readersMutex.Lock()
+	defer mf.readersMutex.Unlock()
+
+	app, err := mf.appendables.Pop(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	mf.waitForReaders(app)
+
+	return app, nil
+}
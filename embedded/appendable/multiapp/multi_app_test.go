@@ -17,14 +17,17 @@ limitations under the License.
 package multiapp
 
 import (
+	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/appendable"
 	"github.com/codenotary/immudb/embedded/appendable/singleapp"
+	"github.com/codenotary/immudb/embedded/cache"
 
 	"github.com/stretchr/testify/require"
 )
@@ -295,6 +298,60 @@ func TestMultiAppCompression(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMultiAppZstdCompressionWithDictionary(t *testing.T) {
+	path := t.TempDir()
+
+	dict := []byte(`{"name":"","email":"@example.com","active":true}`)
+
+	opts := DefaultOptions().
+		WithFileSize(100).
+		WithCompressionFormat(appendable.ZstdCompression).
+		WithCompressionDictionary(dict)
+
+	a, err := Open(path, opts)
+	require.NoError(t, err)
+
+	var offs []int64
+	var values [][]byte
+
+	for i := 0; i < 10; i++ {
+		value := []byte(fmt.Sprintf(`{"name":"user%d","email":"user%d@example.com","active":true}`, i, i))
+
+		off, _, err := a.Append(value)
+		require.NoError(t, err)
+
+		offs = append(offs, off)
+		values = append(values, value)
+	}
+
+	err = a.Close()
+	require.NoError(t, err)
+
+	// existing appendables keep the dictionary they were created with
+	a, err = Open(path, DefaultOptions().WithFileSize(100).WithCompressionDictionary([]byte("another dictionary")))
+	require.NoError(t, err)
+	require.Equal(t, appendable.ZstdCompression, a.CompressionFormat())
+	require.Equal(t, dict, a.compressionDictionary)
+
+	value := []byte(`{"name":"user10","email":"user10@example.com","active":true}`)
+
+	off, _, err := a.Append(value)
+	require.NoError(t, err)
+
+	offs = append(offs, off)
+	values = append(values, value)
+
+	for i, off := range offs {
+		bs := make([]byte, len(values[i]))
+		_, err = a.ReadAt(bs, off)
+		require.NoError(t, err)
+		require.Equal(t, values[i], bs)
+	}
+
+	err = a.Close()
+	require.NoError(t, err)
+}
+
 func TestMultiAppAppendableForCurrentChunk(t *testing.T) {
 	path := t.TempDir()
 
@@ -311,6 +368,7 @@ func TestMultiAppAppendableForCurrentChunk(t *testing.T) {
 	app, err := a.appendableFor(11)
 	require.NoError(t, err)
 	require.Equal(t, a.currApp, app)
+	a.releaseReader(app)
 }
 
 func TestMultiappOpenIncorrectPath(t *testing.T) {
@@ -376,3 +434,110 @@ func TestMultiAppDiscard(t *testing.T) {
 	err = a.Close()
 	require.NoError(t, err)
 }
+
+func TestMultiAppPopCachedChunk(t *testing.T) {
+	path := t.TempDir()
+
+	a, err := Open(path, DefaultOptions().WithFileSize(10))
+	require.NoError(t, err)
+	defer a.Close()
+
+	_, _, err = a.Append([]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12})
+	require.NoError(t, err)
+
+	app, err := a.appendableFor(0)
+	require.NoError(t, err)
+
+	type popResult struct {
+		app appendable.Appendable
+		err error
+	}
+
+	popDone := make(chan popResult)
+
+	go func() {
+		popped, err := a.PopCachedChunk(0)
+		popDone <- popResult{app: popped, err: err}
+	}()
+
+	// the appendable is not handed over while it's being read
+	select {
+	case <-popDone:
+		require.Fail(t, "appendable popped while being read")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	a.releaseReader(app)
+
+	res := <-popDone
+	require.NoError(t, res.err)
+	require.Equal(t, app, res.app)
+	require.NoError(t, res.app.Close())
+
+	_, err = a.PopCachedChunk(0)
+	require.ErrorIs(t, err, cache.ErrKeyNotFound)
+
+	// the chunk is reopened on the next read
+	b := make([]byte, 2)
+	_, err = a.ReadAt(b, 0)
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2}, b)
+}
+
+func TestMultiAppReplaceCachedChunkWhileReadingNewChunk(t *testing.T) {
+	path := t.TempDir()
+
+	a, err := Open(path, DefaultOptions().WithFileSize(10))
+	require.NoError(t, err)
+	defer a.Close()
+
+	_, _, err = a.Append([]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12})
+	require.NoError(t, err)
+
+	oldApp, err := a.appendableFor(0)
+	require.NoError(t, err)
+
+	newApp, err := a.openAppendable(appendableName(0, a.fileExt), false, false)
+	require.NoError(t, err)
+
+	type replaceResult struct {
+		app appendable.Appendable
+		err error
+	}
+
+	replaceDone := make(chan replaceResult)
+
+	go func() {
+		replaced, err := a.ReplaceCachedChunk(0, newApp)
+		replaceDone <- replaceResult{app: replaced, err: err}
+	}()
+
+	// reads of the new appendable are not waited for
+	require.Eventually(t, func() bool {
+		app, err := a.appendableFor(0)
+		require.NoError(t, err)
+		defer a.releaseReader(app)
+
+		return app == newApp
+	}, time.Second, time.Millisecond)
+
+	readingApp, err := a.appendableFor(0)
+	require.NoError(t, err)
+	require.Equal(t, newApp, readingApp)
+
+	// the replaced appendable is handed over once its reads are completed
+	select {
+	case <-replaceDone:
+		require.Fail(t, "appendable replaced while being read")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	a.releaseReader(oldApp)
+
+	res := <-replaceDone
+	require.NoError(t, res.err)
+	require.Equal(t, oldApp, res.app)
+	require.NoError(t, res.app.Close())
+
+	a.releaseReader(readingApp)
+}
@@ -39,14 +39,17 @@ type Options struct {
 	retryableSync   bool // if retryableSync is enabled, buffer space is released only after a successful sync
 	autoSync        bool // if autoSync is enabled, sync is called when the buffer is full
 
-	fileMode          os.FileMode
-	fileSize          int
-	prealloc          bool
-	fileExt           string
-	metadata          []byte
-	maxOpenedFiles    int
-	compressionFormat int
-	compressionLevel  int
+	fileMode              os.FileMode
+	fileSize              int
+	prealloc              bool
+	fileExt               string
+	metadata              []byte
+	maxOpenedFiles        int
+	compressionFormat     int
+	compressionLevel      int
+	compressionDictionary []byte
+
+	appWrapper AppendableWrapper
 }
 
 func DefaultOptions() *Options {
@@ -143,6 +146,18 @@ func (opt *Options) WithCompresionLevel(compressionLevel int) *Options {
 	return opt
 }
 
+// WithCompressionDictionary sets the dictionary used with Zstd compression
+func (opt *Options) WithCompressionDictionary(dict []byte) *Options {
+	opt.compressionDictionary = dict
+	return opt
+}
+
+// WithAppendableWrapper sets the wrapper of the appendable of every chunk, e.g. to encrypt their content
+func (opt *Options) WithAppendableWrapper(appWrapper AppendableWrapper) *Options {
+	opt.appWrapper = appWrapper
+	return opt
+}
+
 func (opts *Options) WithReadBufferSize(size int) *Options {
 	opts.readBufferSize = size
 	return opts
@@ -177,3 +192,7 @@ func (opts *Options) GetWriteBufferSize() int {
 func (opts *Options) GetPrealloc() bool {
 	return opts.prealloc
 }
+
+func (opts *Options) GetAppendableWrapper() AppendableWrapper {
+	return opts.appWrapper
+}
@@ -58,6 +58,7 @@ func TestValidOptions(t *testing.T) {
 	require.Equal(t, []byte{}, opts.WithMetadata([]byte{}).metadata)
 	require.Equal(t, DefaultCompressionFormat, opts.WithCompressionFormat(DefaultCompressionFormat).compressionFormat)
 	require.Equal(t, DefaultCompressionLevel, opts.WithCompresionLevel(DefaultCompressionLevel).compressionLevel)
+	require.Equal(t, []byte{1, 2}, opts.WithCompressionDictionary([]byte{1, 2}).compressionDictionary)
 
 	require.True(t, opts.WithRetryableSync(true).retryableSync)
 	require.True(t, opts.WithAutoSync(true).autoSync)
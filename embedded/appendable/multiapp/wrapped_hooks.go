@@ -0,0 +1,86 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiapp
+
+import (
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/singleapp"
+)
+
+// AppendableWrapper wraps the appendable of every chunk, e.g. to encrypt their content
+type AppendableWrapper interface {
+	// WrapOptions adjusts the options used to open the appendable of a chunk
+	WrapOptions(options *singleapp.Options) error
+
+	// Wrap wraps the appendable of a chunk once it's opened
+	Wrap(app appendable.Appendable) (appendable.Appendable, error)
+}
+
+var _ MultiFileAppendableHooks = (*wrappedHooks)(nil)
+
+type wrappedHooks struct {
+	hooks   MultiFileAppendableHooks
+	wrapper AppendableWrapper
+}
+
+// WrapHooks returns hooks wrapping the appendables opened by the given ones
+func WrapHooks(hooks MultiFileAppendableHooks, wrapper AppendableWrapper) MultiFileAppendableHooks {
+	return &wrappedHooks{
+		hooks:   hooks,
+		wrapper: wrapper,
+	}
+}
+
+func (h *wrappedHooks) OpenAppendable(options *singleapp.Options, appname string, needsWriteAccess bool) (appendable.Appendable, error) {
+	err := h.wrapper.WrapOptions(options)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := h.hooks.OpenAppendable(options, appname, needsWriteAccess)
+	if err != nil {
+		return nil, err
+	}
+
+	wrappedApp, err := h.wrapper.Wrap(app)
+	if err != nil {
+		app.Close()
+		return nil, err
+	}
+
+	return wrappedApp, nil
+}
+
+func (h *wrappedHooks) OpenInitialAppendable(opts *Options, singleAppOpts *singleapp.Options) (appendable.Appendable, int64, error) {
+	err := h.wrapper.WrapOptions(singleAppOpts)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	app, appID, err := h.hooks.OpenInitialAppendable(opts, singleAppOpts)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	wrappedApp, err := h.wrapper.Wrap(app)
+	if err != nil {
+		app.Close()
+		return nil, 0, err
+	}
+
+	return wrappedApp, appID, nil
+}
@@ -30,6 +30,7 @@ const (
 	chunkState_Remote
 	chunkState_Downloading
 	chunkState_DownloadError
+	chunkState_Cached
 )
 
 var chunkStateNames = []string{
@@ -42,6 +43,7 @@ var chunkStateNames = []string{
 	"Remote",
 	"Downloading",
 	"DownloadError",
+	"Cached",
 }
 
 func (s chunkState) String() string {
@@ -96,6 +96,18 @@ var (
 	metricsDownloadRetried   = metricsDownloadEvents.WithLabelValues("retried")
 	metricsDownloadSucceeded = metricsDownloadEvents.WithLabelValues("succeeded")
 
+	// ---- Local cache ---------------------------------------
+
+	metricsCacheEvents = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "immudb_remoteapp_cache_events",
+		Help: "Local disk cache event counters for immudb remote storage",
+	}, []string{"event"})
+
+	metricsCacheHits       = metricsCacheEvents.WithLabelValues("hits")
+	metricsCacheMisses     = metricsCacheEvents.WithLabelValues("misses")
+	metricsCacheEvictions  = metricsCacheEvents.WithLabelValues("evictions")
+	metricsCachePrefetches = metricsCacheEvents.WithLabelValues("prefetches")
+
 	// ---- Chunk statistics --------------------------------
 
 	metricsChunkCounts = promauto.NewGaugeVec(prometheus.GaugeOpts{
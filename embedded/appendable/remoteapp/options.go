@@ -30,6 +30,13 @@ type Options struct {
 	retryMaxDelay    time.Duration
 	retryDelayExp    float64
 	retryDelayJitter float64
+
+	// Tiering policy, the last localChunks chunks or localSize bytes are kept on
+	// local disk, older chunks are fetched on demand into a cache of cacheSize bytes
+	localChunks    int
+	localSize      int64
+	cacheSize      int64
+	prefetchChunks int
 }
 
 func DefaultOptions() *Options {
@@ -52,7 +59,11 @@ func (opts *Options) Valid() bool {
 		opts.parallelUploads < 100000 &&
 		opts.retryMinDelay > 0 &&
 		opts.retryMaxDelay > 0 &&
-		opts.retryDelayExp > 1
+		opts.retryDelayExp > 1 &&
+		opts.localChunks >= 0 &&
+		opts.localSize >= 0 &&
+		opts.cacheSize >= 0 &&
+		opts.prefetchChunks >= 0
 }
 
 func (opts *Options) WithParallelUploads(parallelUploads int) *Options {
@@ -79,3 +90,23 @@ func (opts *Options) WithRetryDelayJitter(retryDelayJitter float64) *Options {
 	opts.retryDelayJitter = retryDelayJitter
 	return opts
 }
+
+func (opts *Options) WithLocalChunks(localChunks int) *Options {
+	opts.localChunks = localChunks
+	return opts
+}
+
+func (opts *Options) WithLocalSize(localSize int64) *Options {
+	opts.localSize = localSize
+	return opts
+}
+
+func (opts *Options) WithCacheSize(cacheSize int64) *Options {
+	opts.cacheSize = cacheSize
+	return opts
+}
+
+func (opts *Options) WithPrefetchChunks(prefetchChunks int) *Options {
+	opts.prefetchChunks = prefetchChunks
+	return opts
+}
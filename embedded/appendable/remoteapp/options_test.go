@@ -40,6 +40,15 @@ func TestValidOptions(t *testing.T) {
 	require.Equal(t, 7*time.Second, opts.WithRetryMaxDelay(7*time.Second).retryMaxDelay)
 	require.Equal(t, 1.3, opts.WithRetryDelayExp(1.3).retryDelayExp)
 	require.Equal(t, 0.2, opts.WithRetryDelayJitter(0.2).retryDelayJitter)
+	require.Equal(t, 3, opts.WithLocalChunks(3).localChunks)
+	require.EqualValues(t, 1024, opts.WithLocalSize(1024).localSize)
+	require.EqualValues(t, 2048, opts.WithCacheSize(2048).cacheSize)
+	require.Equal(t, 2, opts.WithPrefetchChunks(2).prefetchChunks)
 
 	require.True(t, opts.Valid())
+
+	require.False(t, DefaultOptions().WithLocalChunks(-1).Valid())
+	require.False(t, DefaultOptions().WithLocalSize(-1).Valid())
+	require.False(t, DefaultOptions().WithCacheSize(-1).Valid())
+	require.False(t, DefaultOptions().WithPrefetchChunks(-1).Valid())
 }
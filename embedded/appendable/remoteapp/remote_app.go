@@ -26,6 +26,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -44,6 +45,7 @@ type chunkInfo struct {
 	state        chunkState
 	storageSize  int64              // Used storage size in bytes
 	cancelUpload context.CancelFunc // Set to non-nil when the upload is in progress
+	lastAccess   int64              // Used to find least recently used cached chunks
 }
 
 type RemoteStorageAppendable struct {
@@ -70,6 +72,18 @@ type RemoteStorageAppendable struct {
 	chunkDownloadFinished *sync.Cond
 
 	statsUpdaterWaitGroup sync.WaitGroup
+
+	localChunks     int
+	localSize       int64
+	cacheSize       int64
+	prefetchChunks  int
+	accessCounter   int64
+	lastOpenedChunk int64
+	opened          bool
+	closed          bool
+
+	// wraps the appendables of the chunks read from the remote storage, if any
+	appWrapper multiapp.AppendableWrapper
 }
 
 func Open(path string, remotePath string, storage remotestorage.Storage, opts *Options) (*RemoteStorageAppendable, error) {
@@ -104,6 +118,12 @@ func Open(path string, remotePath string, storage remotestorage.Storage, opts *O
 		mainContext:     mainContext,
 		mainCancelFunc:  mainCancelFunc,
 		uploadThrottler: make(chan struct{}, opts.parallelUploads),
+		localChunks:     opts.localChunks,
+		localSize:       opts.localSize,
+		cacheSize:       opts.cacheSize,
+		prefetchChunks:  opts.prefetchChunks,
+		lastOpenedChunk: -2,
+		appWrapper:      opts.GetAppendableWrapper(),
 	}
 	ret.chunkUploadFinished = sync.NewCond(&ret.mutex)
 	ret.chunkDownloadFinished = sync.NewCond(&ret.mutex)
@@ -117,11 +137,13 @@ func Open(path string, remotePath string, storage remotestorage.Storage, opts *O
 
 	// Start uploading all chunks that are still stored locally
 	ret.mutex.Lock()
+	ret.opened = true
 	for chunkID, chunkInfo := range ret.chunkInfos {
 		if chunkInfo.state == chunkState_Local {
 			ret.uploadChunk(int64(chunkID), false)
 		}
 	}
+	ret.enforceTieringPolicy()
 	ret.mutex.Unlock()
 
 	ret.startStatsUpdater()
@@ -152,6 +174,11 @@ func (r *RemoteStorageAppendable) uploadFinished(chunkID int64, state chunkState
 	r.chunkInfos[chunkID].state = state
 	r.chunkInfos[chunkID].cancelUpload = nil
 	r.chunkUploadFinished.Broadcast()
+
+	if state == chunkState_Cached {
+		r.touchChunk(chunkID)
+		r.enforceTieringPolicy()
+	}
 }
 
 func (r *RemoteStorageAppendable) uploadChunk(chunkID int64, dontRemoveFile bool) {
@@ -188,6 +215,7 @@ func (r *RemoteStorageAppendable) uploadChunk(chunkID int64, dontRemoveFile bool
 		defer metricsUploadFinished.Inc()
 
 		var newApp appendable.Appendable
+		keepLocal := dontRemoveFile
 		cp := r.chunkedProcess(ctx)
 
 		// Chunk data was already flushed when changing the active appendable
@@ -226,9 +254,32 @@ func (r *RemoteStorageAppendable) uploadChunk(chunkID int64, dontRemoveFile bool
 			return true, nil
 		})
 
+		// Chunks within the hot range are kept on the local disk
+		cp.Step(func() error {
+			r.mutex.Lock()
+			defer r.mutex.Unlock()
+
+			keepLocal = keepLocal || chunkID >= r.firstHotChunk()
+			return nil
+		})
+
 		// Open new appendable from the remote storage
 		cp.RetryableStep(func(retries int, delay time.Duration) (bool, error) {
+			if keepLocal {
+				return false, nil
+			}
+
 			app, err := r.openRemoteAppendableReader(appName)
+			if err == nil && r.appWrapper != nil {
+				// the cached appendable is replaced by one providing the same content
+				var wrappedApp appendable.Appendable
+
+				wrappedApp, err = r.appWrapper.Wrap(app)
+				if err != nil {
+					app.Close()
+				}
+				app = wrappedApp
+			}
 			if err == nil {
 				newApp = app
 				return false, nil
@@ -240,6 +291,10 @@ func (r *RemoteStorageAppendable) uploadChunk(chunkID int64, dontRemoveFile bool
 
 		// Replace the cached instance of appendable for the chunk
 		cp.Step(func() error {
+			if keepLocal {
+				return nil
+			}
+
 			oldApp, err := r.ReplaceCachedChunk(chunkID, newApp)
 			if err != nil && err != cache.ErrKeyNotFound {
 				// Couldn't replace the cache entry? Can't continue with the cleanup
@@ -256,7 +311,7 @@ func (r *RemoteStorageAppendable) uploadChunk(chunkID int64, dontRemoveFile bool
 
 		// Cleanup the chunk
 		cp.Step(func() error {
-			if dontRemoveFile {
+			if keepLocal {
 				return nil
 			}
 
@@ -289,7 +344,11 @@ func (r *RemoteStorageAppendable) uploadChunk(chunkID int64, dontRemoveFile bool
 		}
 
 		// All done
-		r.uploadFinished(chunkID, chunkState_Remote)
+		if keepLocal {
+			r.uploadFinished(chunkID, chunkState_Cached)
+		} else {
+			r.uploadFinished(chunkID, chunkState_Remote)
+		}
 		metricsUploadSucceeded.Inc()
 	}()
 }
@@ -303,9 +362,16 @@ func (r *RemoteStorageAppendable) downloadFinished(chunkID int64, state chunkSta
 	r.chunkInfos[chunkID].state = state
 	r.chunkInfos[chunkID].cancelUpload = nil
 	r.chunkDownloadFinished.Broadcast()
+
+	if state == chunkState_Cached {
+		r.touchChunk(chunkID)
+		r.enforceTieringPolicy()
+	}
 }
 
-func (r *RemoteStorageAppendable) downloadChunk(chunkID int64) {
+// downloadChunk fetches the chunk from the remote storage into a local file,
+// the chunk is switched to the given state once the download succeeds
+func (r *RemoteStorageAppendable) downloadChunk(chunkID int64, state chunkState) {
 	r.shutdownWaitGroup.Add(1)
 	ctx, cancelFunc := context.WithCancel(r.mainContext)
 	r.chunkInfos[chunkID].state = chunkState_Downloading
@@ -383,7 +449,7 @@ func (r *RemoteStorageAppendable) downloadChunk(chunkID int64) {
 		}
 
 		metricsDownloadSucceeded.Inc()
-		r.downloadFinished(chunkID, chunkState_Local)
+		r.downloadFinished(chunkID, state)
 	}()
 }
 
@@ -397,6 +463,7 @@ func (r *RemoteStorageAppendable) Close() error {
 	// note that flushing is not needed here because
 	// all chunks are already closed
 	r.mutex.Lock()
+	r.closed = true
 	for chunkID, info := range r.chunkInfos {
 		switch info.state {
 		case chunkState_Active, chunkState_Local:
@@ -456,6 +523,12 @@ func (r *RemoteStorageAppendable) OpenAppendable(options *singleapp.Options, app
 		}
 	}
 
+	if !activeChunk {
+		r.prefetch(appID)
+	}
+
+	cacheMiss := false
+
 	for {
 		switch r.chunkInfos[appID].state {
 		case chunkState_Active, chunkState_Local, chunkState_UploadError:
@@ -464,11 +537,24 @@ func (r *RemoteStorageAppendable) OpenAppendable(options *singleapp.Options, app
 			if activeChunk {
 				// Switch to the active chunk state if needed
 				r.chunkInfos[appID].state = chunkState_Active
+			} else {
+				metricsCacheHits.Inc()
+			}
+			return singleapp.Open(filepath.Join(r.path, appname), options)
+
+		case chunkState_Cached:
+			// Chunk is already uploaded but a local copy is still available
+			if activeChunk {
+				r.chunkInfos[appID].state = chunkState_Active
+			} else if !cacheMiss {
+				r.touchChunk(appID)
+				metricsCacheHits.Inc()
 			}
 			return singleapp.Open(filepath.Join(r.path, appname), options)
 
 		case chunkState_Uploading:
 			if !activeChunk {
+				metricsCacheHits.Inc()
 				return singleapp.Open(filepath.Join(r.path, appname), options)
 			}
 
@@ -485,7 +571,15 @@ func (r *RemoteStorageAppendable) OpenAppendable(options *singleapp.Options, app
 		case chunkState_Remote:
 			if activeChunk {
 				// Force download of the chunk, we'll have to wait for it
-				r.downloadChunk(appID)
+				r.downloadChunk(appID, chunkState_Local)
+				continue
+			}
+
+			if r.cacheSize > 0 || appID >= r.firstHotChunk() {
+				// Fetch the chunk into the local cache, we'll have to wait for it
+				cacheMiss = true
+				metricsCacheMisses.Inc()
+				r.downloadChunk(appID, chunkState_Cached)
 				continue
 			}
 
@@ -570,12 +664,21 @@ func (r *RemoteStorageAppendable) OpenInitialAppendable(opts *multiapp.Options,
 				log.Printf("Chunk validation failed, remote chunk %d has more data than the local file", id)
 				return nil, 0, ErrInvalidRemoteStorage
 			}
+			if entry.Size == chunkInfos[id].storageSize {
+				// Local copy of an already uploaded chunk,
+				// the last chunk is excluded since it will become the active one
+				chunkInfos[id].state = chunkState_Cached
+			}
 		} else {
 			chunkInfos[id].state = chunkState_Remote
 			chunkInfos[id].storageSize = entry.Size
 		}
 	}
 
+	if len(chunkInfos) > 0 && chunkInfos[len(chunkInfos)-1].state == chunkState_Cached {
+		chunkInfos[len(chunkInfos)-1].state = chunkState_Local
+	}
+
 	// Ensure we have all chunks
 	for id, info := range chunkInfos {
 		if info.state == chunkState_Invalid {
@@ -605,6 +708,145 @@ func (r *RemoteStorageAppendable) OpenInitialAppendable(opts *multiapp.Options,
 	return app, appID, nil
 }
 
+// firstHotChunk returns the ID of the oldest chunk that should be kept on the local disk,
+// those are the last localChunks chunks or the last chunks fitting in localSize bytes.
+// Must be called with the mutex locked
+func (r *RemoteStorageAppendable) firstHotChunk() int64 {
+	first := int64(len(r.chunkInfos))
+
+	if r.localChunks > 0 {
+		first = int64(len(r.chunkInfos) - r.localChunks)
+		if first < 0 {
+			first = 0
+		}
+	}
+
+	if r.localSize > 0 {
+		size := int64(0)
+		for id := int64(len(r.chunkInfos)) - 1; id >= 0; id-- {
+			size += r.chunkInfos[id].storageSize
+			if size > r.localSize {
+				break
+			}
+			if id < first {
+				first = id
+			}
+		}
+	}
+
+	return first
+}
+
+// touchChunk marks the chunk as recently used, must be called with the mutex locked
+func (r *RemoteStorageAppendable) touchChunk(chunkID int64) {
+	r.accessCounter++
+	r.chunkInfos[chunkID].lastAccess = r.accessCounter
+}
+
+// prefetch starts downloading chunks that follow the given one if reads are sequential,
+// must be called with the mutex locked
+func (r *RemoteStorageAppendable) prefetch(chunkID int64) {
+	sequential := chunkID == r.lastOpenedChunk+1
+	r.lastOpenedChunk = chunkID
+
+	if !sequential || r.prefetchChunks == 0 || r.closed {
+		return
+	}
+
+	for i := chunkID + 1; i <= chunkID+int64(r.prefetchChunks) && i < int64(len(r.chunkInfos)); i++ {
+		if r.chunkInfos[i].state != chunkState_Remote {
+			continue
+		}
+
+		metricsCachePrefetches.Inc()
+		r.downloadChunk(i, chunkState_Cached)
+	}
+}
+
+// enforceTieringPolicy removes local copies of least recently used chunks
+// outside of the hot range once the cache exceeds its size limit,
+// must be called with the mutex locked
+func (r *RemoteStorageAppendable) enforceTieringPolicy() {
+	if !r.opened || r.closed {
+		return
+	}
+
+	firstHot := r.firstHotChunk()
+
+	cached := []int64{}
+	cachedSize := int64(0)
+
+	for id := int64(0); id < firstHot; id++ {
+		if r.chunkInfos[id].state == chunkState_Cached {
+			cached = append(cached, id)
+			cachedSize += r.chunkInfos[id].storageSize
+		}
+	}
+
+	sort.Slice(cached, func(i, j int) bool {
+		return r.chunkInfos[cached[i]].lastAccess < r.chunkInfos[cached[j]].lastAccess
+	})
+
+	for _, id := range cached {
+		if cachedSize <= r.cacheSize {
+			break
+		}
+
+		r.evictChunk(id)
+		cachedSize -= r.chunkInfos[id].storageSize
+	}
+}
+
+// evictChunk removes the local copy of an uploaded chunk, must be called with the mutex locked.
+// The chunk is being cleaned until reads in progress of its cached appendable are completed,
+// the removal takes place without holding the mutex
+func (r *RemoteStorageAppendable) evictChunk(chunkID int64) {
+	r.chunkInfos[chunkID].state = chunkState_Cleaning
+
+	r.shutdownWaitGroup.Add(1)
+	go func() {
+		defer r.shutdownWaitGroup.Done()
+
+		err := r.removeCachedChunk(chunkID)
+		if err != nil {
+			log.Printf("Evicting chunk %d from local cache failed: %v", chunkID, err)
+		}
+
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+
+		if err != nil {
+			r.chunkInfos[chunkID].state = chunkState_Cached
+		} else {
+			r.chunkInfos[chunkID].state = chunkState_Remote
+			metricsCacheEvictions.Inc()
+		}
+
+		r.chunkUploadFinished.Broadcast()
+	}()
+}
+
+func (r *RemoteStorageAppendable) removeCachedChunk(chunkID int64) error {
+	// reads in progress are completed before the appendable is handed over
+	app, err := r.PopCachedChunk(chunkID)
+	if err != nil && !errors.Is(err, cache.ErrKeyNotFound) {
+		return err
+	}
+	if err == nil {
+		err = app.Close()
+		if err != nil && !errors.Is(err, singleapp.ErrAlreadyClosed) {
+			return err
+		}
+	}
+
+	err = os.Remove(filepath.Join(r.path, r.appendableName(chunkID)))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
 func (r *RemoteStorageAppendable) openRemoteAppendableReader(name string) (appendable.Appendable, error) {
 	return openRemoteStorageReader(
 		r.rStorage,
@@ -17,6 +17,7 @@ limitations under the License.
 package remoteapp
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -30,6 +31,7 @@ import (
 	"time"
 
 	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/encrypted"
 	"github.com/codenotary/immudb/embedded/appendable/multiapp"
 	"github.com/codenotary/immudb/embedded/appendable/singleapp"
 	"github.com/codenotary/immudb/embedded/remotestorage"
@@ -331,6 +333,70 @@ func TestReopenFromRemoteStorageOnCleanShutdown(t *testing.T) {
 	require.Equal(t, dataWritten, dataRead)
 }
 
+func TestRemoteStorageWithAppendableWrapper(t *testing.T) {
+	path := t.TempDir()
+
+	keys := encrypted.NewKeyRing()
+	require.NoError(t, keys.AddKey("key1", make([]byte, 32)))
+
+	wrapper, err := encrypted.NewAppendableWrapper(keys, encrypted.DefaultOptions().WithBlockSize(4))
+	require.NoError(t, err)
+
+	mem := memory.Open()
+	opts := DefaultOptions()
+	opts.WithFileExt("tst")
+	opts.WithFileSize(10)
+	opts.WithAppendableWrapper(wrapper)
+
+	app, err := Open(path, "", mem, opts)
+	require.NoError(t, err)
+
+	dataWritten := []byte("Some pretty long string to cross a chunk boundary")
+
+	_, _, err = app.Append(dataWritten)
+	require.NoError(t, err)
+
+	err = app.Flush()
+	require.NoError(t, err)
+
+	// uploaded chunks are read back from the remote storage
+	require.True(t, waitForRemoval(fmt.Sprintf("%s/00000000.tst", path)))
+	require.True(t, waitForRemoval(fmt.Sprintf("%s/00000003.tst", path)))
+
+	dataRead := make([]byte, len(dataWritten))
+	_, err = app.ReadAt(dataRead, 0)
+	require.NoError(t, err)
+	require.Equal(t, dataWritten, dataRead)
+
+	err = app.Close()
+	require.NoError(t, err)
+
+	// uploaded chunks only hold ciphertext
+	for i := 0; i < 4; i++ {
+		obj, err := mem.Get(context.Background(), fmt.Sprintf("%08d.tst", i), 0, -1)
+		require.NoError(t, err)
+
+		content, err := ioutil.ReadAll(obj)
+		require.NoError(t, err)
+		require.NoError(t, obj.Close())
+
+		require.NotContains(t, string(content), string(dataWritten[i*10:(i+1)*10]))
+	}
+
+	err = os.RemoveAll(path)
+	require.NoError(t, err)
+
+	app, err = Open(path, "", mem, opts)
+	require.NoError(t, err)
+
+	defer app.Close()
+
+	dataRead = make([]byte, len(dataWritten))
+	_, err = app.ReadAt(dataRead, 0)
+	require.NoError(t, err)
+	require.Equal(t, dataWritten, dataRead)
+}
+
 func TestRemoteStorageMetrics(t *testing.T) {
 	mStarted := testutil.ToFloat64(metricsUploadStarted)
 	mFinished := testutil.ToFloat64(metricsUploadFinished)
@@ -929,3 +995,205 @@ func TestRemoteStorageOpenInitialAppendableCorruptedLocalFile(t *testing.T) {
 	require.Equal(t, ErrInvalidRemoteStorage, err)
 	require.Nil(t, app)
 }
+
+func prepareRemoteTestChunks(t *testing.T) (string, *memory.Storage, []byte) {
+	path := t.TempDir()
+	mem := memory.Open()
+
+	opts := DefaultOptions()
+	opts.WithFileExt("tst").WithFileSize(10)
+
+	app, err := Open(path, "", mem, opts)
+	require.NoError(t, err)
+
+	dataWritten := []byte("Some pretty long string to cross a chunk boundary")
+	_, _, err = app.Append(dataWritten)
+	require.NoError(t, err)
+
+	for i := 0; i < 4; i++ {
+		require.True(t, waitForChunkState(app, i, chunkState_Remote))
+	}
+
+	err = app.Close()
+	require.NoError(t, err)
+
+	return path, mem, dataWritten
+}
+
+func TestTieringKeepsHotChunks(t *testing.T) {
+	path := t.TempDir()
+	mem := memory.Open()
+
+	opts := DefaultOptions()
+	opts.WithFileExt("tst").WithFileSize(10)
+	opts.WithLocalChunks(3)
+
+	app, err := Open(path, "", mem, opts)
+	require.NoError(t, err)
+
+	_, _, err = app.Append([]byte("Some pretty long string to cross a chunk boundary"))
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		require.True(t, waitForChunkState(app, i, chunkState_Remote))
+		require.True(t, waitForRemoval(fmt.Sprintf("%s/%08d.tst", path, i)))
+	}
+	for i := 2; i < 4; i++ {
+		require.True(t, waitForChunkState(app, i, chunkState_Cached))
+		require.FileExists(t, fmt.Sprintf("%s/%08d.tst", path, i))
+		require.True(t, waitForObject(mem, fmt.Sprintf("%08d.tst", i)))
+	}
+
+	err = app.Close()
+	require.NoError(t, err)
+}
+
+func TestTieringKeepsHotBytes(t *testing.T) {
+	path := t.TempDir()
+	mem := memory.Open()
+
+	opts := DefaultOptions()
+	opts.WithFileExt("tst").WithFileSize(10)
+	opts.WithLocalSize(2*199 + 100)
+
+	app, err := Open(path, "", mem, opts)
+	require.NoError(t, err)
+
+	_, _, err = app.Append([]byte("Some pretty long string to cross a chunk boundary"))
+	require.NoError(t, err)
+
+	require.True(t, waitForChunkState(app, 1, chunkState_Remote))
+	require.True(t, waitForChunkState(app, 2, chunkState_Cached))
+	require.True(t, waitForChunkState(app, 3, chunkState_Cached))
+
+	err = app.Close()
+	require.NoError(t, err)
+}
+
+func TestTieringCacheEviction(t *testing.T) {
+	path, mem, dataWritten := prepareRemoteTestChunks(t)
+
+	mHits := testutil.ToFloat64(metricsCacheHits)
+	mMisses := testutil.ToFloat64(metricsCacheMisses)
+	mEvictions := testutil.ToFloat64(metricsCacheEvictions)
+
+	opts := DefaultOptions()
+	opts.WithFileExt("tst").WithFileSize(10)
+	opts.WithCacheSize(2 * 199)
+
+	app, err := Open(path, "", mem, opts)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		dataRead := make([]byte, 10)
+		_, err = app.ReadAt(dataRead, int64(i*10))
+		require.NoError(t, err)
+		require.Equal(t, dataWritten[i*10:(i+1)*10], dataRead)
+	}
+
+	// The least recently used chunk is evicted from the local cache
+	require.True(t, waitForChunkState(app, 0, chunkState_Remote))
+	require.NoFileExists(t, fmt.Sprintf("%s/00000000.tst", path))
+	for i := 1; i < 3; i++ {
+		require.True(t, waitForChunkState(app, i, chunkState_Cached))
+		require.FileExists(t, fmt.Sprintf("%s/%08d.tst", path, i))
+	}
+
+	// Evicted chunk is fetched again on the next read
+	dataRead := make([]byte, 10)
+	_, err = app.ReadAt(dataRead, 0)
+	require.NoError(t, err)
+	require.Equal(t, dataWritten[:10], dataRead)
+	require.True(t, waitForChunkState(app, 1, chunkState_Remote))
+
+	require.EqualValues(t, 0, testutil.ToFloat64(metricsCacheHits)-mHits)
+	require.EqualValues(t, 4, testutil.ToFloat64(metricsCacheMisses)-mMisses)
+	require.EqualValues(t, 2, testutil.ToFloat64(metricsCacheEvictions)-mEvictions)
+
+	err = app.Close()
+	require.NoError(t, err)
+}
+
+func TestTieringConcurrentReadsAndEvictions(t *testing.T) {
+	path, mem, dataWritten := prepareRemoteTestChunks(t)
+
+	opts := DefaultOptions()
+	opts.WithFileExt("tst").WithFileSize(10)
+	opts.WithCacheSize(199)
+
+	app, err := Open(path, "", mem, opts)
+	require.NoError(t, err)
+
+	const readers = 8
+	const reads = 100
+
+	var wg sync.WaitGroup
+	errs := make(chan error, readers)
+
+	for r := 0; r < readers; r++ {
+		wg.Add(1)
+
+		go func(r int) {
+			defer wg.Done()
+
+			for i := 0; i < reads; i++ {
+				// chunks are read in turns so that each read evicts a chunk that may be being read
+				chunk := (r + i) % 4
+
+				dataRead := make([]byte, 10)
+
+				_, err := app.ReadAt(dataRead, int64(chunk*10))
+				if err != nil {
+					errs <- err
+					return
+				}
+
+				if !bytes.Equal(dataWritten[chunk*10:(chunk+1)*10], dataRead) {
+					errs <- fmt.Errorf("unexpected data read from chunk %d", chunk)
+					return
+				}
+			}
+		}(r)
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	err = app.Close()
+	require.NoError(t, err)
+}
+
+func TestTieringPrefetchOnSequentialReads(t *testing.T) {
+	path, mem, dataWritten := prepareRemoteTestChunks(t)
+
+	mPrefetches := testutil.ToFloat64(metricsCachePrefetches)
+
+	opts := DefaultOptions()
+	opts.WithFileExt("tst").WithFileSize(10)
+	opts.WithCacheSize(1 << 20)
+	opts.WithPrefetchChunks(2)
+
+	app, err := Open(path, "", mem, opts)
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		dataRead := make([]byte, 10)
+		_, err = app.ReadAt(dataRead, int64(i*10))
+		require.NoError(t, err)
+		require.Equal(t, dataWritten[i*10:(i+1)*10], dataRead)
+	}
+
+	// Following chunks are fetched in the background
+	require.True(t, waitForChunkState(app, 2, chunkState_Cached))
+	require.True(t, waitForChunkState(app, 3, chunkState_Cached))
+	require.FileExists(t, fmt.Sprintf("%s/00000003.tst", path))
+
+	require.EqualValues(t, 2, testutil.ToFloat64(metricsCachePrefetches)-mPrefetches)
+
+	err = app.Close()
+	require.NoError(t, err)
+}
@@ -11,11 +11,19 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 )
 
+// metadata keys of the header written by singleapp
+const (
+	metaCompressionFormat = "COMPRESSION_FORMAT"
+	metaCompressionLevel  = "COMPRESSION_LEVEL"
+	metaWrappedMeta       = "WRAPPED_METADATA"
+)
+
 type remoteStorageReader struct {
 	r          remotestorage.Storage
 	name       string
 	baseOffset int64
 	dataCache  []byte // Initially we read the whole object into data cache
+	header     []byte // metadata written by singleapp
 }
 
 func openRemoteStorageReader(r remotestorage.Storage, name string) (*remoteStorageReader, error) {
@@ -42,8 +50,6 @@ func openRemoteStorageReader(r remotestorage.Storage, name string) (*remoteStora
 		return nil, ErrCorruptedMetadata
 	}
 
-	// TODO: Read the metadata and validate it
-
 	baseOffset := int64(4 + binary.BigEndian.Uint32(data[:4]))
 	if baseOffset > int64(len(data)) {
 		metricsCorruptedMetadata.Inc()
@@ -55,15 +61,17 @@ func openRemoteStorageReader(r remotestorage.Storage, name string) (*remoteStora
 		name:       name,
 		baseOffset: baseOffset,
 		dataCache:  data[baseOffset:],
+		header:     data[4:baseOffset],
 	}, nil
 }
 
 func (r *remoteStorageReader) Metadata() []byte {
-	panic("unimplemented")
+	metadata, _ := appendable.NewMetadata(r.header).Get(metaWrappedMeta)
+	return metadata
 }
 
 func (r *remoteStorageReader) Size() (int64, error) {
-	panic("unimplemented")
+	return int64(len(r.dataCache)), nil
 }
 
 func (r *remoteStorageReader) Offset() int64 {
@@ -83,11 +91,16 @@ func (r *remoteStorageReader) Append(bs []byte) (off int64, n int, err error) {
 }
 
 func (r *remoteStorageReader) CompressionFormat() int {
-	panic("unimplemented")
+	compressionFormat, ok := appendable.NewMetadata(r.header).GetInt(metaCompressionFormat)
+	if !ok {
+		return appendable.NoCompression
+	}
+	return compressionFormat
 }
 
 func (r *remoteStorageReader) CompressionLevel() int {
-	panic("unimplemented")
+	compressionLevel, _ := appendable.NewMetadata(r.header).GetInt(metaCompressionLevel)
+	return compressionLevel
 }
 
 func (r *remoteStorageReader) Flush() error {
@@ -6,8 +6,11 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/singleapp"
 	"github.com/codenotary/immudb/embedded/remotestorage"
 	"github.com/codenotary/immudb/embedded/remotestorage/memory"
 	"github.com/stretchr/testify/require"
@@ -36,14 +39,10 @@ func storeData(t *testing.T, s remotestorage.Storage, name string, data []byte)
 func TestRemoteStorageReaderUnsupportedMethods(t *testing.T) {
 	r := remoteStorageReader{}
 
-	require.Panics(t, func() { r.Metadata() })
-	require.Panics(t, func() { r.Size() })
 	require.Panics(t, func() { r.Offset() })
 	require.Panics(t, func() { r.SetOffset(0) })
 	require.Panics(t, func() { r.Append([]byte{0}) })
 	require.Panics(t, func() { r.DiscardUpto(0) })
-	require.Panics(t, func() { r.CompressionFormat() })
-	require.Panics(t, func() { r.CompressionLevel() })
 	require.Panics(t, func() { r.Copy("/tmp") })
 }
 
@@ -95,6 +94,40 @@ func TestRemoteStorageReadAt(t *testing.T) {
 	require.Equal(t, io.EOF, err)
 }
 
+func TestRemoteStorageReaderHeader(t *testing.T) {
+	path := t.TempDir()
+
+	app, err := singleapp.Open(filepath.Join(path, "fl"), singleapp.DefaultOptions().
+		WithCompressionFormat(appendable.ZLibCompression).
+		WithCompresionLevel(appendable.BestCompression).
+		WithMetadata([]byte{1, 2, 3}))
+	require.NoError(t, err)
+
+	_, _, err = app.Append([]byte{1, 2, 3, 4})
+	require.NoError(t, err)
+
+	size, err := app.Size()
+	require.NoError(t, err)
+
+	require.NoError(t, app.Close())
+
+	m := memory.Open()
+
+	err = m.Put(context.Background(), "fl", filepath.Join(path, "fl"))
+	require.NoError(t, err)
+
+	r, err := openRemoteStorageReader(m, "fl")
+	require.NoError(t, err)
+
+	require.Equal(t, []byte{1, 2, 3}, r.Metadata())
+	require.Equal(t, appendable.ZLibCompression, r.CompressionFormat())
+	require.Equal(t, appendable.BestCompression, r.CompressionLevel())
+
+	remoteSize, err := r.Size()
+	require.NoError(t, err)
+	require.Equal(t, size, remoteSize)
+}
+
 func TestRemoteStorageCorruptedHeader(t *testing.T) {
 	for _, d := range []struct {
 		name  string
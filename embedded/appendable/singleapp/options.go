@@ -39,8 +39,9 @@ type Options struct {
 
 	fileMode os.FileMode
 
-	compressionFormat int
-	compressionLevel  int
+	compressionFormat     int
+	compressionLevel      int
+	compressionDictionary []byte
 
 	preallocSize      int
 	createIfNotExists bool
@@ -79,6 +80,14 @@ func (opts *Options) Validate() error {
 		return fmt.Errorf("%w: invalid preallocSize", ErrInvalidOptions)
 	}
 
+	if opts.compressionFormat < appendable.NoCompression || opts.compressionFormat > appendable.SnappyCompression {
+		return fmt.Errorf("%w: invalid compressionFormat", ErrInvalidOptions)
+	}
+
+	if len(opts.compressionDictionary) > appendable.MaxDictionarySize {
+		return fmt.Errorf("%w: invalid compressionDictionary", ErrInvalidOptions)
+	}
+
 	return nil
 }
 
@@ -125,6 +134,10 @@ func (opts *Options) GetCompressionLevel() int {
 	return opts.compressionLevel
 }
 
+func (opts *Options) GetCompressionDictionary() []byte {
+	return opts.compressionDictionary
+}
+
 func (opts *Options) GetReadBufferSize() int {
 	return opts.readBufferSize
 }
@@ -137,11 +150,22 @@ func (opts *Options) GetWriteBuffer() []byte {
 	return opts.writeBuffer
 }
 
+func (opts *Options) GetMetadata() []byte {
+	return opts.metadata
+}
+
 func (opts *Options) WithCompresionLevel(compressionLevel int) *Options {
 	opts.compressionLevel = compressionLevel
 	return opts
 }
 
+// WithCompressionDictionary sets the dictionary used with Zstd compression.
+// It's recorded when the appendable is created, existing appendables keep the dictionary they were created with.
+func (opts *Options) WithCompressionDictionary(dict []byte) *Options {
+	opts.compressionDictionary = dict
+	return opts
+}
+
 func (opts *Options) WithMetadata(metadata []byte) *Options {
 	opts.metadata = metadata
 	return opts
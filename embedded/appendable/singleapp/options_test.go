@@ -19,6 +19,8 @@ package singleapp
 import (
 	"testing"
 
+	"github.com/codenotary/immudb/embedded/appendable"
+
 	"github.com/stretchr/testify/require"
 )
 
@@ -31,6 +33,8 @@ func TestInvalidOptions(t *testing.T) {
 		{"empty", &Options{}},
 		{"ReadBufferSize", DefaultOptions().WithReadBufferSize(0)},
 		{"WriteBuffer", DefaultOptions().WithReadOnly(false).WithWriteBuffer(nil)},
+		{"CompressionFormat", DefaultOptions().WithCompressionFormat(-1)},
+		{"CompressionDictionary", DefaultOptions().WithCompressionDictionary(make([]byte, appendable.MaxDictionarySize+1))},
 	} {
 		t.Run(d.n, func(t *testing.T) {
 			require.ErrorIs(t, d.opts.Validate(), ErrInvalidOptions)
@@ -51,6 +55,7 @@ func TestValidOptions(t *testing.T) {
 	require.Equal(t, DefaultCompressionFormat, opts.WithCompressionFormat(DefaultCompressionFormat).GetCompressionFormat())
 	require.Equal(t, DefaultCompressionLevel, opts.WithCompresionLevel(DefaultCompressionLevel).compressionLevel)
 	require.Equal(t, DefaultCompressionLevel, opts.WithCompresionLevel(DefaultCompressionLevel).GetCompressionLevel())
+	require.Equal(t, []byte{1, 2}, opts.WithCompressionDictionary([]byte{1, 2}).GetCompressionDictionary())
 
 	require.True(t, opts.WithRetryableSync(true).retryableSync)
 	require.True(t, opts.WithAutoSync(true).autoSync)
@@ -66,6 +71,7 @@ func TestValidOptions(t *testing.T) {
 
 	b := make([]byte, DefaultWriteBufferSize)
 	require.Equal(t, b, opts.WithWriteBuffer(b).GetWriteBuffer())
+	require.Equal(t, []byte{1}, opts.WithMetadata([]byte{1}).GetMetadata())
 	require.NoError(t, opts.Validate())
 
 	require.True(t, opts.WithReadOnly(true).readOnly)
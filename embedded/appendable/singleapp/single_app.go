@@ -33,6 +33,8 @@ import (
 
 	"github.com/codenotary/immudb/embedded/appendable"
 	"github.com/codenotary/immudb/embedded/appendable/fileutils"
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
 )
 
 var ErrorPathIsNotADirectory = errors.New("singleapp: path is not a directory")
@@ -48,6 +50,7 @@ const (
 	metaPreallocSize      = "PREALLOC_SIZE"
 	metaCompressionFormat = "COMPRESSION_FORMAT"
 	metaCompressionLevel  = "COMPRESSION_LEVEL"
+	metaCompressionDict   = "COMPRESSION_DICTIONARY"
 	metaWrappedMeta       = "WRAPPED_METADATA"
 )
 
@@ -68,8 +71,13 @@ type AppendableFile struct {
 	retryableSync  bool
 	autoSync       bool
 
-	compressionFormat int
-	compressionLevel  int
+	compressionFormat     int
+	compressionLevel      int
+	compressionDictionary []byte
+
+	// zstd encoder and decoder are expensive to create, thus they're reused
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
 
 	preallocSize int
 
@@ -109,6 +117,7 @@ func Open(fileName string, opts *Options) (*AppendableFile, error) {
 	var metadata []byte
 	var compressionFormat int
 	var compressionLevel int
+	var compressionDictionary []byte
 	var fileBaseOffset int64
 
 	var preallocSize int
@@ -118,6 +127,9 @@ func Open(fileName string, opts *Options) (*AppendableFile, error) {
 		m.PutInt(metaPreallocSize, opts.preallocSize)
 		m.PutInt(metaCompressionFormat, opts.compressionFormat)
 		m.PutInt(metaCompressionLevel, opts.compressionLevel)
+		if len(opts.compressionDictionary) > 0 {
+			m.Put(metaCompressionDict, opts.compressionDictionary)
+		}
 		m.Put(metaWrappedMeta, opts.metadata)
 
 		mBs := m.Bytes()
@@ -166,6 +178,7 @@ func Open(fileName string, opts *Options) (*AppendableFile, error) {
 		preallocSize = opts.preallocSize
 		compressionFormat = opts.compressionFormat
 		compressionLevel = opts.compressionLevel
+		compressionDictionary = opts.compressionDictionary
 		metadata = opts.metadata
 
 		fileBaseOffset = int64(4 + len(mBs))
@@ -203,6 +216,8 @@ func Open(fileName string, opts *Options) (*AppendableFile, error) {
 		}
 		compressionLevel = cl
 
+		compressionDictionary, _ = m.Get(metaCompressionDict)
+
 		metadata, ok = m.Get(metaWrappedMeta)
 		if !ok {
 			return nil, ErrCorruptedMetadata
@@ -217,19 +232,20 @@ func Open(fileName string, opts *Options) (*AppendableFile, error) {
 	}
 
 	return &AppendableFile{
-		f:                 f,
-		fileBaseOffset:    fileBaseOffset,
-		fileOffset:        fileOffset - fileBaseOffset,
-		writeBuffer:       opts.writeBuffer,
-		readBufferSize:    opts.readBufferSize,
-		compressionFormat: compressionFormat,
-		compressionLevel:  compressionLevel,
-		preallocSize:      preallocSize,
-		metadata:          metadata,
-		readOnly:          opts.readOnly,
-		retryableSync:     opts.retryableSync,
-		autoSync:          opts.autoSync,
-		closed:            false,
+		f:                     f,
+		fileBaseOffset:        fileBaseOffset,
+		fileOffset:            fileOffset - fileBaseOffset,
+		writeBuffer:           opts.writeBuffer,
+		readBufferSize:        opts.readBufferSize,
+		compressionFormat:     compressionFormat,
+		compressionLevel:      compressionLevel,
+		compressionDictionary: compressionDictionary,
+		preallocSize:          preallocSize,
+		metadata:              metadata,
+		readOnly:              opts.readOnly,
+		retryableSync:         opts.retryableSync,
+		autoSync:              opts.autoSync,
+		closed:                false,
 	}, nil
 }
 
@@ -275,6 +291,11 @@ func (aof *AppendableFile) CompressionLevel() int {
 	return aof.compressionLevel
 }
 
+// CompressionDictionary returns the dictionary used with Zstd compression, if any
+func (aof *AppendableFile) CompressionDictionary() []byte {
+	return aof.compressionDictionary
+}
+
 func (aof *AppendableFile) Metadata() []byte {
 	return aof.metadata
 }
@@ -368,10 +389,43 @@ func (aof *AppendableFile) writer(w io.Writer) (cw io.Writer, err error) {
 		cw = lzw.NewWriter(w, lzw.MSB, 8)
 	case appendable.ZLibCompression:
 		cw, err = zlib.NewWriterLevel(w, aof.compressionLevel)
+	case appendable.ZstdCompression:
+		if aof.zstdEncoder == nil {
+			eopts := []zstd.EOption{
+				zstd.WithEncoderLevel(zstdEncoderLevel(aof.compressionLevel)),
+				zstd.WithEncoderConcurrency(1),
+			}
+
+			if len(aof.compressionDictionary) > 0 {
+				eopts = append(eopts, zstd.WithEncoderDictRaw(appendable.DictionaryID(aof.compressionDictionary), aof.compressionDictionary))
+			}
+
+			aof.zstdEncoder, err = zstd.NewWriter(nil, eopts...)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		aof.zstdEncoder.Reset(w)
+		cw = aof.zstdEncoder
+	case appendable.SnappyCompression:
+		cw = snappy.NewBufferedWriter(w)
 	}
 	return
 }
 
+// zstdEncoderLevel maps flate-like compression levels into zstd ones
+func zstdEncoderLevel(compressionLevel int) zstd.EncoderLevel {
+	switch compressionLevel {
+	case appendable.DefaultCompression:
+		return zstd.SpeedDefault
+	case appendable.BestCompression:
+		return zstd.SpeedBestCompression
+	}
+
+	return zstd.EncoderLevelFromZstd(compressionLevel)
+}
+
 func (aof *AppendableFile) reader(r io.Reader) (reader io.ReadCloser, err error) {
 	switch aof.compressionFormat {
 	case appendable.FlateCompression:
@@ -382,6 +436,29 @@ func (aof *AppendableFile) reader(r io.Reader) (reader io.ReadCloser, err error)
 		reader = lzw.NewReader(r, lzw.MSB, 8)
 	case appendable.ZLibCompression:
 		reader, err = zlib.NewReader(r)
+	case appendable.ZstdCompression:
+		if aof.zstdDecoder == nil {
+			dopts := []zstd.DOption{zstd.WithDecoderConcurrency(1)}
+
+			if len(aof.compressionDictionary) > 0 {
+				dopts = append(dopts, zstd.WithDecoderDictRaw(appendable.DictionaryID(aof.compressionDictionary), aof.compressionDictionary))
+			}
+
+			aof.zstdDecoder, err = zstd.NewReader(nil, dopts...)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		err = aof.zstdDecoder.Reset(r)
+		if err != nil {
+			return nil, err
+		}
+
+		// the decoder is not closed as it's reused
+		reader = io.NopCloser(aof.zstdDecoder)
+	case appendable.SnappyCompression:
+		reader = io.NopCloser(snappy.NewReader(r))
 	}
 	return
 }
@@ -727,6 +804,14 @@ func (aof *AppendableFile) Close() error {
 
 	aof.closed = true
 
+	if aof.zstdEncoder != nil {
+		aof.zstdEncoder.Close()
+	}
+
+	if aof.zstdDecoder != nil {
+		aof.zstdDecoder.Close()
+	}
+
 	return aof.f.Close()
 }
 
@@ -19,6 +19,7 @@ package singleapp
 import (
 	"bufio"
 	"encoding/binary"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"math/rand"
@@ -479,6 +480,98 @@ func TestSingleAppLZWCompression(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSingleAppZstdCompression(t *testing.T) {
+	opts := DefaultOptions().WithCompressionFormat(appendable.ZstdCompression)
+	a, err := Open(filepath.Join(t.TempDir(), "testdata.aof"), opts)
+	require.NoError(t, err)
+
+	off, _, err := a.Append([]byte{1, 2, 3})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), off)
+
+	off2, _, err := a.Append([]byte{4, 5, 6})
+	require.NoError(t, err)
+
+	err = a.Flush()
+	require.NoError(t, err)
+
+	bs := make([]byte, 3)
+	_, err = a.ReadAt(bs, 0)
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2, 3}, bs)
+
+	_, err = a.ReadAt(bs, off2)
+	require.NoError(t, err)
+	require.Equal(t, []byte{4, 5, 6}, bs)
+
+	err = a.Close()
+	require.NoError(t, err)
+}
+
+func TestSingleAppZstdCompressionWithDictionary(t *testing.T) {
+	var samples [][]byte
+	for i := 0; i < 100; i++ {
+		samples = append(samples, []byte(fmt.Sprintf(`{"name":"user%d","email":"user%d@example.com","active":true}`, i, i)))
+	}
+
+	dict, err := appendable.TrainDictionary(samples, 1024)
+	require.NoError(t, err)
+
+	fileName := filepath.Join(t.TempDir(), "testdata.aof")
+
+	opts := DefaultOptions().
+		WithCompressionFormat(appendable.ZstdCompression).
+		WithCompresionLevel(appendable.BestCompression).
+		WithCompressionDictionary(dict)
+
+	a, err := Open(fileName, opts)
+	require.NoError(t, err)
+	require.Equal(t, dict, a.CompressionDictionary())
+
+	value := []byte(`{"name":"user1000","email":"user1000@example.com","active":true}`)
+
+	off, _, err := a.Append(value)
+	require.NoError(t, err)
+
+	err = a.Close()
+	require.NoError(t, err)
+
+	// the dictionary is recorded when the appendable is created
+	a, err = Open(fileName, DefaultOptions().WithReadOnly(true))
+	require.NoError(t, err)
+	require.Equal(t, appendable.ZstdCompression, a.CompressionFormat())
+	require.Equal(t, dict, a.CompressionDictionary())
+
+	bs := make([]byte, len(value))
+	_, err = a.ReadAt(bs, off)
+	require.NoError(t, err)
+	require.Equal(t, value, bs)
+
+	err = a.Close()
+	require.NoError(t, err)
+}
+
+func TestSingleAppSnappyCompression(t *testing.T) {
+	opts := DefaultOptions().WithCompressionFormat(appendable.SnappyCompression)
+	a, err := Open(filepath.Join(t.TempDir(), "testdata.aof"), opts)
+	require.NoError(t, err)
+
+	off, _, err := a.Append([]byte{1, 2, 3})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), off)
+
+	err = a.Flush()
+	require.NoError(t, err)
+
+	bs := make([]byte, 3)
+	_, err = a.ReadAt(bs, 0)
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2, 3}, bs)
+
+	err = a.Close()
+	require.NoError(t, err)
+}
+
 func TestSingleAppCantCreateFile(t *testing.T) {
 	dir := t.TempDir()
 	os.Mkdir(filepath.Join(dir, "exists"), 0644)
@@ -36,12 +36,21 @@ type DocumentReader interface {
 
 type documentReader struct {
 	rowReader       sql.RowReader
+	docIDFieldName  string
+	projection      *documentProjection
 	onCloseCallback func(reader DocumentReader)
 }
 
-func newDocumentReader(rowReader sql.RowReader, onCloseCallback func(reader DocumentReader)) DocumentReader {
+func newDocumentReader(
+	rowReader sql.RowReader,
+	docIDFieldName string,
+	projection *documentProjection,
+	onCloseCallback func(reader DocumentReader),
+) DocumentReader {
 	return &documentReader{
 		rowReader:       rowReader,
+		docIDFieldName:  docIDFieldName,
+		projection:      projection,
 		onCloseCallback: onCloseCallback,
 	}
 }
@@ -67,19 +76,13 @@ func (r *documentReader) ReadN(ctx context.Context, count int) ([]*protomodel.Do
 			return nil, mayTranslateError(err)
 		}
 
-		docBytes := row.ValuesByPosition[0].RawValue().([]byte)
-
-		doc := &structpb.Struct{}
-		err = proto.Unmarshal(docBytes, doc)
+		var revision *protomodel.DocumentAtRevision
+		revision, err = r.decodeRow(row)
 		if err != nil {
 			return nil, err
 		}
 
-		revisions = append(revisions, &protomodel.DocumentAtRevision{
-			TransactionId: 0, // TODO: not yet available
-			Revision:      0, // TODO: not yet available
-			Document:      doc,
-		})
+		revisions = append(revisions, revision)
 	}
 
 	return revisions, err
@@ -104,19 +107,24 @@ func (r *documentReader) Read(ctx context.Context) (*protomodel.DocumentAtRevisi
 		return nil, mayTranslateError(err)
 	}
 
+	return r.decodeRow(row)
+}
+
+// decodeRow unmarshals the document stored in the row and applies the projection, if any.
+// The document id is taken from the full document so proofs can still be requested for it.
+func (r *documentReader) decodeRow(row *sql.Row) (*protomodel.DocumentAtRevision, error) {
 	docBytes := row.ValuesByPosition[0].RawValue().([]byte)
 
 	doc := &structpb.Struct{}
-	err = proto.Unmarshal(docBytes, doc)
+	err := proto.Unmarshal(docBytes, doc)
 	if err != nil {
 		return nil, err
 	}
 
-	revision := &protomodel.DocumentAtRevision{
+	return &protomodel.DocumentAtRevision{
 		TransactionId: 0, // TODO: not yet available
+		DocumentId:    doc.Fields[r.docIDFieldName].GetStringValue(),
 		Revision:      0, // TODO: not yet available
-		Document:      doc,
-	}
-
-	return revision, err
+		Document:      r.projection.apply(doc),
+	}, nil
 }
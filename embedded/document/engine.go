@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/embedded/store"
@@ -69,7 +70,8 @@ func NewEngine(store *store.ImmuStore, opts *Options) (*Engine, error) {
 
 	sqlOpts := sql.DefaultOptions().
 		WithPrefix(opts.prefix).
-		WithLazyIndexConstraintValidation(true)
+		WithLazyIndexConstraintValidation(true).
+		WithPrivilegeChecker(opts.privilegeChecker)
 
 	engine, err := sql.NewEngine(store, sqlOpts)
 	if err != nil {
@@ -130,11 +132,21 @@ func validateFieldName(fieldName string) error {
 }
 
 func (e *Engine) CreateCollection(ctx context.Context, name, documentIdFieldName string, fields []*protomodel.Field, indexes []*protomodel.Index) error {
+	return e.CreateCollectionWithTTL(ctx, name, documentIdFieldName, fields, indexes, 0)
+}
+
+// CreateCollectionWithTTL creates a collection whose documents expire once the ttl elapses since they were written.
+// A zero ttl means documents do not expire.
+func (e *Engine) CreateCollectionWithTTL(ctx context.Context, name, documentIdFieldName string, fields []*protomodel.Field, indexes []*protomodel.Index, ttl time.Duration) error {
 	err := validateCollectionName(name)
 	if err != nil {
 		return err
 	}
 
+	if ttl < 0 || ttl%time.Second != 0 {
+		return fmt.Errorf("%w: invalid ttl, it must be a non-negative number of seconds", ErrIllegalArguments)
+	}
+
 	if documentIdFieldName == "" {
 		documentIdFieldName = DefaultDocumentIDField
 	}
@@ -205,6 +217,10 @@ func (e *Engine) CreateCollection(ctx context.Context, name, documentIdFieldName
 
 	var indexStmts []sql.SQLStmt
 
+	if ttl > 0 {
+		indexStmts = append(indexStmts, sql.NewSetTableTTLStmt(name, ttl))
+	}
+
 	for _, index := range indexes {
 		if len(index.Fields) == 0 {
 			return fmt.Errorf("%w: no fields specified", ErrIllegalArguments)
@@ -225,10 +241,19 @@ func (e *Engine) CreateCollection(ctx context.Context, name, documentIdFieldName
 			}
 		}
 
+		if index.IsFullText {
+			if len(index.Fields) != 1 || index.IsUnique {
+				return fmt.Errorf("%w: full-text indexes must be non-unique and on a single field", ErrIllegalArguments)
+			}
+
+			indexStmts = append(indexStmts, sql.NewCreateFullTextIndexStmt(name, index.Fields[0]))
+			continue
+		}
+
 		indexStmts = append(indexStmts, sql.NewCreateIndexStmt(name, index.Fields, index.IsUnique))
 	}
 
-	// add indexes to collection
+	// add indexes and ttl to collection
 	if len(indexStmts) > 0 {
 		_, _, err = e.sqlEngine.ExecPreparedStmts(
 			ctx,
@@ -286,6 +311,11 @@ func (e *Engine) GetCollections(ctx context.Context) ([]*protomodel.Collection,
 	return collections, nil
 }
 
+// checkPrivilege checks a privilege on a collection accessed without executing sql statements
+func (e *Engine) checkPrivilege(ctx context.Context, sqlTx *sql.SQLTx, privilege sql.SQLPrivilege, collectionName string) error {
+	return e.sqlEngine.CheckPrivileges(ctx, sqlTx, []sql.TablePrivilege{{Privilege: privilege, Table: collectionName}})
+}
+
 func docIDFieldName(table *sql.Table) string {
 	return table.PrimaryIndex().Cols()[0].Name()
 }
@@ -327,6 +357,7 @@ func collectionFromTable(table *sql.Table) *protomodel.Collection {
 		Name:                table.Name(),
 		DocumentIdFieldName: documentIdFieldName,
 		Indexes:             make([]*protomodel.Index, len(indexes)),
+		Ttl:                 uint64(table.TTL() / time.Second),
 	}
 
 	for _, col := range table.Cols() {
@@ -365,8 +396,9 @@ func collectionFromTable(table *sql.Table) *protomodel.Collection {
 		}
 
 		collection.Indexes[i] = &protomodel.Index{
-			Fields:   fields,
-			IsUnique: index.IsUnique(),
+			Fields:     fields,
+			IsUnique:   index.IsUnique(),
+			IsFullText: index.IsFullText(),
 		}
 	}
 
@@ -472,17 +504,41 @@ func (e *Engine) CreateIndex(ctx context.Context, collectionName string, fields
 		return fmt.Errorf("%w: no fields specified", ErrIllegalArguments)
 	}
 
-	opts := sql.DefaultTxOptions().
-		WithUnsafeMVCC(true).
-		WithSnapshotMustIncludeTxID(func(lastPrecommittedTxID uint64) uint64 { return 0 }).
-		WithSnapshotRenewalPeriod(0).
-		WithExplicitClose(true)
+	for _, field := range fields {
+		err := validateFieldName(field)
+		if err != nil {
+			return err
+		}
+	}
 
-	sqlTx, err := e.sqlEngine.NewTx(ctx, opts)
+	return e.execCatalogStmt(ctx, sql.NewCreateIndexStmt(collectionName, fields, isUnique))
+}
+
+// CreateFullTextIndex creates a full-text index on a string field,
+// which is then used to resolve MATCH comparisons on such field
+func (e *Engine) CreateFullTextIndex(ctx context.Context, collectionName string, field string) error {
+	err := validateCollectionName(collectionName)
 	if err != nil {
-		return mayTranslateError(err)
+		return err
+	}
+
+	err = validateFieldName(field)
+	if err != nil {
+		return err
+	}
+
+	return e.execCatalogStmt(ctx, sql.NewCreateFullTextIndexStmt(collectionName, field))
+}
+
+func (e *Engine) DeleteIndex(ctx context.Context, collectionName string, fields []string) error {
+	err := validateCollectionName(collectionName)
+	if err != nil {
+		return err
+	}
+
+	if len(fields) == 0 {
+		return fmt.Errorf("%w: no fields specified", ErrIllegalArguments)
 	}
-	defer sqlTx.Cancel()
 
 	for _, field := range fields {
 		err := validateFieldName(field)
@@ -491,32 +547,45 @@ func (e *Engine) CreateIndex(ctx context.Context, collectionName string, fields
 		}
 	}
 
-	createIndexStmt := sql.NewCreateIndexStmt(collectionName, fields, isUnique)
+	return e.execCatalogStmt(ctx, sql.NewDropIndexStmt(collectionName, fields))
+}
 
-	_, _, err = e.sqlEngine.ExecPreparedStmts(
-		ctx,
-		sqlTx,
-		[]sql.SQLStmt{createIndexStmt},
-		nil,
-	)
+func (e *Engine) DeleteFullTextIndex(ctx context.Context, collectionName string, field string) error {
+	err := validateCollectionName(collectionName)
 	if err != nil {
-		return mayTranslateError(err)
+		return err
 	}
 
-	err = sqlTx.Commit(ctx)
-	return mayTranslateError(err)
+	err = validateFieldName(field)
+	if err != nil {
+		return err
+	}
+
+	return e.execCatalogStmt(ctx, sql.NewDropFullTextIndexStmt(collectionName, field))
 }
 
-func (e *Engine) DeleteIndex(ctx context.Context, collectionName string, fields []string) error {
+// GrantPrivileges grants privileges on a collection to a user.
+// Once granted privileges on any collection, the user is only allowed to access collections as granted.
+func (e *Engine) GrantPrivileges(ctx context.Context, collectionName, user string, privileges []sql.SQLPrivilege) error {
 	err := validateCollectionName(collectionName)
 	if err != nil {
 		return err
 	}
 
-	if len(fields) == 0 {
-		return fmt.Errorf("%w: no fields specified", ErrIllegalArguments)
+	return e.execCatalogStmt(ctx, sql.NewGrantStmt(privileges, collectionName, user))
+}
+
+// RevokePrivileges revokes privileges previously granted on a collection to a user.
+func (e *Engine) RevokePrivileges(ctx context.Context, collectionName, user string, privileges []sql.SQLPrivilege) error {
+	err := validateCollectionName(collectionName)
+	if err != nil {
+		return err
 	}
 
+	return e.execCatalogStmt(ctx, sql.NewRevokeStmt(privileges, collectionName, user))
+}
+
+func (e *Engine) execCatalogStmt(ctx context.Context, stmt sql.SQLStmt) error {
 	opts := sql.DefaultTxOptions().
 		WithUnsafeMVCC(true).
 		WithSnapshotMustIncludeTxID(func(lastPrecommittedTxID uint64) uint64 { return 0 }).
@@ -529,19 +598,10 @@ func (e *Engine) DeleteIndex(ctx context.Context, collectionName string, fields
 	}
 	defer sqlTx.Cancel()
 
-	for _, field := range fields {
-		err := validateFieldName(field)
-		if err != nil {
-			return err
-		}
-	}
-
-	dropIndexStmt := sql.NewDropIndexStmt(collectionName, fields)
-
 	_, _, err = e.sqlEngine.ExecPreparedStmts(
 		ctx,
 		sqlTx,
-		[]sql.SQLStmt{dropIndexStmt},
+		[]sql.SQLStmt{stmt},
 		nil,
 	)
 	if err != nil {
@@ -562,6 +622,30 @@ func (e *Engine) InsertDocument(ctx context.Context, collectionName string, doc
 }
 
 func (e *Engine) InsertDocuments(ctx context.Context, collectionName string, docs []*structpb.Struct) (txID uint64, docIDs []DocumentID, err error) {
+	return e.InsertDocumentsWithExpiration(ctx, collectionName, docs, time.Time{})
+}
+
+// InsertDocumentsWithExpiration inserts documents which expire at the given time, overriding the ttl of the collection.
+// Expired documents are neither returned nor counted by queries but they can still be audited.
+func (e *Engine) InsertDocumentsWithExpiration(ctx context.Context, collectionName string, docs []*structpb.Struct, expiresAt time.Time) (txID uint64, docIDs []DocumentID, err error) {
+	opts := sql.DefaultTxOptions().
+		WithUnsafeMVCC(true).
+		WithSnapshotMustIncludeTxID(func(lastPrecommittedTxID uint64) uint64 { return 0 }).
+		WithSnapshotRenewalPeriod(0)
+
+	sqlTx, err := e.sqlEngine.NewTx(ctx, opts)
+	if err != nil {
+		return 0, nil, mayTranslateError(err)
+	}
+	defer sqlTx.Cancel()
+
+	return e.upsertDocuments(ctx, sqlTx, collectionName, docs, true, false, expiresAt)
+}
+
+// ImportDocuments inserts documents in a single transaction, keeping the ids of the documents which
+// already have one (e.g. documents previously exported), so they can be restored into a collection.
+// Documents without id are assigned a new one as done by InsertDocuments.
+func (e *Engine) ImportDocuments(ctx context.Context, collectionName string, docs []*structpb.Struct) (txID uint64, docIDs []DocumentID, err error) {
 	opts := sql.DefaultTxOptions().
 		WithUnsafeMVCC(true).
 		WithSnapshotMustIncludeTxID(func(lastPrecommittedTxID uint64) uint64 { return 0 }).
@@ -573,10 +657,10 @@ func (e *Engine) InsertDocuments(ctx context.Context, collectionName string, doc
 	}
 	defer sqlTx.Cancel()
 
-	return e.upsertDocuments(ctx, sqlTx, collectionName, docs, true)
+	return e.upsertDocuments(ctx, sqlTx, collectionName, docs, true, true, time.Time{})
 }
 
-func (e *Engine) upsertDocuments(ctx context.Context, sqlTx *sql.SQLTx, collectionName string, docs []*structpb.Struct, isInsert bool) (txID uint64, docIDs []DocumentID, err error) {
+func (e *Engine) upsertDocuments(ctx context.Context, sqlTx *sql.SQLTx, collectionName string, docs []*structpb.Struct, isInsert, keepDocIDs bool, expiresAt time.Time) (txID uint64, docIDs []DocumentID, err error) {
 	if len(docs) == 0 {
 		return 0, nil, fmt.Errorf("%w: no document specified", ErrIllegalArguments)
 	}
@@ -614,7 +698,7 @@ func (e *Engine) upsertDocuments(ctx context.Context, sqlTx *sql.SQLTx, collecti
 
 		provisionedDocID, docIDProvisioned := doc.Fields[docIDFieldName]
 		if docIDProvisioned {
-			if isInsert {
+			if isInsert && !keepDocIDs {
 				return 0, nil, fmt.Errorf("%w: field (%s) should NOT be specified when inserting a document", ErrIllegalArguments, docIDFieldName)
 			}
 
@@ -652,7 +736,7 @@ func (e *Engine) upsertDocuments(ctx context.Context, sqlTx *sql.SQLTx, collecti
 				rows,
 				isInsert,
 				nil,
-			),
+			).WithExpiration(expiresAt),
 		},
 		nil,
 	)
@@ -829,7 +913,7 @@ func (e *Engine) ReplaceDocuments(ctx context.Context, query *protomodel.Query,
 		return nil, nil
 	}
 
-	txID, docIDs, err := e.upsertDocuments(ctx, sqlTx, query.CollectionName, docs, false)
+	txID, docIDs, err := e.upsertDocuments(ctx, sqlTx, query.CollectionName, docs, false, false, time.Time{})
 	if err != nil {
 		return nil, err
 	}
@@ -863,6 +947,12 @@ func (e *Engine) ReplaceDocuments(ctx context.Context, query *protomodel.Query,
 }
 
 func (e *Engine) GetDocuments(ctx context.Context, query *protomodel.Query, offset int64) (DocumentReader, error) {
+	return e.GetProjectedDocuments(ctx, query, nil, offset)
+}
+
+// GetProjectedDocuments returns a reader of the documents matching the query.
+// When a projection is provided, only the included (and not excluded) fields are returned.
+func (e *Engine) GetProjectedDocuments(ctx context.Context, query *protomodel.Query, projection *protomodel.Projection, offset int64) (DocumentReader, error) {
 	if query == nil {
 		return nil, ErrIllegalArguments
 	}
@@ -874,11 +964,19 @@ func (e *Engine) GetDocuments(ctx context.Context, query *protomodel.Query, offs
 
 	table, err := getTableForCollection(sqlTx, query.CollectionName)
 	if err != nil {
+		defer sqlTx.Cancel()
 		return nil, err
 	}
 
 	queryCondition, err := generateSQLFilteringExpression(query.Expressions, table)
 	if err != nil {
+		defer sqlTx.Cancel()
+		return nil, err
+	}
+
+	docProjection, err := newDocumentProjection(projection, docIDFieldName(table), e.maxNestedFields)
+	if err != nil {
+		defer sqlTx.Cancel()
 		return nil, err
 	}
 
@@ -898,7 +996,55 @@ func (e *Engine) GetDocuments(ctx context.Context, query *protomodel.Query, offs
 		return nil, err
 	}
 
-	return newDocumentReader(r, func(_ DocumentReader) { sqlTx.Cancel() }), nil
+	return newDocumentReader(r, docIDFieldName(table), docProjection, func(_ DocumentReader) { sqlTx.Cancel() }), nil
+}
+
+// ExportDocuments returns a reader of all the documents in the collection as they were right after
+// the transaction txID was committed, so the export is consistent regardless of concurrent changes.
+// When txID is zero, the last committed transaction is used. The transaction actually used is returned.
+func (e *Engine) ExportDocuments(ctx context.Context, collectionName string, txID uint64) (DocumentReader, uint64, error) {
+	lastTxID := e.sqlEngine.GetStore().LastCommittedTxID()
+
+	if txID == 0 {
+		txID = lastTxID
+	}
+
+	if txID == 0 || txID > lastTxID {
+		return nil, 0, fmt.Errorf("%w: invalid transaction id (%d)", ErrIllegalArguments, txID)
+	}
+
+	opts := sql.DefaultTxOptions().
+		WithReadOnly(true).
+		WithSnapshotMustIncludeTxID(func(lastPrecommittedTxID uint64) uint64 { return txID })
+
+	sqlTx, err := e.sqlEngine.NewTx(ctx, opts)
+	if err != nil {
+		return nil, 0, mayTranslateError(err)
+	}
+
+	table, err := getTableForCollection(sqlTx, collectionName)
+	if err != nil {
+		defer sqlTx.Cancel()
+		return nil, 0, err
+	}
+
+	op := sql.NewSelectStmt(
+		[]sql.Selector{sql.NewColSelector(collectionName, DocumentBLOBField)},
+		collectionName,
+		nil,
+		nil,
+		nil,
+		nil,
+	).UntilTx(txID)
+
+	// returning an open reader here, so the caller HAS to close it
+	r, err := e.sqlEngine.QueryPreparedStmt(ctx, sqlTx, op, nil)
+	if err != nil {
+		defer sqlTx.Cancel()
+		return nil, 0, mayTranslateError(err)
+	}
+
+	return newDocumentReader(r, docIDFieldName(table), nil, func(_ DocumentReader) { sqlTx.Cancel() }), txID, nil
 }
 
 func (e *Engine) CountDocuments(ctx context.Context, query *protomodel.Query, offset int64) (int64, error) {
@@ -959,6 +1105,11 @@ func (e *Engine) GetEncodedDocument(ctx context.Context, collectionName string,
 		return 0, "", nil, err
 	}
 
+	err = e.checkPrivilege(ctx, sqlTx, sql.SQLPrivilegeSelect, collectionName)
+	if err != nil {
+		return 0, "", nil, err
+	}
+
 	searchKey, err := e.getKeyForDocument(ctx, sqlTx, collectionName, docID)
 	if err != nil {
 		return 0, "", nil, err
@@ -985,6 +1136,11 @@ func (e *Engine) AuditDocument(ctx context.Context, collectionName string, docID
 	}
 	defer sqlTx.Cancel()
 
+	err = e.checkPrivilege(ctx, sqlTx, sql.SQLPrivilegeSelect, collectionName)
+	if err != nil {
+		return nil, err
+	}
+
 	searchKey, err := e.getKeyForDocument(ctx, sqlTx, collectionName, docID)
 	if err != nil {
 		return nil, err
@@ -1058,6 +1214,10 @@ func generateSQLFilteringExpression(expressions []*protomodel.QueryExpression, t
 				{
 					fieldExp = sql.NewLikeBoolExp(colSelector, true, value)
 				}
+			case protomodel.ComparisonOperator_MATCH:
+				{
+					fieldExp = sql.NewFnCall(sql.MatchFnCall, colSelector, value)
+				}
 			default:
 				{
 					sqlCmpOp, err := sqlCmpOperatorFor(exp.Operator)
@@ -1163,7 +1323,16 @@ func (e *Engine) getDocumentAtTransaction(
 		return nil, err
 	}
 
-	if encDoc.KVMetadata != nil && encDoc.KVMetadata.Deleted() {
+	return decodeDocumentAtRevision(encDoc)
+}
+
+func decodeDocumentAtRevision(encDoc *EncodedDocument) (*protomodel.DocumentAtRevision, error) {
+	deleted := encDoc.KVMetadata != nil && encDoc.KVMetadata.Deleted()
+
+	// the content of expired documents is no longer available
+	expired := encDoc.KVMetadata != nil && encDoc.KVMetadata.IsExpirable() && encDoc.EncodedDocument == nil
+
+	if deleted || expired {
 		return &protomodel.DocumentAtRevision{
 			TransactionId: encDoc.TxID,
 			Metadata:      kvMetadataToProto(encDoc.KVMetadata),
@@ -1247,13 +1416,16 @@ func (e *Engine) getEncodedDocument(
 }
 
 func (e *Engine) readMetadataAndValue(key []byte, atTx uint64, skipIntegrityCheck bool) (*store.KVMetadata, []byte, error) {
-	store := e.sqlEngine.GetStore()
-	entry, _, err := store.ReadTxEntry(atTx, key, skipIntegrityCheck)
+	st := e.sqlEngine.GetStore()
+	entry, _, err := st.ReadTxEntry(atTx, key, skipIntegrityCheck)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	v, err := store.ReadValue(entry)
+	v, err := st.ReadValue(entry)
+	if errors.Is(err, store.ErrExpiredEntry) {
+		return entry.Metadata(), nil, nil
+	}
 	if err != nil {
 		return nil, nil, err
 	}
@@ -17,9 +17,12 @@ package document
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/embedded/store"
 	"github.com/codenotary/immudb/pkg/api/protomodel"
 	"github.com/stretchr/testify/require"
@@ -1389,3 +1392,432 @@ func TestGetDocuments_WithOrderBy(t *testing.T) {
 		}
 	})
 }
+
+func TestFullTextSearch(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	collectionName := "articles"
+
+	err := engine.CreateCollection(
+		ctx,
+		collectionName,
+		"",
+		[]*protomodel.Field{
+			{Name: "title", Type: protomodel.FieldType_STRING},
+			{Name: "body", Type: protomodel.FieldType_STRING},
+		},
+		[]*protomodel.Index{
+			{Fields: []string{"body"}, IsFullText: true},
+		},
+	)
+	require.NoError(t, err)
+
+	t.Run("full-text indexes must be on a single field", func(t *testing.T) {
+		err := engine.CreateCollection(
+			ctx,
+			"invalid",
+			"",
+			[]*protomodel.Field{
+				{Name: "title", Type: protomodel.FieldType_STRING},
+				{Name: "body", Type: protomodel.FieldType_STRING},
+			},
+			[]*protomodel.Index{
+				{Fields: []string{"title", "body"}, IsFullText: true},
+			},
+		)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	_, _, err = engine.InsertDocuments(ctx, collectionName, []*structpb.Struct{
+		{Fields: map[string]*structpb.Value{
+			"title": structpb.NewStringValue("first"),
+			"body":  structpb.NewStringValue("An immutable database keeps every record"),
+		}},
+		{Fields: map[string]*structpb.Value{
+			"title": structpb.NewStringValue("second"),
+			"body":  structpb.NewStringValue("Searching immutable records in the immutable database"),
+		}},
+		{Fields: map[string]*structpb.Value{
+			"title": structpb.NewStringValue("third"),
+			"body":  structpb.NewStringValue("Nothing to see here"),
+		}},
+	})
+	require.NoError(t, err)
+
+	collection, err := engine.GetCollection(ctx, collectionName)
+	require.NoError(t, err)
+	require.Len(t, collection.Indexes, 2)
+	require.False(t, collection.Indexes[0].IsFullText)
+	require.True(t, collection.Indexes[1].IsFullText)
+
+	matchQuery := func(field, text string) *protomodel.Query {
+		return &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{
+							Field:    field,
+							Operator: protomodel.ComparisonOperator_MATCH,
+							Value:    structpb.NewStringValue(text),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	matchingTitles := func(query *protomodel.Query) []string {
+		reader, err := engine.GetDocuments(ctx, query, 0)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		var titles []string
+
+		for {
+			doc, err := reader.Read(ctx)
+			if errors.Is(err, ErrNoMoreDocuments) {
+				break
+			}
+			require.NoError(t, err)
+
+			titles = append(titles, doc.Document.Fields["title"].GetStringValue())
+		}
+
+		return titles
+	}
+
+	t.Run("documents are ranked by relevance", func(t *testing.T) {
+		require.Equal(t, []string{"second", "first"}, matchingTitles(matchQuery("body", "immutable")))
+		require.Equal(t, []string{"second"}, matchingTitles(matchQuery("body", "search records")))
+		require.Empty(t, matchingTitles(matchQuery("body", "mutable")))
+
+		count, err := engine.CountDocuments(ctx, matchQuery("body", "records"), 0)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), count)
+	})
+
+	t.Run("match can be used on fields without a full-text index", func(t *testing.T) {
+		require.Equal(t, []string{"third"}, matchingTitles(matchQuery("title", "THIRD")))
+	})
+
+	t.Run("full-text indexes can be created and deleted", func(t *testing.T) {
+		err := engine.CreateCollection(ctx, "notes", "", []*protomodel.Field{{Name: "text", Type: protomodel.FieldType_STRING}}, nil)
+		require.NoError(t, err)
+
+		err = engine.CreateFullTextIndex(ctx, "notes", "text")
+		require.NoError(t, err)
+
+		err = engine.CreateFullTextIndex(ctx, "notes", "text")
+		require.ErrorIs(t, err, sql.ErrIndexAlreadyExists)
+
+		collection, err := engine.GetCollection(ctx, "notes")
+		require.NoError(t, err)
+		require.Len(t, collection.Indexes, 2)
+		require.True(t, collection.Indexes[1].IsFullText)
+
+		err = engine.DeleteFullTextIndex(ctx, "notes", "text")
+		require.NoError(t, err)
+
+		err = engine.DeleteFullTextIndex(ctx, "notes", "text")
+		require.ErrorIs(t, err, sql.ErrNoAvailableIndex)
+
+		collection, err = engine.GetCollection(ctx, "notes")
+		require.NoError(t, err)
+		require.Len(t, collection.Indexes, 1)
+	})
+}
+
+func TestDocumentExpiration(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	err := engine.CreateCollectionWithTTL(ctx, "invalid", "", nil, nil, -time.Second)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = engine.CreateCollectionWithTTL(ctx, "invalid", "", nil, nil, time.Millisecond)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	collectionName := "sessions"
+
+	err = engine.CreateCollectionWithTTL(
+		ctx,
+		collectionName,
+		"",
+		[]*protomodel.Field{
+			{Name: "user", Type: protomodel.FieldType_STRING},
+		},
+		[]*protomodel.Index{
+			{Fields: []string{"user"}},
+		},
+		time.Hour,
+	)
+	require.NoError(t, err)
+
+	collection, err := engine.GetCollection(ctx, collectionName)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3600), collection.Ttl)
+
+	_, activeDocIDs, err := engine.InsertDocuments(ctx, collectionName, []*structpb.Struct{
+		{Fields: map[string]*structpb.Value{"user": structpb.NewStringValue("alice")}},
+		{Fields: map[string]*structpb.Value{"user": structpb.NewStringValue("bob")}},
+	})
+	require.NoError(t, err)
+
+	_, expiredDocIDs, err := engine.InsertDocumentsWithExpiration(ctx, collectionName, []*structpb.Struct{
+		{Fields: map[string]*structpb.Value{"user": structpb.NewStringValue("alice")}},
+	}, time.Now().Add(-time.Second))
+	require.NoError(t, err)
+
+	query := &protomodel.Query{
+		CollectionName: collectionName,
+		Expressions: []*protomodel.QueryExpression{
+			{
+				FieldComparisons: []*protomodel.FieldComparison{
+					{
+						Field:    "user",
+						Operator: protomodel.ComparisonOperator_EQ,
+						Value:    structpb.NewStringValue("alice"),
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("expired documents should not be returned nor counted", func(t *testing.T) {
+		reader, err := engine.GetDocuments(ctx, query, 0)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		docs, err := reader.ReadN(ctx, 2)
+		require.ErrorIs(t, err, ErrNoMoreDocuments)
+		require.Len(t, docs, 1)
+		require.Equal(t, activeDocIDs[0].EncodeToHexString(), docs[0].DocumentId)
+
+		count, err := engine.CountDocuments(ctx, &protomodel.Query{CollectionName: collectionName}, 0)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), count)
+	})
+
+	t.Run("expired documents can be audited", func(t *testing.T) {
+		revisions, err := engine.AuditDocument(ctx, collectionName, expiredDocIDs[0], false, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, revisions, 1)
+		require.Equal(t, expiredDocIDs[0].EncodeToHexString(), revisions[0].DocumentId)
+		require.NotNil(t, revisions[0].Metadata)
+		require.False(t, revisions[0].Metadata.Deleted)
+		require.LessOrEqual(t, revisions[0].Metadata.ExpiresAt, time.Now().Unix())
+
+		// the content of expired documents is no longer available
+		require.Nil(t, revisions[0].Document)
+	})
+
+	t.Run("documents expire according to the ttl of the collection", func(t *testing.T) {
+		revisions, err := engine.AuditDocument(ctx, collectionName, activeDocIDs[1], false, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, revisions, 1)
+		require.NotNil(t, revisions[0].Metadata)
+		require.InDelta(t, time.Now().Add(time.Hour).Unix(), revisions[0].Metadata.ExpiresAt, 60)
+	})
+}
+
+func TestExportAndImportDocuments(t *testing.T) {
+	engine := makeEngine(t)
+
+	ctx := context.Background()
+
+	for _, name := range []string{"source", "target"} {
+		err := engine.CreateCollection(
+			ctx,
+			name,
+			"",
+			[]*protomodel.Field{
+				{Name: "name", Type: protomodel.FieldType_STRING},
+			},
+			[]*protomodel.Index{
+				{Fields: []string{"name"}},
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	txID, docIDs, err := engine.InsertDocuments(ctx, "source", []*structpb.Struct{
+		{Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("alice")}},
+		{Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("bob")}},
+	})
+	require.NoError(t, err)
+
+	_, _, err = engine.InsertDocument(ctx, "source", &structpb.Struct{
+		Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("charlie")},
+	})
+	require.NoError(t, err)
+
+	err = engine.DeleteDocuments(ctx, &protomodel.Query{
+		CollectionName: "source",
+		Expressions: []*protomodel.QueryExpression{
+			{
+				FieldComparisons: []*protomodel.FieldComparison{
+					{Field: "name", Operator: protomodel.ComparisonOperator_EQ, Value: structpb.NewStringValue("alice")},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	exportAll := func(t *testing.T, collectionName string, atTxID uint64) ([]*protomodel.DocumentAtRevision, uint64) {
+		reader, exportedTxID, err := engine.ExportDocuments(ctx, collectionName, atTxID)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		revisions, err := reader.ReadN(ctx, 10)
+		require.ErrorIs(t, err, ErrNoMoreDocuments)
+
+		return revisions, exportedTxID
+	}
+
+	t.Run("export with invalid arguments should fail", func(t *testing.T) {
+		_, _, err := engine.ExportDocuments(ctx, "source", engine.sqlEngine.GetStore().LastCommittedTxID()+1)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, _, err = engine.ExportDocuments(ctx, "unknown", 0)
+		require.ErrorIs(t, err, ErrCollectionDoesNotExist)
+	})
+
+	t.Run("export should return documents as of the latest transaction", func(t *testing.T) {
+		revisions, exportedTxID := exportAll(t, "source", 0)
+		require.Equal(t, engine.sqlEngine.GetStore().LastCommittedTxID(), exportedTxID)
+		require.Len(t, revisions, 2)
+		require.Equal(t, "bob", revisions[0].Document.Fields["name"].GetStringValue())
+		require.Equal(t, "charlie", revisions[1].Document.Fields["name"].GetStringValue())
+	})
+
+	t.Run("export should return documents as of the specified transaction", func(t *testing.T) {
+		revisions, exportedTxID := exportAll(t, "source", txID)
+		require.Equal(t, txID, exportedTxID)
+		require.Len(t, revisions, 2)
+		require.Equal(t, docIDs[0].EncodeToHexString(), revisions[0].DocumentId)
+		require.Equal(t, "alice", revisions[0].Document.Fields["name"].GetStringValue())
+		require.Equal(t, docIDs[1].EncodeToHexString(), revisions[1].DocumentId)
+	})
+
+	t.Run("imported documents should keep their ids", func(t *testing.T) {
+		revisions, _ := exportAll(t, "source", 0)
+
+		docs := []*structpb.Struct{
+			{Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("dave")}},
+		}
+		for _, rev := range revisions {
+			docs = append(docs, rev.Document)
+		}
+
+		_, importedIDs, err := engine.ImportDocuments(ctx, "target", docs)
+		require.NoError(t, err)
+		require.Len(t, importedIDs, 3)
+		require.Equal(t, revisions[0].DocumentId, importedIDs[1].EncodeToHexString())
+		require.Equal(t, revisions[1].DocumentId, importedIDs[2].EncodeToHexString())
+
+		imported, _ := exportAll(t, "target", 0)
+		require.Len(t, imported, 3)
+
+		_, _, err = engine.ImportDocuments(ctx, "target", docs[1:])
+		require.ErrorIs(t, err, ErrConflict)
+	})
+}
+
+func TestCollectionPrivileges(t *testing.T) {
+	st, err := store.Open(t.TempDir(), store.DefaultOptions())
+	require.NoError(t, err)
+	defer st.Close()
+
+	type userKey struct{}
+
+	checker := func(ctx context.Context, catalog *sql.Catalog, privileges []sql.TablePrivilege) error {
+		user, ok := ctx.Value(userKey{}).(string)
+		if !ok {
+			return nil
+		}
+		return catalog.CheckPrivileges(user, privileges)
+	}
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrivilegeChecker(checker))
+	require.NoError(t, err)
+
+	for _, collectionName := range []string{"orders", "customers"} {
+		err = engine.CreateCollection(
+			context.Background(),
+			collectionName,
+			"",
+			[]*protomodel.Field{{Name: "name", Type: protomodel.FieldType_STRING}},
+			nil,
+		)
+		require.NoError(t, err)
+	}
+
+	doc := func() *structpb.Struct {
+		return &structpb.Struct{Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("n1")}}
+	}
+
+	_, orderID, err := engine.InsertDocument(context.Background(), "orders", doc())
+	require.NoError(t, err)
+
+	_, customerID, err := engine.InsertDocument(context.Background(), "customers", doc())
+	require.NoError(t, err)
+
+	err = engine.GrantPrivileges(context.Background(), "1invalid", "reader", []sql.SQLPrivilege{sql.SQLPrivilegeSelect})
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = engine.GrantPrivileges(context.Background(), "unknown", "reader", []sql.SQLPrivilege{sql.SQLPrivilegeSelect})
+	require.ErrorIs(t, err, ErrCollectionDoesNotExist)
+
+	err = engine.GrantPrivileges(context.Background(), "orders", "reader", []sql.SQLPrivilege{sql.SQLPrivilegeSelect})
+	require.NoError(t, err)
+
+	readerCtx := context.WithValue(context.Background(), userKey{}, "reader")
+
+	t.Run("granted collections can be read", func(t *testing.T) {
+		reader, err := engine.GetDocuments(readerCtx, &protomodel.Query{CollectionName: "orders"}, 0)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		_, err = reader.Read(context.Background())
+		require.NoError(t, err)
+
+		_, _, _, err = engine.GetEncodedDocument(readerCtx, "orders", orderID, 0)
+		require.NoError(t, err)
+
+		_, err = engine.AuditDocument(readerCtx, "orders", orderID, false, 0, 10)
+		require.NoError(t, err)
+	})
+
+	t.Run("access is denied without privileges", func(t *testing.T) {
+		_, err := engine.GetDocuments(readerCtx, &protomodel.Query{CollectionName: "customers"}, 0)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, _, _, err = engine.GetEncodedDocument(readerCtx, "customers", customerID, 0)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = engine.AuditDocument(readerCtx, "customers", customerID, false, 0, 10)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, _, err = engine.InsertDocument(readerCtx, "orders", doc())
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		err = engine.DeleteCollection(readerCtx, "orders")
+		require.ErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("revoked privileges are no longer granted", func(t *testing.T) {
+		err := engine.GrantPrivileges(context.Background(), "customers", "reader", []sql.SQLPrivilege{sql.SQLPrivilegeSelect})
+		require.NoError(t, err)
+
+		err = engine.RevokePrivileges(context.Background(), "orders", "reader", []sql.SQLPrivilege{sql.SQLPrivilegeSelect})
+		require.NoError(t, err)
+
+		_, err = engine.GetDocuments(readerCtx, &protomodel.Query{CollectionName: "orders"}, 0)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, _, _, err = engine.GetEncodedDocument(readerCtx, "customers", customerID, 0)
+		require.NoError(t, err)
+	})
+}
@@ -36,6 +36,7 @@ var (
 	ErrFieldDoesNotExist       = errors.New("field does not exist")
 	ErrReservedName            = errors.New("reserved name")
 	ErrLimitedIndexCreation    = errors.New("index creation is only supported on empty collections")
+	ErrPermissionDenied        = sql.ErrPermissionDenied
 	ErrConflict                = errors.New("conflict due to uniqueness contraint violation or read document was updated by another transaction")
 )
 
@@ -18,6 +18,7 @@ package document
 import (
 	"fmt"
 
+	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/embedded/store"
 )
 
@@ -26,6 +27,8 @@ const DefaultDocumentMaxNestedFields = 3
 type Options struct {
 	prefix          []byte
 	maxNestedFields int
+
+	privilegeChecker sql.PrivilegeChecker
 }
 
 func DefaultOptions() *Options {
@@ -51,3 +54,9 @@ func (opts *Options) WithMaxNestedFields(maxNestedFields int) *Options {
 	opts.maxNestedFields = maxNestedFields
 	return opts
 }
+
+// WithPrivilegeChecker sets the function used to check the privileges required to access collections
+func (opts *Options) WithPrivilegeChecker(privilegeChecker sql.PrivilegeChecker) *Options {
+	opts.privilegeChecker = privilegeChecker
+	return opts
+}
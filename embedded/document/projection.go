@@ -0,0 +1,146 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package document
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/codenotary/immudb/pkg/api/protomodel"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// documentProjection holds the parsed include and exclude paths of a projection.
+// The document id field is always part of the projected document unless explicitly excluded.
+type documentProjection struct {
+	docIDFieldName string
+	include        [][]string
+	exclude        [][]string
+}
+
+func newDocumentProjection(projection *protomodel.Projection, docIDFieldName string, maxNestedFields int) (*documentProjection, error) {
+	if projection == nil || (len(projection.Include) == 0 && len(projection.Exclude) == 0) {
+		return nil, nil
+	}
+
+	include, err := parseProjectionPaths(projection.Include, maxNestedFields)
+	if err != nil {
+		return nil, err
+	}
+
+	exclude, err := parseProjectionPaths(projection.Exclude, maxNestedFields)
+	if err != nil {
+		return nil, err
+	}
+
+	return &documentProjection{
+		docIDFieldName: docIDFieldName,
+		include:        include,
+		exclude:        exclude,
+	}, nil
+}
+
+func parseProjectionPaths(fieldPaths []string, maxNestedFields int) ([][]string, error) {
+	paths := make([][]string, len(fieldPaths))
+
+	for i, fieldPath := range fieldPaths {
+		if fieldPath == DocumentBLOBField {
+			return nil, fmt.Errorf("%w: invalid projection field '%s'", ErrReservedName, fieldPath)
+		}
+
+		if !fieldNameValidation.MatchString(fieldPath) {
+			return nil, fmt.Errorf("%w: invalid projection field '%s'", ErrIllegalArguments, fieldPath)
+		}
+
+		paths[i] = strings.SplitN(fieldPath, documentFieldPathSeparator, maxNestedFields)
+	}
+
+	return paths, nil
+}
+
+// apply returns the projected document. The provided document may be modified.
+func (p *documentProjection) apply(doc *structpb.Struct) *structpb.Struct {
+	if p == nil {
+		return doc
+	}
+
+	projectedDoc := doc
+
+	if len(p.include) > 0 {
+		projectedDoc = &structpb.Struct{
+			Fields: make(map[string]*structpb.Value),
+		}
+
+		if docID, ok := doc.Fields[p.docIDFieldName]; ok {
+			projectedDoc.Fields[p.docIDFieldName] = docID
+		}
+
+		for _, path := range p.include {
+			includeFieldPath(doc, projectedDoc, path)
+		}
+	}
+
+	for _, path := range p.exclude {
+		excludeFieldPath(projectedDoc, path)
+	}
+
+	return projectedDoc
+}
+
+func includeFieldPath(src, dst *structpb.Struct, path []string) {
+	for i, field := range path {
+		val, ok := src.Fields[field]
+		if !ok {
+			return
+		}
+
+		if i == len(path)-1 {
+			dst.Fields[field] = val
+			return
+		}
+
+		nestedSrc := val.GetStructValue()
+		if nestedSrc == nil {
+			return
+		}
+
+		nestedDst := dst.Fields[field].GetStructValue()
+		if nestedDst == nil {
+			nestedDst = &structpb.Struct{
+				Fields: make(map[string]*structpb.Value),
+			}
+			dst.Fields[field] = structpb.NewStructValue(nestedDst)
+		}
+
+		src = nestedSrc
+		dst = nestedDst
+	}
+}
+
+func excludeFieldPath(doc *structpb.Struct, path []string) {
+	for i, field := range path {
+		if i == len(path)-1 {
+			delete(doc.Fields, field)
+			return
+		}
+
+		doc = doc.Fields[field].GetStructValue()
+		if doc == nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package document
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/protomodel"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func newProjectionTestDocument(t *testing.T) *structpb.Struct {
+	doc, err := structpb.NewStruct(map[string]interface{}{
+		"_id":     "0000000000000000000000000000000000000000",
+		"name":    "alice",
+		"country": "wonderland",
+		"address": map[string]interface{}{
+			"street": "mainstreet",
+			"number": 124,
+		},
+	})
+	require.NoError(t, err)
+
+	return doc
+}
+
+func TestNewDocumentProjection(t *testing.T) {
+	t.Run("nil or empty projection should not be applied", func(t *testing.T) {
+		p, err := newDocumentProjection(nil, DefaultDocumentIDField, DefaultDocumentMaxNestedFields)
+		require.NoError(t, err)
+		require.Nil(t, p)
+
+		p, err = newDocumentProjection(&protomodel.Projection{}, DefaultDocumentIDField, DefaultDocumentMaxNestedFields)
+		require.NoError(t, err)
+		require.Nil(t, p)
+
+		doc := newProjectionTestDocument(t)
+		require.Equal(t, doc, p.apply(doc))
+	})
+
+	t.Run("invalid field paths should be rejected", func(t *testing.T) {
+		_, err := newDocumentProjection(&protomodel.Projection{Include: []string{"1name"}}, DefaultDocumentIDField, DefaultDocumentMaxNestedFields)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = newDocumentProjection(&protomodel.Projection{Exclude: []string{""}}, DefaultDocumentIDField, DefaultDocumentMaxNestedFields)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = newDocumentProjection(&protomodel.Projection{Include: []string{DocumentBLOBField}}, DefaultDocumentIDField, DefaultDocumentMaxNestedFields)
+		require.ErrorIs(t, err, ErrReservedName)
+	})
+}
+
+func TestDocumentProjectionApply(t *testing.T) {
+	t.Run("include should keep only the specified fields and the document id", func(t *testing.T) {
+		p, err := newDocumentProjection(&protomodel.Projection{
+			Include: []string{"name", "address.street", "missing", "name.nested"},
+		}, DefaultDocumentIDField, DefaultDocumentMaxNestedFields)
+		require.NoError(t, err)
+
+		projected := p.apply(newProjectionTestDocument(t))
+
+		require.Equal(t, map[string]interface{}{
+			"_id":  "0000000000000000000000000000000000000000",
+			"name": "alice",
+			"address": map[string]interface{}{
+				"street": "mainstreet",
+			},
+		}, projected.AsMap())
+	})
+
+	t.Run("exclude should remove the specified fields", func(t *testing.T) {
+		p, err := newDocumentProjection(&protomodel.Projection{
+			Exclude: []string{"country", "address.number", "missing.field"},
+		}, DefaultDocumentIDField, DefaultDocumentMaxNestedFields)
+		require.NoError(t, err)
+
+		projected := p.apply(newProjectionTestDocument(t))
+
+		require.Equal(t, map[string]interface{}{
+			"_id":  "0000000000000000000000000000000000000000",
+			"name": "alice",
+			"address": map[string]interface{}{
+				"street": "mainstreet",
+			},
+		}, projected.AsMap())
+	})
+
+	t.Run("exclude should be applied after include", func(t *testing.T) {
+		p, err := newDocumentProjection(&protomodel.Projection{
+			Include: []string{"name", "address"},
+			Exclude: []string{"_id", "address.street"},
+		}, DefaultDocumentIDField, DefaultDocumentMaxNestedFields)
+		require.NoError(t, err)
+
+		projected := p.apply(newProjectionTestDocument(t))
+
+		require.Equal(t, map[string]interface{}{
+			"name": "alice",
+			"address": map[string]interface{}{
+				"number": float64(124),
+			},
+		}, projected.AsMap())
+	})
+}
+
+func TestGetProjectedDocuments(t *testing.T) {
+	engine := makeEngine(t)
+
+	ctx := context.Background()
+
+	collectionName := "mycollection"
+
+	err := engine.CreateCollection(
+		ctx,
+		collectionName,
+		"",
+		[]*protomodel.Field{
+			{Name: "country", Type: protomodel.FieldType_STRING},
+		},
+		nil,
+	)
+	require.NoError(t, err)
+
+	txID, docID, err := engine.InsertDocument(ctx, collectionName, &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"country": structpb.NewStringValue("wonderland"),
+			"address": structpb.NewStructValue(&structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					"street": structpb.NewStringValue("mainstreet"),
+					"number": structpb.NewNumberValue(124),
+				},
+			}),
+		},
+	})
+	require.NoError(t, err)
+
+	query := &protomodel.Query{
+		CollectionName: collectionName,
+	}
+
+	t.Run("invalid projection should be rejected", func(t *testing.T) {
+		_, err := engine.GetProjectedDocuments(ctx, query, &protomodel.Projection{Include: []string{"_doc"}}, 0)
+		require.ErrorIs(t, err, ErrReservedName)
+	})
+
+	t.Run("projected documents should only include the requested fields", func(t *testing.T) {
+		reader, err := engine.GetProjectedDocuments(ctx, query, &protomodel.Projection{Include: []string{"address.street"}}, 0)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		revs, err := reader.ReadN(ctx, 2)
+		require.ErrorIs(t, err, ErrNoMoreDocuments)
+		require.Len(t, revs, 1)
+
+		require.Equal(t, docID.EncodeToHexString(), revs[0].DocumentId)
+		require.Equal(t, map[string]interface{}{
+			DefaultDocumentIDField: docID.EncodeToHexString(),
+			"address": map[string]interface{}{
+				"street": "mainstreet",
+			},
+		}, revs[0].Document.AsMap())
+	})
+
+	t.Run("the full document should still be available for proofs", func(t *testing.T) {
+		reader, err := engine.GetProjectedDocuments(ctx, query, &protomodel.Projection{Exclude: []string{"address", "_id"}}, 0)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		rev, err := reader.Read(ctx)
+		require.NoError(t, err)
+		require.NotContains(t, rev.Document.Fields, "address")
+
+		_, _, encDoc, err := engine.GetEncodedDocument(ctx, collectionName, docID, 0)
+		require.NoError(t, err)
+		require.Equal(t, txID, encDoc.TxID)
+
+		revs, err := engine.AuditDocument(ctx, collectionName, docID, false, 0, 1)
+		require.NoError(t, err)
+		require.Len(t, revs, 1)
+		require.Contains(t, revs[0].Document.Fields, "address")
+	})
+}
@@ -105,7 +105,14 @@ func kvMetadataToProto(kvMetadata *store.KVMetadata) *protomodel.DocumentMetadat
 		return nil
 	}
 
-	return &protomodel.DocumentMetadata{
+	md := &protomodel.DocumentMetadata{
 		Deleted: kvMetadata.Deleted(),
 	}
+
+	if kvMetadata.IsExpirable() {
+		expiresAt, _ := kvMetadata.ExpirationTime()
+		md.ExpiresAt = expiresAt.Unix()
+	}
+
+	return md
 }
@@ -0,0 +1,368 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package document
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/protomodel"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const watchHistoryPageSize = 100
+
+// DocumentChangeCallback is invoked for every document change notified by WatchCollection.
+// Returning an error stops watching the collection.
+type DocumentChangeCallback func(changeType protomodel.DocumentChangeType, revision *protomodel.DocumentAtRevision) error
+
+// WatchCollection notifies, in commit order, the insertions, replacements and deletions of
+// documents in the collection made by transactions after sinceTxID.
+// When sinceTxID is zero, only changes committed after the call are notified.
+// Query expressions, if any, are used to filter the notified documents. Deletions are
+// filtered based on the last revision of the document before being deleted.
+// The call blocks until the context is cancelled or the callback returns an error.
+func (e *Engine) WatchCollection(ctx context.Context, query *protomodel.Query, sinceTxID uint64, onChange DocumentChangeCallback) error {
+	if query == nil || onChange == nil {
+		return ErrIllegalArguments
+	}
+
+	if len(query.OrderBy) > 0 || query.Limit > 0 {
+		return fmt.Errorf("%w: ordering and limit are not supported when watching a collection", ErrIllegalArguments)
+	}
+
+	sqlTx, err := e.sqlEngine.NewTx(ctx, sql.DefaultTxOptions().WithReadOnly(true))
+	if err != nil {
+		return mayTranslateError(err)
+	}
+
+	table, err := getTableForCollection(sqlTx, query.CollectionName)
+	if err != nil {
+		sqlTx.Cancel()
+		return err
+	}
+
+	err = e.checkPrivilege(ctx, sqlTx, sql.SQLPrivilegeSelect, query.CollectionName)
+	if err != nil {
+		sqlTx.Cancel()
+		return err
+	}
+
+	// validate the filtering expression before watching
+	_, err = generateSQLFilteringExpression(query.Expressions, table)
+
+	sqlTx.Cancel()
+
+	if err != nil {
+		return err
+	}
+
+	st := e.sqlEngine.GetStore()
+
+	if sinceTxID == 0 {
+		sinceTxID = st.LastCommittedTxID()
+	}
+
+	pkPrefix := sql.MapKey(
+		e.sqlEngine.GetPrefix(),
+		sql.PIndexPrefix,
+		sql.EncodeID(1),
+		sql.EncodeID(table.ID()),
+		sql.EncodeID(table.PrimaryIndex().ID()),
+	)
+
+	tx := store.NewTx(st.MaxTxEntries(), st.MaxKeyLen())
+
+	// last revision seen of each changed document, changes are seen in commit order
+	// thus the history of a document is only read the first time it's changed
+	revisions := make(map[string]*watchedRevision)
+
+	for txID := sinceTxID + 1; ; txID++ {
+		err = st.WaitForTx(ctx, txID, false)
+		if err != nil {
+			return err
+		}
+
+		err = st.ReadTx(txID, false, tx)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range tx.Entries() {
+			if !bytes.HasPrefix(entry.Key(), pkPrefix) {
+				continue
+			}
+
+			err = e.notifyDocumentChange(ctx, table, query.Expressions, txID, entry, revisions, onChange)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+type watchedRevision struct {
+	txID     uint64
+	revision uint64
+}
+
+func (e *Engine) notifyDocumentChange(
+	ctx context.Context,
+	table *sql.Table,
+	expressions []*protomodel.QueryExpression,
+	txID uint64,
+	entry *store.TxEntry,
+	revisions map[string]*watchedRevision,
+	onChange DocumentChangeCallback,
+) error {
+	st := e.sqlEngine.GetStore()
+
+	docID, err := documentIDFromPrimaryKey(entry.Key(), len(entry.Key())-(sql.EncLenLen+MaxDocumentIDLength+1))
+	if err != nil {
+		return err
+	}
+
+	var revision, prevTxID uint64
+
+	if seen, ok := revisions[string(entry.Key())]; ok {
+		revision = seen.revision + 1
+		prevTxID = seen.txID
+
+		seen.revision = revision
+		seen.txID = txID
+	} else {
+		// the index is needed to calculate the revision of the document
+		err = st.WaitForIndexingUpto(ctx, txID)
+		if err != nil {
+			return err
+		}
+
+		revision, prevTxID, err = e.documentRevisionAt(entry.Key(), txID)
+		if err != nil {
+			return err
+		}
+
+		revisions[string(entry.Key())] = &watchedRevision{txID: txID, revision: revision}
+	}
+
+	val, err := st.ReadValue(entry)
+	if errors.Is(err, store.ErrExpiredEntry) {
+		// expired documents are not notified
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	docAtRevision, err := decodeDocumentAtRevision(&EncodedDocument{
+		TxID:            txID,
+		KVMetadata:      entry.Metadata(),
+		EncodedDocument: val,
+	})
+	if err != nil {
+		return err
+	}
+
+	docAtRevision.DocumentId = docID.EncodeToHexString()
+	docAtRevision.Revision = revision
+
+	changeType := protomodel.DocumentChangeType_REPLACED
+
+	if docAtRevision.Metadata != nil && docAtRevision.Metadata.Deleted {
+		changeType = protomodel.DocumentChangeType_DELETED
+	} else if revision == 1 {
+		changeType = protomodel.DocumentChangeType_INSERTED
+	}
+
+	if len(expressions) > 0 {
+		doc := docAtRevision.Document
+
+		if changeType == protomodel.DocumentChangeType_DELETED {
+			if prevTxID == 0 {
+				return nil
+			}
+
+			prevRevision, err := e.getDocumentAtTransaction(entry.Key(), prevTxID, false)
+			if err != nil {
+				return err
+			}
+
+			doc = prevRevision.Document
+		}
+
+		matches, err := e.documentMatches(doc, table, expressions)
+		if err != nil {
+			return err
+		}
+
+		if !matches {
+			return nil
+		}
+	}
+
+	return onChange(changeType, docAtRevision)
+}
+
+func documentIDFromPrimaryKey(key []byte, off int) (DocumentID, error) {
+	if off < 0 || key[off] != sql.KeyValPrefixNotNull {
+		return nil, fmt.Errorf("%w: invalid document key", ErrUnexpectedValue)
+	}
+
+	idLen := int(binary.BigEndian.Uint32(key[len(key)-sql.EncLenLen:]))
+	if idLen > MaxDocumentIDLength {
+		return nil, fmt.Errorf("%w: invalid document key", ErrUnexpectedValue)
+	}
+
+	return NewDocumentIDFromRawBytes(key[off+1 : off+1+idLen])
+}
+
+// documentRevisionAt returns the revision of the document updated at txID and
+// the id of the transaction where the previous revision was written, if any.
+func (e *Engine) documentRevisionAt(key []byte, txID uint64) (revision uint64, prevTxID uint64, err error) {
+	st := e.sqlEngine.GetStore()
+
+	found := false
+
+	for offset, hCount := uint64(0), uint64(1); offset < hCount; offset += watchHistoryPageSize {
+		var txIDs []uint64
+
+		txIDs, hCount, err = st.History(key, offset, true, watchHistoryPageSize)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		for i, id := range txIDs {
+			if found {
+				return revision, id, nil
+			}
+
+			if id == txID {
+				found = true
+				revision = hCount - (offset + uint64(i))
+			}
+		}
+	}
+
+	if !found {
+		return 0, 0, fmt.Errorf("%w: revision not found", ErrDocumentNotFound)
+	}
+
+	return revision, 0, nil
+}
+
+// documentMatches evaluates the query expressions, in Disjunctive Normal Form, against the document.
+func (e *Engine) documentMatches(doc *structpb.Struct, table *sql.Table, expressions []*protomodel.QueryExpression) (bool, error) {
+	for _, exp := range expressions {
+		matches := true
+
+		for _, cmp := range exp.FieldComparisons {
+			column, err := getColumnForField(table, cmp.Field)
+			if err != nil {
+				return false, err
+			}
+
+			cmpMatches, err := e.fieldComparisonMatches(doc, column, cmp)
+			if err != nil {
+				return false, err
+			}
+
+			if !cmpMatches {
+				matches = false
+				break
+			}
+		}
+
+		if matches {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (e *Engine) fieldComparisonMatches(doc *structpb.Struct, column *sql.Column, cmp *protomodel.FieldComparison) (bool, error) {
+	expected, err := structValueToSqlValue(cmp.Value, column.Type())
+	if err != nil {
+		return false, err
+	}
+
+	docValue, err := e.structValueFromFieldPath(doc, cmp.Field)
+	if errors.Is(err, ErrFieldDoesNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	actual, err := structValueToSqlValue(docValue, column.Type())
+	if err != nil {
+		// the field has a different type than the indexed one
+		return false, nil
+	}
+
+	switch cmp.Operator {
+	case protomodel.ComparisonOperator_LIKE, protomodel.ComparisonOperator_NOT_LIKE:
+		{
+			if column.Type() != sql.VarcharType {
+				return false, fmt.Errorf("%w: LIKE operator is only supported on string fields", ErrIllegalArguments)
+			}
+
+			matched, err := regexp.MatchString(cmp.Value.GetStringValue(), docValue.GetStringValue())
+			if err != nil {
+				return false, fmt.Errorf("%w: %v", ErrIllegalArguments, err)
+			}
+
+			return matched != (cmp.Operator == protomodel.ComparisonOperator_NOT_LIKE), nil
+		}
+	case protomodel.ComparisonOperator_MATCH:
+		{
+			if column.Type() != sql.VarcharType {
+				return false, fmt.Errorf("%w: MATCH operator is only supported on string fields", ErrIllegalArguments)
+			}
+
+			return sql.FullTextMatch(docValue.GetStringValue(), cmp.Value.GetStringValue()), nil
+		}
+	}
+
+	r, err := actual.(sql.TypedValue).Compare(expected.(sql.TypedValue))
+	if err != nil {
+		return false, err
+	}
+
+	switch cmp.Operator {
+	case protomodel.ComparisonOperator_EQ:
+		return r == 0, nil
+	case protomodel.ComparisonOperator_NE:
+		return r != 0, nil
+	case protomodel.ComparisonOperator_LT:
+		return r < 0, nil
+	case protomodel.ComparisonOperator_LE:
+		return r <= 0, nil
+	case protomodel.ComparisonOperator_GT:
+		return r > 0, nil
+	case protomodel.ComparisonOperator_GE:
+		return r >= 0, nil
+	}
+
+	return false, fmt.Errorf("%w: unsupported operator ('%s')", ErrIllegalArguments, cmp.Operator)
+}
@@ -0,0 +1,295 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package document
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/protomodel"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type watchedChange struct {
+	changeType protomodel.DocumentChangeType
+	revision   *protomodel.DocumentAtRevision
+}
+
+var errStopWatching = errors.New("stop watching")
+
+func collectChanges(t *testing.T, engine *Engine, query *protomodel.Query, sinceTxID uint64, n int) []watchedChange {
+	var changes []watchedChange
+
+	err := engine.WatchCollection(context.Background(), query, sinceTxID, func(changeType protomodel.DocumentChangeType, revision *protomodel.DocumentAtRevision) error {
+		changes = append(changes, watchedChange{changeType: changeType, revision: revision})
+
+		if len(changes) == n {
+			return errStopWatching
+		}
+
+		return nil
+	})
+	require.ErrorIs(t, err, errStopWatching)
+
+	return changes
+}
+
+func TestWatchCollection(t *testing.T) {
+	engine := makeEngine(t)
+
+	ctx := context.Background()
+
+	collectionName := "mycollection"
+
+	err := engine.CreateCollection(
+		ctx,
+		collectionName,
+		"",
+		[]*protomodel.Field{
+			{Name: "country", Type: protomodel.FieldType_STRING},
+			{Name: "pincode", Type: protomodel.FieldType_INTEGER},
+		},
+		nil,
+	)
+	require.NoError(t, err)
+
+	sinceTxID := engine.sqlEngine.GetStore().LastCommittedTxID()
+
+	t.Run("watching with invalid arguments should fail", func(t *testing.T) {
+		err := engine.WatchCollection(ctx, nil, 0, func(protomodel.DocumentChangeType, *protomodel.DocumentAtRevision) error { return nil })
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = engine.WatchCollection(ctx, &protomodel.Query{CollectionName: collectionName}, 0, nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = engine.WatchCollection(ctx, &protomodel.Query{CollectionName: collectionName, Limit: 1}, 0, func(protomodel.DocumentChangeType, *protomodel.DocumentAtRevision) error { return nil })
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = engine.WatchCollection(ctx, &protomodel.Query{CollectionName: "unknown"}, 0, func(protomodel.DocumentChangeType, *protomodel.DocumentAtRevision) error { return nil })
+		require.ErrorIs(t, err, ErrCollectionDoesNotExist)
+	})
+
+	t.Run("watching should stop when the context is cancelled", func(t *testing.T) {
+		cancelledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		err := engine.WatchCollection(cancelledCtx, &protomodel.Query{CollectionName: collectionName}, 0, func(protomodel.DocumentChangeType, *protomodel.DocumentAtRevision) error { return nil })
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	insertTxID, docID, err := engine.InsertDocument(ctx, collectionName, &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"country": structpb.NewStringValue("wonderland"),
+			"pincode": structpb.NewNumberValue(1),
+		},
+	})
+	require.NoError(t, err)
+
+	_, _, err = engine.InsertDocument(ctx, collectionName, &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"country": structpb.NewStringValue("neverland"),
+			"pincode": structpb.NewNumberValue(2),
+		},
+	})
+	require.NoError(t, err)
+
+	query := &protomodel.Query{
+		CollectionName: collectionName,
+		Expressions: []*protomodel.QueryExpression{
+			{
+				FieldComparisons: []*protomodel.FieldComparison{
+					{
+						Field:    "country",
+						Operator: protomodel.ComparisonOperator_EQ,
+						Value:    structpb.NewStringValue("wonderland"),
+					},
+				},
+			},
+		},
+	}
+
+	revisions, err := engine.ReplaceDocuments(ctx, query, &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"country": structpb.NewStringValue("wonderland"),
+			"pincode": structpb.NewNumberValue(3),
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, revisions, 1)
+
+	err = engine.DeleteDocuments(ctx, query)
+	require.NoError(t, err)
+
+	t.Run("all changes should be notified since the specified transaction", func(t *testing.T) {
+		changes := collectChanges(t, engine, &protomodel.Query{CollectionName: collectionName}, sinceTxID, 4)
+
+		require.Equal(t, protomodel.DocumentChangeType_INSERTED, changes[0].changeType)
+		require.Equal(t, insertTxID, changes[0].revision.TransactionId)
+		require.Equal(t, docID.EncodeToHexString(), changes[0].revision.DocumentId)
+		require.Equal(t, uint64(1), changes[0].revision.Revision)
+		require.Equal(t, 1.0, changes[0].revision.Document.Fields["pincode"].GetNumberValue())
+
+		require.Equal(t, protomodel.DocumentChangeType_INSERTED, changes[1].changeType)
+		require.Equal(t, 2.0, changes[1].revision.Document.Fields["pincode"].GetNumberValue())
+
+		require.Equal(t, protomodel.DocumentChangeType_REPLACED, changes[2].changeType)
+		require.Equal(t, revisions[0].TransactionId, changes[2].revision.TransactionId)
+		require.Equal(t, uint64(2), changes[2].revision.Revision)
+		require.Equal(t, 3.0, changes[2].revision.Document.Fields["pincode"].GetNumberValue())
+
+		require.Equal(t, protomodel.DocumentChangeType_DELETED, changes[3].changeType)
+		require.Equal(t, docID.EncodeToHexString(), changes[3].revision.DocumentId)
+		require.Equal(t, uint64(3), changes[3].revision.Revision)
+		require.True(t, changes[3].revision.Metadata.Deleted)
+		require.Nil(t, changes[3].revision.Document)
+	})
+
+	t.Run("watching should resume from the specified transaction", func(t *testing.T) {
+		changes := collectChanges(t, engine, &protomodel.Query{CollectionName: collectionName}, insertTxID, 1)
+		require.Equal(t, protomodel.DocumentChangeType_INSERTED, changes[0].changeType)
+		require.Equal(t, 2.0, changes[0].revision.Document.Fields["pincode"].GetNumberValue())
+	})
+
+	t.Run("only changes matching the query should be notified", func(t *testing.T) {
+		changes := collectChanges(t, engine, query, sinceTxID, 3)
+
+		require.Equal(t, protomodel.DocumentChangeType_INSERTED, changes[0].changeType)
+		require.Equal(t, protomodel.DocumentChangeType_REPLACED, changes[1].changeType)
+		require.Equal(t, protomodel.DocumentChangeType_DELETED, changes[2].changeType)
+
+		for _, change := range changes {
+			require.Equal(t, docID.EncodeToHexString(), change.revision.DocumentId)
+		}
+	})
+
+	t.Run("changes should be matched using full-text queries", func(t *testing.T) {
+		changes := collectChanges(t, engine, &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{
+							Field:    "country",
+							Operator: protomodel.ComparisonOperator_MATCH,
+							Value:    structpb.NewStringValue("NEVERLAND"),
+						},
+					},
+				},
+			},
+		}, sinceTxID, 1)
+
+		require.Equal(t, protomodel.DocumentChangeType_INSERTED, changes[0].changeType)
+		require.Equal(t, 2.0, changes[0].revision.Document.Fields["pincode"].GetNumberValue())
+	})
+
+	t.Run("new changes should be notified while watching", func(t *testing.T) {
+		lastTxID := engine.sqlEngine.GetStore().LastCommittedTxID()
+
+		done := make(chan []watchedChange)
+
+		go func() {
+			done <- collectChanges(t, engine, &protomodel.Query{
+				CollectionName: collectionName,
+				Expressions: []*protomodel.QueryExpression{
+					{
+						FieldComparisons: []*protomodel.FieldComparison{
+							{
+								Field:    "pincode",
+								Operator: protomodel.ComparisonOperator_GE,
+								Value:    structpb.NewNumberValue(10),
+							},
+						},
+					},
+				},
+			}, lastTxID, 1)
+		}()
+
+		_, _, err := engine.InsertDocument(ctx, collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"country": structpb.NewStringValue("wonderland"),
+				"pincode": structpb.NewNumberValue(5),
+			},
+		})
+		require.NoError(t, err)
+
+		_, newDocID, err := engine.InsertDocument(ctx, collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"country": structpb.NewStringValue("wonderland"),
+				"pincode": structpb.NewNumberValue(10),
+			},
+		})
+		require.NoError(t, err)
+
+		changes := <-done
+		require.Len(t, changes, 1)
+		require.Equal(t, newDocID.EncodeToHexString(), changes[0].revision.DocumentId)
+	})
+
+	t.Run("revisions should be tracked when watching from the middle of the history", func(t *testing.T) {
+		_, ozDocID, err := engine.InsertDocument(ctx, collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"country": structpb.NewStringValue("oz"),
+				"pincode": structpb.NewNumberValue(0),
+			},
+		})
+		require.NoError(t, err)
+
+		ozQuery := &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{
+							Field:    "country",
+							Operator: protomodel.ComparisonOperator_EQ,
+							Value:    structpb.NewStringValue("oz"),
+						},
+					},
+				},
+			},
+		}
+
+		replacements := watchHistoryPageSize + 10
+
+		var secondRevisionTxID uint64
+
+		for i := 1; i <= replacements; i++ {
+			revisions, err := engine.ReplaceDocuments(ctx, ozQuery, &structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					"country": structpb.NewStringValue("oz"),
+					"pincode": structpb.NewNumberValue(float64(i)),
+				},
+			})
+			require.NoError(t, err)
+			require.Len(t, revisions, 1)
+
+			if i == 1 {
+				secondRevisionTxID = revisions[0].TransactionId
+			}
+		}
+
+		changes := collectChanges(t, engine, ozQuery, secondRevisionTxID, replacements-1)
+
+		for i, change := range changes {
+			require.Equal(t, protomodel.DocumentChangeType_REPLACED, change.changeType)
+			require.Equal(t, ozDocID.EncodeToHexString(), change.revision.DocumentId)
+			require.Equal(t, uint64(i+3), change.revision.Revision)
+			require.Equal(t, float64(i+2), change.revision.Document.Fields["pincode"].GetNumberValue())
+		}
+	})
+}
@@ -0,0 +1,459 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/remotestorage"
+)
+
+// Storage implements a remote storage backed by an Azure Blob Storage container,
+// the Azurite emulator can be used by setting its endpoint, e.g. http://127.0.0.1:10000/devstoreaccount1
+type Storage struct {
+	endpoint   string
+	account    string
+	accountKey []byte
+	container  string
+	prefix     string
+	httpClient *http.Client
+}
+
+var (
+	ErrInvalidArguments                  = errors.New("invalid arguments")
+	ErrInvalidArgumentsOffsSize          = fmt.Errorf("%w: negative offset or zero size", ErrInvalidArguments)
+	ErrInvalidArgumentsNameStartSlash    = fmt.Errorf("%w: name can not start with /", ErrInvalidArguments)
+	ErrInvalidArgumentsNameEndSlash      = fmt.Errorf("%w: name can not end with /", ErrInvalidArguments)
+	ErrInvalidArgumentsInvalidName       = fmt.Errorf("%w: invalid name", ErrInvalidArguments)
+	ErrInvalidArgumentsPathNoEndSlash    = fmt.Errorf("%w: path must end with /", ErrInvalidArguments)
+	ErrInvalidArgumentsContainerSlash    = fmt.Errorf("%w: container name can not contain / character", ErrInvalidArguments)
+	ErrInvalidArgumentsContainerEmpty    = fmt.Errorf("%w: container name can not be empty", ErrInvalidArguments)
+	ErrInvalidArgumentsAccountEmpty      = fmt.Errorf("%w: account name can not be empty", ErrInvalidArguments)
+	ErrInvalidArgumentsAccountKeyEncoded = fmt.Errorf("%w: account key must be base64 encoded", ErrInvalidArguments)
+
+	ErrInvalidResponse                     = errors.New("invalid response code")
+	ErrInvalidResponseXmlDecodeError       = fmt.Errorf("%w: xml decode error", ErrInvalidResponse)
+	ErrInvalidResponseEntriesNotSorted     = fmt.Errorf("%w: entries are not sorted", ErrInvalidResponse)
+	ErrInvalidResponseEntryNameWrongPrefix = fmt.Errorf("%w: entry do not have correct prefix", ErrInvalidResponse)
+	ErrInvalidResponseEntryNameMalicious   = fmt.Errorf("%w: entry name contains invalid characters", ErrInvalidResponse)
+	ErrInvalidResponseSubPathsNotSorted    = fmt.Errorf("%w: sub-paths are not sorted", ErrInvalidResponse)
+	ErrInvalidResponseSubPathsWrongPrefix  = fmt.Errorf("%w: sub-paths do not have correct prefix", ErrInvalidResponse)
+	ErrInvalidResponseSubPathsWrongSuffix  = fmt.Errorf("%w: sub-paths do end with '/' suffix", ErrInvalidResponse)
+	ErrInvalidResponseSubPathMalicious     = fmt.Errorf("%w: sub-paths contain invalid characters", ErrInvalidResponse)
+)
+
+const apiVersion = "2020-04-08"
+
+// Open creates an azure blob storage, when the endpoint is empty
+// the public endpoint of the account is used
+func Open(
+	endpoint string,
+	account string,
+	accountKey string,
+	container string,
+	prefix string,
+) (remotestorage.Storage, error) {
+
+	if account == "" {
+		return nil, ErrInvalidArgumentsAccountEmpty
+	}
+
+	key, err := base64.StdEncoding.DecodeString(accountKey)
+	if err != nil {
+		return nil, ErrInvalidArgumentsAccountKeyEncoded
+	}
+
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://%s.blob.core.windows.net", account)
+	}
+
+	// Endpoint must always end with '/'
+	endpoint = strings.TrimRight(endpoint, "/") + "/"
+
+	// Container must have no '/' at all
+	container = strings.Trim(container, "/")
+	if strings.Contains(container, "/") {
+		return nil, ErrInvalidArgumentsContainerSlash
+	}
+
+	// Container name must not be empty
+	if container == "" {
+		return nil, ErrInvalidArgumentsContainerEmpty
+	}
+
+	// if prefix is not empty, it must end with '/'
+	prefix = strings.Trim(prefix, "/")
+	if prefix != "" {
+		prefix = prefix + "/"
+	}
+
+	return &Storage{
+		endpoint:   endpoint,
+		account:    account,
+		accountKey: key,
+		container:  container,
+		prefix:     prefix,
+		httpClient: &http.Client{},
+	}, nil
+}
+
+func (s *Storage) Kind() string {
+	return "azure"
+}
+
+func (s *Storage) String() string {
+	return "azure:" + s.endpoint + s.container + "/" + s.prefix
+}
+
+func (s *Storage) blobURL(name string) string {
+	segments := strings.Split(s.prefix+name, "/")
+	for i := range segments {
+		segments[i] = url.PathEscape(segments[i])
+	}
+
+	return s.endpoint + url.PathEscape(s.container) + "/" + strings.Join(segments, "/")
+}
+
+// signRequest adds the Shared Key authorization header to the request
+func (s *Storage) signRequest(req *http.Request, t time.Time) {
+	req.Header.Set("x-ms-date", t.Format(http.TimeFormat))
+	req.Header.Set("x-ms-version", apiVersion)
+
+	contentLength := ""
+	if req.ContentLength > 0 {
+		contentLength = strconv.FormatInt(req.ContentLength, 10)
+	}
+
+	msHeaders := []string{}
+	for h := range req.Header {
+		h = strings.ToLower(h)
+		if strings.HasPrefix(h, "x-ms-") {
+			msHeaders = append(msHeaders, h)
+		}
+	}
+	sort.Strings(msHeaders)
+
+	canonicalizedHeaders := ""
+	for _, h := range msHeaders {
+		canonicalizedHeaders += h + ":" + strings.TrimSpace(req.Header.Get(h)) + "\n"
+	}
+
+	canonicalizedResource := "/" + s.account + req.URL.EscapedPath()
+
+	query := req.URL.Query()
+	queryKeys := []string{}
+	for k := range query {
+		queryKeys = append(queryKeys, k)
+	}
+	sort.Strings(queryKeys)
+
+	for _, k := range queryKeys {
+		values := query[k]
+		sort.Strings(values)
+		canonicalizedResource += "\n" + strings.ToLower(k) + ":" + strings.Join(values, ",")
+	}
+
+	stringToSign := strings.Join([]string{
+		req.Method,
+		req.Header.Get("Content-Encoding"),
+		req.Header.Get("Content-Language"),
+		contentLength,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		"", // Date, x-ms-date is used instead
+		req.Header.Get("If-Modified-Since"),
+		req.Header.Get("If-Match"),
+		req.Header.Get("If-None-Match"),
+		req.Header.Get("If-Unmodified-Since"),
+		req.Header.Get("Range"),
+		canonicalizedHeaders + canonicalizedResource,
+	}, "\n")
+
+	mac := hmac.New(sha256.New, s.accountKey)
+	mac.Write([]byte(stringToSign))
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	req.Header.Set("Authorization", fmt.Sprintf("SharedKey %s:%s", s.account, signature))
+}
+
+func (s *Storage) request(
+	ctx context.Context,
+	method string,
+	reqURL string,
+	body io.Reader,
+	validStatusCodes []int,
+	setupRequest func(req *http.Request),
+) (*http.Response, error) {
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if setupRequest != nil {
+		setupRequest(req)
+	}
+
+	s.signRequest(req, time.Now().UTC())
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+
+	for _, validStatus := range validStatusCodes {
+		if resp.StatusCode == validStatus {
+			return resp, nil
+		}
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, remotestorage.ErrNotFound
+	}
+
+	return nil, fmt.Errorf(
+		"%w: request failed with status code %d (%s)",
+		ErrInvalidResponse, resp.StatusCode, resp.Status,
+	)
+}
+
+func (s *Storage) validateName(name string, isFolder bool) error {
+	if strings.HasPrefix(name, "/") {
+		return ErrInvalidArgumentsNameStartSlash
+	}
+	if isFolder && name != "" && !strings.HasSuffix(name, "/") {
+		// The path must end with `/` so that we don't match entries in parent directory with same prefix name,
+		// listing blobs is prefix-based without clear notion of directories.
+		return ErrInvalidArgumentsPathNoEndSlash
+	}
+	if !isFolder && strings.HasSuffix(name, "/") {
+		return ErrInvalidArgumentsNameEndSlash
+	}
+	if strings.Contains(name, "//") {
+		return ErrInvalidArgumentsInvalidName
+	}
+	if strings.Contains("/"+name, "/./") || strings.Contains("/"+name, "/../") {
+		return ErrInvalidArgumentsInvalidName
+	}
+	return nil
+}
+
+// Get opens a remote azure blob
+func (s *Storage) Get(ctx context.Context, name string, offs, size int64) (io.ReadCloser, error) {
+	if offs < 0 || size == 0 {
+		return nil, ErrInvalidArgumentsOffsSize
+	}
+	err := s.validateName(name, false)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.request(
+		ctx,
+		"GET",
+		s.blobURL(name),
+		nil,
+		[]int{200, 206},
+		func(req *http.Request) {
+			if size < 0 {
+				req.Header.Set("x-ms-range", fmt.Sprintf("bytes=%d-", offs))
+			} else {
+				req.Header.Set("x-ms-range", fmt.Sprintf("bytes=%d-%d", offs, offs+size-1))
+			}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+// Put uploads a local file as a block blob
+func (s *Storage) Put(ctx context.Context, name string, fileName string) error {
+	err := s.validateName(name, false)
+	if err != nil {
+		return err
+	}
+
+	fl, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer fl.Close()
+
+	flStat, err := fl.Stat()
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.request(
+		ctx,
+		"PUT",
+		s.blobURL(name),
+		ioutil.NopCloser(fl),
+		[]int{201},
+		func(req *http.Request) {
+			req.ContentLength = flStat.Size()
+			req.Header.Set("Content-Type", "application/octet-stream")
+			req.Header.Set("x-ms-blob-type", "BlockBlob")
+		},
+	)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// Exists checks if a remote blob exists and can be read
+func (s *Storage) Exists(ctx context.Context, name string) (bool, error) {
+	err := s.validateName(name, false)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := s.request(ctx, "HEAD", s.blobURL(name), nil, []int{200}, nil)
+	if errors.Is(err, remotestorage.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	resp.Body.Close()
+
+	return true, nil
+}
+
+func (s *Storage) ListEntries(ctx context.Context, path string) ([]remotestorage.EntryInfo, []string, error) {
+	err := s.validateName(path, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prefix := s.prefix + path
+
+	urlValues := url.Values{}
+	urlValues.Set("restype", "container")
+	urlValues.Set("comp", "list")
+	urlValues.Set("delimiter", "/")
+	urlValues.Set("prefix", prefix)
+
+	baseURL := s.endpoint + url.PathEscape(s.container)
+
+	entries := []remotestorage.EntryInfo{}
+	subPaths := []string{}
+
+	for {
+		resp, err := s.request(ctx, "GET", baseURL+"?"+urlValues.Encode(), nil, []int{200}, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		respParsed := struct {
+			Blobs struct {
+				Blob []struct {
+					Name       string
+					Properties struct {
+						ContentLength int64 `xml:"Content-Length"`
+					}
+				}
+				BlobPrefix []struct {
+					Name string
+				}
+			}
+			NextMarker string
+		}{}
+
+		err = xml.NewDecoder(resp.Body).Decode(&respParsed)
+		resp.Body.Close()
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", ErrInvalidResponseXmlDecodeError, err)
+		}
+
+		for _, blob := range respParsed.Blobs.Blob {
+			if !strings.HasPrefix(blob.Name, prefix) {
+				return nil, nil, ErrInvalidResponseEntryNameWrongPrefix
+			}
+
+			err = s.validateName(blob.Name, false)
+			if err != nil {
+				return nil, nil, ErrInvalidResponseEntryNameMalicious
+			}
+
+			blobName := strings.TrimPrefix(blob.Name, prefix)
+			if strings.Contains(blobName, "/") {
+				return nil, nil, ErrInvalidResponseEntryNameMalicious
+			}
+
+			entries = append(entries, remotestorage.EntryInfo{
+				Name: blobName,
+				Size: blob.Properties.ContentLength,
+			})
+		}
+
+		for _, subPath := range respParsed.Blobs.BlobPrefix {
+			if !strings.HasPrefix(subPath.Name, prefix) {
+				return nil, nil, ErrInvalidResponseSubPathsWrongPrefix
+			}
+			if !strings.HasSuffix(subPath.Name, "/") {
+				return nil, nil, ErrInvalidResponseSubPathsWrongSuffix
+			}
+
+			p := subPath.Name[len(prefix) : len(subPath.Name)-1]
+			if p == "." || p == ".." || strings.ContainsAny(p, "\\/:") {
+				// Avoid exploitation by a malicious server
+				return nil, nil, ErrInvalidResponseSubPathMalicious
+			}
+
+			subPaths = append(subPaths, p)
+		}
+
+		if respParsed.NextMarker == "" {
+			break
+		}
+
+		urlValues.Set("marker", respParsed.NextMarker)
+	}
+
+	if !sort.SliceIsSorted(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name }) {
+		return nil, nil, ErrInvalidResponseEntriesNotSorted
+	}
+	if !sort.StringsAreSorted(subPaths) {
+		return nil, nil, ErrInvalidResponseSubPathsNotSorted
+	}
+
+	return entries, subPaths, nil
+}
+
+var _ remotestorage.Storage = (*Storage)(nil)
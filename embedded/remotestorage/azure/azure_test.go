@@ -0,0 +1,357 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/remotestorage"
+	"github.com/stretchr/testify/require"
+)
+
+const testAccountKey = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+
+// fakeBlobServer implements the minimal subset of the blob service used by the storage
+func fakeBlobServer(t *testing.T, container string) *httptest.Server {
+	var mutex sync.Mutex
+	blobs := map[string][]byte{}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		require.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "SharedKey testaccount:"))
+		require.Equal(t, apiVersion, r.Header.Get("x-ms-version"))
+		require.NotEmpty(t, r.Header.Get("x-ms-date"))
+
+		path := strings.TrimPrefix(r.URL.Path, "/"+container)
+
+		if path == "" && r.URL.Query().Get("comp") == "list" {
+			prefix := r.URL.Query().Get("prefix")
+
+			names := []string{}
+			prefixes := map[string]struct{}{}
+
+			for name := range blobs {
+				if !strings.HasPrefix(name, prefix) {
+					continue
+				}
+
+				slash := strings.Index(name[len(prefix):], "/")
+				if slash >= 0 {
+					prefixes[name[:len(prefix)+slash+1]] = struct{}{}
+					continue
+				}
+
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			sortedPrefixes := []string{}
+			for p := range prefixes {
+				sortedPrefixes = append(sortedPrefixes, p)
+			}
+			sort.Strings(sortedPrefixes)
+
+			// Results are split into two pages to exercise continuation markers
+			marker := r.URL.Query().Get("marker")
+			nextMarker := ""
+			if marker == "" && len(names) > 1 {
+				nextMarker = names[len(names)/2]
+				names = names[:len(names)/2]
+				sortedPrefixes = nil
+			} else if marker != "" {
+				names = names[sort.SearchStrings(names, marker):]
+			}
+
+			fmt.Fprint(w, `<?xml version="1.0" encoding="utf-8"?><EnumerationResults><Blobs>`)
+			for _, name := range names {
+				fmt.Fprintf(w, "<Blob><Name>%s</Name><Properties><Content-Length>%d</Content-Length></Properties></Blob>", name, len(blobs[name]))
+			}
+			for _, p := range sortedPrefixes {
+				fmt.Fprintf(w, "<BlobPrefix><Name>%s</Name></BlobPrefix>", p)
+			}
+			fmt.Fprintf(w, "</Blobs><NextMarker>%s</NextMarker></EnumerationResults>", nextMarker)
+			return
+		}
+
+		name := strings.TrimPrefix(path, "/")
+
+		switch r.Method {
+		case "PUT":
+			require.Equal(t, "BlockBlob", r.Header.Get("x-ms-blob-type"))
+
+			data, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.EqualValues(t, r.ContentLength, len(data))
+
+			blobs[name] = data
+			w.WriteHeader(http.StatusCreated)
+
+		case "HEAD", "GET":
+			data, ok := blobs[name]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			if r.Method == "HEAD" {
+				return
+			}
+
+			var start, end int
+			_, err := fmt.Sscanf(r.Header.Get("x-ms-range"), "bytes=%d-%d", &start, &end)
+			if err != nil {
+				end = len(data) - 1
+			}
+
+			w.Header().Set("Content-Length", strconv.Itoa(end-start+1))
+			w.WriteHeader(http.StatusPartialContent)
+			w.Write(data[start : end+1])
+
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+}
+
+func storeFile(t *testing.T, s remotestorage.Storage, name string, data []byte) {
+	fileName := filepath.Join(t.TempDir(), "data")
+	err := ioutil.WriteFile(fileName, data, 0644)
+	require.NoError(t, err)
+
+	err = s.Put(context.Background(), name, fileName)
+	require.NoError(t, err)
+}
+
+func TestOpen(t *testing.T) {
+	s, err := Open(
+		"http://127.0.0.1:10000/devstoreaccount1",
+		"devstoreaccount1",
+		testAccountKey,
+		"immudb",
+		"prefix",
+	)
+	require.NoError(t, err)
+	require.Equal(t, "azure", s.Kind())
+	require.Equal(t, "azure:http://127.0.0.1:10000/devstoreaccount1/immudb/prefix/", s.String())
+
+	s, err = Open("", "account", testAccountKey, "/immudb/", "")
+	require.NoError(t, err)
+	require.Equal(t, "azure:https://account.blob.core.windows.net/immudb/", s.String())
+}
+
+func TestCornerCases(t *testing.T) {
+	_, err := Open("", "", testAccountKey, "immudb", "")
+	require.ErrorIs(t, err, ErrInvalidArgumentsAccountEmpty)
+
+	_, err = Open("", "account", "not base64!", "immudb", "")
+	require.ErrorIs(t, err, ErrInvalidArgumentsAccountKeyEncoded)
+
+	_, err = Open("", "account", testAccountKey, "", "")
+	require.ErrorIs(t, err, ErrInvalidArgumentsContainerEmpty)
+
+	_, err = Open("", "account", testAccountKey, "immudb/test", "")
+	require.ErrorIs(t, err, ErrInvalidArgumentsContainerSlash)
+
+	s, err := Open("", "account", testAccountKey, "immudb", "/test")
+	require.NoError(t, err)
+	require.Equal(t, "test/", s.(*Storage).prefix)
+}
+
+func TestValidateName(t *testing.T) {
+	for _, d := range []struct {
+		name     string
+		isFolder bool
+		err      error
+	}{
+		{"", false, nil},
+		{"", true, nil},
+		{"test/name", false, nil},
+		{"test/name/", true, nil},
+		{"/test", false, ErrInvalidArgumentsNameStartSlash},
+		{"test/", false, ErrInvalidArgumentsNameEndSlash},
+		{"test", true, ErrInvalidArgumentsPathNoEndSlash},
+		{"test//name", false, ErrInvalidArgumentsInvalidName},
+		{"test/../test", false, ErrInvalidArgumentsInvalidName},
+	} {
+		t.Run(fmt.Sprintf("%+v", d), func(t *testing.T) {
+			s := Storage{}
+			err := s.validateName(d.name, d.isFolder)
+			require.ErrorIs(t, err, d.err)
+		})
+	}
+}
+
+func TestSignRequest(t *testing.T) {
+	s, err := Open("http://127.0.0.1:10000/devstoreaccount1", "devstoreaccount1", testAccountKey, "immudb", "")
+	require.NoError(t, err)
+
+	req, err := http.NewRequest("GET", "http://127.0.0.1:10000/devstoreaccount1/immudb?restype=container&comp=list", nil)
+	require.NoError(t, err)
+
+	tm := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	s.(*Storage).signRequest(req, tm)
+	require.Equal(t, "Mon, 02 Jan 2023 03:04:05 GMT", req.Header.Get("x-ms-date"))
+	require.True(t, strings.HasPrefix(req.Header.Get("Authorization"), "SharedKey devstoreaccount1:"))
+
+	signature := req.Header.Get("Authorization")
+
+	// The signature is deterministic and depends on signed headers
+	req.Header.Del("Authorization")
+	s.(*Storage).signRequest(req, tm)
+	require.Equal(t, signature, req.Header.Get("Authorization"))
+
+	req.Header.Set("x-ms-range", "bytes=0-1")
+	s.(*Storage).signRequest(req, tm)
+	require.NotEqual(t, signature, req.Header.Get("Authorization"))
+}
+
+func TestAzureStorage(t *testing.T) {
+	srv := fakeBlobServer(t, "immudb")
+	defer srv.Close()
+
+	s, err := Open(srv.URL, "testaccount", testAccountKey, "immudb", "prefix")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	exists, err := s.Exists(ctx, "test1")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	_, err = s.Get(ctx, "test1", 0, -1)
+	require.ErrorIs(t, err, remotestorage.ErrNotFound)
+
+	storeFile(t, s, "test1", []byte("Hello world"))
+
+	exists, err = s.Exists(ctx, "test1")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	in, err := s.Get(ctx, "test1", 1, 5)
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(in)
+	require.NoError(t, err)
+	require.NoError(t, in.Close())
+	require.Equal(t, []byte("ello "), data)
+
+	in, err = s.Get(ctx, "test1", 0, -1)
+	require.NoError(t, err)
+	data, err = ioutil.ReadAll(in)
+	require.NoError(t, err)
+	require.NoError(t, in.Close())
+	require.Equal(t, []byte("Hello world"), data)
+
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 5; j++ {
+			storeFile(t, s, fmt.Sprintf("test2/folder%d/file%d", i, j), []byte(fmt.Sprintf("Hello world_%d_%d", i, j)))
+		}
+	}
+
+	entries, sub, err := s.ListEntries(ctx, "test2/")
+	require.NoError(t, err)
+	require.Empty(t, entries)
+	require.Equal(t, []string{"folder0", "folder1", "folder2"}, sub)
+
+	entries, sub, err = s.ListEntries(ctx, "test2/folder1/")
+	require.NoError(t, err)
+	require.Empty(t, sub)
+	require.Len(t, entries, 5)
+	require.Equal(t, "file0", entries[0].Name)
+	require.EqualValues(t, 15, entries[0].Size)
+
+	entries, sub, err = s.ListEntries(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, []remotestorage.EntryInfo{{Name: "test1", Size: 11}}, entries)
+	require.Equal(t, []string{"test2"}, sub)
+
+	_, _, err = s.ListEntries(ctx, "test2")
+	require.ErrorIs(t, err, ErrInvalidArgumentsPathNoEndSlash)
+
+	_, err = s.Get(ctx, "test1", -1, 1)
+	require.ErrorIs(t, err, ErrInvalidArgumentsOffsSize)
+
+	err = s.Put(ctx, "test3", filepath.Join(t.TempDir(), "missing"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestAzureStorageInvalidResponses(t *testing.T) {
+	for _, d := range []struct {
+		name string
+		resp string
+		err  error
+	}{
+		{"invalid xml", "<EnumerationResults", ErrInvalidResponseXmlDecodeError},
+		{"wrong entry prefix", "<EnumerationResults><Blobs><Blob><Name>other</Name></Blob></Blobs></EnumerationResults>", ErrInvalidResponseEntryNameWrongPrefix},
+		{"malicious entry", "<EnumerationResults><Blobs><Blob><Name>prefix/a/../b</Name></Blob></Blobs></EnumerationResults>", ErrInvalidResponseEntryNameMalicious},
+		{"entries not sorted", "<EnumerationResults><Blobs><Blob><Name>prefix/b</Name></Blob><Blob><Name>prefix/a</Name></Blob></Blobs></EnumerationResults>", ErrInvalidResponseEntriesNotSorted},
+		{"wrong sub-path prefix", "<EnumerationResults><Blobs><BlobPrefix><Name>other/</Name></BlobPrefix></Blobs></EnumerationResults>", ErrInvalidResponseSubPathsWrongPrefix},
+		{"wrong sub-path suffix", "<EnumerationResults><Blobs><BlobPrefix><Name>prefix/a</Name></BlobPrefix></Blobs></EnumerationResults>", ErrInvalidResponseSubPathsWrongSuffix},
+		{"malicious sub-path", "<EnumerationResults><Blobs><BlobPrefix><Name>prefix/../</Name></BlobPrefix></Blobs></EnumerationResults>", ErrInvalidResponseSubPathMalicious},
+		{"sub-paths not sorted", "<EnumerationResults><Blobs><BlobPrefix><Name>prefix/b/</Name></BlobPrefix><BlobPrefix><Name>prefix/a/</Name></BlobPrefix></Blobs></EnumerationResults>", ErrInvalidResponseSubPathsNotSorted},
+	} {
+		t.Run(d.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, d.resp)
+			}))
+			defer srv.Close()
+
+			s, err := Open(srv.URL, "testaccount", testAccountKey, "immudb", "prefix")
+			require.NoError(t, err)
+
+			_, _, err = s.ListEntries(context.Background(), "")
+			require.ErrorIs(t, err, d.err)
+		})
+	}
+
+	t.Run("error status code", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer srv.Close()
+
+		s, err := Open(srv.URL, "testaccount", testAccountKey, "immudb", "")
+		require.NoError(t, err)
+
+		_, err = s.Exists(context.Background(), "test")
+		require.ErrorIs(t, err, ErrInvalidResponse)
+
+		_, err = s.Get(context.Background(), "test", 0, -1)
+		require.ErrorIs(t, err, ErrInvalidResponse)
+
+		fileName := filepath.Join(t.TempDir(), "data")
+		err = ioutil.WriteFile(fileName, []byte("Hello world"), 0644)
+		require.NoError(t, err)
+
+		err = s.Put(context.Background(), "test", fileName)
+		require.ErrorIs(t, err, ErrInvalidResponse)
+	})
+}
@@ -0,0 +1,111 @@
+//go:build azurite
+// +build azurite
+
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAzureWithAzurite(t *testing.T) {
+	randomBytes := make([]byte, 8)
+	_, err := rand.Read(randomBytes)
+	require.NoError(t, err)
+
+	s, err := Open(
+		"http://127.0.0.1:10000/devstoreaccount1",
+		"devstoreaccount1",
+		testAccountKey,
+		"immudb",
+		fmt.Sprintf("prefix_%x", randomBytes),
+	)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	// Ensure the container exists, 409 is returned if it was already created
+	resp, err := s.(*Storage).request(
+		ctx,
+		"PUT",
+		"http://127.0.0.1:10000/devstoreaccount1/immudb?restype=container",
+		nil,
+		[]int{201, 409},
+		nil,
+	)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	t.Run("check exist if file was not created", func(t *testing.T) {
+		exists, err := s.Exists(ctx, "test1")
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+
+	t.Run("store a file", func(t *testing.T) {
+		storeFile(t, s, "test1", []byte("Hello world"))
+	})
+
+	t.Run("check exist after file was created", func(t *testing.T) {
+		exists, err := s.Exists(ctx, "test1")
+		require.NoError(t, err)
+		require.True(t, exists)
+	})
+
+	t.Run("read file partially", func(t *testing.T) {
+		in, err := s.Get(ctx, "test1", 1, 5)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(in)
+		require.NoError(t, err)
+		err = in.Close()
+		require.NoError(t, err)
+		require.Equal(t, []byte("ello "), data)
+	})
+
+	t.Run("create multiple files in multiple folders", func(t *testing.T) {
+		const foldersCount = 3
+		const entriesCount = 20
+
+		for i := 0; i < foldersCount; i++ {
+			for j := 0; j < entriesCount; j++ {
+				storeFile(t, s, fmt.Sprintf("test2/folder%d/file%d", i, j), []byte(fmt.Sprintf("Hello world_%d_%d", i, j)))
+			}
+		}
+
+		entries, sub, err := s.ListEntries(ctx, "test2/")
+		require.NoError(t, err)
+		require.Empty(t, entries)
+		require.Len(t, sub, foldersCount)
+
+		entries, sub, err = s.ListEntries(ctx, "test2/folder0/")
+		require.NoError(t, err)
+		require.Empty(t, sub)
+		require.Len(t, entries, entriesCount)
+		require.EqualValues(t, "file0", entries[0].Name)
+		require.EqualValues(t, "file1", entries[1].Name)
+		require.EqualValues(t, "file10", entries[2].Name)
+		require.EqualValues(t, 15, entries[0].Size)
+		require.EqualValues(t, 16, entries[2].Size)
+	})
+}
@@ -0,0 +1,400 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/codenotary/immudb/embedded/remotestorage"
+)
+
+const DefaultEndpoint = "https://storage.googleapis.com"
+
+// Storage implements a remote storage backed by a Google Cloud Storage bucket
+// accessed through the JSON API. The fake-gcs-server emulator can be used by
+// setting its endpoint, e.g. http://localhost:4443
+type Storage struct {
+	endpoint    string
+	tokenSource TokenSource
+	bucket      string
+	prefix      string
+	httpClient  *http.Client
+}
+
+var (
+	ErrInvalidArguments               = errors.New("invalid arguments")
+	ErrInvalidArgumentsOffsSize       = fmt.Errorf("%w: negative offset or zero size", ErrInvalidArguments)
+	ErrInvalidArgumentsNameStartSlash = fmt.Errorf("%w: name can not start with /", ErrInvalidArguments)
+	ErrInvalidArgumentsNameEndSlash   = fmt.Errorf("%w: name can not end with /", ErrInvalidArguments)
+	ErrInvalidArgumentsInvalidName    = fmt.Errorf("%w: invalid name", ErrInvalidArguments)
+	ErrInvalidArgumentsPathNoEndSlash = fmt.Errorf("%w: path must end with /", ErrInvalidArguments)
+	ErrInvalidArgumentsBucketSlash    = fmt.Errorf("%w: bucket name can not contain / character", ErrInvalidArguments)
+	ErrInvalidArgumentsBucketEmpty    = fmt.Errorf("%w: bucket name can not be empty", ErrInvalidArguments)
+
+	ErrInvalidResponse                     = errors.New("invalid response code")
+	ErrInvalidResponseJsonDecodeError      = fmt.Errorf("%w: json decode error", ErrInvalidResponse)
+	ErrInvalidResponseEntriesNotSorted     = fmt.Errorf("%w: entries are not sorted", ErrInvalidResponse)
+	ErrInvalidResponseEntryNameWrongPrefix = fmt.Errorf("%w: entry do not have correct prefix", ErrInvalidResponse)
+	ErrInvalidResponseEntryNameMalicious   = fmt.Errorf("%w: entry name contains invalid characters", ErrInvalidResponse)
+	ErrInvalidResponseSubPathsNotSorted    = fmt.Errorf("%w: sub-paths are not sorted", ErrInvalidResponse)
+	ErrInvalidResponseSubPathsWrongPrefix  = fmt.Errorf("%w: sub-paths do not have correct prefix", ErrInvalidResponse)
+	ErrInvalidResponseSubPathsWrongSuffix  = fmt.Errorf("%w: sub-paths do end with '/' suffix", ErrInvalidResponse)
+	ErrInvalidResponseSubPathMalicious     = fmt.Errorf("%w: sub-paths contain invalid characters", ErrInvalidResponse)
+)
+
+// Open creates a gcs storage, when the endpoint is empty the public one is used.
+// The access token is sent as an OAuth2 bearer token, no authorization is sent if it's empty.
+// The token is not refreshed, OpenWithTokenSource should be used for long running processes.
+func Open(
+	endpoint string,
+	accessToken string,
+	bucket string,
+	prefix string,
+) (remotestorage.Storage, error) {
+	var tokenSource TokenSource
+
+	if accessToken != "" {
+		tokenSource = StaticTokenSource(accessToken)
+	}
+
+	return OpenWithTokenSource(endpoint, tokenSource, bucket, prefix)
+}
+
+// OpenWithTokenSource creates a gcs storage whose requests are authorized with the access
+// tokens provided by the token source, e.g. the one of a service account.
+// No authorization is sent if the token source is nil.
+func OpenWithTokenSource(
+	endpoint string,
+	tokenSource TokenSource,
+	bucket string,
+	prefix string,
+) (remotestorage.Storage, error) {
+
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+
+	// Endpoint must always end with '/'
+	endpoint = strings.TrimRight(endpoint, "/") + "/"
+
+	// Bucket must have no '/' at all
+	bucket = strings.Trim(bucket, "/")
+	if strings.Contains(bucket, "/") {
+		return nil, ErrInvalidArgumentsBucketSlash
+	}
+
+	// Bucket name must not be empty
+	if bucket == "" {
+		return nil, ErrInvalidArgumentsBucketEmpty
+	}
+
+	// if prefix is not empty, it must end with '/'
+	prefix = strings.Trim(prefix, "/")
+	if prefix != "" {
+		prefix = prefix + "/"
+	}
+
+	return &Storage{
+		endpoint:    endpoint,
+		tokenSource: tokenSource,
+		bucket:      bucket,
+		prefix:      prefix,
+		httpClient:  &http.Client{},
+	}, nil
+}
+
+func (s *Storage) Kind() string {
+	return "gcs"
+}
+
+func (s *Storage) String() string {
+	return "gcs:" + s.endpoint + s.bucket + "/" + s.prefix
+}
+
+func (s *Storage) objectsURL() string {
+	return s.endpoint + "storage/v1/b/" + url.PathEscape(s.bucket) + "/o"
+}
+
+func (s *Storage) objectURL(name string) string {
+	// the whole object name, including '/' characters, must be escaped
+	return s.objectsURL() + "/" + url.PathEscape(s.prefix+name)
+}
+
+func (s *Storage) request(
+	ctx context.Context,
+	method string,
+	reqURL string,
+	body io.Reader,
+	validStatusCodes []int,
+	setupRequest func(req *http.Request),
+) (*http.Response, error) {
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.tokenSource != nil {
+		accessToken, err := s.tokenSource.Token(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if accessToken != "" {
+			req.Header.Set("Authorization", "Bearer "+accessToken)
+		}
+	}
+
+	if setupRequest != nil {
+		setupRequest(req)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+
+	for _, validStatus := range validStatusCodes {
+		if resp.StatusCode == validStatus {
+			return resp, nil
+		}
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, remotestorage.ErrNotFound
+	}
+
+	return nil, fmt.Errorf(
+		"%w: request failed with status code %d (%s)",
+		ErrInvalidResponse, resp.StatusCode, resp.Status,
+	)
+}
+
+func (s *Storage) validateName(name string, isFolder bool) error {
+	if strings.HasPrefix(name, "/") {
+		return ErrInvalidArgumentsNameStartSlash
+	}
+	if isFolder && name != "" && !strings.HasSuffix(name, "/") {
+		// The path must end with `/` so that we don't match entries in parent directory with same prefix name,
+		// listing objects is prefix-based without clear notion of directories.
+		return ErrInvalidArgumentsPathNoEndSlash
+	}
+	if !isFolder && strings.HasSuffix(name, "/") {
+		return ErrInvalidArgumentsNameEndSlash
+	}
+	if strings.Contains(name, "//") {
+		return ErrInvalidArgumentsInvalidName
+	}
+	if strings.Contains("/"+name, "/./") || strings.Contains("/"+name, "/../") {
+		return ErrInvalidArgumentsInvalidName
+	}
+	return nil
+}
+
+// Get opens a remote gcs object
+func (s *Storage) Get(ctx context.Context, name string, offs, size int64) (io.ReadCloser, error) {
+	if offs < 0 || size == 0 {
+		return nil, ErrInvalidArgumentsOffsSize
+	}
+	err := s.validateName(name, false)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.request(
+		ctx,
+		"GET",
+		s.objectURL(name)+"?alt=media",
+		nil,
+		[]int{200, 206},
+		func(req *http.Request) {
+			if size < 0 {
+				req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offs))
+			} else {
+				req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", offs, offs+size-1))
+			}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+// Put uploads a local file as a gcs object
+func (s *Storage) Put(ctx context.Context, name string, fileName string) error {
+	err := s.validateName(name, false)
+	if err != nil {
+		return err
+	}
+
+	fl, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer fl.Close()
+
+	flStat, err := fl.Stat()
+	if err != nil {
+		return err
+	}
+
+	urlValues := url.Values{}
+	urlValues.Set("uploadType", "media")
+	urlValues.Set("name", s.prefix+name)
+
+	resp, err := s.request(
+		ctx,
+		"POST",
+		s.endpoint+"upload/storage/v1/b/"+url.PathEscape(s.bucket)+"/o?"+urlValues.Encode(),
+		ioutil.NopCloser(fl),
+		[]int{200},
+		func(req *http.Request) {
+			req.ContentLength = flStat.Size()
+			req.Header.Set("Content-Type", "application/octet-stream")
+		},
+	)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// Exists checks if a remote object exists and can be read
+func (s *Storage) Exists(ctx context.Context, name string) (bool, error) {
+	err := s.validateName(name, false)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := s.request(ctx, "GET", s.objectURL(name), nil, []int{200}, nil)
+	if errors.Is(err, remotestorage.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	resp.Body.Close()
+
+	return true, nil
+}
+
+func (s *Storage) ListEntries(ctx context.Context, path string) ([]remotestorage.EntryInfo, []string, error) {
+	err := s.validateName(path, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prefix := s.prefix + path
+
+	urlValues := url.Values{}
+	urlValues.Set("delimiter", "/")
+	urlValues.Set("prefix", prefix)
+
+	entries := []remotestorage.EntryInfo{}
+	subPaths := []string{}
+
+	for {
+		resp, err := s.request(ctx, "GET", s.objectsURL()+"?"+urlValues.Encode(), nil, []int{200}, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		respParsed := struct {
+			Items []struct {
+				Name string `json:"name"`
+				Size int64  `json:"size,string"`
+			} `json:"items"`
+			Prefixes      []string `json:"prefixes"`
+			NextPageToken string   `json:"nextPageToken"`
+		}{}
+
+		err = json.NewDecoder(resp.Body).Decode(&respParsed)
+		resp.Body.Close()
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", ErrInvalidResponseJsonDecodeError, err)
+		}
+
+		for _, object := range respParsed.Items {
+			if !strings.HasPrefix(object.Name, prefix) {
+				return nil, nil, ErrInvalidResponseEntryNameWrongPrefix
+			}
+
+			err = s.validateName(object.Name, false)
+			if err != nil {
+				return nil, nil, ErrInvalidResponseEntryNameMalicious
+			}
+
+			objectName := strings.TrimPrefix(object.Name, prefix)
+			if strings.Contains(objectName, "/") {
+				return nil, nil, ErrInvalidResponseEntryNameMalicious
+			}
+
+			entries = append(entries, remotestorage.EntryInfo{
+				Name: objectName,
+				Size: object.Size,
+			})
+		}
+
+		for _, subPathPrefix := range respParsed.Prefixes {
+			if !strings.HasPrefix(subPathPrefix, prefix) {
+				return nil, nil, ErrInvalidResponseSubPathsWrongPrefix
+			}
+			if !strings.HasSuffix(subPathPrefix, "/") {
+				return nil, nil, ErrInvalidResponseSubPathsWrongSuffix
+			}
+
+			p := subPathPrefix[len(prefix) : len(subPathPrefix)-1]
+			if p == "." || p == ".." || strings.ContainsAny(p, "\\/:") {
+				// Avoid exploitation by a malicious server
+				return nil, nil, ErrInvalidResponseSubPathMalicious
+			}
+
+			subPaths = append(subPaths, p)
+		}
+
+		if respParsed.NextPageToken == "" {
+			break
+		}
+
+		urlValues.Set("pageToken", respParsed.NextPageToken)
+	}
+
+	if !sort.SliceIsSorted(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name }) {
+		return nil, nil, ErrInvalidResponseEntriesNotSorted
+	}
+	if !sort.StringsAreSorted(subPaths) {
+		return nil, nil, ErrInvalidResponseSubPathsNotSorted
+	}
+
+	return entries, subPaths, nil
+}
+
+var _ remotestorage.Storage = (*Storage)(nil)
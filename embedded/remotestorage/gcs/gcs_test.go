@@ -0,0 +1,332 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/remotestorage"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeGCSServer implements the minimal subset of the JSON API used by the storage
+func fakeGCSServer(t *testing.T, bucket string, accessToken string) *httptest.Server {
+	var mutex sync.Mutex
+	objects := map[string][]byte{}
+
+	objectsPath := "/storage/v1/b/" + bucket + "/o"
+	uploadPath := "/upload/storage/v1/b/" + bucket + "/o"
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		require.Equal(t, "Bearer "+accessToken, r.Header.Get("Authorization"))
+
+		switch {
+		case r.Method == "POST" && r.URL.Path == uploadPath:
+			require.Equal(t, "media", r.URL.Query().Get("uploadType"))
+
+			data, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.EqualValues(t, r.ContentLength, len(data))
+
+			objects[r.URL.Query().Get("name")] = data
+			fmt.Fprint(w, "{}")
+
+		case r.Method == "GET" && r.URL.Path == objectsPath:
+			prefix := r.URL.Query().Get("prefix")
+
+			type item struct {
+				Name string `json:"name"`
+				Size string `json:"size"`
+			}
+			resp := struct {
+				Items         []item   `json:"items,omitempty"`
+				Prefixes      []string `json:"prefixes,omitempty"`
+				NextPageToken string   `json:"nextPageToken,omitempty"`
+			}{}
+
+			names := []string{}
+			prefixes := map[string]struct{}{}
+
+			for name := range objects {
+				if !strings.HasPrefix(name, prefix) {
+					continue
+				}
+
+				slash := strings.Index(name[len(prefix):], "/")
+				if slash >= 0 {
+					prefixes[name[:len(prefix)+slash+1]] = struct{}{}
+					continue
+				}
+
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			for p := range prefixes {
+				resp.Prefixes = append(resp.Prefixes, p)
+			}
+			sort.Strings(resp.Prefixes)
+
+			// Results are split into two pages to exercise page tokens
+			pageToken := r.URL.Query().Get("pageToken")
+			if pageToken == "" && len(names) > 1 {
+				resp.NextPageToken = names[len(names)/2]
+				names = names[:len(names)/2]
+				resp.Prefixes = nil
+			} else if pageToken != "" {
+				names = names[sort.SearchStrings(names, pageToken):]
+			}
+
+			for _, name := range names {
+				resp.Items = append(resp.Items, item{Name: name, Size: strconv.Itoa(len(objects[name]))})
+			}
+
+			json.NewEncoder(w).Encode(resp)
+
+		case r.Method == "GET" && strings.HasPrefix(r.URL.Path, objectsPath+"/"):
+			name := strings.TrimPrefix(r.URL.Path, objectsPath+"/")
+
+			data, ok := objects[name]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			if r.URL.Query().Get("alt") != "media" {
+				fmt.Fprintf(w, `{"name":%q,"size":"%d"}`, name, len(data))
+				return
+			}
+
+			var start, end int
+			_, err := fmt.Sscanf(r.Header.Get("Range"), "bytes=%d-%d", &start, &end)
+			if err != nil {
+				end = len(data) - 1
+			}
+
+			w.Header().Set("Content-Length", strconv.Itoa(end-start+1))
+			w.WriteHeader(http.StatusPartialContent)
+			w.Write(data[start : end+1])
+
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+}
+
+func storeFile(t *testing.T, s remotestorage.Storage, name string, data []byte) {
+	fileName := filepath.Join(t.TempDir(), "data")
+	err := ioutil.WriteFile(fileName, data, 0644)
+	require.NoError(t, err)
+
+	err = s.Put(context.Background(), name, fileName)
+	require.NoError(t, err)
+}
+
+func TestOpen(t *testing.T) {
+	s, err := Open("http://localhost:4443", "", "immudb", "prefix")
+	require.NoError(t, err)
+	require.Equal(t, "gcs", s.Kind())
+	require.Equal(t, "gcs:http://localhost:4443/immudb/prefix/", s.String())
+
+	s, err = Open("", "", "/immudb/", "")
+	require.NoError(t, err)
+	require.Equal(t, "gcs:https://storage.googleapis.com/immudb/", s.String())
+}
+
+func TestCornerCases(t *testing.T) {
+	_, err := Open("", "", "", "")
+	require.ErrorIs(t, err, ErrInvalidArgumentsBucketEmpty)
+
+	_, err = Open("", "", "immudb/test", "")
+	require.ErrorIs(t, err, ErrInvalidArgumentsBucketSlash)
+
+	s, err := Open("", "", "immudb", "/test")
+	require.NoError(t, err)
+	require.Equal(t, "test/", s.(*Storage).prefix)
+}
+
+func TestValidateName(t *testing.T) {
+	for _, d := range []struct {
+		name     string
+		isFolder bool
+		err      error
+	}{
+		{"", false, nil},
+		{"", true, nil},
+		{"test/name", false, nil},
+		{"test/name/", true, nil},
+		{"/test", false, ErrInvalidArgumentsNameStartSlash},
+		{"test/", false, ErrInvalidArgumentsNameEndSlash},
+		{"test", true, ErrInvalidArgumentsPathNoEndSlash},
+		{"test//name", false, ErrInvalidArgumentsInvalidName},
+		{"test/../test", false, ErrInvalidArgumentsInvalidName},
+	} {
+		t.Run(fmt.Sprintf("%+v", d), func(t *testing.T) {
+			s := Storage{}
+			err := s.validateName(d.name, d.isFolder)
+			require.ErrorIs(t, err, d.err)
+		})
+	}
+}
+
+func TestObjectURL(t *testing.T) {
+	s, err := Open("http://localhost:4443", "", "immudb", "prefix")
+	require.NoError(t, err)
+
+	// object names are escaped as a single path segment
+	require.Equal(t,
+		"http://localhost:4443/storage/v1/b/immudb/o/prefix%2Ftest%2Ffile",
+		s.(*Storage).objectURL("test/file"),
+	)
+}
+
+func TestGCSStorage(t *testing.T) {
+	srv := fakeGCSServer(t, "immudb", "token")
+	defer srv.Close()
+
+	s, err := Open(srv.URL, "token", "immudb", "prefix")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	exists, err := s.Exists(ctx, "test1")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	_, err = s.Get(ctx, "test1", 0, -1)
+	require.ErrorIs(t, err, remotestorage.ErrNotFound)
+
+	storeFile(t, s, "test1", []byte("Hello world"))
+
+	exists, err = s.Exists(ctx, "test1")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	in, err := s.Get(ctx, "test1", 1, 5)
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(in)
+	require.NoError(t, err)
+	require.NoError(t, in.Close())
+	require.Equal(t, []byte("ello "), data)
+
+	in, err = s.Get(ctx, "test1", 0, -1)
+	require.NoError(t, err)
+	data, err = ioutil.ReadAll(in)
+	require.NoError(t, err)
+	require.NoError(t, in.Close())
+	require.Equal(t, []byte("Hello world"), data)
+
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 5; j++ {
+			storeFile(t, s, fmt.Sprintf("test2/folder%d/file%d", i, j), []byte(fmt.Sprintf("Hello world_%d_%d", i, j)))
+		}
+	}
+
+	entries, sub, err := s.ListEntries(ctx, "test2/")
+	require.NoError(t, err)
+	require.Empty(t, entries)
+	require.Equal(t, []string{"folder0", "folder1", "folder2"}, sub)
+
+	entries, sub, err = s.ListEntries(ctx, "test2/folder1/")
+	require.NoError(t, err)
+	require.Empty(t, sub)
+	require.Len(t, entries, 5)
+	require.Equal(t, "file0", entries[0].Name)
+	require.EqualValues(t, 15, entries[0].Size)
+
+	entries, sub, err = s.ListEntries(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, []remotestorage.EntryInfo{{Name: "test1", Size: 11}}, entries)
+	require.Equal(t, []string{"test2"}, sub)
+
+	_, _, err = s.ListEntries(ctx, "test2")
+	require.ErrorIs(t, err, ErrInvalidArgumentsPathNoEndSlash)
+
+	_, err = s.Get(ctx, "test1", -1, 1)
+	require.ErrorIs(t, err, ErrInvalidArgumentsOffsSize)
+
+	err = s.Put(ctx, "test3", filepath.Join(t.TempDir(), "missing"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestGCSStorageInvalidResponses(t *testing.T) {
+	for _, d := range []struct {
+		name string
+		resp string
+		err  error
+	}{
+		{"invalid json", "{", ErrInvalidResponseJsonDecodeError},
+		{"wrong entry prefix", `{"items":[{"name":"other","size":"1"}]}`, ErrInvalidResponseEntryNameWrongPrefix},
+		{"malicious entry", `{"items":[{"name":"prefix/a/../b","size":"1"}]}`, ErrInvalidResponseEntryNameMalicious},
+		{"entries not sorted", `{"items":[{"name":"prefix/b","size":"1"},{"name":"prefix/a","size":"1"}]}`, ErrInvalidResponseEntriesNotSorted},
+		{"wrong sub-path prefix", `{"prefixes":["other/"]}`, ErrInvalidResponseSubPathsWrongPrefix},
+		{"wrong sub-path suffix", `{"prefixes":["prefix/a"]}`, ErrInvalidResponseSubPathsWrongSuffix},
+		{"malicious sub-path", `{"prefixes":["prefix/../"]}`, ErrInvalidResponseSubPathMalicious},
+		{"sub-paths not sorted", `{"prefixes":["prefix/b/","prefix/a/"]}`, ErrInvalidResponseSubPathsNotSorted},
+	} {
+		t.Run(d.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, d.resp)
+			}))
+			defer srv.Close()
+
+			s, err := Open(srv.URL, "", "immudb", "prefix")
+			require.NoError(t, err)
+
+			_, _, err = s.ListEntries(context.Background(), "")
+			require.ErrorIs(t, err, d.err)
+		})
+	}
+
+	t.Run("error status code", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer srv.Close()
+
+		s, err := Open(srv.URL, "", "immudb", "")
+		require.NoError(t, err)
+
+		_, err = s.Exists(context.Background(), "test")
+		require.ErrorIs(t, err, ErrInvalidResponse)
+
+		_, err = s.Get(context.Background(), "test", 0, -1)
+		require.ErrorIs(t, err, ErrInvalidResponse)
+
+		fileName := filepath.Join(t.TempDir(), "data")
+		err = ioutil.WriteFile(fileName, []byte("Hello world"), 0644)
+		require.NoError(t, err)
+
+		err = s.Put(context.Background(), "test", fileName)
+		require.ErrorIs(t, err, ErrInvalidResponse)
+	})
+}
@@ -0,0 +1,295 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcs
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultTokenURI = "https://oauth2.googleapis.com/token"
+	StorageScope    = "https://www.googleapis.com/auth/devstorage.read_write"
+
+	// tokens are refreshed ahead of their expiration
+	tokenRefreshMargin = time.Minute
+	assertionLifetime  = time.Hour
+)
+
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// TokenSource provides the OAuth2 access tokens used to authorize requests
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+type staticTokenSource string
+
+// StaticTokenSource always provides the same access token, it's not refreshed once expired
+func StaticTokenSource(accessToken string) TokenSource {
+	return staticTokenSource(accessToken)
+}
+
+func (ts staticTokenSource) Token(ctx context.Context) (string, error) {
+	return string(ts), nil
+}
+
+type serviceAccountKey struct {
+	Type         string `json:"type"`
+	ClientEmail  string `json:"client_email"`
+	PrivateKeyID string `json:"private_key_id"`
+	PrivateKey   string `json:"private_key"`
+	TokenURI     string `json:"token_uri"`
+}
+
+// serviceAccountTokenSource obtains access tokens with the OAuth2 JWT bearer grant
+// signed by the key of a service account, tokens are refreshed before they expire
+type serviceAccountTokenSource struct {
+	email      string
+	keyID      string
+	key        *rsa.PrivateKey
+	tokenURI   string
+	scope      string
+	httpClient *http.Client
+	now        func() time.Time
+
+	mutex  sync.Mutex
+	token  string
+	expiry time.Time
+	// closed once the token being fetched is available, nil when no fetch is in progress
+	fetching chan struct{}
+}
+
+// NewServiceAccountTokenSource creates a token source from the JSON key of a service account
+func NewServiceAccountTokenSource(credentialsJSON []byte) (TokenSource, error) {
+	var sa serviceAccountKey
+
+	err := json.Unmarshal(credentialsJSON, &sa)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed service account key: %v", ErrInvalidCredentials, err)
+	}
+
+	if sa.Type != "service_account" {
+		return nil, fmt.Errorf("%w: unsupported credentials type '%s'", ErrInvalidCredentials, sa.Type)
+	}
+
+	if sa.ClientEmail == "" {
+		return nil, fmt.Errorf("%w: client email is missing", ErrInvalidCredentials)
+	}
+
+	key, err := parseRSAPrivateKey(sa.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenURI := sa.TokenURI
+	if tokenURI == "" {
+		tokenURI = DefaultTokenURI
+	}
+
+	return &serviceAccountTokenSource{
+		email:      sa.ClientEmail,
+		keyID:      sa.PrivateKeyID,
+		key:        key,
+		tokenURI:   tokenURI,
+		scope:      StorageScope,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		now:        time.Now,
+	}, nil
+}
+
+func parseRSAPrivateKey(pemKey string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(pemKey))
+	if block == nil {
+		return nil, fmt.Errorf("%w: private key is not PEM encoded", ErrInvalidCredentials)
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed private key: %v", ErrInvalidCredentials, err)
+	}
+
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: private key is not an RSA key", ErrInvalidCredentials)
+	}
+
+	return key, nil
+}
+
+// Token returns the cached access token while it's valid. The token is fetched by a single caller
+// once it's about to expire, meanwhile the current one is still provided
+func (ts *serviceAccountTokenSource) Token(ctx context.Context) (string, error) {
+	for {
+		ts.mutex.Lock()
+
+		token := ts.token
+		now := ts.now()
+
+		if token != "" && now.Add(tokenRefreshMargin).Before(ts.expiry) {
+			ts.mutex.Unlock()
+			return token, nil
+		}
+
+		stillValid := token != "" && now.Before(ts.expiry)
+
+		fetching := ts.fetching
+
+		if fetching != nil {
+			ts.mutex.Unlock()
+
+			if stillValid {
+				return token, nil
+			}
+
+			select {
+			case <-fetching:
+				continue
+			case <-ctx.Done():
+				return "", ctx.Err()
+			}
+		}
+
+		fetching = make(chan struct{})
+		ts.fetching = fetching
+
+		ts.mutex.Unlock()
+
+		fetchedToken, expiry, err := ts.fetchToken(ctx)
+
+		ts.mutex.Lock()
+
+		if err == nil {
+			ts.token = fetchedToken
+			ts.expiry = expiry
+		}
+
+		ts.fetching = nil
+		close(fetching)
+
+		ts.mutex.Unlock()
+
+		if err != nil {
+			if stillValid {
+				// the refresh is retried on the next request
+				return token, nil
+			}
+			return "", err
+		}
+
+		return fetchedToken, nil
+	}
+}
+
+func (ts *serviceAccountTokenSource) fetchToken(ctx context.Context) (string, time.Time, error) {
+	issuedAt := ts.now()
+
+	assertion, err := ts.signedAssertion(issuedAt)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "urn:ietf:params:oauth:grant-type:jwt-bearer")
+	form.Set("assertion", assertion)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", ts.tokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := ts.httpClient.Do(req)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", time.Time{}, fmt.Errorf(
+			"%w: token request failed with status code %d (%s)",
+			ErrInvalidResponse, resp.StatusCode, resp.Status,
+		)
+	}
+
+	respParsed := struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}{}
+
+	err = json.NewDecoder(resp.Body).Decode(&respParsed)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("%w: %v", ErrInvalidResponseJsonDecodeError, err)
+	}
+
+	if respParsed.AccessToken == "" {
+		return "", time.Time{}, fmt.Errorf("%w: access token is missing", ErrInvalidResponse)
+	}
+
+	return respParsed.AccessToken, issuedAt.Add(time.Duration(respParsed.ExpiresIn) * time.Second), nil
+}
+
+// signedAssertion returns the RS256 signed JWT exchanged for an access token
+func (ts *serviceAccountTokenSource) signedAssertion(issuedAt time.Time) (string, error) {
+	header, err := json.Marshal(map[string]string{
+		"alg": "RS256",
+		"typ": "JWT",
+		"kid": ts.keyID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	claims, err := json.Marshal(map[string]interface{}{
+		"iss":   ts.email,
+		"scope": ts.scope,
+		"aud":   ts.tokenURI,
+		"iat":   issuedAt.Unix(),
+		"exp":   issuedAt.Add(assertionLifetime).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	signedContent := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+
+	digest := sha256.Sum256([]byte(signedContent))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, ts.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+
+	return signedContent + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcs
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testServiceAccountKey(t *testing.T) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	return key
+}
+
+func testServiceAccountCredentials(t *testing.T, key *rsa.PrivateKey, tokenURI string) []byte {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	credentials, err := json.Marshal(map[string]string{
+		"type":           "service_account",
+		"client_email":   "immudb@project.iam.gserviceaccount.com",
+		"private_key_id": "key-id",
+		"private_key":    string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		"token_uri":      tokenURI,
+	})
+	require.NoError(t, err)
+
+	return credentials
+}
+
+// fakeTokenServer validates the signed assertions and issues tokens valid for an hour
+func fakeTokenServer(t *testing.T, key *rsa.PrivateKey, issued *int32, failing *int32) *httptest.Server {
+	var srv *httptest.Server
+
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(failing) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "urn:ietf:params:oauth:grant-type:jwt-bearer", r.PostForm.Get("grant_type"))
+
+		parts := strings.Split(r.PostForm.Get("assertion"), ".")
+		require.Len(t, parts, 3)
+
+		signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+		require.NoError(t, err)
+
+		digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+		require.NoError(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature))
+
+		rawClaims, err := base64.RawURLEncoding.DecodeString(parts[1])
+		require.NoError(t, err)
+
+		var claims map[string]interface{}
+		require.NoError(t, json.Unmarshal(rawClaims, &claims))
+		require.Equal(t, "immudb@project.iam.gserviceaccount.com", claims["iss"])
+		require.Equal(t, StorageScope, claims["scope"])
+		require.Equal(t, srv.URL, claims["aud"])
+
+		n := atomic.AddInt32(issued, 1)
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": fmt.Sprintf("token-%d", n),
+			"expires_in":   3600,
+			"token_type":   "Bearer",
+		})
+	}))
+
+	return srv
+}
+
+func TestNewServiceAccountTokenSourceInvalidCredentials(t *testing.T) {
+	credentials := testServiceAccountCredentials(t, testServiceAccountKey(t), "")
+
+	var sa map[string]string
+	require.NoError(t, json.Unmarshal(credentials, &sa))
+
+	for _, d := range []struct {
+		field string
+		value string
+	}{
+		{"type", "authorized_user"},
+		{"client_email", ""},
+		{"private_key", "not a pem key"},
+		{"private_key", string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("garbage")}))},
+	} {
+		t.Run(d.field, func(t *testing.T) {
+			invalid := map[string]string{}
+			for k, v := range sa {
+				invalid[k] = v
+			}
+			invalid[d.field] = d.value
+
+			invalidCredentials, err := json.Marshal(invalid)
+			require.NoError(t, err)
+
+			_, err = NewServiceAccountTokenSource(invalidCredentials)
+			require.ErrorIs(t, err, ErrInvalidCredentials)
+		})
+	}
+
+	_, err := NewServiceAccountTokenSource([]byte("{"))
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+
+	ts, err := NewServiceAccountTokenSource(credentials)
+	require.NoError(t, err)
+	require.Equal(t, DefaultTokenURI, ts.(*serviceAccountTokenSource).tokenURI)
+}
+
+func TestServiceAccountTokenRefresh(t *testing.T) {
+	var issued, failing int32
+
+	key := testServiceAccountKey(t)
+
+	tokenSrv := fakeTokenServer(t, key, &issued, &failing)
+	defer tokenSrv.Close()
+
+	tokenSource, err := NewServiceAccountTokenSource(testServiceAccountCredentials(t, key, tokenSrv.URL))
+	require.NoError(t, err)
+
+	now := time.Now()
+	tokenSource.(*serviceAccountTokenSource).now = func() time.Time { return now }
+
+	var authorization atomic.Value
+
+	storageSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization.Store(r.Header.Get("Authorization"))
+		fmt.Fprint(w, "{}")
+	}))
+	defer storageSrv.Close()
+
+	s, err := OpenWithTokenSource(storageSrv.URL, tokenSource, "immudb", "")
+	require.NoError(t, err)
+
+	exists := func() string {
+		ok, err := s.Exists(context.Background(), "object")
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		return authorization.Load().(string)
+	}
+
+	require.Equal(t, "Bearer token-1", exists())
+
+	t.Run("valid token should be reused", func(t *testing.T) {
+		now = now.Add(30 * time.Minute)
+
+		require.Equal(t, "Bearer token-1", exists())
+		require.EqualValues(t, 1, atomic.LoadInt32(&issued))
+	})
+
+	t.Run("token should be refreshed before it expires", func(t *testing.T) {
+		now = now.Add(30*time.Minute - tokenRefreshMargin/2)
+
+		require.Equal(t, "Bearer token-2", exists())
+		require.EqualValues(t, 2, atomic.LoadInt32(&issued))
+	})
+
+	t.Run("valid token should be used when it can not be refreshed", func(t *testing.T) {
+		atomic.StoreInt32(&failing, 1)
+
+		now = now.Add(time.Hour - tokenRefreshMargin/2)
+
+		require.Equal(t, "Bearer token-2", exists())
+	})
+
+	t.Run("expired token should not be used", func(t *testing.T) {
+		now = now.Add(tokenRefreshMargin)
+
+		_, err := s.Exists(context.Background(), "object")
+		require.ErrorIs(t, err, ErrInvalidResponse)
+
+		atomic.StoreInt32(&failing, 0)
+
+		require.Equal(t, "Bearer token-3", exists())
+	})
+}
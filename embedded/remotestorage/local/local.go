@@ -0,0 +1,263 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/codenotary/immudb/embedded/remotestorage"
+)
+
+var (
+	ErrInvalidArguments               = errors.New("invalid arguments")
+	ErrInvalidArgumentsOffsSize       = fmt.Errorf("%w: negative offset or zero size", ErrInvalidArguments)
+	ErrInvalidArgumentsNameStartSlash = fmt.Errorf("%w: name can not start with /", ErrInvalidArguments)
+	ErrInvalidArgumentsNameEndSlash   = fmt.Errorf("%w: name can not end with /", ErrInvalidArguments)
+	ErrInvalidArgumentsInvalidName    = fmt.Errorf("%w: invalid name", ErrInvalidArguments)
+	ErrInvalidArgumentsPathNoEndSlash = fmt.Errorf("%w: path must end with /", ErrInvalidArguments)
+	ErrInvalidArgumentsDirEmpty       = fmt.Errorf("%w: directory can not be empty", ErrInvalidArguments)
+	ErrInvalidArgumentsNotADirectory  = fmt.Errorf("%w: path is not a directory", ErrInvalidArguments)
+)
+
+const tmpFilePrefix = ".tmp-"
+
+// Storage implements a remote storage backed by a local directory,
+// e.g. a mounted NFS share
+type Storage struct {
+	dir      string
+	fileMode os.FileMode
+}
+
+func Open(dir string, fileMode os.FileMode) (*Storage, error) {
+	if dir == "" {
+		return nil, ErrInvalidArgumentsDirEmpty
+	}
+
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(dir, fileMode|0700)
+	if err != nil {
+		return nil, err
+	}
+
+	fi, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		return nil, ErrInvalidArgumentsNotADirectory
+	}
+
+	return &Storage{
+		dir:      dir,
+		fileMode: fileMode,
+	}, nil
+}
+
+func (s *Storage) Kind() string {
+	return "local"
+}
+
+func (s *Storage) String() string {
+	return "local:" + s.dir + string(filepath.Separator)
+}
+
+func (s *Storage) validateName(name string, isFolder bool) error {
+	if strings.HasPrefix(name, "/") {
+		return ErrInvalidArgumentsNameStartSlash
+	}
+	if isFolder && name != "" && !strings.HasSuffix(name, "/") {
+		return ErrInvalidArgumentsPathNoEndSlash
+	}
+	if !isFolder && strings.HasSuffix(name, "/") {
+		return ErrInvalidArgumentsNameEndSlash
+	}
+	if strings.Contains(name, "//") || strings.Contains(name, "\\") {
+		return ErrInvalidArgumentsInvalidName
+	}
+	if strings.Contains("/"+name, "/./") || strings.Contains("/"+name, "/../") {
+		return ErrInvalidArgumentsInvalidName
+	}
+	if strings.HasPrefix(filepath.Base(name), tmpFilePrefix) {
+		// Names of temporary files are reserved
+		return ErrInvalidArgumentsInvalidName
+	}
+	return nil
+}
+
+func (s *Storage) path(name string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(name))
+}
+
+// Get opens a file stored in the local directory
+func (s *Storage) Get(ctx context.Context, name string, offs, size int64) (io.ReadCloser, error) {
+	if offs < 0 || size == 0 {
+		return nil, ErrInvalidArgumentsOffsSize
+	}
+	err := s.validateName(name, false)
+	if err != nil {
+		return nil, err
+	}
+
+	fl, err := os.Open(s.path(name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, remotestorage.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = fl.Seek(offs, io.SeekStart)
+	if err != nil {
+		fl.Close()
+		return nil, err
+	}
+
+	if size < 0 {
+		return fl, nil
+	}
+
+	return &limitedReadCloser{
+		Reader: io.LimitReader(fl, size),
+		Closer: fl,
+	}, nil
+}
+
+type limitedReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// Put copies a local file into the directory.
+// The content is first written into a temporary file which is then renamed,
+// thus readers never see partially written files.
+func (s *Storage) Put(ctx context.Context, name string, fileName string) error {
+	err := s.validateName(name, false)
+	if err != nil {
+		return err
+	}
+
+	src, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dstPath := s.path(name)
+
+	err = os.MkdirAll(filepath.Dir(dstPath), s.fileMode|0700)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(dstPath), tmpFilePrefix)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, src)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+
+	err = tmp.Sync()
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	err = os.Chmod(tmp.Name(), s.fileMode)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), dstPath)
+}
+
+// Exists checks if a file exists in the directory
+func (s *Storage) Exists(ctx context.Context, name string) (bool, error) {
+	err := s.validateName(name, false)
+	if err != nil {
+		return false, err
+	}
+
+	fi, err := os.Stat(s.path(name))
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return !fi.IsDir(), nil
+}
+
+func (s *Storage) ListEntries(ctx context.Context, path string) ([]remotestorage.EntryInfo, []string, error) {
+	err := s.validateName(path, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dirEntries, err := ioutil.ReadDir(s.path(path))
+	if errors.Is(err, os.ErrNotExist) {
+		return []remotestorage.EntryInfo{}, []string{}, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entries := []remotestorage.EntryInfo{}
+	subPaths := []string{}
+
+	for _, e := range dirEntries {
+		if strings.HasPrefix(e.Name(), tmpFilePrefix) {
+			continue
+		}
+
+		if e.IsDir() {
+			subPaths = append(subPaths, e.Name())
+			continue
+		}
+
+		entries = append(entries, remotestorage.EntryInfo{
+			Name: e.Name(),
+			Size: e.Size(),
+		})
+	}
+
+	// ReadDir returns entries sorted by name
+	return entries, subPaths, nil
+}
+
+var _ remotestorage.Storage = (*Storage)(nil)
@@ -0,0 +1,204 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/remotestorage"
+	"github.com/stretchr/testify/require"
+)
+
+func storeFile(t *testing.T, s *Storage, name string, data []byte) {
+	fileName := filepath.Join(t.TempDir(), "data")
+	err := ioutil.WriteFile(fileName, data, 0644)
+	require.NoError(t, err)
+
+	err = s.Put(context.Background(), name, fileName)
+	require.NoError(t, err)
+}
+
+func TestOpen(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "remote")
+
+	s, err := Open(dir, 0644)
+	require.NoError(t, err)
+	require.Equal(t, "local", s.Kind())
+	require.Equal(t, "local:"+dir+string(filepath.Separator), s.String())
+	require.DirExists(t, dir)
+
+	_, err = Open("", 0644)
+	require.ErrorIs(t, err, ErrInvalidArgumentsDirEmpty)
+
+	fileName := filepath.Join(t.TempDir(), "file")
+	err = ioutil.WriteFile(fileName, []byte{}, 0644)
+	require.NoError(t, err)
+
+	_, err = Open(fileName, 0644)
+	require.Error(t, err)
+}
+
+func TestValidateName(t *testing.T) {
+	for _, d := range []struct {
+		name     string
+		isFolder bool
+		err      error
+	}{
+		{"", false, nil},
+		{"", true, nil},
+		{"test", false, nil},
+		{"test/", true, nil},
+		{"test/name", false, nil},
+		{"test/name/", true, nil},
+		{"/test", false, ErrInvalidArgumentsNameStartSlash},
+		{"test/", false, ErrInvalidArgumentsNameEndSlash},
+		{"test", true, ErrInvalidArgumentsPathNoEndSlash},
+		{"test//name", false, ErrInvalidArgumentsInvalidName},
+		{"test\\name", false, ErrInvalidArgumentsInvalidName},
+		{"test/../test", false, ErrInvalidArgumentsInvalidName},
+		{"../test", false, ErrInvalidArgumentsInvalidName},
+		{"test/.tmp-123", false, ErrInvalidArgumentsInvalidName},
+	} {
+		t.Run(fmt.Sprintf("%+v", d), func(t *testing.T) {
+			s := Storage{}
+			err := s.validateName(d.name, d.isFolder)
+			require.ErrorIs(t, err, d.err)
+		})
+	}
+}
+
+func TestLocalStorage(t *testing.T) {
+	s, err := Open(t.TempDir(), 0644)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	exists, err := s.Exists(ctx, "test1")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	_, err = s.Get(ctx, "test1", 0, -1)
+	require.ErrorIs(t, err, remotestorage.ErrNotFound)
+
+	storeFile(t, s, "test1", []byte("Hello world"))
+
+	exists, err = s.Exists(ctx, "test1")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	t.Run("read whole file", func(t *testing.T) {
+		in, err := s.Get(ctx, "test1", 0, -1)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(in)
+		require.NoError(t, err)
+		require.NoError(t, in.Close())
+		require.Equal(t, []byte("Hello world"), data)
+	})
+
+	t.Run("read file partially", func(t *testing.T) {
+		in, err := s.Get(ctx, "test1", 1, 5)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(in)
+		require.NoError(t, err)
+		require.NoError(t, in.Close())
+		require.Equal(t, []byte("ello "), data)
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		_, err := s.Get(ctx, "test1", -1, 5)
+		require.ErrorIs(t, err, ErrInvalidArgumentsOffsSize)
+
+		_, err = s.Get(ctx, "test1", 0, 0)
+		require.ErrorIs(t, err, ErrInvalidArgumentsOffsSize)
+
+		_, err = s.Get(ctx, "../test1", 0, -1)
+		require.ErrorIs(t, err, ErrInvalidArgumentsInvalidName)
+
+		err = s.Put(ctx, "test1/", "")
+		require.ErrorIs(t, err, ErrInvalidArgumentsNameEndSlash)
+
+		err = s.Put(ctx, "test1", filepath.Join(t.TempDir(), "missing"))
+		require.ErrorIs(t, err, os.ErrNotExist)
+
+		_, err = s.Exists(ctx, "/test1")
+		require.ErrorIs(t, err, ErrInvalidArgumentsNameStartSlash)
+
+		_, _, err = s.ListEntries(ctx, "test2")
+		require.ErrorIs(t, err, ErrInvalidArgumentsPathNoEndSlash)
+	})
+
+	t.Run("list entries in multiple folders", func(t *testing.T) {
+		const foldersCount = 3
+		const entriesCount = 20
+
+		for i := 0; i < foldersCount; i++ {
+			for j := 0; j < entriesCount; j++ {
+				storeFile(t, s, fmt.Sprintf("test2/folder%d/file%d", i, j), []byte(fmt.Sprintf("Hello world_%d_%d", i, j)))
+			}
+		}
+
+		entries, sub, err := s.ListEntries(ctx, "test2/")
+		require.NoError(t, err)
+		require.Empty(t, entries)
+		require.Equal(t, []string{"folder0", "folder1", "folder2"}, sub)
+
+		entries, sub, err = s.ListEntries(ctx, "test2/folder0/")
+		require.NoError(t, err)
+		require.Empty(t, sub)
+		require.Len(t, entries, entriesCount)
+		require.EqualValues(t, "file0", entries[0].Name)
+		require.EqualValues(t, "file1", entries[1].Name)
+		require.EqualValues(t, "file10", entries[2].Name)
+		require.EqualValues(t, 15, entries[0].Size)
+		require.EqualValues(t, 16, entries[2].Size)
+
+		exists, err := s.Exists(ctx, "test2/folder0")
+		require.NoError(t, err)
+		require.False(t, exists)
+
+		entries, sub, err = s.ListEntries(ctx, "missing/")
+		require.NoError(t, err)
+		require.Empty(t, entries)
+		require.Empty(t, sub)
+	})
+
+	t.Run("temporary files are not listed", func(t *testing.T) {
+		err := ioutil.WriteFile(filepath.Join(s.dir, tmpFilePrefix+"123"), []byte{1}, 0644)
+		require.NoError(t, err)
+
+		entries, sub, err := s.ListEntries(ctx, "")
+		require.NoError(t, err)
+		require.Equal(t, []remotestorage.EntryInfo{{Name: "test1", Size: 11}}, entries)
+		require.Equal(t, []string{"test2"}, sub)
+	})
+
+	t.Run("overwrite file", func(t *testing.T) {
+		storeFile(t, s, "test1", []byte("Hello"))
+
+		in, err := s.Get(ctx, "test1", 0, -1)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(in)
+		require.NoError(t, err)
+		require.NoError(t, in.Close())
+		require.Equal(t, []byte("Hello"), data)
+	})
+}
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"sort"
 	"strings"
 	"time"
 
@@ -37,6 +38,8 @@ type Catalog struct {
 	tablesByID   map[uint32]*Table
 	tablesByName map[string]*Table
 	tableCount   uint32 // The tableCount variable is used to assign unique ids to new tables as they are created.
+
+	restrictedUsers map[string]struct{} // users ever granted privileges on a table
 }
 
 type Table struct {
@@ -49,16 +52,20 @@ type Table struct {
 	indexes         []*Index
 	indexesByName   map[string]*Index
 	indexesByColID  map[uint32][]*Index
+	fullTextIndexes map[uint32]*Index
 	primaryIndex    *Index
 	autoIncrementPK bool
 	maxPK           int64
 	indexCount      uint32
+	ttl             time.Duration
+	grants          map[string]privilegeSet
 }
 
 type Index struct {
 	table    *Table
 	id       uint32
 	unique   bool
+	fullText bool
 	cols     []*Column
 	colsByID map[uint32]*Column
 }
@@ -79,6 +86,8 @@ func newCatalog(prefix []byte) *Catalog {
 		tables:       make([]*Table, 0),
 		tablesByID:   make(map[uint32]*Table),
 		tablesByName: make(map[string]*Table),
+
+		restrictedUsers: make(map[string]struct{}),
 	}
 }
 
@@ -131,6 +140,59 @@ func (t *Table) PrimaryIndex() *Index {
 	return t.primaryIndex
 }
 
+// TTL returns the time-to-live of the rows of the table, zero when rows do not expire
+func (t *Table) TTL() time.Duration {
+	return t.ttl
+}
+
+// GrantedPrivileges returns the privileges granted to the user on the table
+func (t *Table) GrantedPrivileges(user string) []SQLPrivilege {
+	return t.grants[user].privileges()
+}
+
+// Grantees returns the users with privileges granted on the table
+func (t *Table) Grantees() []string {
+	users := make([]string, 0, len(t.grants))
+	for user := range t.grants {
+		users = append(users, user)
+	}
+
+	sort.Strings(users)
+
+	return users
+}
+
+// IsRestricted returns true if the user was ever granted privileges on a table.
+// Restricted users remain restricted when all their privileges are revoked
+func (catlg *Catalog) IsRestricted(user string) bool {
+	_, restricted := catlg.restrictedUsers[user]
+	return restricted
+}
+
+// CheckPrivileges returns ErrPermissionDenied if any of the given privileges was not granted to the user.
+// Users never granted privileges on any table are not restricted at the table level, otherwise
+// they can only execute statements for which all the required privileges were granted.
+func (catlg *Catalog) CheckPrivileges(user string, privileges []TablePrivilege) error {
+	if !catlg.IsRestricted(user) {
+		return nil
+	}
+
+	for _, p := range privileges {
+		table, exists := catlg.tablesByName[p.Table]
+		if exists && table.grants[user].includes(p.Privilege) {
+			continue
+		}
+
+		if p.Table == "" {
+			return fmt.Errorf("%w: %s privilege required", ErrPermissionDenied, p.Privilege)
+		}
+
+		return fmt.Errorf("%w: %s privilege required on table '%s'", ErrPermissionDenied, p.Privilege, p.Table)
+	}
+
+	return nil
+}
+
 func (t *Table) IsIndexed(colName string) (indexed bool, err error) {
 	c, exists := t.colsByName[colName]
 	if !exists {
@@ -178,6 +240,12 @@ func (i *Index) IsUnique() bool {
 	return i.unique
 }
 
+// IsFullText returns true for inverted indexes used to resolve MATCH conditions.
+// Full-text indexes can not be used for sorting nor for range scans.
+func (i *Index) IsFullText() bool {
+	return i.fullText
+}
+
 func (i *Index) Cols() []*Column {
 	return i.cols
 }
@@ -213,10 +281,18 @@ func (i *Index) prefix() string {
 		return UIndexPrefix
 	}
 
+	if i.IsFullText() {
+		return FIndexPrefix
+	}
+
 	return SIndexPrefix
 }
 
 func (i *Index) Name() string {
+	if i.IsFullText() {
+		return fullTextIndexName(i.table.name, i.cols)
+	}
+
 	return indexName(i.table.name, i.cols)
 }
 
@@ -252,6 +328,10 @@ func indexName(tableName string, cols []*Column) string {
 	return buf.String()
 }
 
+func fullTextIndexName(tableName string, cols []*Column) string {
+	return indexName(tableName, cols) + ".fulltext"
+}
+
 func (catlg *Catalog) newTable(name string, colsSpec []*ColSpec) (table *Table, err error) {
 	if len(name) == 0 || len(colsSpec) == 0 {
 		return nil, ErrIllegalArguments
@@ -274,14 +354,16 @@ func (catlg *Catalog) newTable(name string, colsSpec []*ColSpec) (table *Table,
 	}
 
 	table = &Table{
-		id:             id,
-		catalog:        catlg,
-		name:           name,
-		cols:           make([]*Column, len(colsSpec)),
-		colsByID:       make(map[uint32]*Column),
-		colsByName:     make(map[string]*Column),
-		indexesByName:  make(map[string]*Index),
-		indexesByColID: make(map[uint32][]*Index),
+		id:              id,
+		catalog:         catlg,
+		name:            name,
+		cols:            make([]*Column, len(colsSpec)),
+		colsByID:        make(map[uint32]*Column),
+		colsByName:      make(map[string]*Column),
+		indexesByName:   make(map[string]*Index),
+		indexesByColID:  make(map[uint32][]*Index),
+		fullTextIndexes: make(map[uint32]*Index),
+		grants:          make(map[string]privilegeSet),
 	}
 
 	for i, cs := range colsSpec {
@@ -328,10 +410,22 @@ func (catlg *Catalog) newTable(name string, colsSpec []*ColSpec) (table *Table,
 }
 
 func (t *Table) newIndex(unique bool, colIDs []uint32) (index *Index, err error) {
+	return t.newIndexOfKind(unique, false, colIDs)
+}
+
+func (t *Table) newFullTextIndex(colIDs []uint32) (index *Index, err error) {
+	return t.newIndexOfKind(false, true, colIDs)
+}
+
+func (t *Table) newIndexOfKind(unique, fullText bool, colIDs []uint32) (index *Index, err error) {
 	if len(colIDs) < 1 {
 		return nil, ErrIllegalArguments
 	}
 
+	if fullText && (len(colIDs) > 1 || unique) {
+		return nil, ErrLimitedFullTextIndex
+	}
+
 	// validate column ids
 	cols := make([]*Column, len(colIDs))
 	colsByID := make(map[uint32]*Column, len(colIDs))
@@ -347,6 +441,10 @@ func (t *Table) newIndex(unique bool, colIDs []uint32) (index *Index, err error)
 			return nil, ErrDuplicatedColumn
 		}
 
+		if fullText && col.colType != VarcharType {
+			return nil, ErrLimitedFullTextIndex
+		}
+
 		cols[i] = col
 		colsByID[colID] = col
 	}
@@ -355,6 +453,7 @@ func (t *Table) newIndex(unique bool, colIDs []uint32) (index *Index, err error)
 		id:       uint32(t.indexCount),
 		table:    t,
 		unique:   unique,
+		fullText: fullText,
 		cols:     cols,
 		colsByID: colsByID,
 	}
@@ -367,9 +466,14 @@ func (t *Table) newIndex(unique bool, colIDs []uint32) (index *Index, err error)
 	t.indexes = append(t.indexes, index)
 	t.indexesByName[index.Name()] = index
 
-	// having a direct way to get the indexes by colID
-	for _, col := range index.cols {
-		t.indexesByColID[col.id] = append(t.indexesByColID[col.id], index)
+	if fullText {
+		// full-text indexes are not considered when sorting or scanning ranges
+		t.fullTextIndexes[cols[0].id] = index
+	} else {
+		// having a direct way to get the indexes by colID
+		for _, col := range index.cols {
+			t.indexesByColID[col.id] = append(t.indexesByColID[col.id], index)
+		}
 	}
 
 	if index.id == PKIndexID {
@@ -496,6 +600,11 @@ func validMaxLenForType(maxLen int, sqlType SQLValueType) bool {
 }
 
 func (catlg *Catalog) load(tx *store.OngoingTx) error {
+	err := catlg.loadRestrictedUsers(tx)
+	if err != nil {
+		return err
+	}
+
 	dbReaderSpec := store.KeyReaderSpec{
 		Prefix:  mapKey(catlg.prefix, catalogTablePrefix, EncodeID(1)),
 		Filters: []store.FilterFn{store.IgnoreExpired},
@@ -559,6 +668,16 @@ func (catlg *Catalog) load(tx *store.OngoingTx) error {
 			return err
 		}
 
+		table.ttl, err = loadTTL(catlg.prefix, tx, table.id)
+		if err != nil {
+			return err
+		}
+
+		err = table.loadGrants(catlg.prefix, tx)
+		if err != nil {
+			return err
+		}
+
 		if table.autoIncrementPK {
 			encMaxPK, err := loadMaxPK(catlg.prefix, tx, table)
 			if errors.Is(err, store.ErrNoMoreEntries) {
@@ -586,6 +705,92 @@ func (catlg *Catalog) load(tx *store.OngoingTx) error {
 	return nil
 }
 
+func (catlg *Catalog) loadRestrictedUsers(tx *store.OngoingTx) error {
+	initialKey := mapKey(catlg.prefix, catalogUserPrefix, EncodeID(1))
+
+	userReader, err := tx.NewKeyReader(store.KeyReaderSpec{
+		Prefix:  initialKey,
+		Filters: []store.FilterFn{store.IgnoreExpired, store.IgnoreDeleted},
+	})
+	if err != nil {
+		return err
+	}
+	defer userReader.Close()
+
+	for {
+		mkey, _, err := userReader.Read()
+		if errors.Is(err, store.ErrNoMoreEntries) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(mkey) == len(initialKey) {
+			return ErrCorruptedData
+		}
+
+		catlg.restrictedUsers[string(mkey[len(initialKey):])] = struct{}{}
+	}
+}
+
+func loadTTL(sqlPrefix []byte, tx *store.OngoingTx, tableID uint32) (time.Duration, error) {
+	vref, err := tx.Get(mapKey(sqlPrefix, catalogTTLPrefix, EncodeID(1), EncodeID(tableID)))
+	if errors.Is(err, store.ErrKeyNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	v, err := vref.Resolve()
+	if err != nil {
+		return 0, err
+	}
+
+	if len(v) != 8 {
+		return 0, ErrCorruptedData
+	}
+
+	return time.Duration(binary.BigEndian.Uint64(v)) * time.Second, nil
+}
+
+func (table *Table) loadGrants(sqlPrefix []byte, tx *store.OngoingTx) error {
+	initialKey := mapKey(sqlPrefix, catalogGrantPrefix, EncodeID(1), EncodeID(table.id))
+
+	grantReader, err := tx.NewKeyReader(store.KeyReaderSpec{
+		Prefix:  initialKey,
+		Filters: []store.FilterFn{store.IgnoreExpired, store.IgnoreDeleted},
+	})
+	if err != nil {
+		return err
+	}
+	defer grantReader.Close()
+
+	for {
+		mkey, vref, err := grantReader.Read()
+		if errors.Is(err, store.ErrNoMoreEntries) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		v, err := vref.Resolve()
+		if err != nil {
+			return err
+		}
+
+		if len(v) != 1 || len(mkey) == len(initialKey) {
+			return ErrCorruptedData
+		}
+
+		table.grants[string(mkey[len(initialKey):])] = privilegeSet(v[0])
+	}
+
+	return nil
+}
+
 func loadMaxPK(sqlPrefix []byte, tx *store.OngoingTx, table *Table) ([]byte, error) {
 	pkReaderSpec := store.KeyReaderSpec{
 		Prefix:    mapKey(sqlPrefix, PIndexPrefix, EncodeID(1), EncodeID(table.id), EncodeID(PKIndexID)),
@@ -713,7 +918,7 @@ func (table *Table) loadIndexes(sqlPrefix []byte, tx *store.OngoingTx) error {
 			return err
 		}
 
-		// v={unique {colID1}(ASC|DESC)...{colIDN}(ASC|DESC)}
+		// v={kind {colID1}(ASC|DESC)...{colIDN}(ASC|DESC)}
 		colSpecLen := EncIDLen + 1
 
 		if len(v) < 1+colSpecLen || len(v)%colSpecLen != 1 {
@@ -733,7 +938,18 @@ func (table *Table) loadIndexes(sqlPrefix []byte, tx *store.OngoingTx) error {
 			colIDs = append(colIDs, colID)
 		}
 
-		index, err := table.newIndex(v[0] > 0, colIDs)
+		var index *Index
+
+		switch v[0] {
+		case secondaryIndexKind:
+			index, err = table.newIndex(false, colIDs)
+		case uniqueIndexKind:
+			index, err = table.newIndex(true, colIDs)
+		case fullTextIndexKind:
+			index, err = table.newFullTextIndex(colIDs)
+		default:
+			return ErrCorruptedData
+		}
 		if err != nil {
 			return err
 		}
@@ -1358,6 +1574,12 @@ func (t *Table) addIndexesToTx(sqlPrefix []byte, tx *store.OngoingTx) error {
 
 // addSchemaToTx adds the schema of the catalog to the given transaction.
 func (catlg *Catalog) addSchemaToTx(sqlPrefix []byte, tx *store.OngoingTx) error {
+	// read restricted users into tx
+	err := addRestrictedUsersToTx(tx, sqlPrefix)
+	if err != nil {
+		return err
+	}
+
 	dbReaderSpec := store.KeyReaderSpec{
 		Prefix:  mapKey(sqlPrefix, catalogTablePrefix, EncodeID(1)),
 		Filters: []store.FilterFn{store.IgnoreExpired, store.IgnoreDeleted},
@@ -1421,11 +1643,107 @@ func (catlg *Catalog) addSchemaToTx(sqlPrefix []byte, tx *store.OngoingTx) error
 			return err
 		}
 
+		// read ttl into tx
+		err = addTTLToTx(tx, sqlPrefix, tableID)
+		if err != nil {
+			return err
+		}
+
+		// read grants into tx
+		err = addGrantsToTx(tx, sqlPrefix, tableID)
+		if err != nil {
+			return err
+		}
+
 	}
 
 	return nil
 }
 
+// addTTLToTx adds the ttl of the given table to the given transaction, if any.
+func addTTLToTx(tx *store.OngoingTx, sqlPrefix []byte, tableID uint32) error {
+	mkey := mapKey(sqlPrefix, catalogTTLPrefix, EncodeID(1), EncodeID(tableID))
+
+	vref, err := tx.Get(mkey)
+	if errors.Is(err, store.ErrKeyNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	v, err := vref.Resolve()
+	if err != nil {
+		return err
+	}
+
+	return tx.Set(mkey, nil, v)
+}
+
+// addRestrictedUsersToTx adds the users restricted to the granted privileges to the given transaction.
+func addRestrictedUsersToTx(tx *store.OngoingTx, sqlPrefix []byte) error {
+	userReader, err := tx.NewKeyReader(store.KeyReaderSpec{
+		Prefix:  mapKey(sqlPrefix, catalogUserPrefix, EncodeID(1)),
+		Filters: []store.FilterFn{store.IgnoreExpired, store.IgnoreDeleted},
+	})
+	if err != nil {
+		return err
+	}
+	defer userReader.Close()
+
+	for {
+		mkey, vref, err := userReader.Read()
+		if errors.Is(err, store.ErrNoMoreEntries) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		v, err := vref.Resolve()
+		if err != nil {
+			return err
+		}
+
+		err = tx.Set(mkey, nil, v)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// addGrantsToTx adds the privileges granted on the given table to the given transaction.
+func addGrantsToTx(tx *store.OngoingTx, sqlPrefix []byte, tableID uint32) error {
+	grantReader, err := tx.NewKeyReader(store.KeyReaderSpec{
+		Prefix:  mapKey(sqlPrefix, catalogGrantPrefix, EncodeID(1), EncodeID(tableID)),
+		Filters: []store.FilterFn{store.IgnoreExpired, store.IgnoreDeleted},
+	})
+	if err != nil {
+		return err
+	}
+	defer grantReader.Close()
+
+	for {
+		mkey, vref, err := grantReader.Read()
+		if errors.Is(err, store.ErrNoMoreEntries) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		v, err := vref.Resolve()
+		if err != nil {
+			return err
+		}
+
+		err = tx.Set(mkey, nil, v)
+		if err != nil {
+			return err
+		}
+	}
+}
+
 // addColSpecsToTx adds the column specs of the given table to the given transaction.
 func addColSpecsToTx(tx *store.OngoingTx, sqlPrefix []byte, tableID uint32) (specs []*ColSpec, err error) {
 	initialKey := mapKey(sqlPrefix, catalogColumnPrefix, EncodeID(1), EncodeID(tableID))
@@ -80,11 +80,13 @@ var ErrMissingParameter = errors.New("missing parameter")
 var ErrUnsupportedParameter = errors.New("unsupported parameter")
 var ErrDuplicatedParameters = errors.New("duplicated parameters")
 var ErrLimitedIndexCreation = errors.New("index creation is only supported on empty tables")
+var ErrLimitedFullTextIndex = errors.New("full-text indexes are limited to a single VARCHAR column")
 var ErrTooManyRows = errors.New("too many rows")
 var ErrAlreadyClosed = store.ErrAlreadyClosed
 var ErrAmbiguousSelector = errors.New("ambiguous selector")
 var ErrUnsupportedCast = fmt.Errorf("%w: unsupported cast", ErrInvalidValue)
 var ErrColumnMismatchInUnionStmt = errors.New("column mismatch in union statement")
+var ErrPermissionDenied = errors.New("permission denied")
 
 var MaxKeyLen = 512
 
@@ -101,7 +103,8 @@ type Engine struct {
 	autocommit                    bool
 	lazyIndexConstraintValidation bool
 
-	multidbHandler MultiDBHandler
+	multidbHandler   MultiDBHandler
+	privilegeChecker PrivilegeChecker
 }
 
 type MultiDBHandler interface {
@@ -111,6 +114,10 @@ type MultiDBHandler interface {
 	ExecPreparedStmts(ctx context.Context, opts *TxOptions, stmts []SQLStmt, params map[string]interface{}) (ntx *SQLTx, committedTxs []*SQLTx, err error)
 }
 
+// PrivilegeChecker is called with the privileges required by a statement before executing it,
+// the statement is rejected when an error is returned
+type PrivilegeChecker func(ctx context.Context, catalog *Catalog, privileges []TablePrivilege) error
+
 func NewEngine(store *store.ImmuStore, opts *Options) (*Engine, error) {
 	if store == nil {
 		return nil, ErrIllegalArguments
@@ -128,6 +135,7 @@ func NewEngine(store *store.ImmuStore, opts *Options) (*Engine, error) {
 		autocommit:                    opts.autocommit,
 		lazyIndexConstraintValidation: opts.lazyIndexConstraintValidation,
 		multidbHandler:                opts.multidbHandler,
+		privilegeChecker:              opts.privilegeChecker,
 	}
 
 	copy(e.prefix, opts.prefix)
@@ -268,6 +276,12 @@ func (e *Engine) execPreparedStmts(ctx context.Context, tx *SQLTx, stmts []SQLSt
 			}
 		}
 
+		err = e.CheckPrivileges(ctx, currTx, RequiredPrivileges(stmt))
+		if err != nil {
+			currTx.Cancel()
+			return nil, committedTxs, stmts[execStmts:], err
+		}
+
 		ntx, err := stmt.execAt(ctx, currTx, nparams)
 		if err != nil {
 			currTx.Cancel()
@@ -351,6 +365,11 @@ func (e *Engine) QueryPreparedStmt(ctx context.Context, tx *SQLTx, stmt DataSour
 		return nil, err
 	}
 
+	err = e.CheckPrivileges(ctx, qtx, RequiredPrivileges(stmt))
+	if err != nil {
+		return nil, err
+	}
+
 	_, err = stmt.execAt(ctx, qtx, nparams)
 	if err != nil {
 		return nil, err
@@ -370,6 +389,15 @@ func (e *Engine) QueryPreparedStmt(ctx context.Context, tx *SQLTx, stmt DataSour
 	return r, nil
 }
 
+// CheckPrivileges checks the given privileges with the privilege checker of the engine, if any
+func (e *Engine) CheckPrivileges(ctx context.Context, tx *SQLTx, privileges []TablePrivilege) error {
+	if e.privilegeChecker == nil || len(privileges) == 0 {
+		return nil
+	}
+
+	return e.privilegeChecker(ctx, tx.catalog, privileges)
+}
+
 func (e *Engine) Catalog(ctx context.Context, tx *SQLTx) (catalog *Catalog, err error) {
 	qtx := tx
 
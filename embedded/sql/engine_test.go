@@ -6672,3 +6672,332 @@ func TestLikeWithNullableColumns(t *testing.T) {
 	_, err = r.Read(context.Background())
 	require.ErrorIs(t, err, ErrNoMoreRows)
 }
+
+func TestTableTTL(t *testing.T) {
+	engine, st := setupCommonTestWithOptions(t, store.DefaultOptions())
+
+	_, _, err := engine.Exec(context.Background(), nil, `
+		CREATE TABLE mytable (
+			id INTEGER AUTO_INCREMENT,
+			code VARCHAR[16],
+			title VARCHAR[64],
+			body VARCHAR,
+			PRIMARY KEY id
+		);
+
+		CREATE UNIQUE INDEX ON mytable(code);
+		CREATE INDEX ON mytable(title);
+		CREATE FULLTEXT INDEX ON mytable(body);
+	`, nil)
+	require.NoError(t, err)
+
+	t.Run("invalid ttl", func(t *testing.T) {
+		_, _, err := engine.ExecPreparedStmts(context.Background(), nil, []SQLStmt{NewSetTableTTLStmt("mytable", -time.Second)}, nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, _, err = engine.ExecPreparedStmts(context.Background(), nil, []SQLStmt{NewSetTableTTLStmt("mytable", time.Millisecond)}, nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, _, err = engine.ExecPreparedStmts(context.Background(), nil, []SQLStmt{NewSetTableTTLStmt("unknown", time.Hour)}, nil)
+		require.ErrorIs(t, err, ErrTableDoesNotExist)
+	})
+
+	insertStmt := func(id int, code, title, body string) *UpsertIntoStmt {
+		return NewUpserIntoStmt(
+			"mytable",
+			[]string{"id", "code", "title", "body"},
+			[]*RowSpec{NewRowSpec([]ValueExp{NewInteger(int64(id)), NewVarchar(code), NewVarchar(title), NewVarchar(body)})},
+			true,
+			nil,
+		)
+	}
+
+	rowExpiration := func(id int) (time.Time, bool) {
+		tx, err := st.NewTx(context.Background(), store.DefaultTxOptions().WithMode(store.ReadOnlyTx))
+		require.NoError(t, err)
+		defer tx.Cancel()
+
+		catalog, err := engine.Catalog(context.Background(), nil)
+		require.NoError(t, err)
+
+		table, err := catalog.GetTableByName("mytable")
+		require.NoError(t, err)
+
+		pkEncVals, err := encodedPK(table, map[uint32]TypedValue{table.primaryIndex.cols[0].id: &Integer{val: int64(id)}})
+		require.NoError(t, err)
+
+		vref, err := tx.GetWithFilters(mapKey(sqlPrefix, PIndexPrefix, EncodeID(1), EncodeID(table.id), EncodeID(PKIndexID), pkEncVals))
+		require.NoError(t, err)
+
+		if vref.KVMetadata() == nil || !vref.KVMetadata().IsExpirable() {
+			return time.Time{}, false
+		}
+
+		expiresAt, err := vref.KVMetadata().ExpirationTime()
+		require.NoError(t, err)
+
+		return expiresAt, true
+	}
+
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	_, _, err = engine.ExecPreparedStmts(context.Background(), nil, []SQLStmt{
+		insertStmt(1, "c1", "title1", "immutable records"),
+		insertStmt(2, "c2", "title2", "expiring records").WithExpiration(time.Now().Add(-time.Second)),
+		insertStmt(3, "c3", "title3", "expiring later").WithExpiration(expiresAt),
+	}, nil)
+	require.NoError(t, err)
+
+	t.Run("expired rows are not returned", func(t *testing.T) {
+		require.Equal(t, []int64{1, 3}, queryIDs(t, engine, "SELECT id FROM mytable", nil))
+		require.Equal(t, []int64{1, 3}, queryIDs(t, engine, "SELECT id FROM mytable USE INDEX ON (title)", nil))
+		require.Empty(t, queryIDs(t, engine, "SELECT id FROM mytable WHERE code = 'c2'", nil))
+		require.Equal(t, []int64{1}, queryIDs(t, engine, "SELECT id FROM mytable WHERE MATCH(body, 'records')", nil))
+
+		r, err := engine.Query(context.Background(), nil, "SELECT COUNT(*) FROM mytable", nil)
+		require.NoError(t, err)
+
+		row, err := r.Read(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, int64(2), row.ValuesByPosition[0].RawValue())
+		require.NoError(t, r.Close())
+	})
+
+	t.Run("unique values of expired rows can be reused", func(t *testing.T) {
+		_, _, err = engine.ExecPreparedStmts(context.Background(), nil, []SQLStmt{insertStmt(4, "c2", "title4", "records again")}, nil)
+		require.NoError(t, err)
+
+		require.Equal(t, []int64{4}, queryIDs(t, engine, "SELECT id FROM mytable WHERE code = 'c2'", nil))
+	})
+
+	t.Run("updated rows keep their expiration time", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "UPDATE mytable SET title = 'title3.1' WHERE id = 3", nil)
+		require.NoError(t, err)
+
+		rowExpiresAt, expirable := rowExpiration(3)
+		require.True(t, expirable)
+		require.Equal(t, expiresAt.Unix(), rowExpiresAt.Unix())
+
+		require.Equal(t, []int64{3}, queryIDs(t, engine, "SELECT id FROM mytable WHERE title = 'title3.1'", nil))
+	})
+
+	t.Run("rows inherit the ttl of the table", func(t *testing.T) {
+		_, _, err := engine.ExecPreparedStmts(context.Background(), nil, []SQLStmt{NewSetTableTTLStmt("mytable", time.Hour)}, nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.ExecPreparedStmts(context.Background(), nil, []SQLStmt{insertStmt(5, "c5", "title5", "ttl records")}, nil)
+		require.NoError(t, err)
+
+		rowExpiresAt, expirable := rowExpiration(5)
+		require.True(t, expirable)
+		require.WithinDuration(t, time.Now().Add(time.Hour), rowExpiresAt, time.Minute)
+
+		_, expirable = rowExpiration(1)
+		require.False(t, expirable)
+
+		// upserting a row makes it expire according to the ttl of the table
+		_, _, err = engine.Exec(context.Background(), nil, "UPSERT INTO mytable(id, code, title, body) VALUES (1, 'c1', 'title1', 'immutable records')", nil)
+		require.NoError(t, err)
+
+		_, expirable = rowExpiration(1)
+		require.True(t, expirable)
+
+		require.Equal(t, []int64{1}, queryIDs(t, engine, "SELECT id FROM mytable WHERE code = 'c1'", nil))
+		require.Equal(t, []int64{1}, queryIDs(t, engine, "SELECT id FROM mytable WHERE title = 'title1'", nil))
+		require.Equal(t, []int64{1}, queryIDs(t, engine, "SELECT id FROM mytable WHERE MATCH(body, 'immutable')", nil))
+	})
+
+	t.Run("ttl survives catalog reloading", func(t *testing.T) {
+		engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+		require.NoError(t, err)
+
+		catalog, err := engine.Catalog(context.Background(), nil)
+		require.NoError(t, err)
+
+		table, err := catalog.GetTableByName("mytable")
+		require.NoError(t, err)
+		require.Equal(t, time.Hour, table.TTL())
+
+		_, _, err = engine.ExecPreparedStmts(context.Background(), nil, []SQLStmt{NewSetTableTTLStmt("mytable", 0)}, nil)
+		require.NoError(t, err)
+
+		catalog, err = engine.Catalog(context.Background(), nil)
+		require.NoError(t, err)
+
+		table, err = catalog.GetTableByName("mytable")
+		require.NoError(t, err)
+		require.Zero(t, table.TTL())
+	})
+}
+
+func TestGrantPrivileges(t *testing.T) {
+	_, st := setupCommonTestWithOptions(t, store.DefaultOptions())
+
+	type userKey struct{}
+
+	checker := func(ctx context.Context, catalog *Catalog, privileges []TablePrivilege) error {
+		user, ok := ctx.Value(userKey{}).(string)
+		if !ok {
+			return nil
+		}
+		return catalog.CheckPrivileges(user, privileges)
+	}
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix).WithPrivilegeChecker(checker))
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		CREATE TABLE orders (id INTEGER AUTO_INCREMENT, amount INTEGER, PRIMARY KEY id);
+		CREATE TABLE customers (id INTEGER AUTO_INCREMENT, name VARCHAR, PRIMARY KEY id);
+
+		INSERT INTO orders(amount) VALUES (10), (20);
+		INSERT INTO customers(name) VALUES ('c1');
+	`, nil)
+	require.NoError(t, err)
+
+	analyticsCtx := context.WithValue(context.Background(), userKey{}, "analytics")
+
+	t.Run("users without grants are not restricted", func(t *testing.T) {
+		require.Equal(t, []int64{1}, queryIDs(t, engine, "SELECT id FROM customers", nil))
+
+		r, err := engine.Query(analyticsCtx, nil, "SELECT id FROM customers", nil)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+	})
+
+	t.Run("invalid grants", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "GRANT SELECT ON unknown TO analytics", nil)
+		require.ErrorIs(t, err, ErrTableDoesNotExist)
+
+		_, _, err = engine.Exec(context.Background(), nil, "GRANT SELECT ON COLLECTION orders TO analytics", nil)
+		require.ErrorIs(t, err, ErrNoSupported)
+
+		_, _, err = engine.ExecPreparedStmts(context.Background(), nil, []SQLStmt{NewGrantStmt([]SQLPrivilege{SQLPrivilegeCreate}, "orders", "analytics")}, nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, _, err = engine.ExecPreparedStmts(context.Background(), nil, []SQLStmt{NewGrantStmt([]SQLPrivilege{SQLPrivilegeSelect}, "orders", "")}, nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	_, _, err = engine.Exec(context.Background(), nil, "GRANT SELECT, INSERT ON orders TO analytics", nil)
+	require.NoError(t, err)
+
+	t.Run("granted privileges are enforced", func(t *testing.T) {
+		r, err := engine.Query(analyticsCtx, nil, "SELECT id FROM orders", nil)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+
+		_, _, err = engine.Exec(analyticsCtx, nil, "INSERT INTO orders(amount) VALUES (30)", nil)
+		require.NoError(t, err)
+
+		_, err = engine.Query(analyticsCtx, nil, "SELECT id FROM customers", nil)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = engine.Query(analyticsCtx, nil, "SELECT * FROM orders INNER JOIN customers ON orders.id = customers.id", nil)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, _, err = engine.Exec(analyticsCtx, nil, "UPDATE orders SET amount = 0 WHERE id = 1", nil)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, _, err = engine.Exec(analyticsCtx, nil, "CREATE TABLE t1 (id INTEGER, PRIMARY KEY id)", nil)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, _, err = engine.Exec(analyticsCtx, nil, "GRANT SELECT ON customers TO analytics", nil)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		require.Equal(t, []int64{1, 2, 3}, queryIDs(t, engine, "SELECT id FROM orders", nil))
+	})
+
+	t.Run("grants are listed", func(t *testing.T) {
+		r, err := engine.Query(context.Background(), nil, "SELECT user, privilege FROM grants('orders')", nil)
+		require.NoError(t, err)
+		defer r.Close()
+
+		for _, privilege := range []SQLPrivilege{SQLPrivilegeSelect, SQLPrivilegeInsert} {
+			row, err := r.Read(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, "analytics", row.ValuesByPosition[0].RawValue())
+			require.Equal(t, string(privilege), row.ValuesByPosition[1].RawValue())
+		}
+
+		_, err = r.Read(context.Background())
+		require.ErrorIs(t, err, ErrNoMoreRows)
+	})
+
+	t.Run("grants survive catalog reloading", func(t *testing.T) {
+		engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix).WithPrivilegeChecker(checker))
+		require.NoError(t, err)
+
+		catalog, err := engine.Catalog(context.Background(), nil)
+		require.NoError(t, err)
+
+		table, err := catalog.GetTableByName("orders")
+		require.NoError(t, err)
+		require.Equal(t, []string{"analytics"}, table.Grantees())
+		require.Equal(t, []SQLPrivilege{SQLPrivilegeSelect, SQLPrivilegeInsert}, table.GrantedPrivileges("analytics"))
+
+		_, err = engine.Query(analyticsCtx, nil, "SELECT id FROM customers", nil)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("revoked privileges are no longer granted", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "REVOKE INSERT ON orders FROM analytics", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(analyticsCtx, nil, "INSERT INTO orders(amount) VALUES (40)", nil)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, _, err = engine.Exec(context.Background(), nil, "REVOKE ALL ON orders FROM analytics", nil)
+		require.NoError(t, err)
+
+		catalog, err := engine.Catalog(context.Background(), nil)
+		require.NoError(t, err)
+
+		table, err := catalog.GetTableByName("orders")
+		require.NoError(t, err)
+		require.Empty(t, table.Grantees())
+
+		// revoking privileges never widens the access of the user
+		require.True(t, catalog.IsRestricted("analytics"))
+
+		_, err = engine.Query(analyticsCtx, nil, "SELECT id FROM customers", nil)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = engine.Query(analyticsCtx, nil, "SELECT id FROM orders", nil)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("grants are deleted with the table", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "GRANT SELECT ON customers TO analytics", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.ExecPreparedStmts(context.Background(), nil, []SQLStmt{NewDropTableStmt("customers")}, nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "CREATE TABLE customers (id INTEGER AUTO_INCREMENT, PRIMARY KEY id)", nil)
+		require.NoError(t, err)
+
+		catalog, err := engine.Catalog(context.Background(), nil)
+		require.NoError(t, err)
+
+		table, err := catalog.GetTableByName("customers")
+		require.NoError(t, err)
+		require.Empty(t, table.Grantees())
+
+		_, err = engine.Query(analyticsCtx, nil, "SELECT id FROM customers", nil)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("restrictions survive catalog reloading", func(t *testing.T) {
+		engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix).WithPrivilegeChecker(checker))
+		require.NoError(t, err)
+
+		catalog, err := engine.Catalog(context.Background(), nil)
+		require.NoError(t, err)
+		require.True(t, catalog.IsRestricted("analytics"))
+		require.False(t, catalog.IsRestricted("reporting"))
+
+		_, err = engine.Query(analyticsCtx, nil, "SELECT id FROM orders", nil)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+	})
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+// MaxFullTextTermLen is the maximum length of an indexed term, longer terms are not indexed
+const MaxFullTextTermLen = 64
+
+// full-text index entries hold the term frequency and the number of terms in the indexed value
+const fullTextEntryLen = 2 * EncLenLen
+
+var englishStopWords = map[string]struct{}{
+	"a": {}, "an": {}, "and": {}, "are": {}, "as": {}, "at": {}, "be": {}, "but": {}, "by": {},
+	"for": {}, "if": {}, "in": {}, "into": {}, "is": {}, "it": {}, "no": {}, "not": {}, "of": {},
+	"on": {}, "or": {}, "such": {}, "that": {}, "the": {}, "their": {}, "then": {}, "there": {},
+	"these": {}, "they": {}, "this": {}, "to": {}, "was": {}, "will": {}, "with": {},
+}
+
+// fullTextTerms splits the text into lower-cased words, discards english stop words
+// and reduces the remaining ones to their stems.
+func fullTextTerms(text string) []string {
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := make([]string, 0, len(words))
+
+	for _, word := range words {
+		word = strings.ToLower(word)
+
+		if _, stopWord := englishStopWords[word]; stopWord {
+			continue
+		}
+
+		term := stemEnglish(word)
+
+		if len(term) > MaxFullTextTermLen {
+			continue
+		}
+
+		terms = append(terms, term)
+	}
+
+	return terms
+}
+
+func distinctFullTextTerms(text string) []string {
+	terms := fullTextTerms(text)
+
+	seen := make(map[string]struct{}, len(terms))
+	distinct := make([]string, 0, len(terms))
+
+	for _, term := range terms {
+		if _, ok := seen[term]; ok {
+			continue
+		}
+
+		seen[term] = struct{}{}
+		distinct = append(distinct, term)
+	}
+
+	return distinct
+}
+
+// FullTextMatch returns true when all the terms of the query are present in the text.
+// Both text and query are analyzed in the same way full-text indexes are built.
+func FullTextMatch(text, query string) bool {
+	queryTerms := distinctFullTextTerms(query)
+	if len(queryTerms) == 0 {
+		return false
+	}
+
+	textTerms := make(map[string]struct{})
+
+	for _, term := range fullTextTerms(text) {
+		textTerms[term] = struct{}{}
+	}
+
+	for _, term := range queryTerms {
+		if _, ok := textTerms[term]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func mapFullTextTerm(sqlPrefix []byte, index *Index, term string) ([]byte, error) {
+	encTerm, _, err := EncodeRawValueAsKey(term, VarcharType, MaxFullTextTermLen)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapKey(sqlPrefix, FIndexPrefix, EncodeID(1), EncodeID(index.table.id), EncodeID(index.id), encTerm), nil
+}
+
+// setFullTextIndexEntries creates one index entry for each distinct term in the value
+func (tx *SQLTx) setFullTextIndexEntries(pkEncVals []byte, index *Index, val TypedValue, md *store.KVMetadata) error {
+	return tx.updateFullTextIndexEntries(pkEncVals, index, val, md)
+}
+
+// deleteFullTextIndexEntries marks the index entries of the terms in the value as deleted
+func (tx *SQLTx) deleteFullTextIndexEntries(pkEncVals []byte, index *Index, val TypedValue) error {
+	md := store.NewKVMetadata()
+	md.AsDeleted(true)
+
+	return tx.updateFullTextIndexEntries(pkEncVals, index, val, md)
+}
+
+func (tx *SQLTx) updateFullTextIndexEntries(pkEncVals []byte, index *Index, val TypedValue, md *store.KVMetadata) error {
+	if val == nil || val.IsNull() {
+		return nil
+	}
+
+	text, ok := val.RawValue().(string)
+	if !ok {
+		return fmt.Errorf("%w: full-text index '%s' requires values of type %s", ErrInvalidTypes, index.Name(), VarcharType)
+	}
+
+	terms := fullTextTerms(text)
+
+	frequencies := make(map[string]uint32, len(terms))
+	for _, term := range terms {
+		frequencies[term]++
+	}
+
+	deleted := md != nil && md.Deleted()
+
+	for term, freq := range frequencies {
+		termKey, err := mapFullTextTerm(tx.sqlPrefix(), index, term)
+		if err != nil {
+			return err
+		}
+
+		var entry []byte
+
+		if !deleted {
+			// v={termFrequency termCount}
+			entry = make([]byte, fullTextEntryLen)
+			binary.BigEndian.PutUint32(entry, freq)
+			binary.BigEndian.PutUint32(entry[EncLenLen:], uint32(len(terms)))
+		}
+
+		err = tx.set(append(termKey, pkEncVals...), md, entry)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (v *FnCall) requiresMatchParams(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if len(v.params) != 2 {
+		return fmt.Errorf("%w: '%s' function expects a column and a query but %d arguments were provided", ErrIllegalArguments, MatchFnCall, len(v.params))
+	}
+
+	for _, p := range v.params {
+		err := p.requiresType(VarcharType, cols, params, implicitTable)
+		if err != nil {
+			return fmt.Errorf("error in '%s' function: %w", MatchFnCall, err)
+		}
+	}
+
+	return nil
+}
+
+func (v *FnCall) reduceMatch(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
+	if len(v.params) != 2 {
+		return nil, fmt.Errorf("%w: '%s' function expects a column and a query but %d arguments were provided", ErrIllegalArguments, MatchFnCall, len(v.params))
+	}
+
+	vals := make([]TypedValue, len(v.params))
+
+	for i, p := range v.params {
+		val, err := p.reduce(tx, row, implicitTable)
+		if err != nil {
+			return nil, fmt.Errorf("error in '%s' function: %w", MatchFnCall, err)
+		}
+
+		if val.Type() != VarcharType {
+			return nil, fmt.Errorf("error in '%s' function: %w (expecting %s)", MatchFnCall, ErrInvalidTypes, VarcharType)
+		}
+
+		if val.IsNull() {
+			return &Bool{val: false}, nil
+		}
+
+		vals[i] = val
+	}
+
+	return &Bool{val: FullTextMatch(vals[0].RawValue().(string), vals[1].RawValue().(string))}, nil
+}
@@ -0,0 +1,380 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+// BM25 ranking parameters
+const (
+	fullTextRankingK1 = 1.2
+	fullTextRankingB  = 0.75
+)
+
+// fullTextRowReader reads the rows containing all the terms of a MATCH query
+// using a full-text index. Rows are returned in descending order of relevance.
+type fullTextRowReader struct {
+	tx         *SQLTx
+	table      *Table
+	tableAlias string
+	colsByPos  []ColDescriptor
+	colsBySel  map[string]ColDescriptor
+	scanSpecs  *ScanSpecs
+
+	params map[string]interface{}
+
+	// primary keys of the matching rows, resolved on first read
+	rankedPKs [][]byte
+	ranked    bool
+	pos       int
+
+	onCloseCallback func()
+}
+
+type fullTextCandidate struct {
+	pk           []byte
+	termCount    uint32
+	frequencies  []uint32
+	matchedTerms int
+	score        float64
+}
+
+func newFullTextRowReader(tx *SQLTx, params map[string]interface{}, table *Table, tableAlias string, scanSpecs *ScanSpecs) (*fullTextRowReader, error) {
+	if table == nil || scanSpecs == nil || scanSpecs.Index == nil || !scanSpecs.Index.IsFullText() || scanSpecs.fullTextQuery == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	if tableAlias == "" {
+		tableAlias = table.name
+	}
+
+	colsByPos, colsBySel := tableColDescriptors(table, tableAlias)
+
+	return &fullTextRowReader{
+		tx:         tx,
+		table:      table,
+		tableAlias: tableAlias,
+		colsByPos:  colsByPos,
+		colsBySel:  colsBySel,
+		scanSpecs:  scanSpecs,
+		params:     params,
+	}, nil
+}
+
+// fullTextMatchFor returns the full-text index and the query of a MATCH condition which
+// must be satisfied by every selected row i.e. it's not part of a disjunction.
+func fullTextMatchFor(exp ValueExp, table *Table, asTable string) (*Index, ValueExp) {
+	switch e := exp.(type) {
+	case *FnCall:
+		{
+			if strings.ToUpper(e.fn) != MatchFnCall || len(e.params) != 2 {
+				return nil, nil
+			}
+
+			sel, ok := e.params[0].(*ColSelector)
+			if !ok {
+				return nil, nil
+			}
+
+			_, selTable, colName := sel.resolve(asTable)
+			if selTable != asTable {
+				return nil, nil
+			}
+
+			col, err := table.GetColumnByName(colName)
+			if err != nil {
+				return nil, nil
+			}
+
+			index, ok := table.fullTextIndexes[col.id]
+			if !ok {
+				return nil, nil
+			}
+
+			return index, e.params[1]
+		}
+	case *BinBoolExp:
+		{
+			if e.op != AND {
+				return nil, nil
+			}
+
+			index, query := fullTextMatchFor(e.left, table, asTable)
+			if index != nil {
+				return index, query
+			}
+
+			return fullTextMatchFor(e.right, table, asTable)
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *fullTextRowReader) onClose(callback func()) {
+	r.onCloseCallback = callback
+}
+
+func (r *fullTextRowReader) Tx() *SQLTx {
+	return r.tx
+}
+
+func (r *fullTextRowReader) TableAlias() string {
+	return r.tableAlias
+}
+
+// OrderBy returns no columns as rows are sorted by relevance
+func (r *fullTextRowReader) OrderBy() []ColDescriptor {
+	return nil
+}
+
+func (r *fullTextRowReader) ScanSpecs() *ScanSpecs {
+	return r.scanSpecs
+}
+
+func (r *fullTextRowReader) Columns(ctx context.Context) ([]ColDescriptor, error) {
+	ret := make([]ColDescriptor, len(r.colsByPos))
+	copy(ret, r.colsByPos)
+	return ret, nil
+}
+
+func (r *fullTextRowReader) colsBySelector(ctx context.Context) (map[string]ColDescriptor, error) {
+	ret := make(map[string]ColDescriptor, len(r.colsBySel))
+	for sel := range r.colsBySel {
+		ret[sel] = r.colsBySel[sel]
+	}
+	return ret, nil
+}
+
+func (r *fullTextRowReader) InferParameters(ctx context.Context, params map[string]SQLValueType) error {
+	cols, err := r.colsBySelector(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.scanSpecs.fullTextQuery.requiresType(VarcharType, cols, params, r.tableAlias)
+}
+
+func (r *fullTextRowReader) Parameters() map[string]interface{} {
+	return r.params
+}
+
+func (r *fullTextRowReader) Read(ctx context.Context) (*Row, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	// evaluation is postponed to allow parameters to be provided after rowReader initialization
+	if !r.ranked {
+		err := r.rank()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for r.pos < len(r.rankedPKs) {
+		pk := r.rankedPKs[r.pos]
+		r.pos++
+
+		vref, err := r.tx.get(mapKey(r.tx.engine.prefix, PIndexPrefix, EncodeID(1), EncodeID(r.table.id), EncodeID(PKIndexID), pk))
+		if errors.Is(err, store.ErrKeyNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := vref.Resolve()
+		if err != nil {
+			return nil, err
+		}
+
+		return decodeRow(r.table, r.tableAlias, v)
+	}
+
+	return nil, ErrNoMoreRows
+}
+
+// rank reads the index entries of every query term and sorts the rows containing all of them
+// using BM25, where document frequencies and the average number of terms are calculated
+// over the rows containing any of the query terms.
+func (r *fullTextRowReader) rank() error {
+	queryExp, err := r.scanSpecs.fullTextQuery.substitute(r.params)
+	if err != nil {
+		return err
+	}
+
+	query, err := queryExp.reduce(r.tx, nil, r.tableAlias)
+	if err != nil {
+		return err
+	}
+
+	if query.Type() != VarcharType {
+		return fmt.Errorf("error in '%s' function: %w (expecting %s)", MatchFnCall, ErrInvalidTypes, VarcharType)
+	}
+
+	r.ranked = true
+
+	if query.IsNull() {
+		return nil
+	}
+
+	terms := distinctFullTextTerms(query.RawValue().(string))
+	if len(terms) == 0 {
+		return nil
+	}
+
+	candidates := make(map[string]*fullTextCandidate)
+	termCounts := make(map[string]uint32)
+	docFrequencies := make([]int, len(terms))
+
+	for i, term := range terms {
+		termKey, err := mapFullTextTerm(r.tx.engine.prefix, r.scanSpecs.Index, term)
+		if err != nil {
+			return err
+		}
+
+		err = r.readTermEntries(termKey, func(pk []byte, frequency, termCount uint32) {
+			docFrequencies[i]++
+			termCounts[string(pk)] = termCount
+
+			candidate, ok := candidates[string(pk)]
+			if !ok && i == 0 {
+				candidate = &fullTextCandidate{
+					pk:          pk,
+					termCount:   termCount,
+					frequencies: make([]uint32, len(terms)),
+				}
+				candidates[string(pk)] = candidate
+			}
+
+			// only rows containing all the previous terms are kept
+			if candidate == nil || candidate.matchedTerms != i {
+				return
+			}
+
+			candidate.frequencies[i] = frequency
+			candidate.matchedTerms++
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	n := float64(len(termCounts))
+
+	var totalTermCount float64
+	for _, termCount := range termCounts {
+		totalTermCount += float64(termCount)
+	}
+
+	avgTermCount := totalTermCount / n
+
+	idfs := make([]float64, len(terms))
+	for i, df := range docFrequencies {
+		idfs[i] = math.Log(1 + (n-float64(df)+0.5)/(float64(df)+0.5))
+	}
+
+	matches := make([]*fullTextCandidate, 0, len(candidates))
+
+	for _, candidate := range candidates {
+		if candidate.matchedTerms != len(terms) {
+			continue
+		}
+
+		norm := fullTextRankingK1 * (1 - fullTextRankingB + fullTextRankingB*float64(candidate.termCount)/avgTermCount)
+
+		for i, frequency := range candidate.frequencies {
+			tf := float64(frequency)
+			candidate.score += idfs[i] * tf * (fullTextRankingK1 + 1) / (tf + norm)
+		}
+
+		matches = append(matches, candidate)
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].score == matches[j].score {
+			return bytes.Compare(matches[i].pk, matches[j].pk) < 0
+		}
+		return matches[i].score > matches[j].score
+	})
+
+	r.rankedPKs = make([][]byte, len(matches))
+
+	for i, match := range matches {
+		r.rankedPKs[i] = match.pk
+	}
+
+	return nil
+}
+
+func (r *fullTextRowReader) readTermEntries(termKey []byte, onEntry func(pk []byte, frequency, termCount uint32)) error {
+	reader, err := r.tx.newKeyReader(store.KeyReaderSpec{
+		Prefix:  termKey,
+		Filters: []store.FilterFn{store.IgnoreExpired, store.IgnoreDeleted},
+	})
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	for {
+		mkey, vref, err := reader.Read()
+		if errors.Is(err, store.ErrNoMoreEntries) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		v, err := vref.Resolve()
+		if err != nil {
+			return err
+		}
+
+		if len(v) != fullTextEntryLen || len(mkey) <= len(termKey) {
+			return ErrCorruptedData
+		}
+
+		pk := make([]byte, len(mkey)-len(termKey))
+		copy(pk, mkey[len(termKey):])
+
+		onEntry(
+			pk,
+			binary.BigEndian.Uint32(v),
+			binary.BigEndian.Uint32(v[EncLenLen:]),
+		)
+	}
+}
+
+func (r *fullTextRowReader) Close() error {
+	if r.onCloseCallback != nil {
+		defer r.onCloseCallback()
+	}
+
+	return nil
+}
@@ -0,0 +1,275 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+type stemmingRule struct {
+	suffix      string
+	replacement string
+}
+
+var porterStep2Rules = []stemmingRule{
+	{"ational", "ate"},
+	{"tional", "tion"},
+	{"enci", "ence"},
+	{"anci", "ance"},
+	{"izer", "ize"},
+	{"abli", "able"},
+	{"alli", "al"},
+	{"entli", "ent"},
+	{"eli", "e"},
+	{"ousli", "ous"},
+	{"ization", "ize"},
+	{"ation", "ate"},
+	{"ator", "ate"},
+	{"alism", "al"},
+	{"iveness", "ive"},
+	{"fulness", "ful"},
+	{"ousness", "ous"},
+	{"aliti", "al"},
+	{"iviti", "ive"},
+	{"biliti", "ble"},
+}
+
+var porterStep3Rules = []stemmingRule{
+	{"icate", "ic"},
+	{"ative", ""},
+	{"alize", "al"},
+	{"iciti", "ic"},
+	{"ical", "ic"},
+	{"ful", ""},
+	{"ness", ""},
+}
+
+var porterStep4Suffixes = []string{
+	"al", "ance", "ence", "er", "ic", "able", "ible", "ant", "ement", "ment", "ent",
+	"ion", "ou", "ism", "ate", "iti", "ous", "ive", "ize",
+}
+
+// stemEnglish reduces an english word, in lower case, to its stem
+// using the Porter stemming algorithm.
+// Words containing other than ASCII letters are returned unchanged.
+func stemEnglish(word string) string {
+	if len(word) <= 2 {
+		return word
+	}
+
+	for i := 0; i < len(word); i++ {
+		if word[i] < 'a' || word[i] > 'z' {
+			return word
+		}
+	}
+
+	w := []byte(word)
+
+	w = porterStep1a(w)
+	w = porterStep1b(w)
+	w = porterStep1c(w)
+	w = porterApplyRules(w, porterStep2Rules)
+	w = porterApplyRules(w, porterStep3Rules)
+	w = porterStep4(w)
+	w = porterStep5(w)
+
+	return string(w)
+}
+
+func isConsonant(w []byte, i int) bool {
+	switch w[i] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return false
+	case 'y':
+		return i == 0 || !isConsonant(w, i-1)
+	}
+
+	return true
+}
+
+// measure returns the number of vowel-consonant sequences in the word
+func measure(w []byte) int {
+	n := len(w)
+	i := 0
+
+	for i < n && isConsonant(w, i) {
+		i++
+	}
+
+	m := 0
+
+	for i < n {
+		for i < n && !isConsonant(w, i) {
+			i++
+		}
+
+		if i == n {
+			break
+		}
+
+		for i < n && isConsonant(w, i) {
+			i++
+		}
+
+		m++
+	}
+
+	return m
+}
+
+func containsVowel(w []byte) bool {
+	for i := range w {
+		if !isConsonant(w, i) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func endsWithDoubleConsonant(w []byte) bool {
+	n := len(w)
+	return n >= 2 && w[n-1] == w[n-2] && isConsonant(w, n-1)
+}
+
+// endsWithCVC checks if the word ends with consonant-vowel-consonant,
+// where the last consonant is not 'w', 'x' or 'y'
+func endsWithCVC(w []byte) bool {
+	n := len(w)
+
+	if n < 3 || !isConsonant(w, n-3) || isConsonant(w, n-2) || !isConsonant(w, n-1) {
+		return false
+	}
+
+	return w[n-1] != 'w' && w[n-1] != 'x' && w[n-1] != 'y'
+}
+
+func hasSuffix(w []byte, suffix string) bool {
+	return len(w) >= len(suffix) && string(w[len(w)-len(suffix):]) == suffix
+}
+
+func replaceSuffix(w []byte, suffix, replacement string) []byte {
+	return append(w[:len(w)-len(suffix)], replacement...)
+}
+
+func porterStep1a(w []byte) []byte {
+	switch {
+	case hasSuffix(w, "sses"):
+		return replaceSuffix(w, "sses", "ss")
+	case hasSuffix(w, "ies"):
+		return replaceSuffix(w, "ies", "i")
+	case hasSuffix(w, "ss"):
+		return w
+	case hasSuffix(w, "s"):
+		return replaceSuffix(w, "s", "")
+	}
+
+	return w
+}
+
+func porterStep1b(w []byte) []byte {
+	if hasSuffix(w, "eed") {
+		if measure(w[:len(w)-3]) > 0 {
+			return replaceSuffix(w, "eed", "ee")
+		}
+		return w
+	}
+
+	var stemmed bool
+
+	if hasSuffix(w, "ed") && containsVowel(w[:len(w)-2]) {
+		w = replaceSuffix(w, "ed", "")
+		stemmed = true
+	} else if hasSuffix(w, "ing") && containsVowel(w[:len(w)-3]) {
+		w = replaceSuffix(w, "ing", "")
+		stemmed = true
+	}
+
+	if !stemmed {
+		return w
+	}
+
+	switch {
+	case hasSuffix(w, "at") || hasSuffix(w, "bl") || hasSuffix(w, "iz"):
+		return append(w, 'e')
+	case endsWithDoubleConsonant(w) && !hasSuffix(w, "l") && !hasSuffix(w, "s") && !hasSuffix(w, "z"):
+		return w[:len(w)-1]
+	case measure(w) == 1 && endsWithCVC(w):
+		return append(w, 'e')
+	}
+
+	return w
+}
+
+func porterStep1c(w []byte) []byte {
+	if hasSuffix(w, "y") && containsVowel(w[:len(w)-1]) {
+		w[len(w)-1] = 'i'
+	}
+
+	return w
+}
+
+func porterApplyRules(w []byte, rules []stemmingRule) []byte {
+	for _, rule := range rules {
+		if !hasSuffix(w, rule.suffix) {
+			continue
+		}
+
+		if measure(w[:len(w)-len(rule.suffix)]) > 0 {
+			return replaceSuffix(w, rule.suffix, rule.replacement)
+		}
+
+		return w
+	}
+
+	return w
+}
+
+func porterStep4(w []byte) []byte {
+	for _, suffix := range porterStep4Suffixes {
+		if !hasSuffix(w, suffix) {
+			continue
+		}
+
+		stem := w[:len(w)-len(suffix)]
+
+		if measure(stem) <= 1 {
+			return w
+		}
+
+		if suffix == "ion" && !hasSuffix(stem, "s") && !hasSuffix(stem, "t") {
+			return w
+		}
+
+		return stem
+	}
+
+	return w
+}
+
+func porterStep5(w []byte) []byte {
+	if hasSuffix(w, "e") {
+		stem := w[:len(w)-1]
+		m := measure(stem)
+
+		if m > 1 || (m == 1 && !endsWithCVC(stem)) {
+			w = stem
+		}
+	}
+
+	if measure(w) > 1 && endsWithDoubleConsonant(w) && hasSuffix(w, "l") {
+		w = w[:len(w)-1]
+	}
+
+	return w
+}
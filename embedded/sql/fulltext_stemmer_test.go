@@ -0,0 +1,79 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStemEnglish(t *testing.T) {
+	testCases := map[string]string{
+		"a":              "a",
+		"is":             "is",
+		"caresses":       "caress",
+		"ponies":         "poni",
+		"caress":         "caress",
+		"cats":           "cat",
+		"feed":           "feed",
+		"agreed":         "agre",
+		"plastered":      "plaster",
+		"bled":           "bled",
+		"motoring":       "motor",
+		"sing":           "sing",
+		"conflated":      "conflat",
+		"troubled":       "troubl",
+		"sized":          "size",
+		"hopping":        "hop",
+		"falling":        "fall",
+		"hissing":        "hiss",
+		"fizzed":         "fizz",
+		"failing":        "fail",
+		"filing":         "file",
+		"happy":          "happi",
+		"sky":            "sky",
+		"relational":     "relat",
+		"conditional":    "condit",
+		"valenci":        "valenc",
+		"digitizer":      "digit",
+		"generalization": "gener",
+		"hopefulness":    "hope",
+		"triplicate":     "triplic",
+		"formative":      "form",
+		"electrical":     "electr",
+		"revival":        "reviv",
+		"adjustment":     "adjust",
+		"adoption":       "adopt",
+		"controll":       "control",
+		"roll":           "roll",
+		"probate":        "probat",
+		"rate":           "rate",
+		"running":        "run",
+		"searches":       "search",
+		"searching":      "search",
+		"connections":    "connect",
+		"connected":      "connect",
+		"immudb":         "immudb",
+		"año":            "año",
+		"v2":             "v2",
+	}
+
+	for word, stem := range testCases {
+		require.Equal(t, stem, stemEnglish(word), "unexpected stem for '%s'", word)
+	}
+}
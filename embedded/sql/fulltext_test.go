@@ -0,0 +1,231 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFullTextTerms(t *testing.T) {
+	require.Empty(t, fullTextTerms(""))
+	require.Empty(t, fullTextTerms("the and of, to!"))
+
+	require.Equal(t,
+		[]string{"immut", "databas", "search", "immut", "record", "2023"},
+		fullTextTerms("Immutable databases: searching the IMMUTABLE records of 2023"),
+	)
+
+	require.Equal(t,
+		[]string{"immut", "databas", "search"},
+		distinctFullTextTerms("immutable database, immutable databases, searches"),
+	)
+}
+
+func TestFullTextMatch(t *testing.T) {
+	require.True(t, FullTextMatch("Searching immutable records", "record search"))
+	require.True(t, FullTextMatch("Searching immutable records", "RECORDS"))
+	require.False(t, FullTextMatch("Searching immutable records", "mutable records"))
+	require.False(t, FullTextMatch("Searching immutable records", "the"))
+	require.False(t, FullTextMatch("Searching immutable records", ""))
+}
+
+func queryIDs(t *testing.T, engine *Engine, query string, params map[string]interface{}) []int64 {
+	r, err := engine.Query(context.Background(), nil, query, params)
+	require.NoError(t, err)
+	defer r.Close()
+
+	var ids []int64
+
+	for {
+		row, err := r.Read(context.Background())
+		if err == ErrNoMoreRows {
+			break
+		}
+		require.NoError(t, err)
+
+		ids = append(ids, row.ValuesByPosition[0].RawValue().(int64))
+	}
+
+	return ids
+}
+
+func TestFullTextIndex(t *testing.T) {
+	st, err := store.Open(t.TempDir(), store.DefaultOptions())
+	require.NoError(t, err)
+	defer closeStore(t, st)
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		CREATE TABLE articles (
+			id INTEGER AUTO_INCREMENT,
+			title VARCHAR,
+			body VARCHAR,
+			year INTEGER,
+			PRIMARY KEY id
+		)`, nil)
+	require.NoError(t, err)
+
+	t.Run("full-text indexes are limited to a single varchar column", func(t *testing.T) {
+		_, _, err = engine.Exec(context.Background(), nil, "CREATE FULLTEXT INDEX ON articles(year)", nil)
+		require.ErrorIs(t, err, ErrLimitedFullTextIndex)
+
+		_, _, err = engine.Exec(context.Background(), nil, "CREATE FULLTEXT INDEX ON articles(unknown)", nil)
+		require.ErrorIs(t, err, ErrColumnDoesNotExist)
+	})
+
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE FULLTEXT INDEX ON articles(body)", nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.ExecPreparedStmts(context.Background(), nil, []SQLStmt{NewCreateFullTextIndexStmt("articles", "title")}, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE FULLTEXT INDEX IF NOT EXISTS ON articles(body)", nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE FULLTEXT INDEX ON articles(body)", nil)
+	require.ErrorIs(t, err, ErrIndexAlreadyExists)
+
+	// a regular index on the same column can still be created
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE INDEX ON articles(year)", nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		INSERT INTO articles(title, body, year) VALUES
+			('first', 'An immutable database keeps every record', 2020),
+			('second', 'Databases and search engines', 2021),
+			('third', 'Search immutable records in the immutable database, search them all', 2022),
+			('fourth', NULL, 2023),
+			('fifth', 'Nothing to see here', 2023)
+	`, nil)
+	require.NoError(t, err)
+
+	t.Run("full-text index is listed", func(t *testing.T) {
+		catalog, err := engine.Catalog(context.Background(), nil)
+		require.NoError(t, err)
+
+		table, err := catalog.GetTableByName("articles")
+		require.NoError(t, err)
+
+		index, err := table.GetIndexByName("articles[body].fulltext")
+		require.NoError(t, err)
+		require.True(t, index.IsFullText())
+		require.False(t, index.IsUnique())
+
+		indexed, err := table.IsIndexed("body")
+		require.NoError(t, err)
+		require.False(t, indexed)
+	})
+
+	t.Run("rows are ranked by relevance", func(t *testing.T) {
+		require.Equal(t, []int64{3, 1}, queryIDs(t, engine, "SELECT id FROM articles WHERE MATCH(body, 'immutable')", nil))
+		require.Equal(t, []int64{2, 3}, queryIDs(t, engine, "SELECT id FROM articles WHERE MATCH(body, 'searching databases')", nil))
+		require.Equal(t, []int64{2, 1, 3}, queryIDs(t, engine, "SELECT id FROM articles WHERE MATCH(body, @q)", map[string]interface{}{"q": "database"}))
+		require.Empty(t, queryIDs(t, engine, "SELECT id FROM articles WHERE MATCH(body, 'the')", nil))
+		require.Empty(t, queryIDs(t, engine, "SELECT id FROM articles WHERE MATCH(body, 'unknown')", nil))
+	})
+
+	t.Run("match can be combined with other conditions", func(t *testing.T) {
+		require.Equal(t, []int64{3}, queryIDs(t, engine, "SELECT id FROM articles WHERE year > 2020 AND MATCH(body, 'immutable')", nil))
+		require.Equal(t, []int64{1}, queryIDs(t, engine, "SELECT id FROM articles WHERE MATCH(body, 'immutable') AND year < 2021 LIMIT 1", nil))
+
+		require.Equal(t, []int64{1, 3, 4, 5}, queryIDs(t, engine, "SELECT id FROM articles WHERE MATCH(body, 'immutable') OR year > 2022", nil))
+		require.Equal(t, []int64{1, 3}, queryIDs(t, engine, "SELECT id FROM articles WHERE MATCH(body, 'immutable') ORDER BY year", nil))
+		require.Equal(t, []int64{2}, queryIDs(t, engine, "SELECT id FROM articles WHERE MATCH(title, 'second')", nil))
+
+		r, err := engine.Query(context.Background(), nil, "SELECT COUNT(*) FROM articles WHERE MATCH(body, 'database')", nil)
+		require.NoError(t, err)
+
+		row, err := r.Read(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, int64(3), row.ValuesByPosition[0].RawValue())
+
+		require.NoError(t, r.Close())
+	})
+
+	t.Run("invalid match arguments", func(t *testing.T) {
+		r, err := engine.Query(context.Background(), nil, "SELECT id FROM articles WHERE MATCH(body)", nil)
+		require.NoError(t, err)
+
+		_, err = r.Read(context.Background())
+		require.ErrorIs(t, err, ErrIllegalArguments)
+		require.NoError(t, r.Close())
+
+		r, err = engine.Query(context.Background(), nil, "SELECT id FROM articles WHERE MATCH(body, 1)", nil)
+		require.NoError(t, err)
+
+		_, err = r.Read(context.Background())
+		require.ErrorIs(t, err, ErrInvalidTypes)
+		require.NoError(t, r.Close())
+
+		r, err = engine.Query(context.Background(), nil, "SELECT id FROM articles WHERE MATCH(year, 'immutable')", nil)
+		require.NoError(t, err)
+
+		_, err = r.Read(context.Background())
+		require.ErrorIs(t, err, ErrInvalidTypes)
+		require.NoError(t, r.Close())
+
+		params, err := engine.InferParameters(context.Background(), nil, "SELECT id FROM articles WHERE MATCH(body, @q)")
+		require.NoError(t, err)
+		require.Equal(t, map[string]SQLValueType{"q": VarcharType}, params)
+
+		_, err = engine.InferParameters(context.Background(), nil, "SELECT id FROM articles WHERE MATCH(body)")
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	t.Run("index is updated with the rows", func(t *testing.T) {
+		_, _, err = engine.Exec(context.Background(), nil, "UPDATE articles SET body = 'Mutable records' WHERE id = 1", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "UPDATE articles SET year = 2019 WHERE id = 3", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "UPSERT INTO articles(id, title, body, year) VALUES (4, 'fourth', 'immutable logs', 2023)", nil)
+		require.NoError(t, err)
+
+		// shorter texts are ranked higher
+		require.Equal(t, []int64{4, 3}, queryIDs(t, engine, "SELECT id FROM articles WHERE MATCH(body, 'immutable')", nil))
+		require.Equal(t, []int64{1, 3}, queryIDs(t, engine, "SELECT id FROM articles WHERE MATCH(body, 'records')", nil))
+
+		_, _, err = engine.Exec(context.Background(), nil, "DELETE FROM articles WHERE MATCH(body, 'search')", nil)
+		require.NoError(t, err)
+
+		require.Equal(t, []int64{4}, queryIDs(t, engine, "SELECT id FROM articles WHERE MATCH(body, 'immutable')", nil))
+		require.Equal(t, []int64{1}, queryIDs(t, engine, "SELECT id FROM articles WHERE MATCH(body, 'records')", nil))
+	})
+
+	t.Run("index survives catalog reloading", func(t *testing.T) {
+		engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+		require.NoError(t, err)
+
+		require.Equal(t, []int64{4}, queryIDs(t, engine, "SELECT id FROM articles WHERE MATCH(body, 'immutable')", nil))
+
+		_, _, err = engine.ExecPreparedStmts(context.Background(), nil, []SQLStmt{NewDropIndexStmt("articles", []string{"body"})}, nil)
+		require.ErrorIs(t, err, ErrNoAvailableIndex)
+
+		_, _, err = engine.ExecPreparedStmts(context.Background(), nil, []SQLStmt{NewDropFullTextIndexStmt("articles", "body")}, nil)
+		require.NoError(t, err)
+
+		// without the index, rows are scanned and matched one by one
+		require.Equal(t, []int64{4}, queryIDs(t, engine, "SELECT id FROM articles WHERE MATCH(body, 'immutable')", nil))
+	})
+}
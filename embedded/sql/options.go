@@ -30,7 +30,8 @@ type Options struct {
 	autocommit                    bool
 	lazyIndexConstraintValidation bool
 
-	multidbHandler MultiDBHandler
+	multidbHandler   MultiDBHandler
+	privilegeChecker PrivilegeChecker
 }
 
 func DefaultOptions() *Options {
@@ -75,3 +76,8 @@ func (opts *Options) WithMultiDBHandler(multidbHandler MultiDBHandler) *Options
 	opts.multidbHandler = multidbHandler
 	return opts
 }
+
+func (opts *Options) WithPrivilegeChecker(privilegeChecker PrivilegeChecker) *Options {
+	opts.privilegeChecker = privilegeChecker
+	return opts
+}
@@ -41,6 +41,7 @@ var reservedWords = map[string]int{
 	"PRIMARY":        PRIMARY,
 	"KEY":            KEY,
 	"UNIQUE":         UNIQUE,
+	"FULLTEXT":       FULLTEXT,
 	"INDEX":          INDEX,
 	"ON":             ON,
 	"ALTER":          ALTER,
@@ -89,6 +90,9 @@ var reservedWords = map[string]int{
 	"IS":             IS,
 	"CAST":           CAST,
 	"::":             SCAST,
+	"GRANT":          GRANT,
+	"REVOKE":         REVOKE,
+	"COLLECTION":     COLLECTION,
 }
 
 var joinTypes = map[string]JoinType{
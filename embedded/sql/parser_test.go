@@ -49,7 +49,7 @@ func TestCreateDatabaseStmt(t *testing.T) {
 		{
 			input:          "CREATE db1",
 			expectedOutput: nil,
-			expectedError:  errors.New("syntax error: unexpected IDENTIFIER, expecting DATABASE or TABLE or UNIQUE or INDEX at position 10"),
+			expectedError:  errors.New("syntax error: unexpected IDENTIFIER at position 10"),
 		},
 	}
 
@@ -269,7 +269,7 @@ func TestCreateTableStmt(t *testing.T) {
 		{
 			input:          "CREATE table1",
 			expectedOutput: nil,
-			expectedError:  errors.New("syntax error: unexpected IDENTIFIER, expecting DATABASE or TABLE or UNIQUE or INDEX at position 13"),
+			expectedError:  errors.New("syntax error: unexpected IDENTIFIER at position 13"),
 		},
 		{
 			input:          "CREATE TABLE table1",
@@ -329,6 +329,16 @@ func TestCreateIndexStmt(t *testing.T) {
 			expectedOutput: []SQLStmt{&CreateIndexStmt{unique: true, table: "table1", cols: []string{"id", "title"}}},
 			expectedError:  nil,
 		},
+		{
+			input:          "CREATE FULLTEXT INDEX IF NOT EXISTS ON table1(title)",
+			expectedOutput: []SQLStmt{&CreateIndexStmt{fullText: true, ifNotExists: true, table: "table1", cols: []string{"title"}}},
+			expectedError:  nil,
+		},
+		{
+			input:          "CREATE FULLTEXT INDEX ON table1(id, title)",
+			expectedOutput: nil,
+			expectedError:  errors.New("syntax error: unexpected ',', expecting ')' at position 35"),
+		},
 	}
 
 	for i, tc := range testCases {
@@ -397,6 +407,86 @@ func TestAlterTable(t *testing.T) {
 	}
 }
 
+func TestGrantStmt(t *testing.T) {
+	testCases := []struct {
+		input          string
+		expectedOutput []SQLStmt
+		expectedError  error
+	}{
+		{
+			input: "GRANT SELECT ON table1 TO user1",
+			expectedOutput: []SQLStmt{
+				&GrantStmt{
+					privileges: []SQLPrivilege{SQLPrivilegeSelect},
+					table:      "table1",
+					user:       "user1",
+				}},
+			expectedError: nil,
+		},
+		{
+			input: "GRANT SELECT, INSERT, UPDATE ON TABLE table1 TO 'User1'",
+			expectedOutput: []SQLStmt{
+				&GrantStmt{
+					privileges: []SQLPrivilege{SQLPrivilegeSelect, SQLPrivilegeInsert, SQLPrivilegeUpdate},
+					table:      "table1",
+					user:       "User1",
+				}},
+			expectedError: nil,
+		},
+		{
+			input: "GRANT ALL ON COLLECTION collection1 TO user1",
+			expectedOutput: []SQLStmt{
+				&GrantStmt{
+					privileges: []SQLPrivilege{SQLPrivilegeSelect, SQLPrivilegeInsert, SQLPrivilegeUpdate, SQLPrivilegeDelete},
+					table:      "collection1",
+					user:       "user1",
+					collection: true,
+				}},
+			expectedError: nil,
+		},
+		{
+			input: "REVOKE DELETE ON table1 FROM user1",
+			expectedOutput: []SQLStmt{
+				&RevokeStmt{
+					privileges: []SQLPrivilege{SQLPrivilegeDelete},
+					table:      "table1",
+					user:       "user1",
+				}},
+			expectedError: nil,
+		},
+		{
+			input: "REVOKE SELECT ON COLLECTION collection1 FROM user1",
+			expectedOutput: []SQLStmt{
+				&RevokeStmt{
+					privileges: []SQLPrivilege{SQLPrivilegeSelect},
+					table:      "collection1",
+					user:       "user1",
+					collection: true,
+				}},
+			expectedError: nil,
+		},
+		{
+			input:          "GRANT CREATE ON table1 TO user1",
+			expectedOutput: nil,
+			expectedError:  errors.New("syntax error: unexpected CREATE at position 12"),
+		},
+		{
+			input:          "REVOKE SELECT ON table1 TO user1",
+			expectedOutput: nil,
+			expectedError:  errors.New("syntax error: unexpected TO, expecting FROM at position 26"),
+		},
+	}
+
+	for i, tc := range testCases {
+		res, err := ParseString(tc.input)
+		require.Equal(t, tc.expectedError, err, fmt.Sprintf("failed on iteration %d", i))
+
+		if tc.expectedError == nil {
+			require.Equal(t, tc.expectedOutput, res, fmt.Sprintf("failed on iteration %d", i))
+		}
+	}
+}
+
 func TestInsertIntoStmt(t *testing.T) {
 	decodedBLOB, err := hex.DecodeString("AED0393F")
 	require.NoError(t, err)
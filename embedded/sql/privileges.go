@@ -0,0 +1,161 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import "fmt"
+
+// SQLPrivilege is the kind of access a statement requires on a table
+type SQLPrivilege string
+
+const (
+	SQLPrivilegeSelect SQLPrivilege = "SELECT"
+	SQLPrivilegeInsert SQLPrivilege = "INSERT"
+	SQLPrivilegeUpdate SQLPrivilege = "UPDATE"
+	SQLPrivilegeDelete SQLPrivilege = "DELETE"
+	SQLPrivilegeCreate SQLPrivilege = "CREATE"
+	SQLPrivilegeAlter  SQLPrivilege = "ALTER"
+	SQLPrivilegeDrop   SQLPrivilege = "DROP"
+	SQLPrivilegeGrant  SQLPrivilege = "GRANT"
+)
+
+// grantablePrivileges are the privileges which can be granted on a table, the position of
+// each privilege is the bit used to persist it in the catalog
+var grantablePrivileges = []SQLPrivilege{
+	SQLPrivilegeSelect,
+	SQLPrivilegeInsert,
+	SQLPrivilegeUpdate,
+	SQLPrivilegeDelete,
+}
+
+// GrantablePrivileges returns the privileges which can be granted to users on a table
+func GrantablePrivileges() []SQLPrivilege {
+	privileges := make([]SQLPrivilege, len(grantablePrivileges))
+	copy(privileges, grantablePrivileges)
+	return privileges
+}
+
+// privilegeSet is the set of privileges granted to a user on a table
+type privilegeSet uint8
+
+func newPrivilegeSet(privileges []SQLPrivilege) (privilegeSet, error) {
+	var set privilegeSet
+
+	for _, p := range privileges {
+		bit, err := privilegeBit(p)
+		if err != nil {
+			return 0, err
+		}
+
+		set |= bit
+	}
+
+	return set, nil
+}
+
+func privilegeBit(privilege SQLPrivilege) (privilegeSet, error) {
+	for i, p := range grantablePrivileges {
+		if p == privilege {
+			return 1 << i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: privilege '%s' can not be granted", ErrIllegalArguments, privilege)
+}
+
+func (set privilegeSet) includes(privilege SQLPrivilege) bool {
+	bit, err := privilegeBit(privilege)
+	return err == nil && set&bit != 0
+}
+
+func (set privilegeSet) privileges() []SQLPrivilege {
+	var privileges []SQLPrivilege
+
+	for i, p := range grantablePrivileges {
+		if set&(1<<i) != 0 {
+			privileges = append(privileges, p)
+		}
+	}
+
+	return privileges
+}
+
+// TablePrivilege is a privilege required on a table, Table is empty when the statement
+// does not refer to a table (e.g. CREATE DATABASE)
+type TablePrivilege struct {
+	Privilege SQLPrivilege
+	Table     string
+}
+
+// RequiredPrivileges returns the privileges required to execute the given statement.
+// Transaction control and session statements do not require any privilege
+func RequiredPrivileges(stmt SQLStmt) []TablePrivilege {
+	switch s := stmt.(type) {
+	case *CreateDatabaseStmt:
+		return []TablePrivilege{{Privilege: SQLPrivilegeCreate}}
+	case *CreateTableStmt:
+		return []TablePrivilege{{Privilege: SQLPrivilegeCreate, Table: s.table}}
+	case *CreateIndexStmt:
+		return []TablePrivilege{{Privilege: SQLPrivilegeCreate, Table: s.table}}
+	case *AddColumnStmt:
+		return []TablePrivilege{{Privilege: SQLPrivilegeAlter, Table: s.table}}
+	case *RenameColumnStmt:
+		return []TablePrivilege{{Privilege: SQLPrivilegeAlter, Table: s.table}}
+	case *SetTableTTLStmt:
+		return []TablePrivilege{{Privilege: SQLPrivilegeAlter, Table: s.table}}
+	case *DropTableStmt:
+		return []TablePrivilege{{Privilege: SQLPrivilegeDrop, Table: s.table}}
+	case *DropIndexStmt:
+		return []TablePrivilege{{Privilege: SQLPrivilegeDrop, Table: s.table}}
+	case *UpsertIntoStmt:
+		privileges := []TablePrivilege{{Privilege: SQLPrivilegeInsert, Table: s.tableRef.table}}
+		if !s.isInsert {
+			// upserts overwrite existing rows
+			privileges = append(privileges, TablePrivilege{Privilege: SQLPrivilegeUpdate, Table: s.tableRef.table})
+		}
+		return privileges
+	case *UpdateStmt:
+		return []TablePrivilege{{Privilege: SQLPrivilegeUpdate, Table: s.tableRef.table}}
+	case *DeleteFromStmt:
+		return []TablePrivilege{{Privilege: SQLPrivilegeDelete, Table: s.tableRef.table}}
+	case *GrantStmt:
+		return []TablePrivilege{{Privilege: SQLPrivilegeGrant, Table: s.table}}
+	case *RevokeStmt:
+		return []TablePrivilege{{Privilege: SQLPrivilegeGrant, Table: s.table}}
+	case DataSource:
+		return dataSourcePrivileges(s)
+	}
+
+	return nil
+}
+
+func dataSourcePrivileges(ds DataSource) []TablePrivilege {
+	switch s := ds.(type) {
+	case *tableRef:
+		return []TablePrivilege{{Privilege: SQLPrivilegeSelect, Table: s.table}}
+	case *SelectStmt:
+		privileges := dataSourcePrivileges(s.ds)
+		for _, join := range s.joins {
+			privileges = append(privileges, dataSourcePrivileges(join.ds)...)
+		}
+		return privileges
+	case *UnionStmt:
+		return append(dataSourcePrivileges(s.left), dataSourcePrivileges(s.right)...)
+	}
+
+	// function data sources (e.g. tables(), columns()) only read the catalog
+	return nil
+}
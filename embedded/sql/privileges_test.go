@@ -0,0 +1,117 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequiredPrivileges(t *testing.T) {
+	testCases := []struct {
+		sql        string
+		privileges []TablePrivilege
+	}{
+		{
+			sql:        "CREATE DATABASE db1",
+			privileges: []TablePrivilege{{Privilege: SQLPrivilegeCreate}},
+		},
+		{
+			sql:        "CREATE TABLE table1 (id INTEGER, PRIMARY KEY id)",
+			privileges: []TablePrivilege{{Privilege: SQLPrivilegeCreate, Table: "table1"}},
+		},
+		{
+			sql:        "CREATE INDEX ON table1(name)",
+			privileges: []TablePrivilege{{Privilege: SQLPrivilegeCreate, Table: "table1"}},
+		},
+		{
+			sql:        "ALTER TABLE table1 ADD COLUMN name VARCHAR",
+			privileges: []TablePrivilege{{Privilege: SQLPrivilegeAlter, Table: "table1"}},
+		},
+		{
+			sql:        "ALTER TABLE table1 RENAME COLUMN name TO title",
+			privileges: []TablePrivilege{{Privilege: SQLPrivilegeAlter, Table: "table1"}},
+		},
+		{
+			sql:        "INSERT INTO table1(id) VALUES (1)",
+			privileges: []TablePrivilege{{Privilege: SQLPrivilegeInsert, Table: "table1"}},
+		},
+		{
+			sql: "UPSERT INTO table1(id) VALUES (1)",
+			privileges: []TablePrivilege{
+				{Privilege: SQLPrivilegeInsert, Table: "table1"},
+				{Privilege: SQLPrivilegeUpdate, Table: "table1"},
+			},
+		},
+		{
+			sql:        "UPDATE table1 SET name = 'n' WHERE id = 1",
+			privileges: []TablePrivilege{{Privilege: SQLPrivilegeUpdate, Table: "table1"}},
+		},
+		{
+			sql:        "DELETE FROM table1 WHERE id = 1",
+			privileges: []TablePrivilege{{Privilege: SQLPrivilegeDelete, Table: "table1"}},
+		},
+		{
+			sql: "SELECT * FROM table1 INNER JOIN table2 ON table1.id = table2.id",
+			privileges: []TablePrivilege{
+				{Privilege: SQLPrivilegeSelect, Table: "table1"},
+				{Privilege: SQLPrivilegeSelect, Table: "table2"},
+			},
+		},
+		{
+			sql: "SELECT id FROM table1 UNION SELECT id FROM (SELECT id FROM table2)",
+			privileges: []TablePrivilege{
+				{Privilege: SQLPrivilegeSelect, Table: "table1"},
+				{Privilege: SQLPrivilegeSelect, Table: "table2"},
+			},
+		},
+		{
+			sql:        "GRANT SELECT ON table1 TO user1",
+			privileges: []TablePrivilege{{Privilege: SQLPrivilegeGrant, Table: "table1"}},
+		},
+		{
+			sql:        "REVOKE ALL ON TABLE table1 FROM user1",
+			privileges: []TablePrivilege{{Privilege: SQLPrivilegeGrant, Table: "table1"}},
+		},
+		{
+			sql: "SELECT * FROM tables()",
+		},
+		{
+			sql: "BEGIN TRANSACTION; COMMIT;",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.sql, func(t *testing.T) {
+			stmts, err := ParseString(tc.sql)
+			require.NoError(t, err)
+
+			var privileges []TablePrivilege
+			for _, stmt := range stmts {
+				privileges = append(privileges, RequiredPrivileges(stmt)...)
+			}
+
+			require.Equal(t, tc.privileges, privileges)
+		})
+	}
+
+	require.Equal(t,
+		[]TablePrivilege{{Privilege: SQLPrivilegeDrop, Table: "table1"}},
+		RequiredPrivileges(NewDropTableStmt("table1")),
+	)
+}
@@ -44,6 +44,7 @@ type ScanSpecs struct {
 	Index         *Index
 	rangesByColID map[uint32]*typedValueRange
 	DescOrder     bool
+	fullTextQuery ValueExp
 }
 
 type Row struct {
@@ -163,6 +164,22 @@ func newRawRowReader(tx *SQLTx, params map[string]interface{}, table *Table, per
 		tableAlias = table.name
 	}
 
+	colsByPos, colsBySel := tableColDescriptors(table, tableAlias)
+
+	return &rawRowReader{
+		tx:         tx,
+		table:      table,
+		period:     period,
+		tableAlias: tableAlias,
+		colsByPos:  colsByPos,
+		colsBySel:  colsBySel,
+		scanSpecs:  scanSpecs,
+		params:     params,
+		reader:     r,
+	}, nil
+}
+
+func tableColDescriptors(table *Table, tableAlias string) ([]ColDescriptor, map[string]ColDescriptor) {
 	colsByPos := make([]ColDescriptor, len(table.Cols()))
 	colsBySel := make(map[string]ColDescriptor, len(table.Cols()))
 
@@ -177,17 +194,7 @@ func newRawRowReader(tx *SQLTx, params map[string]interface{}, table *Table, per
 		colsBySel[colDescriptor.Selector()] = colDescriptor
 	}
 
-	return &rawRowReader{
-		tx:         tx,
-		table:      table,
-		period:     period,
-		tableAlias: tableAlias,
-		colsByPos:  colsByPos,
-		colsBySel:  colsBySel,
-		scanSpecs:  scanSpecs,
-		params:     params,
-		reader:     r,
-	}, nil
+	return colsByPos, colsBySel
 }
 
 func keyReaderSpecFrom(sqlPrefix []byte, table *Table, scanSpecs *ScanSpecs) (spec *store.KeyReaderSpec, err error) {
@@ -427,14 +434,19 @@ func (r *rawRowReader) Read(ctx context.Context) (row *Row, err error) {
 		}
 	}
 
-	valuesByPosition := make([]TypedValue, len(r.table.Cols()))
-	valuesBySelector := make(map[string]TypedValue, len(r.table.Cols()))
+	return decodeRow(r.table, r.tableAlias, v)
+}
+
+// decodeRow decodes the row values as stored in the primary index entry
+func decodeRow(table *Table, tableAlias string, v []byte) (*Row, error) {
+	valuesByPosition := make([]TypedValue, len(table.Cols()))
+	valuesBySelector := make(map[string]TypedValue, len(table.Cols()))
 
-	for i, col := range r.table.Cols() {
+	for i, col := range table.Cols() {
 		v := &NullValue{t: col.colType}
 
 		valuesByPosition[i] = v
-		valuesBySelector[EncodeSelector("", r.tableAlias, col.colName)] = v
+		valuesBySelector[EncodeSelector("", tableAlias, col.colName)] = v
 	}
 
 	if len(v) < EncLenLen {
@@ -454,7 +466,7 @@ func (r *rawRowReader) Read(ctx context.Context) (row *Row, err error) {
 		colID := binary.BigEndian.Uint32(v[voff:])
 		voff += EncIDLen
 
-		col, err := r.table.GetColumnByID(colID)
+		col, err := table.GetColumnByID(colID)
 		if err != nil {
 			return nil, ErrCorruptedData
 		}
@@ -467,7 +479,7 @@ func (r *rawRowReader) Read(ctx context.Context) (row *Row, err error) {
 		voff += n
 
 		valuesByPosition[i] = val
-		valuesBySelector[EncodeSelector("", r.tableAlias, col.colName)] = val
+		valuesBySelector[EncodeSelector("", tableAlias, col.colName)] = val
 	}
 
 	if len(v)-voff > 0 {
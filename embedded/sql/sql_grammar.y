@@ -67,13 +67,16 @@ func setResult(l yyLexer, stmts []SQLStmt) {
     update *colUpdate
     updates []*colUpdate
     onConflict *OnConflictDo
+    privilege SQLPrivilege
+    privileges []SQLPrivilege
 }
 
-%token CREATE USE DATABASE SNAPSHOT SINCE AFTER BEFORE UNTIL TX OF TIMESTAMP TABLE UNIQUE INDEX ON ALTER ADD RENAME TO COLUMN PRIMARY KEY
+%token CREATE USE DATABASE SNAPSHOT SINCE AFTER BEFORE UNTIL TX OF TIMESTAMP TABLE UNIQUE FULLTEXT INDEX ON ALTER ADD RENAME TO COLUMN PRIMARY KEY
 %token BEGIN TRANSACTION COMMIT ROLLBACK
 %token INSERT UPSERT INTO VALUES DELETE UPDATE SET CONFLICT DO NOTHING
 %token SELECT DISTINCT FROM JOIN HAVING WHERE GROUP BY LIMIT OFFSET ORDER ASC DESC AS UNION ALL
 %token NOT LIKE IF EXISTS IN IS
+%token GRANT REVOKE COLLECTION
 %token AUTO_INCREMENT NULL CAST SCAST
 %token <id> NPARAM
 %token <pparam> PPARAM
@@ -139,6 +142,9 @@ func setResult(l yyLexer, stmts []SQLStmt) {
 %type <update> update
 %type <updates> updates
 %type <onConflict> opt_on_conflict
+%type <privileges> privileges grantable_privileges
+%type <privilege> privilege
+%type <id> grantee
 
 %start sql
 
@@ -220,6 +226,11 @@ ddlstmt:
     {
         $$ = &CreateIndexStmt{unique: true, ifNotExists: $4, table: $6, cols: $8}
     }
+|
+    CREATE FULLTEXT INDEX opt_if_not_exists ON IDENTIFIER '(' IDENTIFIER ')'
+    {
+        $$ = &CreateIndexStmt{fullText: true, ifNotExists: $4, table: $6, cols: []string{$8}}
+    }
 |
     ALTER TABLE IDENTIFIER ADD COLUMN colSpec
     {
@@ -230,6 +241,82 @@ ddlstmt:
     {
         $$ = &RenameColumnStmt{table: $3, oldName: $6, newName: $8}
     }
+|
+    GRANT privileges ON opt_table IDENTIFIER TO grantee
+    {
+        $$ = &GrantStmt{privileges: $2, table: $5, user: $7}
+    }
+|
+    GRANT privileges ON COLLECTION IDENTIFIER TO grantee
+    {
+        $$ = &GrantStmt{privileges: $2, table: $5, user: $7, collection: true}
+    }
+|
+    REVOKE privileges ON opt_table IDENTIFIER FROM grantee
+    {
+        $$ = &RevokeStmt{privileges: $2, table: $5, user: $7}
+    }
+|
+    REVOKE privileges ON COLLECTION IDENTIFIER FROM grantee
+    {
+        $$ = &RevokeStmt{privileges: $2, table: $5, user: $7, collection: true}
+    }
+
+opt_table: {} | TABLE
+
+privileges:
+    ALL
+    {
+        $$ = GrantablePrivileges()
+    }
+|
+    grantable_privileges
+    {
+        $$ = $1
+    }
+
+grantable_privileges:
+    privilege
+    {
+        $$ = []SQLPrivilege{$1}
+    }
+|
+    grantable_privileges ',' privilege
+    {
+        $$ = append($1, $3)
+    }
+
+privilege:
+    SELECT
+    {
+        $$ = SQLPrivilegeSelect
+    }
+|
+    INSERT
+    {
+        $$ = SQLPrivilegeInsert
+    }
+|
+    UPDATE
+    {
+        $$ = SQLPrivilegeUpdate
+    }
+|
+    DELETE
+    {
+        $$ = SQLPrivilegeDelete
+    }
+
+grantee:
+    IDENTIFIER
+    {
+        $$ = $1
+    }
+|
+    VARCHAR
+    {
+        $$ = $1
+    }
 
 opt_if_not_exists:
     {
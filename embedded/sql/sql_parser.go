@@ -53,6 +53,8 @@ type yySymType struct {
 	update        *colUpdate
 	updates       []*colUpdate
 	onConflict    *OnConflictDo
+	privilege     SQLPrivilege
+	privileges    []SQLPrivilege
 }
 
 const CREATE = 57346
@@ -68,71 +70,75 @@ const OF = 57355
 const TIMESTAMP = 57356
 const TABLE = 57357
 const UNIQUE = 57358
-const INDEX = 57359
-const ON = 57360
-const ALTER = 57361
-const ADD = 57362
-const RENAME = 57363
-const TO = 57364
-const COLUMN = 57365
-const PRIMARY = 57366
-const KEY = 57367
-const BEGIN = 57368
-const TRANSACTION = 57369
-const COMMIT = 57370
-const ROLLBACK = 57371
-const INSERT = 57372
-const UPSERT = 57373
-const INTO = 57374
-const VALUES = 57375
-const DELETE = 57376
-const UPDATE = 57377
-const SET = 57378
-const CONFLICT = 57379
-const DO = 57380
-const NOTHING = 57381
-const SELECT = 57382
-const DISTINCT = 57383
-const FROM = 57384
-const JOIN = 57385
-const HAVING = 57386
-const WHERE = 57387
-const GROUP = 57388
-const BY = 57389
-const LIMIT = 57390
-const OFFSET = 57391
-const ORDER = 57392
-const ASC = 57393
-const DESC = 57394
-const AS = 57395
-const UNION = 57396
-const ALL = 57397
-const NOT = 57398
-const LIKE = 57399
-const IF = 57400
-const EXISTS = 57401
-const IN = 57402
-const IS = 57403
-const AUTO_INCREMENT = 57404
-const NULL = 57405
-const CAST = 57406
-const SCAST = 57407
-const NPARAM = 57408
-const PPARAM = 57409
-const JOINTYPE = 57410
-const LOP = 57411
-const CMPOP = 57412
-const IDENTIFIER = 57413
-const TYPE = 57414
-const INTEGER = 57415
-const FLOAT = 57416
-const VARCHAR = 57417
-const BOOLEAN = 57418
-const BLOB = 57419
-const AGGREGATE_FUNC = 57420
-const ERROR = 57421
-const DOT = 57422
-const STMT_SEPARATOR = 57423
+const FULLTEXT = 57359
+const INDEX = 57360
+const ON = 57361
+const ALTER = 57362
+const ADD = 57363
+const RENAME = 57364
+const TO = 57365
+const COLUMN = 57366
+const PRIMARY = 57367
+const KEY = 57368
+const BEGIN = 57369
+const TRANSACTION = 57370
+const COMMIT = 57371
+const ROLLBACK = 57372
+const INSERT = 57373
+const UPSERT = 57374
+const INTO = 57375
+const VALUES = 57376
+const DELETE = 57377
+const UPDATE = 57378
+const SET = 57379
+const CONFLICT = 57380
+const DO = 57381
+const NOTHING = 57382
+const SELECT = 57383
+const DISTINCT = 57384
+const FROM = 57385
+const JOIN = 57386
+const HAVING = 57387
+const WHERE = 57388
+const GROUP = 57389
+const BY = 57390
+const LIMIT = 57391
+const OFFSET = 57392
+const ORDER = 57393
+const ASC = 57394
+const DESC = 57395
+const AS = 57396
+const UNION = 57397
+const ALL = 57398
+const NOT = 57399
+const LIKE = 57400
+const IF = 57401
+const EXISTS = 57402
+const IN = 57403
+const IS = 57404
+const GRANT = 57405
+const REVOKE = 57406
+const COLLECTION = 57407
+const AUTO_INCREMENT = 57408
+const NULL = 57409
+const CAST = 57410
+const SCAST = 57411
+const NPARAM = 57412
+const PPARAM = 57413
+const JOINTYPE = 57414
+const LOP = 57415
+const CMPOP = 57416
+const IDENTIFIER = 57417
+const TYPE = 57418
+const INTEGER = 57419
+const FLOAT = 57420
+const VARCHAR = 57421
+const BOOLEAN = 57422
+const BLOB = 57423
+const AGGREGATE_FUNC = 57424
+const ERROR = 57425
+const DOT = 57426
+const STMT_SEPARATOR = 57427
 
 var yyToknames = [...]string{
 	"$end",
@@ -151,6 +157,7 @@ var yyToknames = [...]string{
 	"TIMESTAMP",
 	"TABLE",
 	"UNIQUE",
+	"FULLTEXT",
 	"INDEX",
 	"ON",
 	"ALTER",
@@ -196,6 +203,9 @@ var yyToknames = [...]string{
 	"EXISTS",
 	"IN",
 	"IS",
+	"GRANT",
+	"REVOKE",
+	"COLLECTION",
 	"AUTO_INCREMENT",
 	"NULL",
 	"CAST",
@@ -238,205 +248,230 @@ var yyExca = [...]int16{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 74,
-	57, 139,
-	60, 139,
-	-2, 127,
-	-1, 189,
-	43, 103,
-	-2, 98,
-	-1, 218,
-	43, 103,
-	-2, 100,
+	-1, 91,
+	58, 156,
+	61, 156,
+	-2, 144,
+	-1, 222,
+	44, 120,
+	-2, 115,
+	-1, 258,
+	44, 120,
+	-2, 117,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 378
+const yyLast = 425
 
 var yyAct = [...]int16{
-	73, 295, 60, 183, 140, 211, 235, 239, 88, 137,
-	146, 175, 217, 106, 234, 98, 176, 157, 45, 6,
-	79, 268, 101, 181, 226, 206, 181, 18, 181, 181,
-	277, 272, 72, 254, 252, 271, 227, 182, 240, 150,
-	255, 253, 222, 76, 205, 203, 78, 195, 194, 180,
-	91, 87, 236, 89, 90, 241, 148, 59, 92, 110,
-	82, 83, 84, 85, 86, 61, 202, 133, 199, 133,
-	77, 159, 132, 117, 103, 81, 76, 128, 129, 78,
-	130, 112, 131, 91, 87, 109, 89, 90, 97, 96,
-	20, 92, 62, 82, 83, 84, 85, 86, 61, 61,
-	142, 99, 110, 77, 62, 57, 124, 139, 81, 294,
-	258, 288, 154, 149, 122, 123, 143, 153, 257, 161,
-	162, 163, 164, 165, 166, 206, 151, 118, 119, 121,
-	120, 196, 124, 174, 177, 124, 181, 144, 105, 251,
-	122, 123, 231, 27, 28, 223, 188, 197, 186, 171,
-	172, 189, 178, 118, 119, 121, 120, 108, 121, 120,
-	173, 62, 138, 192, 204, 193, 190, 187, 191, 198,
-	201, 62, 124, 257, 71, 107, 233, 124, 61, 209,
-	122, 123, 220, 102, 179, 213, 123, 267, 158, 160,
-	215, 155, 158, 118, 119, 121, 120, 152, 118, 119,
-	121, 120, 113, 177, 221, 124, 65, 232, 26, 228,
-	63, 34, 49, 238, 224, 127, 44, 145, 200, 230,
-	266, 242, 229, 93, 126, 237, 118, 119, 121, 120,
-	124, 244, 243, 250, 168, 111, 246, 177, 40, 169,
-	249, 167, 170, 64, 115, 116, 55, 35, 259, 296,
-	297, 260, 280, 212, 149, 264, 263, 184, 287, 275,
-	262, 99, 274, 39, 269, 245, 104, 32, 276, 37,
-	18, 285, 278, 270, 53, 281, 210, 208, 283, 31,
-	30, 21, 247, 286, 135, 289, 134, 41, 42, 207,
-	292, 293, 290, 94, 95, 76, 284, 298, 78, 2,
-	299, 214, 91, 87, 114, 89, 90, 67, 10, 11,
-	92, 66, 82, 83, 84, 85, 86, 61, 185, 147,
-	38, 29, 77, 12, 43, 70, 69, 81, 47, 48,
-	7, 22, 8, 9, 13, 14, 33, 141, 15, 16,
-	23, 25, 24, 19, 18, 256, 100, 125, 248, 265,
-	50, 51, 52, 279, 291, 225, 261, 75, 74, 273,
-	219, 218, 216, 68, 46, 54, 36, 58, 56, 80,
-	282, 136, 156, 17, 5, 4, 3, 1,
+	90, 337, 76, 216, 168, 251, 276, 280, 105, 165,
+	174, 204, 129, 257, 121, 275, 185, 6, 205, 242,
+	58, 96, 124, 20, 310, 214, 240, 214, 214, 266,
+	214, 319, 313, 314, 296, 293, 267, 297, 215, 93,
+	295, 281, 95, 294, 262, 239, 237, 228, 227, 108,
+	104, 178, 106, 107, 148, 213, 277, 109, 282, 99,
+	100, 101, 102, 103, 77, 146, 147, 133, 176, 94,
+	75, 236, 233, 232, 98, 157, 157, 148, 142, 143,
+	145, 144, 187, 156, 22, 202, 154, 135, 126, 147,
+	141, 132, 120, 119, 152, 153, 78, 93, 148, 155,
+	95, 142, 143, 145, 144, 336, 133, 108, 104, 181,
+	106, 107, 122, 330, 300, 109, 88, 99, 100, 101,
+	102, 103, 77, 170, 145, 144, 299, 94, 240, 229,
+	167, 214, 98, 238, 128, 182, 177, 148, 171, 64,
+	292, 148, 78, 190, 191, 192, 193, 194, 195, 77,
+	179, 172, 146, 147, 272, 73, 243, 203, 206, 148,
+	244, 142, 143, 145, 144, 142, 143, 145, 144, 263,
+	146, 147, 201, 78, 221, 207, 219, 131, 110, 222,
+	299, 78, 166, 142, 143, 145, 144, 230, 77, 200,
+	225, 274, 226, 269, 224, 220, 223, 231, 130, 235,
+	30, 31, 249, 139, 140, 125, 208, 186, 189, 188,
+	183, 180, 163, 162, 161, 160, 136, 81, 253, 186,
+	79, 46, 62, 255, 57, 173, 260, 151, 291, 309,
+	245, 246, 247, 197, 234, 308, 261, 206, 290, 150,
+	36, 273, 268, 196, 115, 113, 264, 148, 115, 198,
+	134, 52, 199, 279, 270, 271, 80, 71, 38, 51,
+	47, 283, 40, 39, 338, 339, 278, 322, 37, 29,
+	252, 284, 285, 217, 329, 287, 317, 304, 206, 286,
+	122, 316, 212, 34, 211, 53, 54, 127, 44, 301,
+	89, 49, 302, 20, 118, 177, 306, 305, 114, 38,
+	327, 320, 312, 40, 39, 116, 311, 69, 250, 37,
+	318, 117, 23, 248, 43, 83, 84, 323, 175, 42,
+	325, 288, 159, 158, 241, 328, 210, 331, 209, 111,
+	112, 2, 334, 335, 332, 93, 326, 45, 95, 340,
+	254, 138, 341, 137, 82, 108, 104, 65, 106, 107,
+	10, 11, 63, 109, 50, 99, 100, 101, 102, 103,
+	77, 66, 67, 68, 33, 94, 12, 218, 56, 55,
+	98, 32, 169, 7, 24, 8, 9, 15, 16, 41,
+	21, 17, 18, 25, 27, 28, 26, 20, 87, 86,
+	60, 61, 35, 298, 123, 149, 289, 307, 321, 333,
+	265, 303, 92, 91, 315, 259, 258, 256, 85, 13,
+	14, 59, 70, 48, 74, 72, 97, 324, 164, 184,
+	19, 5, 4, 3, 1,
 }
 
 var yyPact = [...]int16{
-	304, -1000, -1000, 3, -1000, -1000, -1000, 254, -1000, -1000,
-	325, 137, 306, 248, 247, 225, 140, 193, 228, -1000,
-	304, -1000, 180, 180, 180, 307, -1000, 145, 320, 141,
-	140, 140, 140, 238, -1000, 191, 21, -1000, -1000, 139,
-	187, 135, 293, 180, -1000, -1000, 315, 20, 20, 273,
-	1, 0, 216, 112, 230, -1000, 224, -1000, 57, 104,
-	-1000, -3, 22, -1000, 176, -7, 131, 286, -1000, 20,
-	20, -1000, 239, 45, 159, -1000, 239, 239, -8, -1000,
-	-1000, 239, -1000, -1000, -1000, -1000, -1000, -16, -1000, -1000,
-	-1000, -1000, -21, -1000, 263, 261, 91, 91, 332, 239,
-	56, -1000, 147, -1000, -32, 100, -1000, -1000, 126, 33,
-	120, -1000, 117, -17, 118, -1000, -1000, 45, 239, 239,
-	239, 239, 239, 239, 178, 182, 77, -1000, 116, 74,
-	230, 71, 239, 239, 117, 113, -40, 55, -1000, -52,
-	209, 301, 45, 332, 112, 239, 332, 320, 230, 104,
-	-19, 104, -1000, -41, -42, -1000, 50, -1000, 75, 91,
-	-20, 74, 74, 169, 169, 116, 144, -1000, 155, 239,
-	-22, -1000, -44, -1000, 111, -45, 44, 45, -1000, 267,
-	244, 108, 243, 204, 239, 283, 209, -1000, 45, 114,
-	104, -47, -1000, -1000, -1000, -1000, 121, -66, -53, 91,
-	-1000, 116, -13, -1000, 70, -1000, 239, 105, -36, -1000,
-	-36, -1000, 239, 45, -33, 204, 216, -1000, 114, 222,
-	-1000, -1000, 104, 257, -1000, 177, 66, -1000, -55, -48,
-	-56, -49, 45, -1000, 92, -1000, 239, 37, 45, -1000,
-	-1000, 91, -1000, 214, -1000, -32, -1000, -33, 158, -1000,
-	124, -70, -1000, -1000, -1000, -1000, -1000, -36, 236, -54,
-	-58, 218, 212, 332, -59, -1000, -1000, -1000, -1000, -1000,
-	234, -1000, -1000, 202, 239, 90, 278, -1000, 232, 209,
-	211, 45, 30, -1000, 239, -1000, 204, 90, 90, 45,
-	-1000, 28, 198, -1000, 90, -1000, -1000, -1000, 198, -1000,
+	346, -1000, -1000, -7, -1000, -1000, -1000, 284, -1000, -1000,
+	368, 194, 356, 227, 227, 286, 281, 245, 146, 205,
+	249, -1000, 346, -1000, 192, 192, 192, 351, 350, -1000,
+	149, 382, 147, 333, -1000, 54, -1000, -1000, -1000, -1000,
+	-1000, 328, 146, 146, 146, 270, -1000, 201, 67, -1000,
+	-1000, 145, 199, 142, 325, 192, 192, -1000, -1000, 378,
+	278, 278, 308, 233, 268, 229, 1, 0, 234, 130,
+	252, -1000, 244, -1000, 49, 123, -1000, -1, 22, -1000,
+	190, -5, 141, 324, 322, -1000, 278, 278, -1000, 40,
+	97, 170, -1000, 40, 40, -6, -1000, -1000, 40, -1000,
+	-1000, -1000, -1000, -1000, -9, -1000, -1000, -1000, -1000, -17,
+	-1000, 299, 298, 140, 139, -1000, -1000, 138, 137, 107,
+	107, 367, 40, 66, -1000, 151, -1000, -24, 106, -1000,
+	-1000, 136, 21, 135, -1000, 132, -10, 134, 133, -1000,
+	-1000, 97, 40, 40, 40, 40, 40, 40, 176, 191,
+	113, -1000, 15, 36, 252, -8, 40, 40, 132, 131,
+	305, 303, 241, 239, -38, 46, -1000, -55, 224, 349,
+	97, 367, 130, 40, 367, 382, 252, 123, -16, 123,
+	-1000, -45, -46, -1000, 44, -1000, 111, 107, -19, -20,
+	36, 36, 185, 185, 15, 75, -1000, 167, 40, -21,
+	-1000, -47, -1000, 79, -48, 43, 97, -1000, 301, 81,
+	81, 81, 81, 279, 127, 274, 220, 40, 321, 224,
+	-1000, 97, 154, 123, -49, -1000, -1000, -1000, -1000, 144,
+	-65, -57, 107, 118, -1000, 15, -18, -1000, 78, -1000,
+	40, 116, -1000, -1000, -1000, -1000, -1000, -1000, -36, -1000,
+	-36, -1000, 40, 97, -34, 220, 234, -1000, 154, 235,
+	-1000, -1000, 123, 295, -1000, 171, 63, -1000, -58, -50,
+	-53, -59, -56, 97, -1000, 95, -1000, 40, 41, 97,
+	-1000, -1000, 107, -1000, 230, -1000, -24, -1000, -34, 169,
+	-1000, 162, -71, -1000, -1000, -1000, -1000, -1000, -1000, -36,
+	264, -61, -60, 236, 228, 367, -62, -1000, -1000, -1000,
+	-1000, -1000, 262, -1000, -1000, 216, 40, 98, 317, -1000,
+	260, 224, 226, 97, 28, -1000, 40, -1000, 220, 98,
+	98, 97, -1000, 20, 212, -1000, 98, -1000, -1000, -1000,
+	212, -1000,
 }
 
 var yyPgo = [...]int16{
-	0, 377, 299, 376, 375, 374, 19, 373, 372, 17,
-	9, 7, 371, 370, 14, 6, 16, 11, 369, 8,
-	20, 368, 367, 2, 366, 365, 10, 319, 18, 364,
-	363, 174, 362, 12, 361, 360, 0, 15, 359, 358,
-	357, 356, 3, 5, 355, 13, 354, 353, 1, 4,
-	263, 349, 348, 347, 22, 346, 345, 343,
+	0, 424, 331, 423, 422, 421, 17, 420, 419, 16,
+	9, 7, 418, 417, 15, 6, 18, 11, 416, 8,
+	21, 415, 414, 2, 413, 412, 10, 318, 20, 411,
+	408, 116, 407, 13, 406, 405, 0, 14, 404, 403,
+	402, 401, 3, 5, 400, 12, 399, 398, 1, 4,
+	259, 397, 396, 395, 22, 394, 393, 364, 392, 240,
+	19, 380, 245,
 }
 
 var yyR1 = [...]int8{
-	0, 1, 2, 2, 57, 57, 3, 3, 3, 4,
+	0, 1, 2, 2, 61, 61, 3, 3, 3, 4,
 	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
-	4, 4, 50, 50, 11, 11, 5, 5, 5, 5,
-	56, 56, 55, 55, 54, 12, 12, 14, 14, 15,
-	10, 10, 13, 13, 17, 17, 16, 16, 18, 18,
-	18, 18, 18, 18, 18, 18, 18, 18, 19, 8,
-	8, 9, 44, 44, 51, 51, 52, 52, 52, 6,
-	6, 7, 25, 25, 24, 24, 21, 21, 22, 22,
-	20, 20, 20, 23, 23, 26, 26, 26, 27, 28,
-	29, 29, 29, 30, 30, 30, 31, 31, 32, 32,
-	33, 33, 34, 35, 35, 37, 37, 41, 41, 38,
-	38, 42, 42, 43, 43, 47, 47, 49, 49, 46,
-	46, 48, 48, 48, 45, 45, 45, 36, 36, 36,
-	36, 36, 36, 36, 36, 39, 39, 39, 39, 53,
-	53, 40, 40, 40, 40, 40, 40, 40, 40,
+	4, 4, 4, 4, 4, 4, 4, 62, 62, 57,
+	57, 58, 58, 59, 59, 59, 59, 60, 60, 50,
+	50, 11, 11, 5, 5, 5, 5, 56, 56, 55,
+	55, 54, 12, 12, 14, 14, 15, 10, 10, 13,
+	13, 17, 17, 16, 16, 18, 18, 18, 18, 18,
+	18, 18, 18, 18, 18, 19, 8, 8, 9, 44,
+	44, 51, 51, 52, 52, 52, 6, 6, 7, 25,
+	25, 24, 24, 21, 21, 22, 22, 20, 20, 20,
+	23, 23, 26, 26, 26, 27, 28, 29, 29, 29,
+	30, 30, 30, 31, 31, 32, 32, 33, 33, 34,
+	35, 35, 37, 37, 41, 41, 38, 38, 42, 42,
+	43, 43, 47, 47, 49, 49, 46, 46, 48, 48,
+	48, 45, 45, 45, 36, 36, 36, 36, 36, 36,
+	36, 36, 39, 39, 39, 39, 53, 53, 40, 40,
+	40, 40, 40, 40, 40, 40,
 }
 
 var yyR2 = [...]int8{
 	0, 1, 2, 3, 0, 1, 1, 1, 1, 2,
 	1, 1, 1, 4, 2, 3, 3, 11, 8, 9,
-	6, 8, 0, 3, 1, 3, 9, 8, 7, 8,
-	0, 4, 1, 3, 3, 0, 1, 1, 3, 3,
-	1, 3, 1, 3, 0, 1, 1, 3, 1, 1,
-	1, 1, 1, 6, 1, 1, 1, 1, 4, 1,
-	3, 5, 0, 3, 0, 1, 0, 1, 2, 1,
-	4, 13, 0, 1, 0, 1, 1, 1, 2, 4,
-	1, 4, 4, 1, 3, 3, 4, 2, 1, 2,
-	0, 2, 2, 0, 2, 2, 2, 1, 0, 1,
-	1, 2, 6, 0, 1, 0, 2, 0, 3, 0,
-	2, 0, 2, 0, 2, 0, 3, 0, 4, 2,
-	4, 0, 1, 1, 0, 1, 2, 1, 1, 2,
-	2, 4, 4, 6, 6, 1, 1, 3, 3, 0,
-	1, 3, 3, 3, 3, 3, 3, 3, 4,
+	9, 6, 8, 7, 7, 7, 7, 0, 1, 1,
+	1, 1, 3, 1, 1, 1, 1, 1, 1, 0,
+	3, 1, 3, 9, 8, 7, 8, 0, 4, 1,
+	3, 3, 0, 1, 1, 3, 3, 1, 3, 1,
+	3, 0, 1, 1, 3, 1, 1, 1, 1, 1,
+	6, 1, 1, 1, 1, 4, 1, 3, 5, 0,
+	3, 0, 1, 0, 1, 2, 1, 4, 13, 0,
+	1, 0, 1, 1, 1, 2, 4, 1, 4, 4,
+	1, 3, 3, 4, 2, 1, 2, 0, 2, 2,
+	0, 2, 2, 2, 1, 0, 1, 1, 2, 6,
+	0, 1, 0, 2, 0, 3, 0, 2, 0, 2,
+	0, 2, 0, 3, 0, 4, 2, 4, 0, 1,
+	1, 0, 1, 2, 1, 1, 2, 2, 4, 4,
+	6, 6, 1, 1, 3, 3, 0, 1, 3, 3,
+	3, 3, 3, 3, 3, 4,
 }
 
 var yyChk = [...]int16{
-	-1000, -1, -2, -3, -4, -5, -6, 26, 28, 29,
-	4, 5, 19, 30, 31, 34, 35, -7, 40, -57,
-	87, 27, 6, 15, 17, 16, 71, 6, 7, 15,
-	32, 32, 42, -27, 71, 54, -24, 41, -2, -50,
-	58, -50, -50, 17, 71, -28, -29, 8, 9, 71,
-	-27, -27, -27, 36, -25, 55, -21, 84, -22, -20,
-	-23, 78, 71, 71, 56, 71, 18, -50, -30, 11,
-	10, -31, 12, -36, -39, -40, 56, 83, 59, -20,
-	-18, 88, 73, 74, 75, 76, 77, 64, -19, 66,
-	67, 63, 71, -31, 20, 21, 88, 88, -37, 45,
-	-55, -54, 71, -6, 42, 81, -45, 71, 53, 88,
-	80, 59, 88, 71, 18, -31, -31, -36, 82, 83,
-	85, 84, 69, 70, 61, -53, 65, 56, -36, -36,
-	88, -36, 88, 88, 23, 23, -12, -10, 71, -10,
-	-49, 5, -36, -37, 81, 70, -26, -27, 88, -19,
-	71, -20, 71, 84, -23, 71, -8, -9, 71, 88,
-	71, -36, -36, -36, -36, -36, -36, 63, 56, 57,
-	60, 72, -6, 89, -36, -17, -16, -36, -9, 71,
-	89, 81, 89, -42, 48, 17, -49, -54, -36, -49,
-	-28, -6, -45, -45, 89, 89, 81, 72, -10, 88,
-	63, -36, 88, 89, 53, 89, 81, 22, 33, 71,
-	33, -43, 49, -36, 18, -42, -32, -33, -34, -35,
-	68, -45, 89, 24, -9, -44, 90, 89, -10, -6,
-	-16, 72, -36, 71, -14, -15, 88, -14, -36, -11,
-	71, 88, -43, -37, -33, 43, -45, 25, -52, 63,
-	56, 73, 89, 89, 89, 89, -56, 81, 18, -17,
-	-10, -41, 46, -26, -11, -51, 62, 63, 91, -15,
-	37, 89, 89, -38, 44, 47, -49, 89, 38, -47,
-	50, -36, -13, -23, 18, 39, -42, 47, 81, -36,
-	-43, -46, -23, -23, 81, -48, 51, 52, -23, -48,
+	-1000, -1, -2, -3, -4, -5, -6, 27, 29, 30,
+	4, 5, 20, 63, 64, 31, 32, 35, 36, -7,
+	41, -61, 91, 28, 6, 15, 18, 16, 17, 75,
+	6, 7, 15, -57, 56, -58, -59, 41, 31, 36,
+	35, -57, 33, 33, 43, -27, 75, 55, -24, 42,
+	-2, -50, 59, -50, -50, 18, 18, 75, -28, -29,
+	8, 9, 75, 19, 85, 19, -27, -27, -27, 37,
+	-25, 56, -21, 88, -22, -20, -23, 82, 75, 75,
+	57, 75, 19, -50, -50, -30, 11, 10, -31, 12,
+	-36, -39, -40, 57, 87, 60, -20, -18, 92, 77,
+	78, 79, 80, 81, 68, -19, 70, 71, 67, 75,
+	-31, 21, 22, -62, 65, 15, -59, -62, 65, 92,
+	92, -37, 46, -55, -54, 75, -6, 43, 85, -45,
+	75, 54, 92, 84, 60, 92, 75, 19, 19, -31,
+	-31, -36, 86, 87, 89, 88, 73, 74, 62, -53,
+	69, 57, -36, -36, 92, -36, 92, 92, 24, 24,
+	75, 75, 75, 75, -12, -10, 75, -10, -49, 5,
+	-36, -37, 85, 74, -26, -27, 92, -19, 75, -20,
+	75, 88, -23, 75, -8, -9, 75, 92, 75, 75,
+	-36, -36, -36, -36, -36, -36, 67, 57, 58, 61,
+	76, -6, 93, -36, -17, -16, -36, -9, 75, 23,
+	23, 43, 43, 93, 85, 93, -42, 49, 18, -49,
+	-54, -36, -49, -28, -6, -45, -45, 93, 93, 85,
+	76, -10, 92, 92, 67, -36, 92, 93, 54, 93,
+	85, 23, -60, 75, 79, -60, -60, -60, 34, 75,
+	34, -43, 50, -36, 19, -42, -32, -33, -34, -35,
+	72, -45, 93, 25, -9, -44, 94, 93, -10, 75,
+	-6, -16, 76, -36, 75, -14, -15, 92, -14, -36,
+	-11, 75, 92, -43, -37, -33, 44, -45, 26, -52,
+	67, 57, 77, 93, 93, 93, 93, 93, -56, 85,
+	19, -17, -10, -41, 47, -26, -11, -51, 66, 67,
+	95, -15, 38, 93, 93, -38, 45, 48, -49, 93,
+	39, -47, 51, -36, -13, -23, 19, 40, -42, 48,
+	85, -36, -43, -46, -23, -23, 85, -48, 52, 53,
+	-23, -48,
 }
 
 var yyDef = [...]int16{
 	0, -2, 1, 4, 6, 7, 8, 10, 11, 12,
-	0, 0, 0, 0, 0, 0, 0, 69, 74, 2,
-	5, 9, 22, 22, 22, 0, 14, 0, 90, 0,
-	0, 0, 0, 0, 88, 72, 0, 75, 3, 0,
-	0, 0, 0, 22, 15, 16, 93, 0, 0, 0,
-	0, 0, 105, 0, 0, 73, 0, 76, 77, 124,
-	80, 0, 83, 13, 0, 0, 0, 0, 89, 0,
-	0, 91, 0, 97, -2, 128, 0, 0, 0, 135,
-	136, 0, 48, 49, 50, 51, 52, 0, 54, 55,
-	56, 57, 83, 92, 0, 0, 35, 0, 117, 0,
-	105, 32, 0, 70, 0, 0, 78, 125, 0, 0,
-	0, 23, 0, 0, 0, 94, 95, 96, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 140, 129, 130,
-	0, 0, 0, 44, 0, 0, 0, 36, 40, 0,
-	111, 0, 106, 117, 0, 0, 117, 90, 0, 124,
-	88, 124, 126, 0, 0, 84, 0, 59, 0, 0,
-	0, 141, 142, 143, 144, 145, 146, 147, 0, 0,
-	0, 138, 0, 137, 0, 0, 45, 46, 20, 0,
-	0, 0, 0, 113, 0, 0, 111, 33, 34, -2,
-	124, 0, 87, 79, 81, 82, 0, 62, 0, 0,
-	148, 131, 0, 132, 0, 58, 0, 0, 0, 41,
-	0, 28, 0, 112, 0, 113, 105, 99, -2, 0,
-	104, 85, 124, 0, 60, 66, 0, 18, 0, 0,
-	0, 0, 47, 21, 30, 37, 44, 27, 114, 118,
-	24, 0, 29, 107, 101, 0, 86, 0, 64, 67,
-	0, 0, 19, 133, 134, 53, 26, 0, 0, 0,
-	0, 109, 0, 117, 0, 61, 65, 68, 63, 38,
-	0, 39, 25, 115, 0, 0, 0, 17, 0, 111,
-	0, 110, 108, 42, 0, 31, 113, 0, 0, 102,
-	71, 116, 121, 43, 0, 119, 122, 123, 121, 120,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 86,
+	91, 2, 5, 9, 39, 39, 39, 0, 0, 14,
+	0, 107, 0, 0, 29, 30, 31, 33, 34, 35,
+	36, 0, 0, 0, 0, 0, 105, 89, 0, 92,
+	3, 0, 0, 0, 0, 39, 39, 15, 16, 110,
+	0, 0, 0, 27, 0, 27, 0, 0, 122, 0,
+	0, 90, 0, 93, 94, 141, 97, 0, 100, 13,
+	0, 0, 0, 0, 0, 106, 0, 0, 108, 0,
+	114, -2, 145, 0, 0, 0, 152, 153, 0, 65,
+	66, 67, 68, 69, 0, 71, 72, 73, 74, 100,
+	109, 0, 0, 0, 0, 28, 32, 0, 0, 52,
+	0, 134, 0, 122, 49, 0, 87, 0, 0, 95,
+	142, 0, 0, 0, 40, 0, 0, 0, 0, 111,
+	112, 113, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 157, 146, 147, 0, 0, 0, 61, 0, 0,
+	0, 0, 0, 0, 0, 53, 57, 0, 128, 0,
+	123, 134, 0, 0, 134, 107, 0, 141, 105, 141,
+	143, 0, 0, 101, 0, 76, 0, 0, 0, 0,
+	158, 159, 160, 161, 162, 163, 164, 0, 0, 0,
+	155, 0, 154, 0, 0, 62, 63, 21, 0, 0,
+	0, 0, 0, 0, 0, 0, 130, 0, 0, 128,
+	50, 51, -2, 141, 0, 104, 96, 98, 99, 0,
+	79, 0, 0, 0, 165, 148, 0, 149, 0, 75,
+	0, 0, 23, 37, 38, 24, 25, 26, 0, 58,
+	0, 45, 0, 129, 0, 130, 122, 116, -2, 0,
+	121, 102, 141, 0, 77, 83, 0, 18, 0, 0,
+	0, 0, 0, 64, 22, 47, 54, 61, 44, 131,
+	135, 41, 0, 46, 124, 118, 0, 103, 0, 81,
+	84, 0, 0, 19, 20, 150, 151, 70, 43, 0,
+	0, 0, 0, 126, 0, 134, 0, 78, 82, 85,
+	80, 55, 0, 56, 42, 132, 0, 0, 0, 17,
+	0, 128, 0, 127, 125, 59, 0, 48, 130, 0,
+	0, 119, 88, 133, 138, 60, 0, 136, 139, 140,
+	138, 137,
 }
 
 var yyTok1 = [...]int8{
@@ -444,12 +479,12 @@ var yyTok1 = [...]int8{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	88, 89, 84, 82, 81, 83, 86, 85, 3, 3,
+	92, 93, 88, 86, 85, 87, 90, 89, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 90, 3, 91,
+	3, 94, 3, 95,
 }
 
 var yyTok2 = [...]int8{
@@ -460,7 +495,8 @@ var yyTok2 = [...]int8{
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
 	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
 	62, 63, 64, 65, 66, 67, 68, 69, 70, 71,
-	72, 73, 74, 75, 76, 77, 78, 79, 80, 87,
+	72, 73, 74, 75, 76, 77, 78, 79, 80, 81,
+	82, 83, 84, 91,
 }
 
 var yyTok3 = [...]int8{
@@ -878,256 +914,335 @@ yydefault:
 			yyVAL.stmt = &CreateIndexStmt{unique: true, ifNotExists: yyDollar[4].boolean, table: yyDollar[6].id, cols: yyDollar[8].ids}
 		}
 	case 20:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		{
+			yyVAL.stmt = &CreateIndexStmt{fullText: true, ifNotExists: yyDollar[4].boolean, table: yyDollar[6].id, cols: []string{yyDollar[8].id}}
+		}
+	case 21:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.stmt = &AddColumnStmt{table: yyDollar[3].id, colSpec: yyDollar[6].colSpec}
 		}
-	case 21:
+	case 22:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		{
 			yyVAL.stmt = &RenameColumnStmt{table: yyDollar[3].id, oldName: yyDollar[6].id, newName: yyDollar[8].id}
 		}
-	case 22:
+	case 23:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		{
+			yyVAL.stmt = &GrantStmt{privileges: yyDollar[2].privileges, table: yyDollar[5].id, user: yyDollar[7].id}
+		}
+	case 24:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		{
+			yyVAL.stmt = &GrantStmt{privileges: yyDollar[2].privileges, table: yyDollar[5].id, user: yyDollar[7].id, collection: true}
+		}
+	case 25:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		{
+			yyVAL.stmt = &RevokeStmt{privileges: yyDollar[2].privileges, table: yyDollar[5].id, user: yyDollar[7].id}
+		}
+	case 26:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		{
+			yyVAL.stmt = &RevokeStmt{privileges: yyDollar[2].privileges, table: yyDollar[5].id, user: yyDollar[7].id, collection: true}
+		}
+	case 27:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+		}
+	case 29:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.privileges = GrantablePrivileges()
+		}
+	case 30:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.privileges = yyDollar[1].privileges
+		}
+	case 31:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.privileges = []SQLPrivilege{yyDollar[1].privilege}
+		}
+	case 32:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.privileges = append(yyDollar[1].privileges, yyDollar[3].privilege)
+		}
+	case 33:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.privilege = SQLPrivilegeSelect
+		}
+	case 34:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.privilege = SQLPrivilegeInsert
+		}
+	case 35:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.privilege = SQLPrivilegeUpdate
+		}
+	case 36:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.privilege = SQLPrivilegeDelete
+		}
+	case 37:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.id = yyDollar[1].id
+		}
+	case 38:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.id = yyDollar[1].str
+		}
+	case 39:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 23:
+	case 40:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.boolean = true
 		}
-	case 24:
+	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.ids = []string{yyDollar[1].id}
 		}
-	case 25:
+	case 42:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.ids = yyDollar[2].ids
 		}
-	case 26:
+	case 43:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		{
 			yyVAL.stmt = &UpsertIntoStmt{isInsert: true, tableRef: yyDollar[3].tableRef, cols: yyDollar[5].ids, rows: yyDollar[8].rows, onConflict: yyDollar[9].onConflict}
 		}
-	case 27:
+	case 44:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		{
 			yyVAL.stmt = &UpsertIntoStmt{tableRef: yyDollar[3].tableRef, cols: yyDollar[5].ids, rows: yyDollar[8].rows}
 		}
-	case 28:
+	case 45:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		{
 			yyVAL.stmt = &DeleteFromStmt{tableRef: yyDollar[3].tableRef, where: yyDollar[4].exp, indexOn: yyDollar[5].ids, limit: yyDollar[6].exp, offset: yyDollar[7].exp}
 		}
-	case 29:
+	case 46:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		{
 			yyVAL.stmt = &UpdateStmt{tableRef: yyDollar[2].tableRef, updates: yyDollar[4].updates, where: yyDollar[5].exp, indexOn: yyDollar[6].ids, limit: yyDollar[7].exp, offset: yyDollar[8].exp}
 		}
-	case 30:
+	case 47:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.onConflict = nil
 		}
-	case 31:
+	case 48:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.onConflict = &OnConflictDo{}
 		}
-	case 32:
+	case 49:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.updates = []*colUpdate{yyDollar[1].update}
 		}
-	case 33:
+	case 50:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.updates = append(yyDollar[1].updates, yyDollar[3].update)
 		}
-	case 34:
+	case 51:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.update = &colUpdate{col: yyDollar[1].id, op: yyDollar[2].cmpOp, val: yyDollar[3].exp}
 		}
-	case 35:
+	case 52:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.ids = nil
 		}
-	case 36:
+	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.ids = yyDollar[1].ids
 		}
-	case 37:
+	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.rows = []*RowSpec{yyDollar[1].row}
 		}
-	case 38:
+	case 55:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.rows = append(yyDollar[1].rows, yyDollar[3].row)
 		}
-	case 39:
+	case 56:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.row = &RowSpec{Values: yyDollar[2].values}
 		}
-	case 40:
+	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.ids = []string{yyDollar[1].id}
 		}
-	case 41:
+	case 58:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.ids = append(yyDollar[1].ids, yyDollar[3].id)
 		}
-	case 42:
+	case 59:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.cols = []*ColSelector{yyDollar[1].col}
 		}
-	case 43:
+	case 60:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.cols = append(yyDollar[1].cols, yyDollar[3].col)
 		}
-	case 44:
+	case 61:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.values = nil
 		}
-	case 45:
+	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.values = yyDollar[1].values
 		}
-	case 46:
+	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.values = []ValueExp{yyDollar[1].exp}
 		}
-	case 47:
+	case 64:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].exp)
 		}
-	case 48:
+	case 65:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Integer{val: int64(yyDollar[1].integer)}
 		}
-	case 49:
+	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Float64{val: float64(yyDollar[1].float)}
 		}
-	case 50:
+	case 67:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Varchar{val: yyDollar[1].str}
 		}
-	case 51:
+	case 68:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Bool{val: yyDollar[1].boolean}
 		}
-	case 52:
+	case 69:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Blob{val: yyDollar[1].blob}
 		}
-	case 53:
+	case 70:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.value = &Cast{val: yyDollar[3].exp, t: yyDollar[5].sqlType}
 		}
-	case 54:
+	case 71:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = yyDollar[1].value
 		}
-	case 55:
+	case 72:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Param{id: yyDollar[1].id}
 		}
-	case 56:
+	case 73:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Param{id: fmt.Sprintf("param%d", yyDollar[1].pparam), pos: yyDollar[1].pparam}
 		}
-	case 57:
+	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &NullValue{t: AnyType}
 		}
-	case 58:
+	case 75:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.value = &FnCall{fn: yyDollar[1].id, params: yyDollar[3].values}
 		}
-	case 59:
+	case 76:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.colsSpec = []*ColSpec{yyDollar[1].colSpec}
 		}
-	case 60:
+	case 77:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.colsSpec = append(yyDollar[1].colsSpec, yyDollar[3].colSpec)
 		}
-	case 61:
+	case 78:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		{
 			yyVAL.colSpec = &ColSpec{colName: yyDollar[1].id, colType: yyDollar[2].sqlType, maxLen: int(yyDollar[3].integer), notNull: yyDollar[4].boolean, autoIncrement: yyDollar[5].boolean}
 		}
-	case 62:
+	case 79:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.integer = 0
 		}
-	case 63:
+	case 80:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.integer = yyDollar[2].integer
 		}
-	case 64:
+	case 81:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 65:
+	case 82:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.boolean = true
 		}
-	case 66:
+	case 83:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 67:
+	case 84:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 68:
+	case 85:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.boolean = true
 		}
-	case 69:
+	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 70:
+	case 87:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.stmt = &UnionStmt{
@@ -1136,7 +1251,7 @@ yydefault:
 				right:    yyDollar[4].stmt.(DataSource),
 			}
 		}
-	case 71:
+	case 88:
 		yyDollar = yyS[yypt-13 : yypt+1]
 		{
 			yyVAL.stmt = &SelectStmt{
@@ -1153,392 +1268,392 @@ yydefault:
 				offset:    yyDollar[13].exp,
 			}
 		}
-	case 72:
+	case 89:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.distinct = true
 		}
-	case 73:
+	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.distinct = false
 		}
-	case 74:
+	case 91:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.distinct = false
 		}
-	case 75:
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.distinct = true
 		}
-	case 76:
+	case 93:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.sels = nil
 		}
-	case 77:
+	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.sels = yyDollar[1].sels
 		}
-	case 78:
+	case 95:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyDollar[1].sel.setAlias(yyDollar[2].id)
 			yyVAL.sels = []Selector{yyDollar[1].sel}
 		}
-	case 79:
+	case 96:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyDollar[3].sel.setAlias(yyDollar[4].id)
 			yyVAL.sels = append(yyDollar[1].sels, yyDollar[3].sel)
 		}
-	case 80:
+	case 97:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.sel = yyDollar[1].col
 		}
-	case 81:
+	case 98:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.sel = &AggColSelector{aggFn: yyDollar[1].aggFn, col: "*"}
 		}
-	case 82:
+	case 99:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.sel = &AggColSelector{aggFn: yyDollar[1].aggFn, table: yyDollar[3].col.table, col: yyDollar[3].col.col}
 		}
-	case 83:
+	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.col = &ColSelector{col: yyDollar[1].id}
 		}
-	case 84:
+	case 101:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.col = &ColSelector{table: yyDollar[1].id, col: yyDollar[3].id}
 		}
-	case 85:
+	case 102:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyDollar[1].tableRef.period = yyDollar[2].period
 			yyDollar[1].tableRef.as = yyDollar[3].id
 			yyVAL.ds = yyDollar[1].tableRef
 		}
-	case 86:
+	case 103:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyDollar[2].stmt.(*SelectStmt).as = yyDollar[4].id
 			yyVAL.ds = yyDollar[2].stmt.(DataSource)
 		}
-	case 87:
+	case 104:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.ds = &FnDataSourceStmt{fnCall: yyDollar[1].value.(*FnCall), as: yyDollar[2].id}
 		}
-	case 88:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.tableRef = &tableRef{table: yyDollar[1].id}
 		}
-	case 89:
+	case 106:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.period = period{start: yyDollar[1].openPeriod, end: yyDollar[2].openPeriod}
 		}
-	case 90:
+	case 107:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.openPeriod = nil
 		}
-	case 91:
+	case 108:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.openPeriod = &openPeriod{inclusive: true, instant: yyDollar[2].periodInstant}
 		}
-	case 92:
+	case 109:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.openPeriod = &openPeriod{instant: yyDollar[2].periodInstant}
 		}
-	case 93:
+	case 110:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.openPeriod = nil
 		}
-	case 94:
+	case 111:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.openPeriod = &openPeriod{inclusive: true, instant: yyDollar[2].periodInstant}
 		}
-	case 95:
+	case 112:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.openPeriod = &openPeriod{instant: yyDollar[2].periodInstant}
 		}
-	case 96:
+	case 113:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.periodInstant = periodInstant{instantType: txInstant, exp: yyDollar[2].exp}
 		}
-	case 97:
+	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.periodInstant = periodInstant{instantType: timeInstant, exp: yyDollar[1].exp}
 		}
-	case 98:
+	case 115:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.joins = nil
 		}
-	case 99:
+	case 116:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.joins = yyDollar[1].joins
 		}
-	case 100:
+	case 117:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.joins = []*JoinSpec{yyDollar[1].join}
 		}
-	case 101:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.joins = append([]*JoinSpec{yyDollar[1].join}, yyDollar[2].joins...)
 		}
-	case 102:
+	case 119:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.join = &JoinSpec{joinType: yyDollar[1].joinType, ds: yyDollar[3].ds, indexOn: yyDollar[4].ids, cond: yyDollar[6].exp}
 		}
-	case 103:
+	case 120:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.joinType = InnerJoin
 		}
-	case 104:
+	case 121:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.joinType = yyDollar[1].joinType
 		}
-	case 105:
+	case 122:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 106:
+	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 107:
+	case 124:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.cols = nil
 		}
-	case 108:
+	case 125:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.cols = yyDollar[3].cols
 		}
-	case 109:
+	case 126:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 110:
+	case 127:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 111:
+	case 128:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 112:
+	case 129:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 113:
+	case 130:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 114:
+	case 131:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 115:
+	case 132:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.ordcols = nil
 		}
-	case 116:
+	case 133:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.ordcols = yyDollar[3].ordcols
 		}
-	case 117:
+	case 134:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.ids = nil
 		}
-	case 118:
+	case 135:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.ids = yyDollar[4].ids
 		}
-	case 119:
+	case 136:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.ordcols = []*OrdCol{{sel: yyDollar[1].col, descOrder: yyDollar[2].opt_ord}}
 		}
-	case 120:
+	case 137:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.ordcols = append(yyDollar[1].ordcols, &OrdCol{sel: yyDollar[3].col, descOrder: yyDollar[4].opt_ord})
 		}
-	case 121:
+	case 138:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.opt_ord = false
 		}
-	case 122:
+	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.opt_ord = false
 		}
-	case 123:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.opt_ord = true
 		}
-	case 124:
+	case 141:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.id = ""
 		}
-	case 125:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.id = yyDollar[1].id
 		}
-	case 126:
+	case 143:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.id = yyDollar[2].id
 		}
-	case 127:
+	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[1].exp
 		}
-	case 128:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[1].binExp
 		}
-	case 129:
+	case 146:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = &NotBoolExp{exp: yyDollar[2].exp}
 		}
-	case 130:
+	case 147:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = &NumExp{left: &Integer{val: 0}, op: SUBSOP, right: yyDollar[2].exp}
 		}
-	case 131:
+	case 148:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.exp = &LikeBoolExp{val: yyDollar[1].exp, notLike: yyDollar[2].boolean, pattern: yyDollar[4].exp}
 		}
-	case 132:
+	case 149:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.exp = &ExistsBoolExp{q: (yyDollar[3].stmt).(DataSource)}
 		}
-	case 133:
+	case 150:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.exp = &InSubQueryExp{val: yyDollar[1].exp, notIn: yyDollar[2].boolean, q: yyDollar[5].stmt.(*SelectStmt)}
 		}
-	case 134:
+	case 151:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.exp = &InListExp{val: yyDollar[1].exp, notIn: yyDollar[2].boolean, values: yyDollar[5].values}
 		}
-	case 135:
+	case 152:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[1].sel
 		}
-	case 136:
+	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[1].value
 		}
-	case 137:
+	case 154:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 138:
+	case 155:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.exp = &Cast{val: yyDollar[1].exp, t: yyDollar[3].sqlType}
 		}
-	case 139:
+	case 156:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 140:
+	case 157:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.boolean = true
 		}
-	case 141:
+	case 158:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &NumExp{left: yyDollar[1].exp, op: ADDOP, right: yyDollar[3].exp}
 		}
-	case 142:
+	case 159:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &NumExp{left: yyDollar[1].exp, op: SUBSOP, right: yyDollar[3].exp}
 		}
-	case 143:
+	case 160:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &NumExp{left: yyDollar[1].exp, op: DIVOP, right: yyDollar[3].exp}
 		}
-	case 144:
+	case 161:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &NumExp{left: yyDollar[1].exp, op: MULTOP, right: yyDollar[3].exp}
 		}
-	case 145:
+	case 162:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &BinBoolExp{left: yyDollar[1].exp, op: yyDollar[2].logicOp, right: yyDollar[3].exp}
 		}
-	case 146:
+	case 163:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &CmpBoolExp{left: yyDollar[1].exp, op: yyDollar[2].cmpOp, right: yyDollar[3].exp}
 		}
-	case 147:
+	case 164:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &CmpBoolExp{left: yyDollar[1].exp, op: EQ, right: &NullValue{t: AnyType}}
 		}
-	case 148:
+	case 165:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.binExp = &CmpBoolExp{left: yyDollar[1].exp, op: NE, right: &NullValue{t: AnyType}}
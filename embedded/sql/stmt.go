@@ -34,10 +34,14 @@ const (
 	//catalogDatabasePrefix = "CTL.DATABASE." // (key=CTL.DATABASE.{1}, value={dbNAME}) // deprecated entries
 	catalogTablePrefix  = "CTL.TABLE."  // (key=CTL.TABLE.{1}{tableID}, value={tableNAME})
 	catalogColumnPrefix = "CTL.COLUMN." // (key=CTL.COLUMN.{1}{tableID}{colID}{colTYPE}, value={(auto_incremental | nullable){maxLen}{colNAME}})
-	catalogIndexPrefix  = "CTL.INDEX."  // (key=CTL.INDEX.{1}{tableID}{indexID}, value={unique {colID1}(ASC|DESC)...{colIDN}(ASC|DESC)})
+	catalogIndexPrefix  = "CTL.INDEX."  // (key=CTL.INDEX.{1}{tableID}{indexID}, value={kind {colID1}(ASC|DESC)...{colIDN}(ASC|DESC)})
+	catalogTTLPrefix    = "CTL.TTL."    // (key=CTL.TTL.{1}{tableID}, value={ttl in seconds})
+	catalogGrantPrefix  = "CTL.GRANT."  // (key=CTL.GRANT.{1}{tableID}{user}, value={privileges bitmask})
+	catalogUserPrefix   = "CTL.USER."   // (key=CTL.USER.{1}{user}, value={restricted}) users restricted to the granted privileges
 	PIndexPrefix        = "R."          // (key=R.{1}{tableID}{0}({null}({pkVal}{padding}{pkValLen})?)+, value={count (colID valLen val)+})
 	SIndexPrefix        = "E."          // (key=E.{1}{tableID}{indexID}({null}({val}{padding}{valLen})?)+({pkVal}{padding}{pkValLen})+, value={})
 	UIndexPrefix        = "N."          // (key=N.{1}{tableID}{indexID}({null}({val}{padding}{valLen})?)+, value={({pkVal}{padding}{pkValLen})+})
+	FIndexPrefix        = "F."          // (key=F.{1}{tableID}{indexID}{notnull}{term}{padding}{termLen}({pkVal}{padding}{pkValLen})+, value={termFrequency termCount})
 
 	// Old prefixes that must not be reused:
 	//  `CATALOG.DATABASE.`
@@ -54,6 +58,13 @@ const (
 
 const PKIndexID = uint32(0)
 
+// kind of index as persisted in the catalog
+const (
+	secondaryIndexKind byte = iota
+	uniqueIndexKind
+	fullTextIndexKind
+)
+
 const (
 	nullableFlag      byte = 1 << iota
 	autoIncrementFlag byte = 1 << iota
@@ -126,6 +137,8 @@ const (
 	TablesFnCall    string = "TABLES"
 	ColumnsFnCall   string = "COLUMNS"
 	IndexesFnCall   string = "INDEXES"
+	GrantsFnCall    string = "GRANTS"
+	MatchFnCall     string = "MATCH"
 )
 
 type SQLStmt interface {
@@ -352,6 +365,7 @@ func NewColSpec(name string, colType SQLValueType, maxLen int, autoIncrement boo
 
 type CreateIndexStmt struct {
 	unique      bool
+	fullText    bool
 	ifNotExists bool
 	table       string
 	cols        []string
@@ -361,6 +375,12 @@ func NewCreateIndexStmt(table string, cols []string, isUnique bool) *CreateIndex
 	return &CreateIndexStmt{unique: isUnique, table: table, cols: cols}
 }
 
+// NewCreateFullTextIndexStmt creates an inverted index on a VARCHAR column,
+// which can then be queried with the MATCH function.
+func NewCreateFullTextIndexStmt(table string, col string) *CreateIndexStmt {
+	return &CreateIndexStmt{fullText: true, table: table, cols: []string{col}}
+}
+
 func (stmt *CreateIndexStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
 	return nil
 }
@@ -389,20 +409,31 @@ func (stmt *CreateIndexStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 			return nil, err
 		}
 
+		colIDs[i] = col.id
+
+		// full-text indexes hold terms and not the values themselves
+		if stmt.fullText {
+			continue
+		}
+
 		if variableSizedType(col.colType) && !tx.engine.lazyIndexConstraintValidation && (col.MaxLen() == 0 || col.MaxLen() > MaxKeyLen) {
 			return nil, fmt.Errorf("%w: can not create index using column '%s'. Max key length for variable columns is %d", ErrLimitedKeyType, col.colName, MaxKeyLen)
 		}
 
 		indexKeyLen += col.MaxLen()
-
-		colIDs[i] = col.id
 	}
 
 	if !tx.engine.lazyIndexConstraintValidation && indexKeyLen > MaxKeyLen {
 		return nil, fmt.Errorf("%w: can not create index using columns '%v'. Max key length is %d", ErrLimitedKeyType, stmt.cols, MaxKeyLen)
 	}
 
-	index, err := table.newIndex(stmt.unique, colIDs)
+	var index *Index
+
+	if stmt.fullText {
+		index, err = table.newFullTextIndex(colIDs)
+	} else {
+		index, err = table.newIndex(stmt.unique, colIDs)
+	}
 	if err == ErrIndexAlreadyExists && stmt.ifNotExists {
 		return tx, nil
 	}
@@ -422,14 +453,16 @@ func (stmt *CreateIndexStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 		}
 	}
 
-	// v={unique {colID1}(ASC|DESC)...{colIDN}(ASC|DESC)}
+	// v={kind {colID1}(ASC|DESC)...{colIDN}(ASC|DESC)}
 	// TODO: currently only ASC order is supported
 	colSpecLen := EncIDLen + 1
 
 	encodedValues := make([]byte, 1+len(index.cols)*colSpecLen)
 
 	if index.IsUnique() {
-		encodedValues[0] = 1
+		encodedValues[0] = uniqueIndexKind
+	} else if index.IsFullText() {
+		encodedValues[0] = fullTextIndexKind
 	}
 
 	for i, col := range index.cols {
@@ -517,12 +550,195 @@ func (stmt *RenameColumnStmt) execAt(ctx context.Context, tx *SQLTx, params map[
 	return tx, nil
 }
 
+// SetTableTTLStmt sets the time-to-live of the rows written into a table from then on.
+// A zero TTL means rows do not expire.
+type SetTableTTLStmt struct {
+	table string
+	ttl   time.Duration
+}
+
+func NewSetTableTTLStmt(table string, ttl time.Duration) *SetTableTTLStmt {
+	return &SetTableTTLStmt{table: table, ttl: ttl}
+}
+
+func (stmt *SetTableTTLStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *SetTableTTLStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if stmt.ttl < 0 || stmt.ttl%time.Second != 0 {
+		return nil, fmt.Errorf("%w: ttl must be a non-negative number of seconds", ErrIllegalArguments)
+	}
+
+	table, err := tx.catalog.GetTableByName(stmt.table)
+	if err != nil {
+		return nil, err
+	}
+
+	var encTTL [8]byte
+	binary.BigEndian.PutUint64(encTTL[:], uint64(stmt.ttl/time.Second))
+
+	err = tx.set(mapKey(tx.sqlPrefix(), catalogTTLPrefix, EncodeID(1), EncodeID(table.id)), nil, encTTL[:])
+	if err != nil {
+		return nil, err
+	}
+
+	table.ttl = stmt.ttl
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+// GrantStmt grants privileges on a table to a user.
+// Once granted privileges on any table, the user is only allowed to access tables as granted,
+// even after all the granted privileges are revoked or the granted tables are dropped.
+type GrantStmt struct {
+	privileges []SQLPrivilege
+	table      string
+	user       string
+	collection bool
+}
+
+func NewGrantStmt(privileges []SQLPrivilege, table, user string) *GrantStmt {
+	return &GrantStmt{privileges: privileges, table: table, user: user}
+}
+
+func (stmt *GrantStmt) Privileges() []SQLPrivilege {
+	return stmt.privileges
+}
+
+func (stmt *GrantStmt) Table() string {
+	return stmt.table
+}
+
+func (stmt *GrantStmt) User() string {
+	return stmt.user
+}
+
+// OnCollection returns true when privileges are granted on a document collection instead of a table
+func (stmt *GrantStmt) OnCollection() bool {
+	return stmt.collection
+}
+
+func (stmt *GrantStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *GrantStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	return tx, updateGrants(tx, stmt.privileges, stmt.table, stmt.user, stmt.collection, false)
+}
+
+// RevokeStmt revokes privileges previously granted on a table to a user.
+type RevokeStmt struct {
+	privileges []SQLPrivilege
+	table      string
+	user       string
+	collection bool
+}
+
+func NewRevokeStmt(privileges []SQLPrivilege, table, user string) *RevokeStmt {
+	return &RevokeStmt{privileges: privileges, table: table, user: user}
+}
+
+func (stmt *RevokeStmt) Privileges() []SQLPrivilege {
+	return stmt.privileges
+}
+
+func (stmt *RevokeStmt) Table() string {
+	return stmt.table
+}
+
+func (stmt *RevokeStmt) User() string {
+	return stmt.user
+}
+
+// OnCollection returns true when privileges are revoked on a document collection instead of a table
+func (stmt *RevokeStmt) OnCollection() bool {
+	return stmt.collection
+}
+
+func (stmt *RevokeStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *RevokeStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	return tx, updateGrants(tx, stmt.privileges, stmt.table, stmt.user, stmt.collection, true)
+}
+
+func updateGrants(tx *SQLTx, privileges []SQLPrivilege, tableName, user string, collection, revoke bool) error {
+	if collection {
+		// collections are tables of the catalog managed by the document engine
+		return fmt.Errorf("%w: privileges on collections must be managed by the document engine", ErrNoSupported)
+	}
+
+	if len(privileges) == 0 || user == "" {
+		return ErrIllegalArguments
+	}
+
+	set, err := newPrivilegeSet(privileges)
+	if err != nil {
+		return err
+	}
+
+	table, err := tx.catalog.GetTableByName(tableName)
+	if err != nil {
+		return err
+	}
+
+	granted, exists := table.grants[user]
+
+	if revoke {
+		set = granted &^ set
+	} else {
+		set = granted | set
+	}
+
+	if !revoke && !tx.catalog.IsRestricted(user) {
+		// revoking privileges must never widen the access of the user, so the
+		// restriction is kept once set
+		err = tx.set(mapKey(tx.sqlPrefix(), catalogUserPrefix, EncodeID(1), []byte(user)), nil, []byte{1})
+		if err != nil {
+			return err
+		}
+
+		tx.catalog.restrictedUsers[user] = struct{}{}
+	}
+
+	mkey := mapKey(tx.sqlPrefix(), catalogGrantPrefix, EncodeID(1), EncodeID(table.id), []byte(user))
+
+	if set == 0 {
+		if !exists {
+			return nil
+		}
+
+		err = tx.delete(mkey)
+		if err != nil {
+			return err
+		}
+
+		delete(table.grants, user)
+	} else {
+		err = tx.set(mkey, nil, []byte{byte(set)})
+		if err != nil {
+			return err
+		}
+
+		table.grants[user] = set
+	}
+
+	tx.mutatedCatalog = true
+
+	return nil
+}
+
 type UpsertIntoStmt struct {
 	isInsert   bool
 	tableRef   *tableRef
 	cols       []string
 	rows       []*RowSpec
 	onConflict *OnConflictDo
+	expiresAt  time.Time
 }
 
 func NewUpserIntoStmt(table string, cols []string, rows []*RowSpec, isInsert bool, onConflict *OnConflictDo) *UpsertIntoStmt {
@@ -535,6 +751,13 @@ func NewUpserIntoStmt(table string, cols []string, rows []*RowSpec, isInsert boo
 	}
 }
 
+// WithExpiration sets the time at which the upserted rows expire, overriding the TTL of the table.
+// Expired rows are not returned by queries but they remain in the history of the store.
+func (stmt *UpsertIntoStmt) WithExpiration(expiresAt time.Time) *UpsertIntoStmt {
+	stmt.expiresAt = expiresAt
+	return stmt
+}
+
 type RowSpec struct {
 	Values []ValueExp
 }
@@ -688,7 +911,7 @@ func (stmt *UpsertIntoStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 		mkey := mapKey(tx.sqlPrefix(), PIndexPrefix, EncodeID(1), EncodeID(table.id), EncodeID(table.primaryIndex.id), pkEncVals)
 
 		_, err = tx.get(mkey)
-		if err != nil && err != store.ErrKeyNotFound {
+		if err != nil && !errors.Is(err, store.ErrKeyNotFound) {
 			return nil, err
 		}
 
@@ -707,7 +930,7 @@ func (stmt *UpsertIntoStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 			}
 		}
 
-		err = tx.doUpsert(ctx, pkEncVals, valuesByColID, table, !stmt.isInsert)
+		err = tx.doUpsert(ctx, pkEncVals, valuesByColID, table, !stmt.isInsert, stmt.expiresAt)
 		if err != nil {
 			return nil, err
 		}
@@ -716,9 +939,20 @@ func (stmt *UpsertIntoStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 	return tx, nil
 }
 
-func (tx *SQLTx) doUpsert(ctx context.Context, pkEncVals []byte, valuesByColID map[uint32]TypedValue, table *Table, reuseIndex bool) error {
+func (tx *SQLTx) doUpsert(ctx context.Context, pkEncVals []byte, valuesByColID map[uint32]TypedValue, table *Table, reuseIndex bool, expiresAt time.Time) error {
+	md, err := tx.rowMetadata(table, expiresAt)
+	if err != nil {
+		return err
+	}
+
+	// primary index entry
+	mkey := mapKey(tx.sqlPrefix(), PIndexPrefix, EncodeID(1), EncodeID(table.id), EncodeID(table.primaryIndex.id), pkEncVals)
+
 	var reusableIndexEntries map[uint32]struct{}
 
+	// reused index entries must be rewritten when the expiration of the row changes
+	var refreshIndexEntries bool
+
 	if reuseIndex && len(table.indexes) > 1 {
 		currPKRow, err := tx.fetchPKRow(ctx, table, valuesByColID)
 		if err != nil && err != ErrNoMoreRows {
@@ -726,6 +960,15 @@ func (tx *SQLTx) doUpsert(ctx context.Context, pkEncVals []byte, valuesByColID m
 		}
 
 		if err == nil {
+			currVRef, err := tx.get(mkey)
+			if err != nil {
+				return err
+			}
+
+			currMD := currVRef.KVMetadata()
+
+			refreshIndexEntries = md != nil || (currMD != nil && currMD.IsExpirable())
+
 			currValuesByColID := make(map[uint32]TypedValue, len(currPKRow.ValuesBySelector))
 
 			for _, col := range table.cols {
@@ -740,9 +983,6 @@ func (tx *SQLTx) doUpsert(ctx context.Context, pkEncVals []byte, valuesByColID m
 		}
 	}
 
-	// primary index entry
-	mkey := mapKey(tx.sqlPrefix(), PIndexPrefix, EncodeID(1), EncodeID(table.id), EncodeID(table.primaryIndex.id), pkEncVals)
-
 	valbuf := bytes.Buffer{}
 
 	// null values are not serialized
@@ -756,7 +996,7 @@ func (tx *SQLTx) doUpsert(ctx context.Context, pkEncVals []byte, valuesByColID m
 	b := make([]byte, EncLenLen)
 	binary.BigEndian.PutUint32(b, uint32(encodedVals))
 
-	_, err := valbuf.Write(b)
+	_, err = valbuf.Write(b)
 	if err != nil {
 		return err
 	}
@@ -786,7 +1026,7 @@ func (tx *SQLTx) doUpsert(ctx context.Context, pkEncVals []byte, valuesByColID m
 		}
 	}
 
-	err = tx.set(mkey, nil, valbuf.Bytes())
+	err = tx.set(mkey, md, valbuf.Bytes())
 	if err != nil {
 		return err
 	}
@@ -797,11 +1037,18 @@ func (tx *SQLTx) doUpsert(ctx context.Context, pkEncVals []byte, valuesByColID m
 			continue
 		}
 
-		if reusableIndexEntries != nil {
-			_, reusable := reusableIndexEntries[index.id]
-			if reusable {
-				continue
+		_, reusable := reusableIndexEntries[index.id]
+		if reusable && !refreshIndexEntries {
+			continue
+		}
+
+		if index.IsFullText() {
+			err = tx.setFullTextIndexEntries(pkEncVals, index, valuesByColID[index.cols[0].id], md)
+			if err != nil {
+				return err
 			}
+
+			continue
 		}
 
 		var prefix string
@@ -850,18 +1097,18 @@ func (tx *SQLTx) doUpsert(ctx context.Context, pkEncVals []byte, valuesByColID m
 
 		mkey := mapKey(tx.sqlPrefix(), prefix, encodedValues...)
 
-		if index.IsUnique() {
+		if index.IsUnique() && !reusable {
 			// mkey must not exist
 			_, err := tx.get(mkey)
 			if err == nil {
 				return store.ErrKeyAlreadyExists
 			}
-			if err != store.ErrKeyNotFound {
+			if !errors.Is(err, store.ErrKeyNotFound) {
 				return err
 			}
 		}
 
-		err = tx.set(mkey, nil, val)
+		err = tx.set(mkey, md, val)
 		if err != nil {
 			return err
 		}
@@ -872,6 +1119,27 @@ func (tx *SQLTx) doUpsert(ctx context.Context, pkEncVals []byte, valuesByColID m
 	return nil
 }
 
+// rowMetadata returns the metadata of the entries of a row, which expire at the given time
+// or, when it's not specified, once the TTL of the table elapses.
+func (tx *SQLTx) rowMetadata(table *Table, expiresAt time.Time) (*store.KVMetadata, error) {
+	if expiresAt.IsZero() && table.ttl == 0 {
+		return nil, nil
+	}
+
+	if expiresAt.IsZero() {
+		expiresAt = tx.Timestamp().Add(table.ttl)
+	}
+
+	md := store.NewKVMetadata()
+
+	err := md.ExpiresAt(expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return md, nil
+}
+
 func EncodedPK(table *Table, valuesByColID map[uint32]TypedValue) ([]byte, error) {
 	return encodedPK(table, valuesByColID)
 }
@@ -953,6 +1221,37 @@ func (tx *SQLTx) deprecateIndexEntries(
 			continue
 		}
 
+		if index.IsFullText() {
+			col := index.cols[0]
+
+			currVal, specified := currValuesByColID[col.id]
+			if !specified {
+				currVal = &NullValue{t: col.colType}
+			}
+
+			newVal, specified := newValuesByColID[col.id]
+			if !specified {
+				newVal = &NullValue{t: col.colType}
+			}
+
+			r, err := currVal.Compare(newVal)
+			if err != nil {
+				return nil, err
+			}
+
+			if r == 0 {
+				reusableIndexEntries[index.id] = struct{}{}
+				continue
+			}
+
+			err = tx.deleteFullTextIndexEntries(pkEncVals, index, currVal)
+			if err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
 		var prefix string
 		var encodedValues [][]byte
 
@@ -1178,12 +1477,19 @@ func (stmt *UpdateStmt) execAt(ctx context.Context, tx *SQLTx, params map[string
 		mkey := mapKey(tx.sqlPrefix(), PIndexPrefix, EncodeID(1), EncodeID(table.id), EncodeID(table.primaryIndex.id), pkEncVals)
 
 		// mkey must exist
-		_, err = tx.get(mkey)
+		vref, err := tx.get(mkey)
 		if err != nil {
 			return nil, err
 		}
 
-		err = tx.doUpsert(ctx, pkEncVals, valuesByColID, table, true)
+		// updated rows keep their expiration time
+		var expiresAt time.Time
+
+		if vref.KVMetadata() != nil && vref.KVMetadata().IsExpirable() {
+			expiresAt, _ = vref.KVMetadata().ExpirationTime()
+		}
+
+		err = tx.doUpsert(ctx, pkEncVals, valuesByColID, table, true, expiresAt)
 		if err != nil {
 			return nil, err
 		}
@@ -1271,6 +1577,15 @@ func (stmt *DeleteFromStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 
 func (tx *SQLTx) deleteIndexEntries(pkEncVals []byte, valuesByColID map[uint32]TypedValue, table *Table) error {
 	for _, index := range table.indexes {
+		if index.IsFullText() {
+			err := tx.deleteFullTextIndexEntries(pkEncVals, index, valuesByColID[index.cols[0].id])
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
 		var prefix string
 		var encodedValues [][]byte
 
@@ -1940,11 +2255,27 @@ type FnCall struct {
 	params []ValueExp
 }
 
+func NewFnCall(fn string, params ...ValueExp) *FnCall {
+	return &FnCall{
+		fn:     fn,
+		params: params,
+	}
+}
+
 func (v *FnCall) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
 	if strings.ToUpper(v.fn) == NowFnCall {
 		return TimestampType, nil
 	}
 
+	if strings.ToUpper(v.fn) == MatchFnCall {
+		err := v.requiresMatchParams(cols, params, implicitTable)
+		if err != nil {
+			return AnyType, err
+		}
+
+		return BooleanType, nil
+	}
+
 	return AnyType, fmt.Errorf("%w: unknown function %s", ErrIllegalArguments, v.fn)
 }
 
@@ -1957,6 +2288,14 @@ func (v *FnCall) requiresType(t SQLValueType, cols map[string]ColDescriptor, par
 		return nil
 	}
 
+	if strings.ToUpper(v.fn) == MatchFnCall {
+		if t != BooleanType {
+			return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, BooleanType, t)
+		}
+
+		return v.requiresMatchParams(cols, params, implicitTable)
+	}
+
 	return fmt.Errorf("%w: unkown function %s", ErrIllegalArguments, v.fn)
 }
 
@@ -1984,11 +2323,24 @@ func (v *FnCall) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue,
 		return &Timestamp{val: tx.Timestamp().Truncate(time.Microsecond).UTC()}, nil
 	}
 
+	if strings.ToUpper(v.fn) == MatchFnCall {
+		return v.reduceMatch(tx, row, implicitTable)
+	}
+
 	return nil, fmt.Errorf("%w: unkown function %s", ErrIllegalArguments, v.fn)
 }
 
 func (v *FnCall) reduceSelectors(row *Row, implicitTable string) ValueExp {
-	return v
+	ps := make([]ValueExp, len(v.params))
+
+	for i, p := range v.params {
+		ps[i] = p.reduceSelectors(row, implicitTable)
+	}
+
+	return &FnCall{
+		fn:     v.fn,
+		params: ps,
+	}
 }
 
 func (v *FnCall) isConstant() bool {
@@ -2209,6 +2561,23 @@ func NewSelectStmt(
 	}
 }
 
+// UntilTx restricts the statement to the rows as they were right after the given transaction
+// was committed, equivalent to the UNTIL TX clause.
+func (stmt *SelectStmt) UntilTx(txID uint64) *SelectStmt {
+	tableRef, ok := stmt.ds.(*tableRef)
+	if ok {
+		tableRef.period.end = &openPeriod{
+			inclusive: true,
+			instant: periodInstant{
+				instantType: txInstant,
+				exp:         NewInteger(int64(txID)),
+			},
+		}
+	}
+
+	return stmt
+}
+
 func (stmt *SelectStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
 	_, err := stmt.execAt(ctx, tx, nil)
 	if err != nil {
@@ -2412,6 +2781,24 @@ func (stmt *SelectStmt) genScanSpecs(tx *SQLTx, params map[string]interface{}) (
 		}
 	}
 
+	// rows are ranked by relevance when a full-text index can be used to resolve the query
+	if stmt.where != nil &&
+		len(stmt.indexOn) == 0 &&
+		len(stmt.orderBy) == 0 &&
+		len(stmt.groupBy) == 0 &&
+		tableRef.period.start == nil &&
+		tableRef.period.end == nil {
+
+		fullTextIndex, fullTextQuery := fullTextMatchFor(stmt.where, table, tableRef.Alias())
+		if fullTextIndex != nil {
+			return &ScanSpecs{
+				Index:         fullTextIndex,
+				rangesByColID: rangesByColID,
+				fullTextQuery: fullTextQuery,
+			}, nil
+		}
+	}
+
 	var preferredIndex *Index
 
 	if len(stmt.indexOn) > 0 {
@@ -2696,6 +3083,10 @@ func (stmt *tableRef) Resolve(ctx context.Context, tx *SQLTx, params map[string]
 		return nil, err
 	}
 
+	if scanSpecs != nil && scanSpecs.Index != nil && scanSpecs.Index.IsFullText() {
+		return newFullTextRowReader(tx, params, table, stmt.as, scanSpecs)
+	}
+
 	return newRawRowReader(tx, params, table, stmt.period, stmt.as, scanSpecs)
 }
 
@@ -3860,6 +4251,10 @@ func (stmt *FnDataSourceStmt) Alias() string {
 		{
 			return "indexes"
 		}
+	case GrantsFnCall:
+		{
+			return "grants"
+		}
 	}
 
 	// not reachable
@@ -3888,6 +4283,10 @@ func (stmt *FnDataSourceStmt) Resolve(ctx context.Context, tx *SQLTx, params map
 		{
 			return stmt.resolveListIndexes(ctx, tx, params, scanSpecs)
 		}
+	case GrantsFnCall:
+		{
+			return stmt.resolveListGrants(ctx, tx, params, scanSpecs)
+		}
 	}
 
 	return nil, fmt.Errorf("%w (%s)", ErrFunctionDoesNotExist, stmt.fnCall.fn)
@@ -4098,6 +4497,60 @@ func (stmt *FnDataSourceStmt) resolveListIndexes(ctx context.Context, tx *SQLTx,
 	return newValuesRowReader(tx, params, cols, stmt.Alias(), values)
 }
 
+func (stmt *FnDataSourceStmt) resolveListGrants(ctx context.Context, tx *SQLTx, params map[string]interface{}, _ *ScanSpecs) (RowReader, error) {
+	if len(stmt.fnCall.params) != 1 {
+		return nil, fmt.Errorf("%w: function '%s' expect table name as parameter", ErrIllegalArguments, GrantsFnCall)
+	}
+
+	cols := []ColDescriptor{
+		{
+			Column: "table",
+			Type:   VarcharType,
+		},
+		{
+			Column: "user",
+			Type:   VarcharType,
+		},
+		{
+			Column: "privilege",
+			Type:   VarcharType,
+		},
+	}
+
+	val, err := stmt.fnCall.params[0].substitute(params)
+	if err != nil {
+		return nil, err
+	}
+
+	tableName, err := val.reduce(tx, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if tableName.Type() != VarcharType {
+		return nil, fmt.Errorf("%w: expected '%s' for table name but type '%s' given instead", ErrIllegalArguments, VarcharType, tableName.Type())
+	}
+
+	table, err := tx.catalog.GetTableByName(tableName.RawValue().(string))
+	if err != nil {
+		return nil, err
+	}
+
+	var values [][]ValueExp
+
+	for _, user := range table.Grantees() {
+		for _, p := range table.GrantedPrivileges(user) {
+			values = append(values, []ValueExp{
+				&Varchar{val: table.name},
+				&Varchar{val: user},
+				&Varchar{val: string(p)},
+			})
+		}
+	}
+
+	return newValuesRowReader(tx, params, cols, stmt.Alias(), values)
+}
+
 // DropTableStmt represents a statement to delete a table.
 type DropTableStmt struct {
 	table string
@@ -4161,6 +4614,20 @@ func (stmt *DropTableStmt) execAt(ctx context.Context, tx *SQLTx, params map[str
 		}
 	}
 
+	// delete ttl
+	err = tx.delete(mapKey(tx.sqlPrefix(), catalogTTLPrefix, EncodeID(1), EncodeID(table.id)))
+	if err != nil && !errors.Is(err, store.ErrKeyNotFound) {
+		return nil, err
+	}
+
+	// delete grants
+	for user := range table.grants {
+		err = tx.delete(mapKey(tx.sqlPrefix(), catalogGrantPrefix, EncodeID(1), EncodeID(table.id), []byte(user)))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// delete table
 	mappedKey := mapKey(
 		tx.sqlPrefix(),
@@ -4180,14 +4647,19 @@ func (stmt *DropTableStmt) execAt(ctx context.Context, tx *SQLTx, params map[str
 
 // DropIndexStmt represents a statement to delete a table.
 type DropIndexStmt struct {
-	table   string
-	columns []string
+	table    string
+	columns  []string
+	fullText bool
 }
 
 func NewDropIndexStmt(table string, columns []string) *DropIndexStmt {
 	return &DropIndexStmt{table: table, columns: columns}
 }
 
+func NewDropFullTextIndexStmt(table string, column string) *DropIndexStmt {
+	return &DropIndexStmt{table: table, columns: []string{column}, fullText: true}
+}
+
 func (stmt *DropIndexStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
 	return nil
 }
@@ -4218,7 +4690,12 @@ func (stmt *DropIndexStmt) execAt(ctx context.Context, tx *SQLTx, params map[str
 		cols[i] = col
 	}
 
-	index, err := table.GetIndexByName(indexName(table.name, cols))
+	name := indexName(table.name, cols)
+	if stmt.fullText {
+		name = fullTextIndexName(table.name, cols)
+	}
+
+	index, err := table.GetIndexByName(name)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,524 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+
+	"github.com/codenotary/immudb/embedded/tbtree"
+	"github.com/codenotary/immudb/embedded/watchers"
+)
+
+var ErrBulkLoadInProgress = errors.New("bulk load already in progress")
+
+// BulkLoader writes large amounts of entries into the store bypassing the regular transaction processing.
+// Entries are grouped into transactions as large as permitted, skipping MVCC validations,
+// and their values are directly appended into the value logs.
+// Indexes covering the loaded keys stop indexing from the first of their entries being set,
+// they keep serving reads as of the last indexed transaction while the remaining indexes keep indexing as usual.
+// Once committed, a fresh tree is built bottom-up for each of those indexes by merging in key order
+// their current entries with sorted runs of the newer transactions, the new tree then replaces the index.
+// Transactions created by the loader are regular ones, thus they can be verified as usual.
+type BulkLoader struct {
+	st *ImmuStore
+
+	entries []*EntrySpec
+	keys    map[[sha256.Size]byte]struct{}
+
+	// indexes suspended by the loader, by position
+	suspended []bool
+
+	firstTxID uint64
+	lastTxID  uint64
+	loaded    uint64
+
+	closed bool
+
+	mutex sync.Mutex
+}
+
+// BulkLoadResult describes the transactions created by a bulk load
+type BulkLoadResult struct {
+	FirstTxID uint64
+	LastTxID  uint64
+	Entries   uint64
+}
+
+// BulkLoad starts a bulk load, only one bulk load may be in progress at a time.
+// The returned loader must be either committed or cancelled
+func (s *ImmuStore) BulkLoad(ctx context.Context) (*BulkLoader, error) {
+	s.bulkLoadMutex.Lock()
+	defer s.bulkLoadMutex.Unlock()
+
+	if s.IsClosed() {
+		return nil, ErrAlreadyClosed
+	}
+
+	if s.bulkLoader != nil {
+		return nil, ErrBulkLoadInProgress
+	}
+
+	s.bulkLoader = &BulkLoader{
+		st:        s,
+		entries:   make([]*EntrySpec, 0, s.maxTxEntries),
+		keys:      make(map[[sha256.Size]byte]struct{}, s.maxTxEntries),
+		suspended: make([]bool, len(s.indexers)),
+	}
+
+	return s.bulkLoader, nil
+}
+
+// Set adds an entry to the load, entries don't need to be sorted.
+// A new transaction is written each time the current one gets full or the key was already set in it
+func (l *BulkLoader) Set(ctx context.Context, key []byte, md *KVMetadata, value []byte) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.closed {
+		return ErrAlreadyClosed
+	}
+
+	if len(key) == 0 {
+		return ErrNullKey
+	}
+
+	if len(key) > l.st.maxKeyLen {
+		return ErrMaxKeyLenExceeded
+	}
+
+	if len(value) > l.st.maxValueLen {
+		return ErrMaxValueLenExceeded
+	}
+
+	// the index is suspended before any of its entries is written by the loader
+	if md == nil || !md.NonIndexable() {
+		i := l.st.indexerFor(key)
+
+		if !l.suspended[i] {
+			err := l.st.indexers[i].suspendForBulkLoad()
+			if err != nil {
+				return err
+			}
+
+			l.suspended[i] = true
+		}
+	}
+
+	kid := sha256.Sum256(key)
+
+	_, isKeyUpdate := l.keys[kid]
+
+	if isKeyUpdate || len(l.entries) == l.st.maxTxEntries {
+		err := l.flush(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	l.entries = append(l.entries, &EntrySpec{Key: key, Metadata: md, Value: value})
+	l.keys[kid] = struct{}{}
+
+	return nil
+}
+
+// flush writes the pending entries as a new transaction
+func (l *BulkLoader) flush(ctx context.Context) error {
+	if len(l.entries) == 0 {
+		return nil
+	}
+
+	hdr, err := l.st.bulkPrecommit(ctx, l.entries)
+	if err != nil {
+		return err
+	}
+
+	if l.firstTxID == 0 {
+		l.firstTxID = hdr.ID
+	}
+
+	l.lastTxID = hdr.ID
+	l.loaded += uint64(len(l.entries))
+
+	l.entries = make([]*EntrySpec, 0, l.st.maxTxEntries)
+	l.keys = make(map[[sha256.Size]byte]struct{}, l.st.maxTxEntries)
+
+	return nil
+}
+
+// Commit writes the pending entries, waits for all the loaded transactions to be committed
+// and indexes them before resuming regular indexing
+func (l *BulkLoader) Commit(ctx context.Context) (*BulkLoadResult, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.closed {
+		return nil, ErrAlreadyClosed
+	}
+
+	err := l.flush(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if l.lastTxID == 0 {
+		l.close(false)
+		return nil, ErrNoEntriesProvided
+	}
+
+	err = l.st.commitWHub.WaitFor(ctx, l.lastTxID)
+	if errors.Is(err, watchers.ErrAlreadyClosed) {
+		return nil, ErrAlreadyClosed
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	l.close(true)
+
+	return &BulkLoadResult{
+		FirstTxID: l.firstTxID,
+		LastTxID:  l.lastTxID,
+		Entries:   l.loaded,
+	}, nil
+}
+
+// Cancel discards the entries not yet written and resumes regular indexing.
+// Transactions already written by the loader are kept and indexed as usual
+func (l *BulkLoader) Cancel() error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.closed {
+		return ErrAlreadyClosed
+	}
+
+	l.close(false)
+
+	return nil
+}
+
+func (l *BulkLoader) close(bulkIndexing bool) {
+	l.closed = true
+
+	upToTxID := uint64(0)
+	workers := 0
+
+	// indexes can't be bulk-loaded when remote storage is used
+	if bulkIndexing && !l.st.compactionDisabled {
+		upToTxID = l.st.LastCommittedTxID()
+		workers = runtime.NumCPU()
+	}
+
+	for i, indexer := range l.st.indexers {
+		if l.suspended[i] {
+			indexer.resumeAfterBulkLoad(upToTxID, workers)
+		}
+	}
+
+	l.st.bulkLoadMutex.Lock()
+	l.st.bulkLoader = nil
+	l.st.bulkLoadMutex.Unlock()
+}
+
+// abortBulkLoad cancels the ongoing bulk load, if any
+func (s *ImmuStore) abortBulkLoad() {
+	s.bulkLoadMutex.Lock()
+	loader := s.bulkLoader
+	s.bulkLoadMutex.Unlock()
+
+	if loader != nil {
+		loader.Cancel()
+	}
+}
+
+// bulkPrecommit writes a new transaction with the provided entries,
+// values are appended into the value logs without any validation against the current state
+func (s *ImmuStore) bulkPrecommit(ctx context.Context, entries []*EntrySpec) (*TxHeader, error) {
+	err := s.validateEntries(entries)
+	if err != nil {
+		return nil, err
+	}
+
+	// the number of pre-committed transactions is kept below the limit
+	lastPrecommittedTxID := s.LastPrecommittedTxID()
+
+	if s.synced && lastPrecommittedTxID >= uint64(s.maxActiveTransactions) {
+		err = s.commitWHub.WaitFor(ctx, lastPrecommittedTxID-uint64(s.maxActiveTransactions)+1)
+		if errors.Is(err, watchers.ErrAlreadyClosed) {
+			return nil, ErrAlreadyClosed
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := s.fetchAllocTx()
+	if err != nil {
+		return nil, err
+	}
+	defer s.releaseAllocTx(tx)
+
+	tx.header.Version = s.writeTxHeaderVersion
+	tx.header.Metadata = nil
+	tx.header.NEntries = len(entries)
+
+	for i, e := range entries {
+		txe := tx.entries[i]
+		txe.setKey(e.Key)
+		txe.md = e.Metadata
+		txe.vLen = len(e.Value)
+		txe.hVal = sha256.Sum256(e.Value)
+	}
+
+	err = tx.BuildHashTree()
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.embeddedValues {
+		offsets, err := s.appendValuesIntoAnyVLog(entries)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range entries {
+			tx.entries[i].vOff = offsets[i]
+		}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.closed {
+		return nil, ErrAlreadyClosed
+	}
+
+	err = s.performPrecommit(tx, entries, s.timeFunc().Unix(), s.aht.Size())
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.Header(), nil
+}
+
+// suspendForBulkLoad stops indexing until resumeAfterBulkLoad is called,
+// compactions and rebuilds of the index are held off meanwhile
+func (idx *indexer) suspendForBulkLoad() error {
+	idx.compactionMutex.Lock()
+
+	idx.mutex.Lock()
+	defer idx.mutex.Unlock()
+
+	if idx.closed {
+		idx.compactionMutex.Unlock()
+		return ErrAlreadyClosed
+	}
+
+	idx.stop()
+
+	return nil
+}
+
+// resumeAfterBulkLoad replaces the index with a tree built with the given number of workers
+// including the transactions up to upToTxID, and resumes indexing.
+// Pending transactions are indexed as usual when no workers are provided
+func (idx *indexer) resumeAfterBulkLoad(upToTxID uint64, workers int) {
+	defer idx.compactionMutex.Unlock()
+
+	// the index can not be replaced while compactionMutex is held
+	idx.mutex.Lock()
+	index := idx.index
+	closed := idx.closed
+	idx.mutex.Unlock()
+
+	if closed {
+		return
+	}
+
+	var tree *tbtree.TBtree
+	var err error
+
+	if workers > 0 && index.Ts() < upToTxID {
+		idx.store.logger.Infof("Building index '%s' with loaded transactions using %d workers...", idx.path, workers)
+
+		tree, err = idx.buildWithLoaded(context.Background(), index, upToTxID, workers)
+		if err != nil {
+			// the index was not modified, loaded transactions are indexed as usual
+			idx.store.logger.Warningf("%v: while building index '%s' with loaded transactions", err, idx.path)
+		}
+	}
+
+	idx.mutex.Lock()
+	defer idx.mutex.Unlock()
+
+	if tree != nil {
+		if idx.closed {
+			idx.discardRebuild(tree)
+			return
+		}
+
+		err = idx.installRebuild(tree)
+		if err != nil {
+			// the index may have been removed, thus it's indexed again from scratch
+			idx.store.logger.Warningf("%v: while replacing index '%s', rebuilding the index", err, idx.path)
+
+			err = idx.reset()
+			if err != nil {
+				idx.store.logger.Errorf("%v: while rebuilding index '%s'", err, idx.path)
+			}
+		}
+	}
+
+	idx.resume()
+}
+
+// buildWithLoaded builds bottom-up a fresh copy of the index including the transactions up to upToTxID.
+// The entries of the index are dumped as a sorted run, which is merged in key order with
+// the sorted runs of the newer transactions generated in parallel
+func (idx *indexer) buildWithLoaded(ctx context.Context, index *tbtree.TBtree, upToTxID uint64, workers int) (*tbtree.TBtree, error) {
+	rebuildPath := idx.path + rebuildDirSuffix
+	runsPath := idx.path + runsDirSuffix
+
+	// leftovers of an interrupted build are discarded
+	err := os.RemoveAll(rebuildPath)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.RemoveAll(runsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(runsPath, idx.fileMode)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(runsPath)
+
+	partitionRuns, err := idx.buildSortedRuns(ctx, runsPath, 1, workers, index.Ts()+1, upToTxID)
+	if err != nil {
+		return nil, err
+	}
+
+	indexRun := filepath.Join(runsPath, "index")
+
+	err = dumpIndexRun(ctx, index, indexRun)
+	if err != nil {
+		return nil, err
+	}
+
+	runs, err := reduceRuns(ctx, runsPath, "all", append(partitionRuns[0], indexRun))
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := tbtree.Open(rebuildPath, index.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	builder, err := tree.NewBuilder()
+	if err == nil {
+		err = mergeRuns(ctx, runs, builder.Add)
+	}
+	if err == nil {
+		err = builder.Commit()
+	}
+	if err == nil && tree.Ts() < upToTxID {
+		// logical time of the index is moved up to the last transaction even if it didn't include indexable entries
+		err = tree.IncreaseTs(upToTxID)
+	}
+	if err != nil {
+		idx.discardRebuild(tree)
+		return nil, err
+	}
+
+	idx.store.logger.Infof("Index '%s' built up to transaction %d", idx.path, upToTxID)
+
+	return tree, nil
+}
+
+// dumpIndexRun writes the entries of the index into a new sorted run.
+// Every update of a key is written with its latest value, as only the latest value of a key is kept by the index
+func dumpIndexRun(ctx context.Context, index *tbtree.TBtree, path string) error {
+	snap, err := index.SnapshotMustIncludeTs(index.Ts())
+	if err != nil {
+		return err
+	}
+	defer snap.Close()
+
+	reader, err := snap.NewReader(tbtree.ReaderSpec{})
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+
+	for i := 0; ; i++ {
+		if i%rebuildLoadBulkSize == 0 && ctx.Err() != nil {
+			f.Close()
+			return ctx.Err()
+		}
+
+		key, value, _, hc, err := reader.Read()
+		if errors.Is(err, tbtree.ErrNoMoreEntries) {
+			break
+		}
+		if err != nil {
+			f.Close()
+			return err
+		}
+
+		for offset := uint64(0); offset < hc; offset += rebuildLoadBulkSize {
+			tss, _, err := snap.History(key, offset, false, rebuildLoadBulkSize)
+			if err != nil {
+				f.Close()
+				return err
+			}
+
+			for _, ts := range tss {
+				err = writeKVT(w, &tbtree.KVT{K: key, V: value, T: ts})
+				if err != nil {
+					f.Close()
+					return err
+				}
+			}
+		}
+	}
+
+	err = w.Flush()
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,309 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkLoad(t *testing.T) {
+	opts := DefaultOptions().
+		WithMaxTxEntries(16).
+		WithIndexes(&IndexSpec{Name: "sql", Prefix: []byte("sql.")})
+
+	st, err := Open(t.TempDir(), opts)
+	require.NoError(t, err)
+	defer st.Close()
+
+	tx, err := st.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key3"), nil, []byte("initial"))
+	require.NoError(t, err)
+
+	initialHdr, err := tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	t.Run("empty bulk load should fail", func(t *testing.T) {
+		loader, err := st.BulkLoad(context.Background())
+		require.NoError(t, err)
+
+		_, err = loader.Commit(context.Background())
+		require.ErrorIs(t, err, ErrNoEntriesProvided)
+
+		err = loader.Cancel()
+		require.ErrorIs(t, err, ErrAlreadyClosed)
+	})
+
+	t.Run("cancelled bulk load should discard pending entries", func(t *testing.T) {
+		loader, err := st.BulkLoad(context.Background())
+		require.NoError(t, err)
+
+		err = loader.Set(context.Background(), []byte("cancelled"), nil, []byte("value"))
+		require.NoError(t, err)
+
+		err = loader.Cancel()
+		require.NoError(t, err)
+
+		err = loader.Set(context.Background(), []byte("cancelled"), nil, []byte("value"))
+		require.ErrorIs(t, err, ErrAlreadyClosed)
+
+		require.Equal(t, initialHdr.ID, st.LastCommittedTxID())
+	})
+
+	const keyCount = 100
+
+	loader, err := st.BulkLoad(context.Background())
+	require.NoError(t, err)
+
+	_, err = st.BulkLoad(context.Background())
+	require.ErrorIs(t, err, ErrBulkLoadInProgress)
+
+	err = loader.Set(context.Background(), nil, nil, []byte("value"))
+	require.ErrorIs(t, err, ErrNullKey)
+
+	perm := rand.Perm(keyCount)
+
+	// key1 is loaded first so that its update is always written into the last transaction
+	for i, k := range perm {
+		if k == 1 {
+			perm[0], perm[i] = perm[i], perm[0]
+			break
+		}
+	}
+
+	for _, k := range perm {
+		prefix := "key"
+		if k%2 == 0 {
+			prefix = "sql.key"
+		}
+
+		err = loader.Set(context.Background(), []byte(fmt.Sprintf("%s%d", prefix, k)), nil, []byte(fmt.Sprintf("value%d", k)))
+		require.NoError(t, err)
+	}
+
+	// keys updated within the load are written into a following transaction
+	err = loader.Set(context.Background(), []byte("key1"), nil, []byte("updated"))
+	require.NoError(t, err)
+
+	res, err := loader.Commit(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, initialHdr.ID+1, res.FirstTxID)
+	require.Equal(t, uint64(keyCount+1), res.Entries)
+	require.Equal(t, res.FirstTxID+keyCount/16, res.LastTxID)
+	require.Equal(t, res.LastTxID, st.LastCommittedTxID())
+
+	err = st.WaitForIndexingUpto(context.Background(), res.LastTxID)
+	require.NoError(t, err)
+
+	for _, indexName := range st.IndexNames() {
+		indexedUpTo, err := st.IndexInfoFor(indexName)
+		require.NoError(t, err)
+		require.Equal(t, res.LastTxID, indexedUpTo)
+	}
+
+	for k := 0; k < keyCount; k++ {
+		prefix := "key"
+		if k%2 == 0 {
+			prefix = "sql.key"
+		}
+
+		valRef, err := st.Get([]byte(fmt.Sprintf("%s%d", prefix, k)))
+		require.NoError(t, err)
+
+		val, err := valRef.Resolve()
+		require.NoError(t, err)
+
+		if k == 1 {
+			require.Equal(t, []byte("updated"), val)
+			require.Equal(t, res.LastTxID, valRef.Tx())
+		} else {
+			require.Equal(t, []byte(fmt.Sprintf("value%d", k)), val)
+		}
+	}
+
+	txs, _, err := st.History([]byte("key3"), 0, false, 10)
+	require.NoError(t, err)
+	require.Len(t, txs, 2)
+	require.Equal(t, initialHdr.ID, txs[0])
+
+	t.Run("loaded transactions should be verifiable", func(t *testing.T) {
+		lastHdr, err := st.ReadTxHeader(res.LastTxID, false, false)
+		require.NoError(t, err)
+
+		proof, err := st.DualProof(initialHdr, lastHdr)
+		require.NoError(t, err)
+
+		verifies := VerifyDualProof(proof, initialHdr.ID, lastHdr.ID, initialHdr.Alh(), lastHdr.Alh())
+		require.True(t, verifies)
+
+		tx := NewTx(st.MaxTxEntries(), st.MaxKeyLen())
+
+		for txID := res.FirstTxID; txID <= res.LastTxID; txID++ {
+			err = st.ReadTx(txID, false, tx)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("regular indexing should be resumed", func(t *testing.T) {
+		tx, err := st.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte("key3"), nil, []byte("final"))
+		require.NoError(t, err)
+
+		hdr, err := tx.Commit(context.Background())
+		require.NoError(t, err)
+
+		err = st.WaitForIndexingUpto(context.Background(), hdr.ID)
+		require.NoError(t, err)
+
+		valRef, err := st.Get([]byte("key3"))
+		require.NoError(t, err)
+		require.Equal(t, hdr.ID, valRef.Tx())
+		require.Equal(t, uint64(3), valRef.HC())
+	})
+
+	t.Run("indexes not covering loaded keys should keep indexing", func(t *testing.T) {
+		loader, err := st.BulkLoad(context.Background())
+		require.NoError(t, err)
+
+		err = loader.Set(context.Background(), []byte("sql.loaded"), nil, []byte("value"))
+		require.NoError(t, err)
+
+		tx, err := st.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte("key4"), nil, []byte("final"))
+		require.NoError(t, err)
+
+		// committing with Commit would wait for the suspended index
+		hdr, err := tx.AsyncCommit(context.Background())
+		require.NoError(t, err)
+
+		err = st.WaitForIndexingUpto(context.Background(), hdr.ID, DefaultIndexName)
+		require.NoError(t, err)
+
+		valRef, err := st.Get([]byte("key4"))
+		require.NoError(t, err)
+		require.Equal(t, hdr.ID, valRef.Tx())
+
+		indexedUpTo, err := st.IndexInfoFor("sql")
+		require.NoError(t, err)
+		require.Less(t, indexedUpTo, hdr.ID)
+
+		res, err := loader.Commit(context.Background())
+		require.NoError(t, err)
+
+		err = st.WaitForIndexingUpto(context.Background(), res.LastTxID)
+		require.NoError(t, err)
+
+		valRef, err = st.Get([]byte("sql.loaded"))
+		require.NoError(t, err)
+		require.Equal(t, res.LastTxID, valRef.Tx())
+	})
+
+	t.Run("bulk load should be cancelled when the store is closed", func(t *testing.T) {
+		loader, err := st.BulkLoad(context.Background())
+		require.NoError(t, err)
+
+		err = st.Close()
+		require.NoError(t, err)
+
+		_, err = loader.Commit(context.Background())
+		require.ErrorIs(t, err, ErrAlreadyClosed)
+
+		_, err = st.BulkLoad(context.Background())
+		require.ErrorIs(t, err, ErrAlreadyClosed)
+	})
+}
+
+func TestIndexBuildWithLoadedTransactions(t *testing.T) {
+	st, err := Open(t.TempDir(), DefaultOptions())
+	require.NoError(t, err)
+	defer st.Close()
+
+	const txCount = 50
+	const keyCount = 10
+
+	set := func(i int) uint64 {
+		tx, err := st.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte(fmt.Sprintf("key%d", i%keyCount)), nil, []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+
+		hdr, err := tx.AsyncCommit(context.Background())
+		require.NoError(t, err)
+
+		return hdr.ID
+	}
+
+	for i := 0; i < txCount/2; i++ {
+		set(i)
+	}
+
+	err = st.WaitForIndexingUpto(context.Background(), txCount/2)
+	require.NoError(t, err)
+
+	idx := st.indexers[0]
+
+	err = idx.suspendForBulkLoad()
+	require.NoError(t, err)
+
+	var lastTxID uint64
+	for i := txCount / 2; i < txCount; i++ {
+		lastTxID = set(i)
+	}
+
+	err = st.commitWHub.WaitFor(context.Background(), lastTxID)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(txCount/2), idx.Ts())
+
+	tree, err := idx.buildWithLoaded(context.Background(), idx.index, lastTxID, 4)
+	require.NoError(t, err)
+
+	require.Equal(t, lastTxID, tree.Ts())
+
+	for k := 0; k < keyCount; k++ {
+		key := []byte(fmt.Sprintf("key%d", k))
+
+		_, ts, hc, err := tree.Get(key)
+		require.NoError(t, err)
+		require.Equal(t, uint64(txCount-keyCount+k+1), ts)
+		require.Equal(t, uint64(txCount/keyCount), hc)
+
+		tss, _, err := tree.History(key, 0, false, txCount)
+		require.NoError(t, err)
+
+		for i, ts := range tss {
+			require.Equal(t, uint64(i*keyCount+k+1), ts)
+		}
+	}
+
+	idx.discardRebuild(tree)
+	idx.resumeAfterBulkLoad(lastTxID, 0)
+
+	err = st.WaitForIndexingUpto(context.Background(), lastTxID)
+	require.NoError(t, err)
+}
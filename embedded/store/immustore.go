@@ -97,6 +97,9 @@ var ErrInvalidPreconditionInvalidTxID = fmt.Errorf("%w: invalid transaction ID",
 var ErrSourceTxNewerThanTargetTx = fmt.Errorf("%w: source tx is newer than target tx", ErrIllegalArguments)
 
 var ErrCompactionUnsupported = errors.New("compaction is unsupported when remote storage is used")
+var ErrIndexRebuildUnsupported = errors.New("index rebuild is unsupported when remote storage is used")
+var ErrIndexNotFound = errors.New("index not found")
+var ErrValueRangeOutOfBounds = fmt.Errorf("%w: value range out of bounds", ErrIllegalArguments)
 
 var ErrMetadataUnsupported = errors.New(
 	"metadata is unsupported when in 1.1 compatibility mode, " +
@@ -137,7 +140,9 @@ const (
 )
 
 const indexDirname = "index"
+const indexesDirname = "indexes"
 const ahtDirname = "aht"
+const relocationsDirname = "relocations"
 
 type ImmuStore struct {
 	path string
@@ -172,8 +177,11 @@ type ImmuStore struct {
 
 	commitStateRWMutex sync.RWMutex
 
-	embeddedValues        bool
-	preallocFiles         bool
+	embeddedValues bool
+	preallocFiles  bool
+
+	// wraps the appendables of the indexes and trees, e.g. to encrypt them
+	appWrapper            multiapp.AppendableWrapper
 	readOnly              bool
 	synced                bool
 	syncFrequency         time.Duration
@@ -207,13 +215,22 @@ type ImmuStore struct {
 	durablePrecommitWHub *watchers.WatchersHub
 	commitWHub           *watchers.WatchersHub
 
-	indexer *indexer
+	indexer  *indexer   // default index
+	indexers []*indexer // all the indexes, starting with the default one
 
 	closed bool
 
 	mutex sync.Mutex
 
 	compactionDisabled bool
+
+	bulkLoader    *BulkLoader // ongoing bulk load, if any
+	bulkLoadMutex sync.Mutex
+
+	relocations         *tbtree.TBtree // original value offsets to the ones of values moved by compaction
+	relocationsOpts     *tbtree.Options
+	relocationsMutex    sync.RWMutex
+	vLogCompactionMutex sync.Mutex
 }
 
 type refVLog struct {
@@ -250,6 +267,11 @@ func Open(path string, opts *Options) (*ImmuStore, error) {
 	metadata.PutInt(metaMaxValueLen, opts.MaxValueLen)
 	metadata.PutInt(metaFileSize, opts.FileSize)
 
+	appWrapper, err := opts.appendableWrapper()
+	if err != nil {
+		return nil, err
+	}
+
 	appendableOpts := multiapp.DefaultOptions().
 		WithReadOnly(opts.ReadOnly).
 		WithWriteBufferSize(opts.WriteBufferSize).
@@ -257,7 +279,8 @@ func Open(path string, opts *Options) (*ImmuStore, error) {
 		WithAutoSync(true).
 		WithFileSize(opts.FileSize).
 		WithFileMode(opts.FileMode).
-		WithMetadata(metadata.Bytes())
+		WithMetadata(metadata.Bytes()).
+		WithAppendableWrapper(appWrapper)
 
 	appFactory := opts.appFactory
 	if appFactory == nil {
@@ -303,6 +326,7 @@ func Open(path string, opts *Options) (*ImmuStore, error) {
 		appendableOpts.WithPrealloc(false)
 		appendableOpts.WithCompressionFormat(opts.CompressionFormat)
 		appendableOpts.WithCompresionLevel(opts.CompressionLevel)
+		appendableOpts.WithCompressionDictionary(opts.CompressionDictionary)
 		appendableOpts.WithMaxOpenedFiles(opts.VLogMaxOpenedFiles)
 
 		for i := 0; i < opts.MaxIOConcurrency; i++ {
@@ -574,6 +598,11 @@ func OpenWith(path string, vLogs []appendable.Appendable, txLog, cLog appendable
 
 	ahtPath := filepath.Join(path, ahtDirname)
 
+	appWrapper, err := opts.appendableWrapper()
+	if err != nil {
+		return nil, err
+	}
+
 	ahtOpts := ahtree.DefaultOptions().
 		WithReadOnly(opts.ReadOnly).
 		WithFileMode(opts.FileMode).
@@ -581,7 +610,8 @@ func OpenWith(path string, vLogs []appendable.Appendable, txLog, cLog appendable
 		WithRetryableSync(opts.Synced).
 		WithAutoSync(true).
 		WithWriteBufferSize(opts.AHTOpts.WriteBufferSize).
-		WithSyncThld(opts.AHTOpts.SyncThld)
+		WithSyncThld(opts.AHTOpts.SyncThld).
+		WithAppendableWrapper(appWrapper)
 
 	if opts.appFactory != nil {
 		ahtOpts.WithAppFactory(func(rootPath, subPath string, appOpts *multiapp.Options) (appendable.Appendable, error) {
@@ -623,6 +653,7 @@ func OpenWith(path string, vLogs []appendable.Appendable, txLog, cLog appendable
 
 		embeddedValues: embeddedValues,
 		preallocFiles:  preallocFiles,
+		appWrapper:     appWrapper,
 
 		readOnly:              opts.ReadOnly,
 		synced:                opts.Synced,
@@ -690,20 +721,61 @@ func OpenWith(path string, vLogs []appendable.Appendable, txLog, cLog appendable
 		return nil, err
 	}
 
+	store.relocationsOpts = tbtree.DefaultOptions().
+		WithReadOnly(opts.ReadOnly).
+		WithFileMode(opts.FileMode).
+		WithLogger(opts.logger).
+		WithFileSize(opts.FileSize).
+		WithMaxKeySize(offsetSize).
+		WithMaxValueSize(offsetSize).
+		WithAppendableWrapper(store.appWrapper)
+
+	// the relocation table is only created once value logs get compacted
+	_, err = os.Stat(filepath.Join(store.path, relocationsDirname))
+	if err == nil && !embeddedValues {
+		store.relocations, err = tbtree.Open(filepath.Join(store.path, relocationsDirname), store.relocationsOpts)
+		if err != nil {
+			store.Close()
+			return nil, fmt.Errorf("could not open relocation table: %w", err)
+		}
+	}
+
 	indexPath := filepath.Join(store.path, indexDirname)
 
-	store.indexer, err = newIndexer(indexPath, store, opts)
+	store.indexer, err = newIndexer(indexPath, nil, store, opts)
 	if err != nil {
 		store.Close()
 		return nil, fmt.Errorf("could not open indexer: %w", err)
 	}
 
-	if store.indexer.Ts() > committedTxID {
-		store.Close()
-		return nil, fmt.Errorf("corrupted commit log: index size is too large: %w", ErrCorruptedCLog)
+	store.indexers = append(store.indexers, store.indexer)
+
+	if len(opts.Indexes) > 0 && !opts.ReadOnly {
+		err = os.MkdirAll(filepath.Join(store.path, indexesDirname), opts.FileMode)
+		if err != nil {
+			store.Close()
+			return nil, err
+		}
+	}
+
+	for _, spec := range opts.Indexes {
+		indexer, err := newIndexer(filepath.Join(store.path, indexesDirname, spec.Name), spec, store, opts)
+		if err != nil {
+			store.Close()
+			return nil, fmt.Errorf("could not open indexer '%s': %w", spec.Name, err)
+		}
 
-		// TODO: if indexing is done on pre-committed txs, the index may be rollback to a previous snapshot where it was already synced
-		// NOTE: compaction should preserve snapshot which are not synced... so to ensure rollback can be achieved
+		store.indexers = append(store.indexers, indexer)
+	}
+
+	for _, indexer := range store.indexers {
+		if indexer.Ts() > committedTxID {
+			store.Close()
+			return nil, fmt.Errorf("corrupted commit log: index size is too large: %w", ErrCorruptedCLog)
+
+			// TODO: if indexing is done on pre-committed txs, the index may be rollback to a previous snapshot where it was already synced
+			// NOTE: compaction should preserve snapshot which are not synced... so to ensure rollback can be achieved
+		}
 	}
 
 	if store.synced {
@@ -782,8 +854,159 @@ func (s *ImmuStore) notify(nType NotificationType, mandatory bool, formattedMess
 	}
 }
 
+// IndexInfo returns the ID of the last transaction indexed by all the indexes
 func (s *ImmuStore) IndexInfo() uint64 {
-	return s.indexer.Ts()
+	ts := s.indexer.Ts()
+
+	for _, indexer := range s.indexers[1:] {
+		its := indexer.Ts()
+		if its < ts {
+			ts = its
+		}
+	}
+
+	return ts
+}
+
+// IndexNames returns the names of the indexes of the store, starting with the default one
+func (s *ImmuStore) IndexNames() []string {
+	names := make([]string, len(s.indexers))
+
+	for i, indexer := range s.indexers {
+		names[i] = indexer.name
+	}
+
+	return names
+}
+
+// IndexInfoFor returns the ID of the last transaction indexed by the named index
+func (s *ImmuStore) IndexInfoFor(indexName string) (uint64, error) {
+	indexer, err := s.indexerByName(indexName)
+	if err != nil {
+		return 0, err
+	}
+
+	return indexer.Ts(), nil
+}
+
+func (s *ImmuStore) indexerByName(indexName string) (*indexer, error) {
+	for _, indexer := range s.indexers {
+		if indexer.name == indexName {
+			return indexer, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: '%s'", ErrIndexNotFound, indexName)
+}
+
+// indexerFor returns the position of the index covering the key,
+// the one with the longest matching prefix
+func (s *ImmuStore) indexerFor(key []byte) int {
+	for i := len(s.indexers) - 1; i > 0; i-- {
+		if s.indexers[i].indexes(key) {
+			return i
+		}
+	}
+
+	return 0
+}
+
+// indexersForRange returns the positions of the indexes holding keys starting with prefix,
+// a single one when it covers all of them
+func (s *ImmuStore) indexersForRange(prefix []byte) []int {
+	for i := len(s.indexers) - 1; i >= 0; i-- {
+		if s.indexers[i].coversRange(prefix) {
+			return []int{i}
+		}
+	}
+
+	var indexes []int
+
+	for i, indexer := range s.indexers {
+		if indexer.overlapsRange(prefix) {
+			indexes = append(indexes, i)
+		}
+	}
+
+	return indexes
+}
+
+// lowestWithPrefix returns the lowest key found by getWithPrefix among the given indexes,
+// keys are held by a single index thus no further ordering is needed
+func lowestWithPrefix(indexes []int, getWithPrefix func(i int) ([]byte, []byte, uint64, uint64, error)) (key []byte, value []byte, tx uint64, hc uint64, err error) {
+	for _, i := range indexes {
+		k, v, t, h, err := getWithPrefix(i)
+		if errors.Is(err, ErrKeyNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, 0, 0, err
+		}
+
+		if key == nil || bytes.Compare(k, key) < 0 {
+			key, value, tx, hc = k, v, t, h
+		}
+	}
+
+	if key == nil {
+		return nil, nil, 0, 0, ErrKeyNotFound
+	}
+
+	return key, value, tx, hc, nil
+}
+
+// PauseIndexing stops indexing new transactions into the named index until it's resumed
+func (s *ImmuStore) PauseIndexing(indexName string) error {
+	indexer, err := s.indexerByName(indexName)
+	if err != nil {
+		return err
+	}
+
+	indexer.Pause()
+
+	return nil
+}
+
+// ResumeIndexing resumes indexing of transactions into the named index
+func (s *ImmuStore) ResumeIndexing(indexName string) error {
+	indexer, err := s.indexerByName(indexName)
+	if err != nil {
+		return err
+	}
+
+	indexer.Resume()
+
+	return nil
+}
+
+// RebuildIndex discards the content of the named index and indexes again all the transactions.
+// Reads served by the index may return partial results until it catches up
+func (s *ImmuStore) RebuildIndex(indexName string) error {
+	if s.compactionDisabled {
+		return ErrIndexRebuildUnsupported
+	}
+
+	indexer, err := s.indexerByName(indexName)
+	if err != nil {
+		return err
+	}
+
+	return indexer.Rebuild()
+}
+
+// IndexRebuildProgress returns whether any index is being rebuilt in parallel,
+// and how many of the transactions to be indexed were already processed
+func (s *ImmuStore) IndexRebuildProgress() (inProgress bool, processedTxs, totalTxs uint64) {
+	for _, indexer := range s.indexers {
+		rebuilding, processed, total := indexer.RebuildProgress()
+		if rebuilding {
+			inProgress = true
+			processedTxs += processed
+			totalTxs += total
+		}
+	}
+
+	return inProgress, processedTxs, totalTxs
 }
 
 func (s *ImmuStore) Get(key []byte) (valRef ValueRef, err error) {
@@ -791,7 +1014,7 @@ func (s *ImmuStore) Get(key []byte) (valRef ValueRef, err error) {
 }
 
 func (s *ImmuStore) GetWithFilters(key []byte, filters ...FilterFn) (valRef ValueRef, err error) {
-	indexedVal, tx, hc, err := s.indexer.Get(key)
+	indexedVal, tx, hc, err := s.indexers[s.indexerFor(key)].Get(key)
 	if err != nil {
 		return nil, err
 	}
@@ -822,7 +1045,9 @@ func (s *ImmuStore) GetWithPrefix(prefix []byte, neq []byte) (key []byte, valRef
 }
 
 func (s *ImmuStore) GetWithPrefixAndFilters(prefix []byte, neq []byte, filters ...FilterFn) (key []byte, valRef ValueRef, err error) {
-	key, indexedVal, tx, hc, err := s.indexer.GetWithPrefix(prefix, neq)
+	key, indexedVal, tx, hc, err := lowestWithPrefix(s.indexersForRange(prefix), func(i int) ([]byte, []byte, uint64, uint64, error) {
+		return s.indexers[i].GetWithPrefix(prefix, neq)
+	})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -849,7 +1074,7 @@ func (s *ImmuStore) GetWithPrefixAndFilters(prefix []byte, neq []byte, filters .
 }
 
 func (s *ImmuStore) History(key []byte, offset uint64, descOrder bool, limit int) (txs []uint64, hCount uint64, err error) {
-	return s.indexer.History(key, offset, descOrder, limit)
+	return s.indexers[s.indexerFor(key)].History(key, offset, descOrder, limit)
 }
 
 func (s *ImmuStore) UseTimeFunc(timeFunc TimeFunc) error {
@@ -874,30 +1099,39 @@ func (s *ImmuStore) NewTxHolderPool(poolSize int, preallocated bool) (TxPool, er
 	})
 }
 
-func (s *ImmuStore) syncSnapshot() (*Snapshot, error) {
-	snap, err := s.indexer.index.SyncSnapshot()
-	if err != nil {
-		return nil, err
+// newSnapshot takes a snapshot of every index
+func (s *ImmuStore) newSnapshot(snapshotFn func(indexer *indexer) (*tbtree.Snapshot, error)) (*Snapshot, error) {
+	snaps := make([]*tbtree.Snapshot, len(s.indexers))
+
+	for i, indexer := range s.indexers {
+		snap, err := snapshotFn(indexer)
+		if err != nil {
+			for _, snap := range snaps[:i] {
+				snap.Close()
+			}
+			return nil, err
+		}
+
+		snaps[i] = snap
 	}
 
 	return &Snapshot{
-		st:   s,
-		snap: snap,
-		ts:   time.Now(),
+		st:    s,
+		snaps: snaps,
+		ts:    time.Now(),
 	}, nil
 }
 
-func (s *ImmuStore) Snapshot() (*Snapshot, error) {
-	snap, err := s.indexer.Snapshot()
-	if err != nil {
-		return nil, err
-	}
+func (s *ImmuStore) syncSnapshot() (*Snapshot, error) {
+	return s.newSnapshot(func(indexer *indexer) (*tbtree.Snapshot, error) {
+		return indexer.index.SyncSnapshot()
+	})
+}
 
-	return &Snapshot{
-		st:   s,
-		snap: snap,
-		ts:   time.Now(),
-	}, nil
+func (s *ImmuStore) Snapshot() (*Snapshot, error) {
+	return s.newSnapshot(func(indexer *indexer) (*tbtree.Snapshot, error) {
+		return indexer.Snapshot()
+	})
 }
 
 // SnapshotMustIncludeTxID returns a new snapshot based on an existent dumped root (snapshot reuse).
@@ -921,16 +1155,9 @@ func (s *ImmuStore) SnapshotMustIncludeTxIDWithRenewalPeriod(ctx context.Context
 		return nil, err
 	}
 
-	snap, err := s.indexer.SnapshotMustIncludeTxIDWithRenewalPeriod(txID, renewalPeriod)
-	if err != nil {
-		return nil, err
-	}
-
-	return &Snapshot{
-		st:   s,
-		snap: snap,
-		ts:   time.Now(),
-	}, nil
+	return s.newSnapshot(func(indexer *indexer) (*tbtree.Snapshot, error) {
+		return indexer.SnapshotMustIncludeTxIDWithRenewalPeriod(txID, renewalPeriod)
+	})
 }
 
 func (s *ImmuStore) CommittedAlh() (uint64, [sha256.Size]byte) {
@@ -1034,7 +1261,9 @@ func (s *ImmuStore) WaitForTx(ctx context.Context, txID uint64, allowPrecommitte
 	return err
 }
 
-func (s *ImmuStore) WaitForIndexingUpto(ctx context.Context, txID uint64) error {
+// WaitForIndexingUpto waits until the transaction is indexed by the named indexes,
+// or by all the indexes if no name is provided
+func (s *ImmuStore) WaitForIndexingUpto(ctx context.Context, txID uint64, indexNames ...string) error {
 	s.waiteesMutex.Lock()
 
 	if s.waiteesCount == s.maxWaitees {
@@ -1052,18 +1281,88 @@ func (s *ImmuStore) WaitForIndexingUpto(ctx context.Context, txID uint64) error
 		s.waiteesMutex.Unlock()
 	}()
 
-	return s.indexer.WaitForIndexingUpto(ctx, txID)
+	indexers, err := s.indexersByName(indexNames)
+	if err != nil {
+		return err
+	}
+
+	for _, indexer := range indexers {
+		err := indexer.WaitForIndexingUpto(ctx, txID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-func (s *ImmuStore) CompactIndex() error {
+// CompactIndex compacts the named indexes, or all the indexes if no name is provided
+func (s *ImmuStore) CompactIndex(indexNames ...string) error {
 	if s.compactionDisabled {
 		return ErrCompactionUnsupported
 	}
-	return s.indexer.CompactIndex()
+
+	indexers, err := s.indexersByName(indexNames)
+	if err != nil {
+		return err
+	}
+
+	for _, indexer := range indexers {
+		err := indexer.CompactIndex()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FlushIndex flushes the named indexes, or all the indexes if no name is provided
+func (s *ImmuStore) FlushIndex(cleanupPercentage float32, synced bool, indexNames ...string) error {
+	indexers, err := s.indexersByName(indexNames)
+	if err != nil {
+		return err
+	}
+
+	for _, indexer := range indexers {
+		err := indexer.FlushIndex(cleanupPercentage, synced)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *ImmuStore) indexersByName(indexNames []string) ([]*indexer, error) {
+	if len(indexNames) == 0 {
+		return s.indexers, nil
+	}
+
+	indexers := make([]*indexer, len(indexNames))
+
+	for i, name := range indexNames {
+		indexer, err := s.indexerByName(name)
+		if err != nil {
+			return nil, err
+		}
+
+		indexers[i] = indexer
+	}
+
+	return indexers, nil
 }
 
-func (s *ImmuStore) FlushIndex(cleanupPercentage float32, synced bool) error {
-	return s.indexer.FlushIndex(cleanupPercentage, synced)
+func (s *ImmuStore) pauseIndexing() {
+	for _, indexer := range s.indexers {
+		indexer.Pause()
+	}
+}
+
+func (s *ImmuStore) resumeIndexing() {
+	for _, indexer := range s.indexers {
+		indexer.Resume()
+	}
 }
 
 func maxTxSize(maxTxEntries, maxKeyLen, maxTxMetadataLen, maxKVMetadataLen int) int {
@@ -1921,8 +2220,8 @@ func (s *ImmuStore) preCommitWith(ctx context.Context, callback func(txID uint64
 	}
 	defer otx.Cancel()
 
-	s.indexer.Pause()
-	defer s.indexer.Resume()
+	s.pauseIndexing()
+	defer s.resumeIndexing()
 
 	lastPreCommittedTxID := s.LastPrecommittedTxID()
 
@@ -1946,7 +2245,7 @@ func (s *ImmuStore) preCommitWith(ctx context.Context, callback func(txID uint64
 	}
 
 	if otx.hasPreconditions() {
-		s.indexer.Resume()
+		s.resumeIndexing()
 
 		// Preconditions must be executed with up-to-date tree
 		err = s.WaitForIndexingUpto(ctx, lastPreCommittedTxID)
@@ -1959,7 +2258,7 @@ func (s *ImmuStore) preCommitWith(ctx context.Context, callback func(txID uint64
 			return nil, err
 		}
 
-		s.indexer.Pause()
+		s.pauseIndexing()
 	}
 
 	tx, err := s.fetchAllocTx()
@@ -2863,11 +3162,69 @@ func (s *ImmuStore) ReadValue(entry *TxEntry) ([]byte, error) {
 	return b, nil
 }
 
+// ReadValueRange returns up to length bytes of the value associated to a key at a specific transaction,
+// starting at the given offset within the value. The remaining part of the value is returned when length is zero.
+// Unlike ReadValue, the digest of the value is only validated when the whole value needs to be read
+func (s *ImmuStore) ReadValueRange(entry *TxEntry, offset, length int) ([]byte, error) {
+	if entry == nil || !entry.readonly {
+		return nil, ErrIllegalArguments
+	}
+
+	if entry.md != nil && !entry.md.readonly {
+		return nil, ErrIllegalArguments
+	}
+
+	if entry.md != nil && entry.md.ExpiredAt(time.Now()) {
+		return nil, ErrExpiredEntry
+	}
+
+	return s.readValueRange(entry.vOff, entry.vLen, entry.hVal, offset, length)
+}
+
+func (s *ImmuStore) readValueRange(off int64, vLen int, hvalue [sha256.Size]byte, offset, length int) ([]byte, error) {
+	if offset < 0 || length < 0 {
+		return nil, ErrIllegalArguments
+	}
+
+	if offset > vLen {
+		return nil, ErrValueRangeOutOfBounds
+	}
+
+	if length == 0 || length > vLen-offset {
+		length = vLen - offset
+	}
+
+	if length == 0 {
+		// while not required, nil is returned instead of an empty slice
+		return nil, nil
+	}
+
+	b := make([]byte, length)
+
+	err := s.readValueRangeAt(b, off, vLen, hvalue, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
 // readValueAt fills b with the value referenced by off
 // expected value size and digest may be required for validations to pass
 func (s *ImmuStore) readValueAt(b []byte, off int64, hvalue [sha256.Size]byte, skipIntegrityCheck bool) (n int, err error) {
 	vLogID, offset := decodeOffset(off)
 
+	if !s.embeddedValues && len(b) > 0 {
+		// value logs are not discarded while the value is being read
+		s.relocationsMutex.RLock()
+		defer s.relocationsMutex.RUnlock()
+
+		vLogID, offset, err = s.relocatedOffset(off)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	if !s.embeddedValues && vLogID == 0 && len(b) > 0 {
 		// it means value was not stored on any vlog i.e. a truncated transaction was replicated
 		return 0, io.EOF
@@ -2928,6 +3285,84 @@ func (s *ImmuStore) readValueAt(b []byte, off int64, hvalue [sha256.Size]byte, s
 	return n, nil
 }
 
+// readValueRangeAt fills b with the part of the value referenced by off starting at the given position.
+// Values which can not be partially read are fully read and validated
+func (s *ImmuStore) readValueRangeAt(b []byte, off int64, vLen int, hvalue [sha256.Size]byte, start int) error {
+	if s.vLogCache != nil {
+		val, err := s.vLogCache.Get(off)
+		if err == nil {
+			copy(b, val.([]byte)[start:])
+			return nil
+		}
+		if !errors.Is(err, cache.ErrKeyNotFound) {
+			return err
+		}
+	}
+
+	vLogID, offset := decodeOffset(off)
+
+	if !s.embeddedValues {
+		if vLogID == 0 {
+			// it means value was not stored on any vlog i.e. a truncated transaction was replicated
+			return io.EOF
+		}
+
+		var err error
+
+		// value logs are not discarded while the value is being read
+		s.relocationsMutex.RLock()
+
+		vLogID, offset, err = s.relocatedOffset(off)
+		if err != nil {
+			s.relocationsMutex.RUnlock()
+			return err
+		}
+	}
+
+	compressed, err := s.readRangeFromVLog(b, vLogID, offset+int64(start))
+
+	if !s.embeddedValues {
+		s.relocationsMutex.RUnlock()
+	}
+
+	if err != nil || !compressed {
+		return err
+	}
+
+	// values can not be partially read from compressed value logs
+	val := make([]byte, vLen)
+
+	_, err = s.readValueAt(val, off, hvalue, false)
+	if err != nil {
+		return err
+	}
+
+	copy(b, val[start:])
+
+	return nil
+}
+
+// readRangeFromVLog reads len(b) bytes at the given offset of the value log.
+// Nothing is read if the value log is compressed, which is then reported to the caller
+func (s *ImmuStore) readRangeFromVLog(b []byte, vLogID byte, offset int64) (compressed bool, err error) {
+	vLog, err := s.fetchVLog(vLogID)
+	if err != nil {
+		return false, err
+	}
+	defer s.releaseVLog(vLogID)
+
+	if vLog.CompressionFormat() != appendable.NoCompression {
+		return true, nil
+	}
+
+	_, err = vLog.ReadAt(b, offset)
+	if errors.Is(err, multiapp.ErrAlreadyClosed) || errors.Is(err, singleapp.ErrAlreadyClosed) {
+		return false, ErrAlreadyClosed
+	}
+
+	return false, err
+}
+
 func (s *ImmuStore) validateEntries(entries []*EntrySpec) error {
 	if len(entries) > s.maxTxEntries {
 		return ErrMaxTxEntriesLimitExceeded
@@ -3104,6 +3539,8 @@ func (s *ImmuStore) IsClosed() bool {
 }
 
 func (s *ImmuStore) Close() error {
+	s.abortBulkLoad()
+
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
@@ -3135,8 +3572,8 @@ func (s *ImmuStore) Close() error {
 	err = s.commitWHub.Close()
 	merr.Append(err)
 
-	if s.indexer != nil {
-		err = s.indexer.Close()
+	for _, indexer := range s.indexers {
+		err = indexer.Close()
 		merr.Append(err)
 	}
 
@@ -3149,6 +3586,11 @@ func (s *ImmuStore) Close() error {
 	err = s.aht.Close()
 	merr.Append(err)
 
+	if s.relocations != nil {
+		err = s.relocations.Close()
+		merr.Append(err)
+	}
+
 	used, _, _ := s.txPool.Stats()
 	if used > 0 {
 		merr.Append(errors.New("not all tx holders were released"))
@@ -3264,6 +3706,17 @@ func (s *ImmuStore) TruncateUptoTx(minTxID uint64) error {
 		return nil
 	}
 
+	tombstones, err := s.valueLogTombstones(minTxID)
+	if err != nil {
+		return err
+	}
+
+	return s.discardValueLogs(tombstones)
+}
+
+// valueLogTombstones returns, for each value log, the minimum offset that can be discarded
+// without deleting any value of the transactions from minTxID onwards
+func (s *ImmuStore) valueLogTombstones(minTxID uint64) (map[byte]int64, error) {
 	// tombstones maintain the minimum offset for each value log file that can be safely deleted.
 	tombstones := make(map[byte]int64)
 
@@ -3311,7 +3764,7 @@ func (s *ImmuStore) TruncateUptoTx(minTxID uint64) error {
 			if err != nil && !errors.Is(err, ErrTxEntryIndexOutOfRange) /* tx has entries*/ {
 				// if there is an error reading a transaction, stop the traversal and return the error.
 				s.logger.Errorf("failed to fetch transaction %d {traversal=back, err = %v}", i, err)
-				return err
+				return nil, err
 			}
 			i--
 		}
@@ -3329,11 +3782,20 @@ func (s *ImmuStore) TruncateUptoTx(minTxID uint64) error {
 			err := front(j)
 			if err != nil && !errors.Is(err, ErrTxEntryIndexOutOfRange) /* tx has entries*/ {
 				s.logger.Errorf("failed to fetch transaction %d {traversal=front, err = %v}", j, err)
-				return err
+				return nil, err
 			}
 		}
 	}
 
+	return tombstones, nil
+}
+
+// discardValueLogs deletes the content of each value log up to the offset given by its tombstone
+func (s *ImmuStore) discardValueLogs(tombstones map[byte]int64) error {
+	// values being read must not be discarded in the middle of a relocation lookup
+	s.relocationsMutex.Lock()
+	defer s.relocationsMutex.Unlock()
+
 	// Delete offset from different value logs
 	merr := multierr.NewMultiErr()
 	{
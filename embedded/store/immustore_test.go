@@ -35,6 +35,7 @@ import (
 	"github.com/codenotary/immudb/embedded"
 	"github.com/codenotary/immudb/embedded/ahtree"
 	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/encrypted"
 	"github.com/codenotary/immudb/embedded/appendable/mocked"
 	"github.com/codenotary/immudb/embedded/appendable/multiapp"
 	"github.com/codenotary/immudb/embedded/htree"
@@ -195,6 +196,163 @@ func TestImmudbStoreOpenWithInvalidPath(t *testing.T) {
 	require.ErrorIs(t, err, ErrPathIsNotADirectory)
 }
 
+func TestImmudbStoreWithEncryptedAppendables(t *testing.T) {
+	dir := t.TempDir()
+
+	keys := encrypted.NewKeyRing()
+	require.NoError(t, keys.AddKey("key1", make([]byte, 32)))
+
+	opts := DefaultOptions().
+		WithAppFactory(encrypted.AppFactory(keys, encrypted.DefaultOptions().WithBlockSize(512)))
+
+	immuStore, err := Open(dir, opts)
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		tx, err := immuStore.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte(fmt.Sprintf("key%d", i)), nil, []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+
+		_, err = tx.Commit(context.Background())
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, immuStore.Close())
+
+	// the key is rotated, existing content remains readable
+	require.NoError(t, keys.AddKey("key2", make([]byte, 16)))
+
+	immuStore, err = Open(dir, opts)
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	require.EqualValues(t, 10, immuStore.LastCommittedTxID())
+
+	err = immuStore.WaitForIndexingUpto(context.Background(), 10)
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		valRef, err := immuStore.Get([]byte(fmt.Sprintf("key%d", i)))
+		require.NoError(t, err)
+
+		val, err := valRef.Resolve()
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprintf("value%d", i)), val)
+	}
+
+	t.Run("opening without the keys should fail", func(t *testing.T) {
+		_, err := Open(dir, DefaultOptions().
+			WithAppFactory(encrypted.AppFactory(encrypted.NewKeyRing(), encrypted.DefaultOptions())))
+		require.Error(t, err)
+	})
+}
+
+func TestImmudbStoreWithEncryption(t *testing.T) {
+	dir := t.TempDir()
+
+	keys := encrypted.NewKeyRing()
+	require.NoError(t, keys.AddKey("key1", make([]byte, 32)))
+
+	t.Run("invalid encryption options should fail", func(t *testing.T) {
+		_, err := Open(dir, DefaultOptions().WithEncryption(keys, nil))
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = Open(dir, DefaultOptions().
+			WithEncryption(keys, encrypted.DefaultOptions()).
+			WithCompressionFormat(appendable.GZipCompression))
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	opts := DefaultOptions().
+		WithEncryption(keys, encrypted.DefaultOptions().WithBlockSize(512))
+
+	immuStore, err := Open(dir, opts)
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		tx, err := immuStore.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte(fmt.Sprintf("secret-key%d", i)), nil, []byte(fmt.Sprintf("secret-value%d", i)))
+		require.NoError(t, err)
+
+		_, err = tx.Commit(context.Background())
+		require.NoError(t, err)
+	}
+
+	err = immuStore.FlushIndex(0, true)
+	require.NoError(t, err)
+
+	require.NoError(t, immuStore.Close())
+
+	// neither keys nor values are stored in plain text, including the index and the hash tree
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		require.NotContains(t, string(content), "secret-", path)
+		return nil
+	})
+	require.NoError(t, err)
+
+	// the key is rotated, existing content remains readable
+	require.NoError(t, keys.AddKey("key2", make([]byte, 16)))
+
+	immuStore, err = Open(dir, opts)
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	require.EqualValues(t, 10, immuStore.LastCommittedTxID())
+
+	tx, err := immuStore.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("secret-key10"), nil, []byte("secret-value10"))
+	require.NoError(t, err)
+
+	_, err = tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	err = immuStore.WaitForIndexingUpto(context.Background(), 11)
+	require.NoError(t, err)
+
+	for i := 0; i <= 10; i++ {
+		valRef, err := immuStore.Get([]byte(fmt.Sprintf("secret-key%d", i)))
+		require.NoError(t, err)
+
+		val, err := valRef.Resolve()
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprintf("secret-value%d", i)), val)
+	}
+
+	// the hash tree is readable as well
+	err = immuStore.WaitForIndexingUpto(context.Background(), 11)
+	require.NoError(t, err)
+
+	_, err = immuStore.aht.InclusionProof(1, 11)
+	require.NoError(t, err)
+
+	t.Run("opening without encryption should fail", func(t *testing.T) {
+		_, err := Open(dir, DefaultOptions())
+		require.Error(t, err)
+	})
+
+	t.Run("opening without the keys should fail", func(t *testing.T) {
+		_, err := Open(dir, DefaultOptions().WithEncryption(encrypted.NewKeyRing(), encrypted.DefaultOptions()))
+		require.Error(t, err)
+	})
+}
+
 func TestImmudbStoreOnClosedStore(t *testing.T) {
 	immuStore, err := Open(t.TempDir(), DefaultOptions().WithMaxConcurrency(1))
 	require.NoError(t, err)
@@ -2283,6 +2441,59 @@ func TestReOpeningWithCompressionEnabledImmudbStore(t *testing.T) {
 	}
 }
 
+func TestImmudbStoreWithZstdAndSnappyCompression(t *testing.T) {
+	var samples [][]byte
+	for i := 0; i < 100; i++ {
+		samples = append(samples, []byte(fmt.Sprintf(`{"id":%d,"status":"pending"}`, i)))
+	}
+
+	dict, err := appendable.TrainDictionary(samples, 1024)
+	require.NoError(t, err)
+
+	for _, opts := range []*Options{
+		DefaultOptions().WithCompressionFormat(appendable.ZstdCompression),
+		DefaultOptions().WithCompressionFormat(appendable.ZstdCompression).WithCompressionDictionary(dict),
+		DefaultOptions().WithCompressionFormat(appendable.SnappyCompression),
+	} {
+		t.Run(appendable.CompressionFormatName(opts.CompressionFormat), func(t *testing.T) {
+			dir := t.TempDir()
+
+			opts.WithEmbeddedValues(false).WithMaxIOConcurrency(1)
+
+			for it := 0; it < 2; it++ {
+				immuStore, err := Open(dir, opts)
+				require.NoError(t, err)
+
+				for i := 0; i < 10; i++ {
+					tx, err := immuStore.NewWriteOnlyTx(context.Background())
+					require.NoError(t, err)
+
+					err = tx.Set([]byte(fmt.Sprintf("key%d_%d", it, i)), nil, []byte(fmt.Sprintf(`{"id":%d,"status":"pending"}`, i)))
+					require.NoError(t, err)
+
+					_, err = tx.Commit(context.Background())
+					require.NoError(t, err)
+				}
+
+				err = immuStore.WaitForIndexingUpto(context.Background(), immuStore.LastCommittedTxID())
+				require.NoError(t, err)
+
+				for i := 0; i < 10; i++ {
+					valRef, err := immuStore.Get([]byte(fmt.Sprintf("key%d_%d", it, i)))
+					require.NoError(t, err)
+
+					val, err := valRef.Resolve()
+					require.NoError(t, err)
+					require.Equal(t, []byte(fmt.Sprintf(`{"id":%d,"status":"pending"}`, i)), val)
+				}
+
+				err = immuStore.Close()
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestUncommittedTxOverwriting(t *testing.T) {
 	path := t.TempDir()
 
@@ -4964,3 +5175,95 @@ func TestImmudbStore_ExportTxWithEmptyValues(t *testing.T) {
 	_, err = st.ExportTx(hdr.ID, false, false, txholder)
 	require.NoError(t, err)
 }
+
+func TestImmudbStoreReadValueRange(t *testing.T) {
+	for _, opts := range []*Options{
+		DefaultOptions(),
+		DefaultOptions().WithEmbeddedValues(true),
+		DefaultOptions().WithCompressionFormat(appendable.GZipCompression),
+		DefaultOptions().WithVLogCacheSize(10),
+	} {
+		st, err := Open(t.TempDir(), opts)
+		require.NoError(t, err)
+
+		value := make([]byte, 4096)
+		_, err = rand.Read(value)
+		require.NoError(t, err)
+
+		tx, err := st.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte("key"), nil, value)
+		require.NoError(t, err)
+
+		err = tx.Set([]byte("empty"), nil, nil)
+		require.NoError(t, err)
+
+		hdr, err := tx.Commit(context.Background())
+		require.NoError(t, err)
+
+		entry, _, err := st.ReadTxEntry(hdr.ID, []byte("key"), false)
+		require.NoError(t, err)
+
+		_, err = st.ReadValueRange(nil, 0, 0)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = st.ReadValueRange(entry, -1, 0)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = st.ReadValueRange(entry, len(value)+1, 0)
+		require.ErrorIs(t, err, ErrValueRangeOutOfBounds)
+
+		val, err := st.ReadValueRange(entry, 0, 0)
+		require.NoError(t, err)
+		require.Equal(t, value, val)
+
+		val, err = st.ReadValueRange(entry, 100, 200)
+		require.NoError(t, err)
+		require.Equal(t, value[100:300], val)
+
+		val, err = st.ReadValueRange(entry, len(value)-10, 100)
+		require.NoError(t, err)
+		require.Equal(t, value[len(value)-10:], val)
+
+		val, err = st.ReadValueRange(entry, len(value), 0)
+		require.NoError(t, err)
+		require.Empty(t, val)
+
+		valRef, err := st.Get([]byte("key"))
+		require.NoError(t, err)
+
+		val, err = valRef.ResolveRange(1000, 10)
+		require.NoError(t, err)
+		require.Equal(t, value[1000:1010], val)
+
+		valRef, err = st.Get([]byte("empty"))
+		require.NoError(t, err)
+
+		val, err = valRef.ResolveRange(0, 10)
+		require.NoError(t, err)
+		require.Empty(t, val)
+
+		t.Run("values set within an ongoing transaction should be partially resolved", func(t *testing.T) {
+			tx, err := st.NewTx(context.Background(), DefaultTxOptions())
+			require.NoError(t, err)
+			defer tx.Cancel()
+
+			err = tx.Set([]byte("key"), nil, []byte("ongoing"))
+			require.NoError(t, err)
+
+			valRef, err := tx.Get([]byte("key"))
+			require.NoError(t, err)
+
+			val, err := valRef.ResolveRange(2, 0)
+			require.NoError(t, err)
+			require.Equal(t, []byte("going"), val)
+
+			_, err = valRef.ResolveRange(8, 0)
+			require.ErrorIs(t, err, ErrValueRangeOutOfBounds)
+		})
+
+		err = st.Close()
+		require.NoError(t, err)
+	}
+}
@@ -17,11 +17,13 @@ limitations under the License.
 package store
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
 	"sync"
 	"time"
@@ -35,8 +37,18 @@ import (
 )
 
 type indexer struct {
+	// accessed atomically, kept first to be 64-bit aligned
+	rebuildProcessedTxs uint64
+	rebuildTotalTxs     uint64
+
 	path string
 
+	name   string
+	prefix []byte
+
+	// prefixes of more specific indexes, keys starting with them are not indexed here
+	excludedPrefixes [][]byte
+
 	store *ImmuStore
 	tx    *Tx
 
@@ -56,8 +68,16 @@ type indexer struct {
 
 	closed bool
 
-	compactionMutex sync.Mutex
-	mutex           sync.Mutex
+	compactionMutex  sync.Mutex
+	mutex            sync.Mutex
+	maxWaitees       int
+	compactionStopCh chan struct{}
+
+	rebuildParallelism int
+	fileMode           os.FileMode
+
+	// progress of the ongoing parallel rebuild, if any
+	rebuilding int32
 
 	metricsLastCommittedTrx prometheus.Gauge
 	metricsLastIndexedTrx   prometheus.Gauge
@@ -84,37 +104,68 @@ var (
 	}, []string{
 		"db",
 	})
+	metricsLastIndexedTrxIdByIndex = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "immudb_index_last_indexed_trx_id",
+		Help: "The highest id of transaction indexed by an additional index",
+	}, []string{
+		"db",
+		"index",
+	})
 )
 
-func newIndexer(path string, store *ImmuStore, opts *Options) (*indexer, error) {
+// newIndexer opens the index described by spec, the default index is opened when spec is nil
+func newIndexer(path string, spec *IndexSpec, store *ImmuStore, opts *Options) (*indexer, error) {
 	if store == nil {
 		return nil, fmt.Errorf("%w: nil store", ErrIllegalArguments)
 	}
 
+	if spec == nil {
+		spec = &IndexSpec{Name: DefaultIndexName}
+	}
+
+	idxOpts := spec.Opts
+	if idxOpts == nil {
+		idxOpts = opts.IndexOpts
+	}
+
+	var excludedPrefixes [][]byte
+
+	for _, other := range opts.Indexes {
+		if len(other.Prefix) > len(spec.Prefix) && bytes.HasPrefix(other.Prefix, spec.Prefix) {
+			excludedPrefixes = append(excludedPrefixes, other.Prefix)
+		}
+	}
+
 	indexOpts := tbtree.DefaultOptions().
 		WithReadOnly(opts.ReadOnly).
 		WithFileMode(opts.FileMode).
 		WithLogger(opts.logger).
 		WithFileSize(opts.FileSize).
-		WithCacheSize(opts.IndexOpts.CacheSize).
-		WithFlushThld(opts.IndexOpts.FlushThld).
-		WithSyncThld(opts.IndexOpts.SyncThld).
-		WithFlushBufferSize(opts.IndexOpts.FlushBufferSize).
-		WithCleanupPercentage(opts.IndexOpts.CleanupPercentage).
-		WithMaxActiveSnapshots(opts.IndexOpts.MaxActiveSnapshots).
-		WithMaxNodeSize(opts.IndexOpts.MaxNodeSize).
+		WithCacheSize(idxOpts.CacheSize).
+		WithFlushThld(idxOpts.FlushThld).
+		WithSyncThld(idxOpts.SyncThld).
+		WithFlushBufferSize(idxOpts.FlushBufferSize).
+		WithCleanupPercentage(idxOpts.CleanupPercentage).
+		WithMaxActiveSnapshots(idxOpts.MaxActiveSnapshots).
+		WithMaxNodeSize(idxOpts.MaxNodeSize).
 		WithMaxKeySize(opts.MaxKeyLen).
 		WithMaxValueSize(lszSize + offsetSize + sha256.Size + sszSize + maxTxMetadataLen + sszSize + maxKVMetadataLen). // indexed values
-		WithNodesLogMaxOpenedFiles(opts.IndexOpts.NodesLogMaxOpenedFiles).
-		WithHistoryLogMaxOpenedFiles(opts.IndexOpts.HistoryLogMaxOpenedFiles).
-		WithCommitLogMaxOpenedFiles(opts.IndexOpts.CommitLogMaxOpenedFiles).
-		WithRenewSnapRootAfter(opts.IndexOpts.RenewSnapRootAfter).
-		WithCompactionThld(opts.IndexOpts.CompactionThld).
-		WithDelayDuringCompaction(opts.IndexOpts.DelayDuringCompaction)
+		WithNodesLogMaxOpenedFiles(idxOpts.NodesLogMaxOpenedFiles).
+		WithHistoryLogMaxOpenedFiles(idxOpts.HistoryLogMaxOpenedFiles).
+		WithCommitLogMaxOpenedFiles(idxOpts.CommitLogMaxOpenedFiles).
+		WithRenewSnapRootAfter(idxOpts.RenewSnapRootAfter).
+		WithCompactionThld(idxOpts.CompactionThld).
+		WithDelayDuringCompaction(idxOpts.DelayDuringCompaction).
+		WithAppendableWrapper(store.appWrapper)
 
 	if opts.appFactory != nil {
+		indexDir, err := filepath.Rel(store.path, path)
+		if err != nil {
+			return nil, err
+		}
+
 		indexOpts.WithAppFactory(func(rootPath, subPath string, appOpts *multiapp.Options) (appendable.Appendable, error) {
-			return opts.appFactory(store.path, filepath.Join(indexDirname, subPath), appOpts)
+			return opts.appFactory(store.path, filepath.Join(indexDir, subPath), appOpts)
 		})
 	}
 
@@ -133,7 +184,7 @@ func newIndexer(path string, store *ImmuStore, opts *Options) (*indexer, error)
 		return nil, err
 	}
 
-	kvs := make([]*tbtree.KVT, store.maxTxEntries*opts.IndexOpts.MaxBulkSize)
+	kvs := make([]*tbtree.KVT, store.maxTxEntries*idxOpts.MaxBulkSize)
 	for i := range kvs {
 		// vLen + vOff + vHash + txmdLen + txmd + kvmdLen + kvmd
 		elen := lszSize + offsetSize + sha256.Size + sszSize + maxTxMetadataLen + sszSize + maxKVMetadataLen
@@ -143,25 +194,85 @@ func newIndexer(path string, store *ImmuStore, opts *Options) (*indexer, error)
 	indexer := &indexer{
 		store:                  store,
 		tx:                     tx,
-		maxBulkSize:            opts.IndexOpts.MaxBulkSize,
-		bulkPreparationTimeout: opts.IndexOpts.BulkPreparationTimeout,
+		maxBulkSize:            idxOpts.MaxBulkSize,
+		bulkPreparationTimeout: idxOpts.BulkPreparationTimeout,
 		_kvs:                   kvs,
 		path:                   path,
+		name:                   spec.Name,
+		prefix:                 spec.Prefix,
+		excludedPrefixes:       excludedPrefixes,
 		index:                  index,
 		wHub:                   wHub,
+		maxWaitees:             opts.MaxWaitees,
 		state:                  stopped,
 		stateCond:              sync.NewCond(&sync.Mutex{}),
+		compactionStopCh:       make(chan struct{}),
+		rebuildParallelism:     idxOpts.RebuildParallelism,
+		fileMode:               opts.FileMode,
 	}
 
 	dbName := filepath.Base(store.path)
-	indexer.metricsLastIndexedTrx = metricsLastIndexedTrxId.WithLabelValues(dbName)
+	if spec.Name == DefaultIndexName {
+		indexer.metricsLastIndexedTrx = metricsLastIndexedTrxId.WithLabelValues(dbName)
+	} else {
+		indexer.metricsLastIndexedTrx = metricsLastIndexedTrxIdByIndex.WithLabelValues(dbName, spec.Name)
+	}
 	indexer.metricsLastCommittedTrx = metricsLastCommittedTrx.WithLabelValues(dbName)
 
 	indexer.resume()
 
+	if idxOpts.CompactionFrequency > 0 && opts.appFactory == nil {
+		go indexer.scheduleCompaction(idxOpts.CompactionFrequency)
+	}
+
 	return indexer, nil
 }
 
+// indexes returns true if the key is covered by this index
+func (idx *indexer) indexes(key []byte) bool {
+	if !bytes.HasPrefix(key, idx.prefix) {
+		return false
+	}
+
+	for _, prefix := range idx.excludedPrefixes {
+		if bytes.HasPrefix(key, prefix) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// coversRange returns true if all the keys starting with prefix are covered by this index
+func (idx *indexer) coversRange(prefix []byte) bool {
+	if !bytes.HasPrefix(prefix, idx.prefix) {
+		return false
+	}
+
+	for _, excluded := range idx.excludedPrefixes {
+		if bytes.HasPrefix(excluded, prefix) || bytes.HasPrefix(prefix, excluded) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// overlapsRange returns true if some of the keys starting with prefix may be covered by this index
+func (idx *indexer) overlapsRange(prefix []byte) bool {
+	if !bytes.HasPrefix(prefix, idx.prefix) && !bytes.HasPrefix(idx.prefix, prefix) {
+		return false
+	}
+
+	for _, excluded := range idx.excludedPrefixes {
+		if bytes.HasPrefix(prefix, excluded) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (idx *indexer) Ts() uint64 {
 	idx.mutex.Lock()
 	defer idx.mutex.Unlock()
@@ -247,6 +358,7 @@ func (idx *indexer) Close() error {
 	}
 
 	idx.stop()
+	close(idx.compactionStopCh)
 	idx.wHub.Close()
 	idx.store.releaseAllocTx(idx.tx)
 
@@ -271,15 +383,15 @@ func (idx *indexer) CompactIndex() (err error) {
 	idx.compactionMutex.Lock()
 	defer idx.compactionMutex.Unlock()
 
-	idx.store.logger.Infof("Compacting index '%s'...", idx.store.path)
+	idx.store.logger.Infof("Compacting index '%s'...", idx.path)
 
 	defer func() {
 		if err == nil {
-			idx.store.logger.Infof("Index '%s' sucessfully compacted", idx.store.path)
+			idx.store.logger.Infof("Index '%s' sucessfully compacted", idx.path)
 		} else if err == tbtree.ErrCompactionThresholdNotReached {
-			idx.store.logger.Infof("Compaction of index '%s' not needed: %v", idx.store.path, err)
+			idx.store.logger.Infof("Compaction of index '%s' not needed: %v", idx.path, err)
 		} else {
-			idx.store.logger.Warningf("%v: while compacting index '%s'", err, idx.store.path)
+			idx.store.logger.Warningf("%v: while compacting index '%s'", err, idx.path)
 		}
 	}()
 
@@ -309,6 +421,80 @@ func (idx *indexer) FlushIndex(cleanupPercentage float32, synced bool) (err erro
 	return nil
 }
 
+func (idx *indexer) scheduleCompaction(frequency time.Duration) {
+	ticker := time.NewTicker(frequency)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-idx.compactionStopCh:
+			return
+		case <-ticker.C:
+			err := idx.CompactIndex()
+			if errors.Is(err, ErrAlreadyClosed) {
+				return
+			}
+		}
+	}
+}
+
+// Rebuild discards the content of the index and indexes again all the transactions
+func (idx *indexer) Rebuild() error {
+	idx.compactionMutex.Lock()
+	defer idx.compactionMutex.Unlock()
+
+	if idx.rebuildParallelism > 1 {
+		return idx.rebuildParallel()
+	}
+
+	idx.mutex.Lock()
+	defer idx.mutex.Unlock()
+
+	if idx.closed {
+		return ErrAlreadyClosed
+	}
+
+	idx.store.logger.Infof("Rebuilding index '%s'...", idx.path)
+
+	idx.stop()
+	defer idx.resume()
+
+	return idx.reset()
+}
+
+// reset replaces the index with an empty one, idx.mutex must be held by the caller and indexing be stopped
+func (idx *indexer) reset() error {
+	opts := idx.index.GetOptions()
+
+	err := idx.index.Close()
+	if err != nil {
+		return err
+	}
+
+	err = os.RemoveAll(idx.path)
+	if err != nil {
+		return err
+	}
+
+	index, err := tbtree.Open(idx.path, opts)
+	if err != nil {
+		return err
+	}
+
+	idx.index = index
+
+	// indexing progress is tracked from scratch,
+	// waitees are kept waiting until the transactions are indexed again
+	if idx.wHub != nil {
+		err = idx.wHub.Reset(0)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (idx *indexer) stop() {
 	idx.stateCond.L.Lock()
 	idx.state = stopped
@@ -316,17 +502,17 @@ func (idx *indexer) stop() {
 	idx.stateCond.L.Unlock()
 	idx.stateCond.Signal()
 
-	idx.store.notify(Info, true, "Indexing gracefully stopped at '%s'", idx.store.path)
+	idx.store.notify(Info, true, "Indexing gracefully stopped at '%s'", idx.path)
 }
 
 func (idx *indexer) resume() {
 	idx.stateCond.L.Lock()
 	idx.state = running
 	idx.ctx, idx.cancelFunc = context.WithCancel(context.Background())
-	go idx.doIndexing()
+	go idx.doIndexing(idx.ctx)
 	idx.stateCond.L.Unlock()
 
-	idx.store.notify(Info, true, "Indexing in progress at '%s'", idx.store.path)
+	idx.store.notify(Info, true, "Indexing in progress at '%s'", idx.path)
 }
 
 func (idx *indexer) restartIndex() error {
@@ -370,10 +556,20 @@ func (idx *indexer) Pause() {
 	idx.stateCond.L.Unlock()
 }
 
-func (idx *indexer) doIndexing() {
+func (idx *indexer) doIndexing(ctx context.Context) {
 	committedTxID := idx.store.LastCommittedTxID()
 	idx.metricsLastCommittedTrx.Set(float64(committedTxID))
 
+	if idx.rebuildParallelism > 1 && !idx.store.compactionDisabled && idx.Ts() == 0 && committedTxID > 0 {
+		err := idx.rebuildFromScratch(ctx, committedTxID)
+		if ctx.Err() != nil || errors.Is(err, ErrAlreadyClosed) {
+			return
+		}
+		if err != nil {
+			idx.store.logger.Warningf("Parallel rebuild of index '%s' failed, indexing sequentially: %v", idx.path, err)
+		}
+	}
+
 	for {
 		lastIndexedTx := idx.index.Ts()
 		idx.metricsLastIndexedTrx.Set(float64(lastIndexedTx))
@@ -382,12 +578,12 @@ func (idx *indexer) doIndexing() {
 			idx.wHub.DoneUpto(lastIndexedTx)
 		}
 
-		err := idx.store.commitWHub.WaitFor(idx.ctx, lastIndexedTx+1)
-		if idx.ctx.Err() != nil || errors.Is(err, watchers.ErrAlreadyClosed) {
+		err := idx.store.commitWHub.WaitFor(ctx, lastIndexedTx+1)
+		if ctx.Err() != nil || errors.Is(err, watchers.ErrAlreadyClosed) {
 			return
 		}
 		if err != nil {
-			idx.store.logger.Errorf("Indexing failed at '%s' due to error: %v", idx.store.path, err)
+			idx.store.logger.Errorf("Indexing failed at '%s' due to error: %v", idx.path, err)
 			time.Sleep(60 * time.Second)
 		}
 
@@ -395,7 +591,7 @@ func (idx *indexer) doIndexing() {
 		idx.metricsLastCommittedTrx.Set(float64(committedTxID))
 
 		txsToIndex := committedTxID - lastIndexedTx
-		idx.store.notify(Info, false, "%d transaction/s to be indexed at '%s'", txsToIndex, idx.store.path)
+		idx.store.notify(Info, false, "%d transaction/s to be indexed at '%s'", txsToIndex, idx.path)
 
 		idx.stateCond.L.Lock()
 		for {
@@ -415,7 +611,7 @@ func (idx *indexer) doIndexing() {
 			return
 		}
 		if err != nil {
-			idx.store.logger.Errorf("Indexing failed at '%s' due to error: %v", idx.store.path, err)
+			idx.store.logger.Errorf("Indexing failed at '%s' due to error: %v", idx.path, err)
 			time.Sleep(60 * time.Second)
 		}
 	}
@@ -442,48 +638,20 @@ func (idx *indexer) indexSince(txID uint64) error {
 			txmd = idx.tx.header.Metadata.Bytes()
 		}
 
-		txmdLen := len(txmd)
-
 		for _, e := range txEntries {
 			if e.md != nil && e.md.NonIndexable() {
 				continue
 			}
 
-			// vLen + vOff + vHash + txmdLen + txmd + kvmdLen + kvmd
-			var b [lszSize + offsetSize + sha256.Size + sszSize + maxTxMetadataLen + sszSize + maxKVMetadataLen]byte
-			o := 0
-
-			binary.BigEndian.PutUint32(b[o:], uint32(e.vLen))
-			o += lszSize
-
-			binary.BigEndian.PutUint64(b[o:], uint64(e.vOff))
-			o += offsetSize
-
-			copy(b[o:], e.hVal[:])
-			o += sha256.Size
-
-			binary.BigEndian.PutUint16(b[o:], uint16(txmdLen))
-			o += sszSize
-
-			copy(b[o:], txmd)
-			o += txmdLen
-
-			var kvmd []byte
-
-			if e.md != nil {
-				kvmd = e.md.Bytes()
+			if !idx.indexes(e.key()) {
+				continue
 			}
 
-			kvmdLen := len(kvmd)
-
-			binary.BigEndian.PutUint16(b[o:], uint16(kvmdLen))
-			o += sszSize
-
-			copy(b[o:], kvmd)
-			o += kvmdLen
+			var b [indexedValueMaxLen]byte
+			n := encodeIndexedValue(b[:], e, txmd)
 
 			idx._kvs[indexableEntries].K = e.key()
-			idx._kvs[indexableEntries].V = b[:o]
+			idx._kvs[indexableEntries].V = b[:n]
 			idx._kvs[indexableEntries].T = txID + uint64(i)
 
 			indexableEntries++
@@ -521,3 +689,40 @@ func (idx *indexer) indexSince(txID uint64) error {
 
 	return nil
 }
+
+// vLen + vOff + vHash + txmdLen + txmd + kvmdLen + kvmd
+const indexedValueMaxLen = lszSize + offsetSize + sha256.Size + sszSize + maxTxMetadataLen + sszSize + maxKVMetadataLen
+
+// encodeIndexedValue writes into b the value stored in the index for the entry and returns its length
+func encodeIndexedValue(b []byte, e *TxEntry, txmd []byte) int {
+	o := 0
+
+	binary.BigEndian.PutUint32(b[o:], uint32(e.vLen))
+	o += lszSize
+
+	binary.BigEndian.PutUint64(b[o:], uint64(e.vOff))
+	o += offsetSize
+
+	copy(b[o:], e.hVal[:])
+	o += sha256.Size
+
+	binary.BigEndian.PutUint16(b[o:], uint16(len(txmd)))
+	o += sszSize
+
+	copy(b[o:], txmd)
+	o += len(txmd)
+
+	var kvmd []byte
+
+	if e.md != nil {
+		kvmd = e.md.Bytes()
+	}
+
+	binary.BigEndian.PutUint16(b[o:], uint16(len(kvmd)))
+	o += sszSize
+
+	copy(b[o:], kvmd)
+	o += len(kvmd)
+
+	return o
+}
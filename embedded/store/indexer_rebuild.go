@@ -0,0 +1,727 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bufio"
+	"bytes"
+	"container/heap"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/codenotary/immudb/embedded/tbtree"
+)
+
+const (
+	rebuildDirSuffix = ".rebuild"
+	runsDirSuffix    = ".runs"
+
+	// number of consecutive transactions read by a rebuild worker at a time
+	rebuildTxBatchSize = 64
+
+	// number of entries of a partition kept in memory by a rebuild worker before being spilled as a sorted run
+	rebuildRunSize = 1 << 14
+
+	// maximum number of sorted runs merged at once
+	rebuildMergeFanIn = 64
+
+	// number of entries loaded into the rebuilt index at once
+	rebuildLoadBulkSize = 1 << 12
+)
+
+// RebuildProgress returns whether the index is being rebuilt in parallel,
+// and how many of the transactions to be indexed were already processed
+func (idx *indexer) RebuildProgress() (inProgress bool, processedTxs, totalTxs uint64) {
+	if atomic.LoadInt32(&idx.rebuilding) == 0 {
+		return false, 0, 0
+	}
+
+	return true, atomic.LoadUint64(&idx.rebuildProcessedTxs), atomic.LoadUint64(&idx.rebuildTotalTxs)
+}
+
+// rebuildParallel builds a fresh copy of the index while the current one keeps
+// serving reads and indexing new transactions, the copy replaces it once it caught up
+func (idx *indexer) rebuildParallel() error {
+	idx.mutex.Lock()
+	closed := idx.closed
+	idx.mutex.Unlock()
+
+	if closed {
+		return ErrAlreadyClosed
+	}
+
+	idx.store.logger.Infof("Rebuilding index '%s' with %d workers...", idx.path, idx.rebuildParallelism)
+
+	tree, err := idx.buildParallel(context.Background(), idx.store.LastCommittedTxID())
+	if err != nil {
+		return err
+	}
+
+	// transactions indexed in the meantime are included before taking the index over
+	err = idx.catchUp(tree, idx.Ts())
+	if err != nil {
+		idx.discardRebuild(tree)
+		return err
+	}
+
+	idx.mutex.Lock()
+	defer idx.mutex.Unlock()
+
+	if idx.closed {
+		idx.discardRebuild(tree)
+		return ErrAlreadyClosed
+	}
+
+	idx.stop()
+	defer idx.resume()
+
+	err = idx.catchUp(tree, idx.index.Ts())
+	if err != nil {
+		idx.discardRebuild(tree)
+		return err
+	}
+
+	return idx.installRebuild(tree)
+}
+
+// rebuildFromScratch builds the whole index in parallel instead of indexing transactions one bulk at a time,
+// it's meant to be called by the indexing goroutine when the index is empty (e.g. its files were lost)
+func (idx *indexer) rebuildFromScratch(ctx context.Context, upToTxID uint64) error {
+	idx.compactionMutex.Lock()
+	defer idx.compactionMutex.Unlock()
+
+	idx.store.logger.Infof("Index '%s' is empty, rebuilding it with %d workers...", idx.path, idx.rebuildParallelism)
+
+	tree, err := idx.buildParallel(ctx, upToTxID)
+	if err != nil {
+		return err
+	}
+
+	idx.mutex.Lock()
+	defer idx.mutex.Unlock()
+
+	if idx.closed {
+		idx.discardRebuild(tree)
+		return ErrAlreadyClosed
+	}
+
+	return idx.installRebuild(tree)
+}
+
+// buildParallel builds a new index including all the transactions up to upToTxID
+func (idx *indexer) buildParallel(ctx context.Context, upToTxID uint64) (tree *tbtree.TBtree, err error) {
+	rebuildPath := idx.path + rebuildDirSuffix
+
+	atomic.StoreUint64(&idx.rebuildProcessedTxs, 0)
+	atomic.StoreUint64(&idx.rebuildTotalTxs, upToTxID)
+	atomic.StoreInt32(&idx.rebuilding, 1)
+	defer atomic.StoreInt32(&idx.rebuilding, 0)
+
+	// leftovers of an interrupted rebuild are discarded
+	err = os.RemoveAll(rebuildPath)
+	if err != nil {
+		return nil, err
+	}
+
+	idx.mutex.Lock()
+	treeOpts := idx.index.GetOptions()
+	idx.mutex.Unlock()
+
+	tree, err = tbtree.Open(rebuildPath, treeOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = idx.bulkIndex(ctx, tree, 1, upToTxID, idx.rebuildParallelism)
+	if err != nil {
+		idx.discardRebuild(tree)
+		return nil, err
+	}
+
+	idx.store.logger.Infof("Index '%s' rebuilt up to transaction %d", idx.path, upToTxID)
+
+	return tree, nil
+}
+
+// bulkIndex indexes into tree the transactions in the range [fromTxID, upToTxID].
+// The keyspace is split into as many partitions as workers, workers read transactions concurrently
+// and spill sorted runs of each partition, the runs are then merged and bulk-loaded into the tree
+func (idx *indexer) bulkIndex(ctx context.Context, tree *tbtree.TBtree, fromTxID, upToTxID uint64, workers int) error {
+	if fromTxID > upToTxID {
+		return nil
+	}
+
+	runsPath := idx.path + runsDirSuffix
+
+	// leftovers of an interrupted bulk indexing are discarded
+	err := os.RemoveAll(runsPath)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(runsPath, idx.fileMode)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(runsPath)
+
+	partitions := workers
+
+	runs, err := idx.buildSortedRuns(ctx, runsPath, partitions, workers, fromTxID, upToTxID)
+	if err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, partitions)
+
+	for p := 0; p < partitions; p++ {
+		wg.Add(1)
+
+		go func(p int) {
+			defer wg.Done()
+			errs[p] = idx.loadPartition(ctx, tree, runsPath, p, runs[p])
+		}(p)
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	if tree.Ts() < upToTxID {
+		// logical time of the index is moved up to the last transaction even if it didn't include indexable entries
+		return tree.IncreaseTs(upToTxID)
+	}
+
+	return nil
+}
+
+// buildSortedRuns reads the transactions in the range [fromTxID, upToTxID] concurrently and
+// returns the paths of the sorted runs generated for each partition
+func (idx *indexer) buildSortedRuns(ctx context.Context, runsPath string, partitions, workers int, fromTxID, upToTxID uint64) ([][]string, error) {
+	nextTxID := fromTxID - 1
+
+	var wg sync.WaitGroup
+	errs := make([]error, workers)
+	workerRuns := make([][][]string, workers)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+
+		go func(w int) {
+			defer wg.Done()
+
+			workerRuns[w], errs[w] = idx.sortedRunsWorker(ctx, runsPath, w, partitions, &nextTxID, upToTxID)
+		}(w)
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	runs := make([][]string, partitions)
+
+	for _, wruns := range workerRuns {
+		for p := range wruns {
+			runs[p] = append(runs[p], wruns[p]...)
+		}
+	}
+
+	return runs, nil
+}
+
+func (idx *indexer) sortedRunsWorker(ctx context.Context, runsPath string, worker, partitions int, nextTxID *uint64, upToTxID uint64) ([][]string, error) {
+	tx := NewTx(idx.store.maxTxEntries, idx.store.maxKeyLen)
+
+	runs := make([][]string, partitions)
+	buffers := make([][]*tbtree.KVT, partitions)
+
+	spill := func(p int) error {
+		if len(buffers[p]) == 0 {
+			return nil
+		}
+
+		runPath := filepath.Join(runsPath, fmt.Sprintf("%d_%d_%d", p, worker, len(runs[p])))
+
+		err := writeSortedRun(runPath, buffers[p])
+		if err != nil {
+			return err
+		}
+
+		runs[p] = append(runs[p], runPath)
+		buffers[p] = buffers[p][:0]
+
+		return nil
+	}
+
+	for {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		initialTxID := atomic.AddUint64(nextTxID, rebuildTxBatchSize) - rebuildTxBatchSize + 1
+		if initialTxID > upToTxID {
+			break
+		}
+
+		finalTxID := initialTxID + rebuildTxBatchSize - 1
+		if finalTxID > upToTxID {
+			finalTxID = upToTxID
+		}
+
+		for txID := initialTxID; txID <= finalTxID; txID++ {
+			err := idx.store.readTx(txID, false, false, tx)
+			if err != nil {
+				return nil, err
+			}
+
+			var txmd []byte
+
+			if tx.header.Metadata != nil {
+				txmd = tx.header.Metadata.Bytes()
+			}
+
+			for _, e := range tx.Entries() {
+				if e.md != nil && e.md.NonIndexable() {
+					continue
+				}
+
+				if !idx.indexes(e.key()) {
+					continue
+				}
+
+				var b [indexedValueMaxLen]byte
+				n := encodeIndexedValue(b[:], e, txmd)
+
+				key := make([]byte, len(e.key()))
+				copy(key, e.key())
+
+				p := partitionOf(key, partitions)
+
+				buffers[p] = append(buffers[p], &tbtree.KVT{K: key, V: b[:n], T: txID})
+
+				if len(buffers[p]) >= rebuildRunSize {
+					err = spill(p)
+					if err != nil {
+						return nil, err
+					}
+				}
+			}
+
+			atomic.AddUint64(&idx.rebuildProcessedTxs, 1)
+		}
+	}
+
+	for p := range buffers {
+		err := spill(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return runs, nil
+}
+
+// loadPartition merges the sorted runs of a partition and bulk-loads them into the tree.
+// All the entries of a key belong to the same partition, thus they are loaded in increasing time order
+func (idx *indexer) loadPartition(ctx context.Context, tree *tbtree.TBtree, runsPath string, partition int, runs []string) error {
+	runs, err := reduceRuns(ctx, runsPath, fmt.Sprintf("%d", partition), runs)
+	if err != nil {
+		return err
+	}
+
+	bulk := make([]*tbtree.KVT, 0, rebuildLoadBulkSize)
+
+	err = mergeRuns(ctx, runs, func(kvt *tbtree.KVT) error {
+		bulk = append(bulk, kvt)
+
+		if len(bulk) < rebuildLoadBulkSize {
+			return nil
+		}
+
+		err := tree.BulkLoad(bulk)
+		bulk = bulk[:0]
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(bulk) > 0 {
+		return tree.BulkLoad(bulk)
+	}
+
+	return nil
+}
+
+// catchUp indexes into tree the transactions committed after it was built, up to upToTxID
+func (idx *indexer) catchUp(tree *tbtree.TBtree, upToTxID uint64) error {
+	tx := NewTx(idx.store.maxTxEntries, idx.store.maxKeyLen)
+
+	for txID := tree.Ts() + 1; txID <= upToTxID; txID++ {
+		err := idx.store.readTx(txID, false, false, tx)
+		if err != nil {
+			return err
+		}
+
+		var txmd []byte
+
+		if tx.header.Metadata != nil {
+			txmd = tx.header.Metadata.Bytes()
+		}
+
+		var kvts []*tbtree.KVT
+
+		for _, e := range tx.Entries() {
+			if e.md != nil && e.md.NonIndexable() {
+				continue
+			}
+
+			if !idx.indexes(e.key()) {
+				continue
+			}
+
+			var b [indexedValueMaxLen]byte
+			n := encodeIndexedValue(b[:], e, txmd)
+
+			kvts = append(kvts, &tbtree.KVT{K: e.key(), V: b[:n], T: txID})
+		}
+
+		if len(kvts) == 0 {
+			err = tree.IncreaseTs(txID)
+		} else {
+			err = tree.BulkInsert(kvts)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// installRebuild replaces the index with the rebuilt tree, idx.mutex must be held by the caller
+func (idx *indexer) installRebuild(tree *tbtree.TBtree) error {
+	err := tree.Close()
+	if err != nil {
+		os.RemoveAll(idx.path + rebuildDirSuffix)
+		return err
+	}
+
+	opts := idx.index.GetOptions()
+
+	err = idx.index.Close()
+	if err != nil && !errors.Is(err, tbtree.ErrAlreadyClosed) {
+		return err
+	}
+
+	err = os.RemoveAll(idx.path)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(idx.path+rebuildDirSuffix, idx.path)
+	if err != nil {
+		return err
+	}
+
+	index, err := tbtree.Open(idx.path, opts)
+	if err != nil {
+		return err
+	}
+
+	idx.index = index
+
+	idx.store.logger.Infof("Index '%s' successfully rebuilt", idx.path)
+
+	return nil
+}
+
+func (idx *indexer) discardRebuild(tree *tbtree.TBtree) {
+	tree.Close()
+	os.RemoveAll(idx.path + rebuildDirSuffix)
+}
+
+func partitionOf(key []byte, partitions int) int {
+	h := fnv.New32a()
+	h.Write(key)
+
+	return int(h.Sum32() % uint32(partitions))
+}
+
+// writeSortedRun sorts the entries by key and time, and writes them into a new file:
+// kLen + k + vLen + v + t
+func writeSortedRun(path string, kvts []*tbtree.KVT) error {
+	less := func(i, j int) bool {
+		return compareKVT(kvts[i], kvts[j]) < 0
+	}
+
+	// entries loaded in key order don't need to be sorted again
+	if !sort.SliceIsSorted(kvts, less) {
+		sort.Slice(kvts, less)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+
+	for _, kvt := range kvts {
+		err = writeKVT(w, kvt)
+		if err != nil {
+			f.Close()
+			return err
+		}
+	}
+
+	err = w.Flush()
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func writeKVT(w *bufio.Writer, kvt *tbtree.KVT) error {
+	var b [txIDSize]byte
+
+	binary.BigEndian.PutUint16(b[:], uint16(len(kvt.K)))
+	w.Write(b[:sszSize])
+	w.Write(kvt.K)
+
+	binary.BigEndian.PutUint16(b[:], uint16(len(kvt.V)))
+	w.Write(b[:sszSize])
+	w.Write(kvt.V)
+
+	binary.BigEndian.PutUint64(b[:], kvt.T)
+	_, err := w.Write(b[:txIDSize])
+
+	// bufio.Writer errors are sticky, the last write reports any previous failure
+	return err
+}
+
+func readKVT(r *bufio.Reader) (*tbtree.KVT, error) {
+	var b [txIDSize]byte
+
+	_, err := io.ReadFull(r, b[:sszSize])
+	if err != nil {
+		return nil, err
+	}
+
+	k := make([]byte, binary.BigEndian.Uint16(b[:]))
+
+	_, err = io.ReadFull(r, k)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = io.ReadFull(r, b[:sszSize])
+	if err != nil {
+		return nil, err
+	}
+
+	v := make([]byte, binary.BigEndian.Uint16(b[:]))
+
+	_, err = io.ReadFull(r, v)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = io.ReadFull(r, b[:txIDSize])
+	if err != nil {
+		return nil, err
+	}
+
+	return &tbtree.KVT{K: k, V: v, T: binary.BigEndian.Uint64(b[:])}, nil
+}
+
+func compareKVT(a, b *tbtree.KVT) int {
+	c := bytes.Compare(a.K, b.K)
+	if c != 0 {
+		return c
+	}
+
+	if a.T < b.T {
+		return -1
+	}
+	if a.T > b.T {
+		return 1
+	}
+
+	return 0
+}
+
+type runReader struct {
+	f    *os.File
+	r    *bufio.Reader
+	head *tbtree.KVT
+}
+
+type runHeap []*runReader
+
+func (h runHeap) Len() int           { return len(h) }
+func (h runHeap) Less(i, j int) bool { return compareKVT(h[i].head, h[j].head) < 0 }
+func (h runHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *runHeap) Push(x interface{}) {
+	*h = append(*h, x.(*runReader))
+}
+
+func (h *runHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	rr := old[n-1]
+	*h = old[:n-1]
+	return rr
+}
+
+// mergeRuns calls fn with the entries of all the runs in key and time order
+func mergeRuns(ctx context.Context, runs []string, fn func(kvt *tbtree.KVT) error) (err error) {
+	h := make(runHeap, 0, len(runs))
+
+	defer func() {
+		for _, rr := range h {
+			rr.f.Close()
+		}
+	}()
+
+	for _, run := range runs {
+		f, err := os.Open(run)
+		if err != nil {
+			return err
+		}
+
+		rr := &runReader{f: f, r: bufio.NewReader(f)}
+
+		rr.head, err = readKVT(rr.r)
+		if errors.Is(err, io.EOF) {
+			f.Close()
+			continue
+		}
+		if err != nil {
+			f.Close()
+			return err
+		}
+
+		h = append(h, rr)
+	}
+
+	heap.Init(&h)
+
+	for i := 0; h.Len() > 0; i++ {
+		if i%rebuildLoadBulkSize == 0 && ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		rr := h[0]
+
+		err = fn(rr.head)
+		if err != nil {
+			return err
+		}
+
+		rr.head, err = readKVT(rr.r)
+		if errors.Is(err, io.EOF) {
+			rr.f.Close()
+			heap.Pop(&h)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		heap.Fix(&h, 0)
+	}
+
+	return nil
+}
+
+// reduceRuns merges the runs in multiple passes when there are too many of them to be read at once,
+// name is used to identify the merged runs
+func reduceRuns(ctx context.Context, runsPath, name string, runs []string) ([]string, error) {
+	for pass := 0; len(runs) > rebuildMergeFanIn; pass++ {
+		var merged []string
+
+		for i := 0; i < len(runs); i += rebuildMergeFanIn {
+			j := i + rebuildMergeFanIn
+			if j > len(runs) {
+				j = len(runs)
+			}
+
+			mergedPath := filepath.Join(runsPath, fmt.Sprintf("%s_m%d_%d", name, pass, len(merged)))
+
+			err := mergeRunsInto(ctx, mergedPath, runs[i:j])
+			if err != nil {
+				return nil, err
+			}
+
+			merged = append(merged, mergedPath)
+		}
+
+		runs = merged
+	}
+
+	return runs, nil
+}
+
+// mergeRunsInto merges the runs into a new one, the merged runs are removed
+func mergeRunsInto(ctx context.Context, path string, runs []string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+
+	err = mergeRuns(ctx, runs, func(kvt *tbtree.KVT) error {
+		return writeKVT(w, kvt)
+	})
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	for _, run := range runs {
+		os.Remove(run)
+	}
+
+	return f.Close()
+}
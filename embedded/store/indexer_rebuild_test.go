@@ -0,0 +1,199 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/tbtree"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParallelIndexRebuild(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := DefaultOptions().
+		WithIndexOptions(DefaultIndexOptions().WithRebuildParallelism(4)).
+		WithIndexes(&IndexSpec{Name: "sql", Prefix: []byte("sql.")})
+
+	st, err := Open(dir, opts)
+	require.NoError(t, err)
+	defer func() { st.Close() }()
+
+	const txCount = 300
+	const keyCount = 40
+
+	for i := 0; i < txCount; i++ {
+		tx, err := st.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte(fmt.Sprintf("key%d", i%keyCount)), nil, []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+
+		err = tx.Set([]byte(fmt.Sprintf("sql.row%d", i%keyCount)), nil, []byte(fmt.Sprintf("row%d", i)))
+		require.NoError(t, err)
+
+		if i%10 == 0 {
+			md := NewKVMetadata()
+
+			err = md.AsNonIndexable(true)
+			require.NoError(t, err)
+
+			err = tx.Set([]byte(fmt.Sprintf("hidden%d", i)), md, []byte("hidden"))
+			require.NoError(t, err)
+		}
+
+		_, err = tx.AsyncCommit(context.Background())
+		require.NoError(t, err)
+	}
+
+	err = st.WaitForIndexingUpto(context.Background(), txCount)
+	require.NoError(t, err)
+
+	requireIndexed := func(t *testing.T) {
+		for k := 0; k < keyCount; k++ {
+			for _, prefix := range []string{"key", "sql.row"} {
+				key := []byte(fmt.Sprintf("%s%d", prefix, k))
+
+				valRef, err := st.Get(key)
+				require.NoError(t, err)
+
+				// transactions updating the key
+				var expectedTxs []uint64
+				for txID := uint64(k + 1); txID <= txCount; txID += keyCount {
+					expectedTxs = append(expectedTxs, txID)
+				}
+
+				require.Equal(t, expectedTxs[len(expectedTxs)-1], valRef.Tx())
+				require.Equal(t, uint64(len(expectedTxs)), valRef.HC())
+
+				txs, _, err := st.History(key, 0, false, txCount)
+				require.NoError(t, err)
+				require.Equal(t, expectedTxs, txs)
+			}
+		}
+
+		_, err := st.Get([]byte("hidden0"))
+		require.ErrorIs(t, err, ErrKeyNotFound)
+	}
+
+	requireIndexed(t)
+
+	t.Run("explicitly rebuilt indexes should keep their content", func(t *testing.T) {
+		err := st.RebuildIndex(DefaultIndexName)
+		require.NoError(t, err)
+
+		err = st.RebuildIndex("sql")
+		require.NoError(t, err)
+
+		require.NoDirExists(t, filepath.Join(dir, indexDirname+rebuildDirSuffix))
+		require.NoDirExists(t, filepath.Join(dir, indexDirname+runsDirSuffix))
+
+		inProgress, _, _ := st.IndexRebuildProgress()
+		require.False(t, inProgress)
+
+		require.Equal(t, uint64(txCount), st.IndexInfo())
+
+		requireIndexed(t)
+	})
+
+	t.Run("lost indexes should be rebuilt in parallel when the store is opened", func(t *testing.T) {
+		err := st.Close()
+		require.NoError(t, err)
+
+		err = os.RemoveAll(filepath.Join(dir, indexDirname))
+		require.NoError(t, err)
+
+		err = os.RemoveAll(filepath.Join(dir, indexesDirname, "sql"))
+		require.NoError(t, err)
+
+		st, err = Open(dir, opts)
+		require.NoError(t, err)
+
+		err = st.WaitForIndexingUpto(context.Background(), txCount)
+		require.NoError(t, err)
+
+		requireIndexed(t)
+
+		tx, err := st.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte("key0"), nil, []byte("value"))
+		require.NoError(t, err)
+
+		hdr, err := tx.Commit(context.Background())
+		require.NoError(t, err)
+
+		valRef, err := st.Get([]byte("key0"))
+		require.NoError(t, err)
+		require.Equal(t, hdr.ID, valRef.Tx())
+	})
+}
+
+func TestMergeSortedRuns(t *testing.T) {
+	dir := t.TempDir()
+
+	var runs []string
+
+	for r := 0; r < 3; r++ {
+		var kvts []*tbtree.KVT
+
+		for i := 9; i >= 0; i-- {
+			kvts = append(kvts, &tbtree.KVT{
+				K: []byte(fmt.Sprintf("key%d", i%4)),
+				V: []byte(fmt.Sprintf("value%d_%d", r, i)),
+				T: uint64(i*3 + r + 1),
+			})
+		}
+
+		runPath := filepath.Join(dir, fmt.Sprintf("run%d", r))
+
+		err := writeSortedRun(runPath, kvts)
+		require.NoError(t, err)
+
+		runs = append(runs, runPath)
+	}
+
+	mergedPath := filepath.Join(dir, "merged")
+
+	err := mergeRunsInto(context.Background(), mergedPath, runs[1:])
+	require.NoError(t, err)
+	require.NoFileExists(t, runs[1])
+
+	var merged []*tbtree.KVT
+
+	err = mergeRuns(context.Background(), []string{runs[0], mergedPath}, func(kvt *tbtree.KVT) error {
+		merged = append(merged, kvt)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, merged, 30)
+
+	for i := 1; i < len(merged); i++ {
+		require.Negative(t, compareKVT(merged[i-1], merged[i]))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err = mergeRuns(ctx, []string{runs[0]}, func(kvt *tbtree.KVT) error { return nil })
+	require.ErrorIs(t, err, context.Canceled)
+}
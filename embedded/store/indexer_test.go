@@ -18,6 +18,7 @@ package store
 
 import (
 	"context"
+	"errors"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -30,7 +31,7 @@ import (
 )
 
 func TestNewIndexerFailure(t *testing.T) {
-	indexer, err := newIndexer(t.TempDir(), nil, nil)
+	indexer, err := newIndexer(t.TempDir(), nil, nil, nil)
 	require.Nil(t, indexer)
 	require.ErrorIs(t, err, ErrIllegalArguments)
 }
@@ -200,3 +201,220 @@ func TestClosedIndexer(t *testing.T) {
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, ErrAlreadyClosed)
 }
+
+func TestMultipleIndexes(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := DefaultOptions().WithIndexes(
+		&IndexSpec{Name: "sql", Prefix: []byte("sql.")},
+		&IndexSpec{Name: "sql_catalog", Prefix: []byte("sql.catalog."), Opts: DefaultIndexOptions().WithFlushThld(10)},
+	)
+
+	st, err := Open(dir, opts)
+	require.NoError(t, err)
+	defer func() { st.Close() }()
+
+	require.Equal(t, []string{DefaultIndexName, "sql", "sql_catalog"}, st.IndexNames())
+
+	commit := func(keys ...string) *TxHeader {
+		tx, err := st.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		for _, k := range keys {
+			err = tx.Set([]byte(k), nil, []byte("v_"+k))
+			require.NoError(t, err)
+		}
+
+		hdr, err := tx.AsyncCommit(context.Background())
+		require.NoError(t, err)
+
+		return hdr
+	}
+
+	hdr := commit("kv1", "sql.row1", "sql.catalog.table1")
+
+	err = st.WaitForIndexingUpto(context.Background(), hdr.ID)
+	require.NoError(t, err)
+
+	t.Run("keys are indexed by the index with the longest matching prefix", func(t *testing.T) {
+		for _, k := range []string{"kv1", "sql.row1", "sql.catalog.table1"} {
+			valRef, err := st.Get([]byte(k))
+			require.NoError(t, err)
+
+			val, err := valRef.Resolve()
+			require.NoError(t, err)
+			require.Equal(t, []byte("v_"+k), val)
+		}
+
+		_, _, _, err := st.indexers[0].Get([]byte("sql.row1"))
+		require.ErrorIs(t, err, ErrKeyNotFound)
+
+		_, _, _, err = st.indexers[1].Get([]byte("sql.catalog.table1"))
+		require.ErrorIs(t, err, ErrKeyNotFound)
+
+		_, _, _, err = st.indexers[2].Get([]byte("sql.catalog.table1"))
+		require.NoError(t, err)
+	})
+
+	t.Run("key readers are served by a single index", func(t *testing.T) {
+		snap, err := st.Snapshot()
+		require.NoError(t, err)
+		defer snap.Close()
+
+		reader, err := snap.NewKeyReader(KeyReaderSpec{Prefix: []byte("sql.r")})
+		require.NoError(t, err)
+
+		key, _, err := reader.Read()
+		require.NoError(t, err)
+		require.Equal(t, []byte("sql.row1"), key)
+
+		_, _, err = reader.Read()
+		require.ErrorIs(t, err, ErrNoMoreEntries)
+
+		err = reader.Close()
+		require.NoError(t, err)
+	})
+
+	t.Run("key readers spanning multiple indexes merge their entries", func(t *testing.T) {
+		snap, err := st.Snapshot()
+		require.NoError(t, err)
+		defer snap.Close()
+
+		readAll := func(spec KeyReaderSpec) []string {
+			reader, err := snap.NewKeyReader(spec)
+			require.NoError(t, err)
+			defer reader.Close()
+
+			var keys []string
+
+			for {
+				key, _, err := reader.Read()
+				if errors.Is(err, ErrNoMoreEntries) {
+					return keys
+				}
+				require.NoError(t, err)
+
+				keys = append(keys, string(key))
+			}
+		}
+
+		require.Equal(t, []string{"kv1", "sql.catalog.table1", "sql.row1"}, readAll(KeyReaderSpec{}))
+		require.Equal(t, []string{"sql.row1", "sql.catalog.table1", "kv1"}, readAll(KeyReaderSpec{DescOrder: true}))
+		require.Equal(t, []string{"sql.catalog.table1", "sql.row1"}, readAll(KeyReaderSpec{Prefix: []byte("sql.")}))
+		require.Equal(t, []string{"sql.row1"}, readAll(KeyReaderSpec{Prefix: []byte("sql."), Offset: 1}))
+
+		reader, err := snap.NewKeyReader(KeyReaderSpec{Prefix: []byte("sql.")})
+		require.NoError(t, err)
+		defer reader.Close()
+
+		key, _, err := reader.ReadBetween(1, hdr.ID)
+		require.NoError(t, err)
+		require.Equal(t, []byte("sql.catalog.table1"), key)
+
+		_, _, err = reader.Read()
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = reader.Reset()
+		require.NoError(t, err)
+
+		key, _, err = reader.Read()
+		require.NoError(t, err)
+		require.Equal(t, []byte("sql.catalog.table1"), key)
+
+		key, _, err = reader.Read()
+		require.NoError(t, err)
+		require.Equal(t, []byte("sql.row1"), key)
+
+		_, _, err = reader.Read()
+		require.ErrorIs(t, err, ErrNoMoreEntries)
+
+		key, _, err = st.GetWithPrefix([]byte("sql"), nil)
+		require.NoError(t, err)
+		require.Equal(t, []byte("sql.catalog.table1"), key)
+
+		key, _, err = snap.GetWithPrefix([]byte("sql"), []byte("sql.catalog.table1"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("sql.row1"), key)
+
+		_, _, err = st.GetWithPrefix([]byte("sql.unknown"), nil)
+		require.ErrorIs(t, err, ErrKeyNotFound)
+	})
+
+	t.Run("indexes are paused independently", func(t *testing.T) {
+		err := st.PauseIndexing("unknown")
+		require.ErrorIs(t, err, ErrIndexNotFound)
+
+		err = st.PauseIndexing(DefaultIndexName)
+		require.NoError(t, err)
+
+		hdr := commit("kv2", "sql.row2")
+
+		err = st.WaitForIndexingUpto(context.Background(), hdr.ID, "sql", "sql_catalog")
+		require.NoError(t, err)
+
+		ts, err := st.IndexInfoFor(DefaultIndexName)
+		require.NoError(t, err)
+		require.Less(t, ts, hdr.ID)
+		require.Less(t, st.IndexInfo(), hdr.ID)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		err = st.WaitForIndexingUpto(ctx, hdr.ID, DefaultIndexName)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		err = st.ResumeIndexing(DefaultIndexName)
+		require.NoError(t, err)
+
+		err = st.WaitForIndexingUpto(context.Background(), hdr.ID)
+		require.NoError(t, err)
+		require.Equal(t, hdr.ID, st.IndexInfo())
+	})
+
+	t.Run("indexes are flushed and rebuilt independently", func(t *testing.T) {
+		err := st.FlushIndex(0, true, "sql")
+		require.NoError(t, err)
+
+		err = st.FlushIndex(0, true, "unknown")
+		require.ErrorIs(t, err, ErrIndexNotFound)
+
+		waitErr := make(chan error)
+
+		go func() {
+			waitErr <- st.WaitForIndexingUpto(context.Background(), st.LastCommittedTxID()+1, "sql")
+		}()
+
+		time.Sleep(10 * time.Millisecond)
+
+		err = st.RebuildIndex("sql")
+		require.NoError(t, err)
+
+		err = st.WaitForIndexingUpto(context.Background(), st.LastCommittedTxID(), "sql")
+		require.NoError(t, err)
+
+		_, err = st.Get([]byte("sql.row2"))
+		require.NoError(t, err)
+
+		// callers waiting while the index is rebuilt are not released with an error
+		commit("kv3", "sql.row3")
+		require.NoError(t, <-waitErr)
+
+		err = st.RebuildIndex("unknown")
+		require.ErrorIs(t, err, ErrIndexNotFound)
+	})
+
+	err = st.Close()
+	require.NoError(t, err)
+
+	require.DirExists(t, filepath.Join(dir, indexesDirname, "sql"))
+	require.DirExists(t, filepath.Join(dir, indexesDirname, "sql_catalog"))
+
+	st, err = Open(dir, opts)
+	require.NoError(t, err)
+
+	err = st.WaitForIndexingUpto(context.Background(), st.LastCommittedTxID())
+	require.NoError(t, err)
+
+	_, err = st.Get([]byte("sql.catalog.table1"))
+	require.NoError(t, err)
+}
@@ -27,7 +27,7 @@ import (
 
 type Snapshot struct {
 	st             *ImmuStore
-	snap           *tbtree.Snapshot
+	snaps          []*tbtree.Snapshot // one snapshot per index, in the same order as the store indexes
 	ts             time.Time
 	refInterceptor valueRefInterceptor
 }
@@ -73,8 +73,12 @@ type KeyReaderSpec struct {
 	Offset        uint64
 }
 
+func (s *Snapshot) snapFor(key []byte) *tbtree.Snapshot {
+	return s.snaps[s.st.indexerFor(key)]
+}
+
 func (s *Snapshot) set(key, value []byte) error {
-	return s.snap.Set(key, value)
+	return s.snapFor(key).Set(key, value)
 }
 
 func (s *Snapshot) Get(key []byte) (valRef ValueRef, err error) {
@@ -82,7 +86,7 @@ func (s *Snapshot) Get(key []byte) (valRef ValueRef, err error) {
 }
 
 func (s *Snapshot) GetWithFilters(key []byte, filters ...FilterFn) (valRef ValueRef, err error) {
-	indexedVal, tx, hc, err := s.snap.Get(key)
+	indexedVal, tx, hc, err := s.snapFor(key).Get(key)
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +119,9 @@ func (s *Snapshot) GetWithPrefix(prefix []byte, neq []byte) (key []byte, valRef
 }
 
 func (s *Snapshot) GetWithPrefixAndFilters(prefix []byte, neq []byte, filters ...FilterFn) (key []byte, valRef ValueRef, err error) {
-	key, indexedVal, tx, hc, err := s.snap.GetWithPrefix(prefix, neq)
+	key, indexedVal, tx, hc, err := lowestWithPrefix(s.st.indexersForRange(prefix), func(i int) ([]byte, []byte, uint64, uint64, error) {
+		return s.snaps[i].GetWithPrefix(prefix, neq)
+	})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -144,28 +150,64 @@ func (s *Snapshot) GetWithPrefixAndFilters(prefix []byte, neq []byte, filters ..
 }
 
 func (s *Snapshot) History(key []byte, offset uint64, descOrder bool, limit int) (tss []uint64, hCount uint64, err error) {
-	return s.snap.History(key, offset, descOrder, limit)
+	return s.snapFor(key).History(key, offset, descOrder, limit)
 }
 
+// Ts returns the ID of the last transaction included in the snapshots of all the indexes
 func (s *Snapshot) Ts() uint64 {
-	return s.snap.Ts()
+	ts := s.snaps[0].Ts()
+
+	for _, snap := range s.snaps[1:] {
+		if snap.Ts() < ts {
+			ts = snap.Ts()
+		}
+	}
+
+	return ts
 }
 
 func (s *Snapshot) Close() error {
-	return s.snap.Close()
+	var err error
+
+	for _, snap := range s.snaps {
+		cerr := snap.Close()
+		if err == nil {
+			err = cerr
+		}
+	}
+
+	return err
 }
 
 func (s *Snapshot) NewKeyReader(spec KeyReaderSpec) (KeyReader, error) {
-	r, err := s.snap.NewReader(tbtree.ReaderSpec{
-		SeekKey:       spec.SeekKey,
-		EndKey:        spec.EndKey,
-		Prefix:        spec.Prefix,
-		InclusiveSeek: spec.InclusiveSeek,
-		InclusiveEnd:  spec.InclusiveEnd,
-		DescOrder:     spec.DescOrder,
-	})
-	if err != nil {
-		return nil, err
+	indexes := s.st.indexersForRange(spec.Prefix)
+
+	readers := make([]*tbtree.Reader, len(indexes))
+
+	for i, index := range indexes {
+		r, err := s.snaps[index].NewReader(tbtree.ReaderSpec{
+			SeekKey:       spec.SeekKey,
+			EndKey:        spec.EndKey,
+			Prefix:        spec.Prefix,
+			InclusiveSeek: spec.InclusiveSeek,
+			InclusiveEnd:  spec.InclusiveEnd,
+			DescOrder:     spec.DescOrder,
+		})
+		if err != nil {
+			for _, r := range readers[:i] {
+				r.Close()
+			}
+			return nil, err
+		}
+
+		readers[i] = r
+	}
+
+	var r indexReader = readers[0]
+
+	if len(readers) > 1 {
+		// the range spans multiple indexes, their entries are merged in key order
+		r = newMergedReader(readers, spec.DescOrder)
 	}
 
 	var refInterceptor valueRefInterceptor
@@ -195,6 +237,7 @@ func (s *Snapshot) NewKeyReader(spec KeyReaderSpec) (KeyReader, error) {
 
 type ValueRef interface {
 	Resolve() (val []byte, err error)
+	ResolveRange(offset, length int) (val []byte, err error)
 	Tx() uint64
 	HC() uint64
 	TxMetadata() *TxMetadata
@@ -320,6 +363,16 @@ func (v *valueRef) Resolve() (val []byte, err error) {
 	return refVal, nil
 }
 
+// ResolveRange returns up to length bytes of the value starting at the given offset,
+// the remaining part of the value is returned when length is zero
+func (v *valueRef) ResolveRange(offset, length int) (val []byte, err error) {
+	if v.kvmd != nil && v.kvmd.ExpiredAt(time.Now()) {
+		return nil, ErrExpiredEntry
+	}
+
+	return v.st.readValueRange(v.vOff, int(v.valLen), v.hVal, offset, length)
+}
+
 func (v *valueRef) Tx() uint64 {
 	return v.tx
 }
@@ -346,7 +399,7 @@ func (v *valueRef) Len() uint32 {
 
 type storeKeyReader struct {
 	snap           *Snapshot
-	reader         *tbtree.Reader
+	reader         indexReader
 	filters        []FilterFn
 	refInterceptor valueRefInterceptor
 
@@ -0,0 +1,187 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/codenotary/immudb/embedded/tbtree"
+)
+
+// indexReader reads the entries of a key range from one or more indexes
+type indexReader interface {
+	Read() (key []byte, value []byte, ts uint64, hc uint64, err error)
+	ReadBetween(initialTs, finalTs uint64) (key []byte, ts uint64, hc uint64, err error)
+	Reset() error
+	Close() error
+}
+
+// mergedReader reads in key order the entries of a range spanning multiple indexes.
+// Each key is held by a single index, thus entries are merged without duplicates
+type mergedReader struct {
+	readers   []*tbtree.Reader
+	descOrder bool
+
+	// next entry of each reader, nil when it must be read.
+	// Pending entries were read either by Read or by ReadBetween with the given range
+	heads     []*mergedEntry
+	between   bool
+	initialTs uint64
+	finalTs   uint64
+}
+
+type mergedEntry struct {
+	key   []byte
+	value []byte
+	ts    uint64
+	hc    uint64
+	err   error
+}
+
+func newMergedReader(readers []*tbtree.Reader, descOrder bool) *mergedReader {
+	return &mergedReader{
+		readers:   readers,
+		descOrder: descOrder,
+		heads:     make([]*mergedEntry, len(readers)),
+	}
+}
+
+func (r *mergedReader) Read() (key []byte, value []byte, ts uint64, hc uint64, err error) {
+	err = r.setMode(false, 0, 0)
+	if err != nil {
+		return nil, nil, 0, 0, err
+	}
+
+	for i, reader := range r.readers {
+		if r.heads[i] == nil {
+			key, value, ts, hc, err := reader.Read()
+			r.heads[i] = &mergedEntry{key: key, value: value, ts: ts, hc: hc, err: err}
+		}
+	}
+
+	e, err := r.next()
+	if err != nil {
+		return nil, nil, 0, 0, err
+	}
+
+	return e.key, e.value, e.ts, e.hc, nil
+}
+
+func (r *mergedReader) ReadBetween(initialTs, finalTs uint64) (key []byte, ts uint64, hc uint64, err error) {
+	err = r.setMode(true, initialTs, finalTs)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	for i, reader := range r.readers {
+		if r.heads[i] == nil {
+			key, ts, hc, err := reader.ReadBetween(initialTs, finalTs)
+			r.heads[i] = &mergedEntry{key: key, ts: ts, hc: hc, err: err}
+		}
+	}
+
+	e, err := r.next()
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	return e.key, e.ts, e.hc, nil
+}
+
+// setMode validates pending entries were read in the same way they are requested
+func (r *mergedReader) setMode(between bool, initialTs, finalTs uint64) error {
+	if r.between == between && r.initialTs == initialTs && r.finalTs == finalTs {
+		return nil
+	}
+
+	for _, e := range r.heads {
+		if e != nil {
+			return fmt.Errorf("%w: reading mode can not be changed without resetting the reader", ErrIllegalArguments)
+		}
+	}
+
+	r.between = between
+	r.initialTs = initialTs
+	r.finalTs = finalTs
+
+	return nil
+}
+
+// next returns the lowest pending entry, or the greatest one in descending order
+func (r *mergedReader) next() (*mergedEntry, error) {
+	next := -1
+
+	for i, e := range r.heads {
+		if errors.Is(e.err, ErrNoMoreEntries) {
+			continue
+		}
+
+		if e.err != nil {
+			// the entry is read again on the next call
+			r.heads[i] = nil
+			return nil, e.err
+		}
+
+		if next < 0 {
+			next = i
+			continue
+		}
+
+		cmp := bytes.Compare(e.key, r.heads[next].key)
+
+		if (!r.descOrder && cmp < 0) || (r.descOrder && cmp > 0) {
+			next = i
+		}
+	}
+
+	if next < 0 {
+		return nil, ErrNoMoreEntries
+	}
+
+	e := r.heads[next]
+	r.heads[next] = nil
+
+	return e, nil
+}
+
+func (r *mergedReader) Reset() error {
+	for i, reader := range r.readers {
+		err := reader.Reset()
+		if err != nil {
+			return err
+		}
+
+		r.heads[i] = nil
+	}
+
+	return nil
+}
+
+func (r *mergedReader) Close() error {
+	var err error
+
+	for _, reader := range r.readers {
+		cerr := reader.Close()
+		if err == nil {
+			err = cerr
+		}
+	}
+
+	return err
+}
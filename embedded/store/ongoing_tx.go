@@ -155,6 +155,22 @@ func (oref *ongoingValRef) Resolve() (val []byte, err error) {
 	return oref.value, nil
 }
 
+func (oref *ongoingValRef) ResolveRange(offset, length int) (val []byte, err error) {
+	if offset < 0 || length < 0 {
+		return nil, ErrIllegalArguments
+	}
+
+	if offset > len(oref.value) {
+		return nil, ErrValueRangeOutOfBounds
+	}
+
+	if length == 0 || length > len(oref.value)-offset {
+		length = len(oref.value) - offset
+	}
+
+	return oref.value[offset : offset+length], nil
+}
+
 func (oref *ongoingValRef) Tx() uint64 {
 	return 0
 }
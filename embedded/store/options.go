@@ -23,6 +23,7 @@ import (
 
 	"github.com/codenotary/immudb/embedded/ahtree"
 	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/encrypted"
 	"github.com/codenotary/immudb/embedded/appendable/multiapp"
 	"github.com/codenotary/immudb/embedded/tbtree"
 	"github.com/codenotary/immudb/pkg/logger"
@@ -51,6 +52,7 @@ const DefaultCommitLogMaxOpenedFiles = 10
 const DefaultWriteTxHeaderVersion = MaxTxHeaderVersion
 const DefaultWriteBufferSize = 1 << 22 //4Mb
 const DefaultIndexingMaxBulkSize = 1
+const DefaultIndexRebuildParallelism = 1
 const DefaultBulkPreparationTimeout = DefaultSyncFrequency
 const DefaultTruncationFrequency = 24 * time.Hour
 const MinimumRetentionPeriod = 24 * time.Hour
@@ -58,6 +60,9 @@ const MinimumTruncationFrequency = 1 * time.Hour
 
 const MaxFileSize = (1 << 31) - 1 // 2Gb
 
+const DefaultIndexName = "default"
+const MaxIndexNameLen = 64
+
 type AppFactoryFunc func(
 	rootPath string,
 	subPath string,
@@ -84,6 +89,10 @@ type Options struct {
 
 	appFactory AppFactoryFunc
 
+	// when set, the content of every appendable is encrypted with the keys provided
+	encryptionKeys encrypted.KeyProvider
+	encryptionOpts *encrypted.Options
+
 	CompactionDisabled bool
 
 	// Maximum number of pre-committed transactions
@@ -124,18 +133,22 @@ type Options struct {
 	UseExternalCommitAllowance bool
 
 	// options below are only set during initialization and stored as metadata
-	MaxTxEntries      int
-	MaxKeyLen         int
-	MaxValueLen       int
-	FileSize          int
-	CompressionFormat int
-	CompressionLevel  int
-	EmbeddedValues    bool
-	PreallocFiles     bool
+	MaxTxEntries          int
+	MaxKeyLen             int
+	MaxValueLen           int
+	FileSize              int
+	CompressionFormat     int
+	CompressionLevel      int
+	CompressionDictionary []byte
+	EmbeddedValues        bool
+	PreallocFiles         bool
 
 	// options below affect indexing
 	IndexOpts *IndexOptions
 
+	// additional indexes, each one covering the keys with a given prefix
+	Indexes []*IndexSpec
+
 	// options below affect appendable hash tree
 	AHTOpts *AHTOptions
 }
@@ -185,6 +198,26 @@ type IndexOptions struct {
 
 	// Maximum time waiting for more transactions to be committed and included into the same bulk
 	BulkPreparationTimeout time.Duration
+
+	// Time between automatic index compactions (disabled when zero)
+	CompactionFrequency time.Duration
+
+	// Number of workers used to rebuild the index from scratch (rebuilds are sequential when lower than 2)
+	RebuildParallelism int
+}
+
+// IndexSpec defines an index covering the keys starting with the given prefix.
+// Keys are indexed by the index with the longest matching prefix, the default
+// index covers all the keys not covered by any other index
+type IndexSpec struct {
+	// Name used to refer to the index, it's also the name of the index folder
+	Name string
+
+	// Prefix of the indexed keys
+	Prefix []byte
+
+	// Options of the index, store index options are used when nil
+	Opts *IndexOptions
 }
 
 type AHTOptions struct {
@@ -259,6 +292,7 @@ func DefaultIndexOptions() *IndexOptions {
 
 		MaxBulkSize:            DefaultIndexingMaxBulkSize,
 		BulkPreparationTimeout: DefaultBulkPreparationTimeout,
+		RebuildParallelism:     DefaultIndexRebuildParallelism,
 	}
 }
 
@@ -342,18 +376,54 @@ func (opts *Options) Validate() error {
 	if opts.MaxValueLen <= 0 {
 		return fmt.Errorf("%w: invalid MaxValueLen", ErrInvalidOptions)
 	}
+	if opts.CompressionFormat < appendable.NoCompression || opts.CompressionFormat > appendable.SnappyCompression {
+		return fmt.Errorf("%w: invalid CompressionFormat", ErrInvalidOptions)
+	}
+	if len(opts.CompressionDictionary) > appendable.MaxDictionarySize {
+		return fmt.Errorf("%w: invalid CompressionDictionary", ErrInvalidOptions)
+	}
 	if opts.FileSize <= 0 || opts.FileSize >= MaxFileSize {
 		return fmt.Errorf("%w: invalid FileSize", ErrInvalidOptions)
 	}
 	if opts.logger == nil {
 		return fmt.Errorf("%w: invalid log", ErrInvalidOptions)
 	}
+	if opts.encryptionKeys != nil {
+		if opts.CompressionFormat != appendable.NoCompression {
+			return fmt.Errorf("%w: compression can not be used along with encryption", ErrInvalidOptions)
+		}
+
+		err := opts.encryptionOpts.Validate()
+		if err != nil {
+			return fmt.Errorf("%w: invalid encryption options: %v", ErrInvalidOptions, err)
+		}
+	}
 
 	err := opts.IndexOpts.Validate()
 	if err != nil {
 		return err
 	}
 
+	names := make(map[string]struct{}, len(opts.Indexes))
+	prefixes := make(map[string]struct{}, len(opts.Indexes))
+
+	for _, spec := range opts.Indexes {
+		err := spec.Validate()
+		if err != nil {
+			return err
+		}
+
+		if _, ok := names[spec.Name]; ok {
+			return fmt.Errorf("%w: duplicated index name '%s'", ErrInvalidOptions, spec.Name)
+		}
+		names[spec.Name] = struct{}{}
+
+		if _, ok := prefixes[string(spec.Prefix)]; ok {
+			return fmt.Errorf("%w: duplicated prefix of index '%s'", ErrInvalidOptions, spec.Name)
+		}
+		prefixes[string(spec.Prefix)] = struct{}{}
+	}
+
 	return opts.AHTOpts.Validate()
 }
 
@@ -406,10 +476,46 @@ func (opts *IndexOptions) Validate() error {
 	if opts.CommitLogMaxOpenedFiles <= 0 {
 		return fmt.Errorf("%w: invalid index option CommitLogMaxOpenedFiles", ErrInvalidOptions)
 	}
+	if opts.CompactionFrequency < 0 {
+		return fmt.Errorf("%w: invalid index option CompactionFrequency", ErrInvalidOptions)
+	}
+	if opts.RebuildParallelism < 1 {
+		return fmt.Errorf("%w: invalid index option RebuildParallelism", ErrInvalidOptions)
+	}
 
 	return nil
 }
 
+func (spec *IndexSpec) Validate() error {
+	if spec == nil {
+		return fmt.Errorf("%w: nil index spec", ErrInvalidOptions)
+	}
+	if !validIndexName(spec.Name) {
+		return fmt.Errorf("%w: invalid index name '%s'", ErrInvalidOptions, spec.Name)
+	}
+	if len(spec.Prefix) == 0 {
+		return fmt.Errorf("%w: empty prefix of index '%s'", ErrInvalidOptions, spec.Name)
+	}
+	if spec.Opts == nil {
+		return nil
+	}
+	return spec.Opts.Validate()
+}
+
+func validIndexName(name string) bool {
+	if name == "" || name == DefaultIndexName || len(name) > MaxIndexNameLen {
+		return false
+	}
+
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z') && !(c >= 'A' && c <= 'Z') && !(c >= '0' && c <= '9') && c != '_' && c != '-' {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (opts *AHTOptions) Validate() error {
 	if opts == nil {
 		return fmt.Errorf("%w: nil AHT options ", ErrInvalidOptions)
@@ -459,6 +565,23 @@ func (opts *Options) WithAppFactory(appFactory AppFactoryFunc) *Options {
 	return opts
 }
 
+// WithEncryption encrypts the content of every appendable of the store with the keys provided,
+// encryption is disabled when keys is nil
+func (opts *Options) WithEncryption(keys encrypted.KeyProvider, encOpts *encrypted.Options) *Options {
+	opts.encryptionKeys = keys
+	opts.encryptionOpts = encOpts
+	return opts
+}
+
+// appendableWrapper returns the wrapper of the appendables of the store, nil when encryption is disabled
+func (opts *Options) appendableWrapper() (multiapp.AppendableWrapper, error) {
+	if opts.encryptionKeys == nil {
+		return nil, nil
+	}
+
+	return encrypted.NewAppendableWrapper(opts.encryptionKeys, opts.encryptionOpts)
+}
+
 func (opts *Options) WithCompactionDisabled(disabled bool) *Options {
 	opts.CompactionDisabled = disabled
 	return opts
@@ -559,6 +682,13 @@ func (opts *Options) WithCompresionLevel(compressionLevel int) *Options {
 	return opts
 }
 
+// WithCompressionDictionary sets the dictionary used when values are compressed with Zstd,
+// it can be built from sample values with appendable.TrainDictionary
+func (opts *Options) WithCompressionDictionary(dict []byte) *Options {
+	opts.CompressionDictionary = dict
+	return opts
+}
+
 func (opts *Options) WithEmbeddedValues(embeddedValues bool) *Options {
 	opts.EmbeddedValues = embeddedValues
 	return opts
@@ -574,6 +704,11 @@ func (opts *Options) WithIndexOptions(indexOptions *IndexOptions) *Options {
 	return opts
 }
 
+func (opts *Options) WithIndexes(indexes ...*IndexSpec) *Options {
+	opts.Indexes = indexes
+	return opts
+}
+
 func (opts *Options) WithAHTOptions(ahtOptions *AHTOptions) *Options {
 	opts.AHTOpts = ahtOptions
 	return opts
@@ -656,6 +791,16 @@ func (opts *IndexOptions) WithCommitLogMaxOpenedFiles(commitLogMaxOpenedFiles in
 	return opts
 }
 
+func (opts *IndexOptions) WithCompactionFrequency(compactionFrequency time.Duration) *IndexOptions {
+	opts.CompactionFrequency = compactionFrequency
+	return opts
+}
+
+func (opts *IndexOptions) WithRebuildParallelism(rebuildParallelism int) *IndexOptions {
+	opts.RebuildParallelism = rebuildParallelism
+	return opts
+}
+
 // AHTOptions
 
 func (opts *AHTOptions) WithWriteBufferSize(writeBufferSize int) *AHTOptions {
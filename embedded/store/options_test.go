@@ -54,7 +54,23 @@ func TestInvalidOptions(t *testing.T) {
 		{"MaxKeyLen-max", DefaultOptions().WithMaxKeyLen(MaxKeyLen + 1)},
 		{"MaxValueLen", DefaultOptions().WithMaxValueLen(0)},
 		{"FileSize", DefaultOptions().WithFileSize(0)},
+		{"CompressionFormat", DefaultOptions().WithCompressionFormat(appendable.SnappyCompression + 1)},
+		{"CompressionDictionary", DefaultOptions().WithCompressionDictionary(make([]byte, appendable.MaxDictionarySize+1))},
 		{"FileSize-max", DefaultOptions().WithFileSize(MaxFileSize)},
+		{"Indexes-nil", DefaultOptions().WithIndexes(nil)},
+		{"Indexes-name", DefaultOptions().WithIndexes(&IndexSpec{Name: "", Prefix: []byte("a")})},
+		{"Indexes-default-name", DefaultOptions().WithIndexes(&IndexSpec{Name: DefaultIndexName, Prefix: []byte("a")})},
+		{"Indexes-invalid-name", DefaultOptions().WithIndexes(&IndexSpec{Name: "../idx", Prefix: []byte("a")})},
+		{"Indexes-prefix", DefaultOptions().WithIndexes(&IndexSpec{Name: "idx"})},
+		{"Indexes-opts", DefaultOptions().WithIndexes(&IndexSpec{Name: "idx", Prefix: []byte("a"), Opts: &IndexOptions{}})},
+		{"Indexes-duplicated-name", DefaultOptions().WithIndexes(
+			&IndexSpec{Name: "idx", Prefix: []byte("a")},
+			&IndexSpec{Name: "idx", Prefix: []byte("b")},
+		)},
+		{"Indexes-duplicated-prefix", DefaultOptions().WithIndexes(
+			&IndexSpec{Name: "idx1", Prefix: []byte("a")},
+			&IndexSpec{Name: "idx2", Prefix: []byte("a")},
+		)},
 	} {
 		t.Run(d.n, func(t *testing.T) {
 			require.ErrorIs(t, d.opts.Validate(), ErrInvalidOptions)
@@ -85,6 +101,8 @@ func TestInvalidIndexOptions(t *testing.T) {
 		{"NodesLogMaxOpenedFiles", DefaultIndexOptions().WithNodesLogMaxOpenedFiles(0)},
 		{"HistoryLogMaxOpenedFiles", DefaultIndexOptions().WithHistoryLogMaxOpenedFiles(0)},
 		{"CommitLogMaxOpenedFiles", DefaultIndexOptions().WithCommitLogMaxOpenedFiles(0)},
+		{"CompactionFrequency", DefaultIndexOptions().WithCompactionFrequency(-1)},
+		{"RebuildParallelism", DefaultIndexOptions().WithRebuildParallelism(0)},
 	} {
 		t.Run(d.n, func(t *testing.T) {
 			require.ErrorIs(t, d.opts.Validate(), ErrInvalidOptions)
@@ -118,6 +136,7 @@ func TestValidOptions(t *testing.T) {
 	require.Equal(t, 1, opts.WithCommitLogMaxOpenedFiles(1).CommitLogMaxOpenedFiles)
 	require.Equal(t, DefaultCompressionLevel, opts.WithCompresionLevel(DefaultCompressionLevel).CompressionLevel)
 	require.Equal(t, DefaultCompressionFormat, opts.WithCompressionFormat(DefaultCompressionFormat).CompressionFormat)
+	require.Equal(t, []byte{1, 2}, opts.WithCompressionDictionary([]byte{1, 2}).CompressionDictionary)
 	require.Equal(t, DefaultMaxConcurrency, opts.WithMaxConcurrency(DefaultMaxConcurrency).MaxConcurrency)
 	require.Equal(t, 1<<20, opts.WithWriteBufferSize(1<<20).WriteBufferSize)
 	require.Equal(t, DefaultFileMode, opts.WithFileMode(DefaultFileMode).FileMode)
@@ -195,6 +214,7 @@ func TestValidOptions(t *testing.T) {
 	require.Equal(t, 1*time.Millisecond, indexOpts.WithDelayDuringCompaction(1*time.Millisecond).DelayDuringCompaction)
 	require.Equal(t, 4096*2, indexOpts.WithFlushBufferSize(4096*2).FlushBufferSize)
 	require.Equal(t, float32(10), indexOpts.WithCleanupPercentage(10).CleanupPercentage)
+	require.Equal(t, 4, indexOpts.WithRebuildParallelism(4).RebuildParallelism)
 
 	require.Nil(t, opts.WithAHTOptions(nil).AHTOpts)
 	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
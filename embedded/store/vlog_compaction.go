@@ -0,0 +1,495 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/tbtree"
+)
+
+const vLogCompactionCheckpointFilename = "vlog_compaction"
+
+// number of keys visited between checkpoints of a value-log compaction
+const vLogCompactionBatchSize = 1_000
+
+// vLogCompactionCheckpoint keeps track of the progress of an interrupted compaction
+type vLogCompactionCheckpoint struct {
+	tombstones map[byte]int64
+	indexName  string
+	lastKey    []byte
+}
+
+// CompactValueLogs moves the values which are still referenced by the indexes and
+// are stored below the truncation point of minTxID into the tail of the value logs,
+// so value-log files can then be discarded as done by TruncateUptoTx.
+// Values keep being addressed by their original offsets, thus transactions and their proofs remain valid.
+// Only the latest non-deleted value of each key is preserved.
+// Moved values are written at a rate of at most maxBytesPerSec bytes per second (unlimited if zero).
+// An interrupted compaction is resumed by the following call, its truncation point is preserved until completed.
+func (s *ImmuStore) CompactValueLogs(ctx context.Context, minTxID uint64, maxBytesPerSec int) error {
+	if maxBytesPerSec < 0 {
+		return fmt.Errorf("%w: invalid maxBytesPerSec", ErrIllegalArguments)
+	}
+
+	if s.IsClosed() {
+		return ErrAlreadyClosed
+	}
+
+	if s.embeddedValues {
+		s.logger.Infof("value-log compaction with embedded values does not move any data")
+		return nil
+	}
+
+	if s.compactionDisabled {
+		return ErrCompactionUnsupported
+	}
+
+	s.vLogCompactionMutex.Lock()
+	defer s.vLogCompactionMutex.Unlock()
+
+	err := s.openRelocations()
+	if err != nil {
+		return err
+	}
+
+	checkpoint, err := s.readVLogCompactionCheckpoint()
+	if err != nil {
+		return err
+	}
+
+	if checkpoint == nil {
+		// values referenced by the indexes must be visible before compacting
+		err = s.WaitForIndexingUpto(ctx, minTxID)
+		if err != nil {
+			return err
+		}
+
+		tombstones, err := s.valueLogTombstones(minTxID)
+		if err != nil {
+			return err
+		}
+
+		checkpoint = &vLogCompactionCheckpoint{
+			tombstones: tombstones,
+			indexName:  s.indexers[0].name,
+		}
+
+		err = s.writeVLogCompactionCheckpoint(checkpoint)
+		if err != nil {
+			return err
+		}
+
+		s.logger.Infof("running value-log compaction up to transaction '%d'", minTxID)
+	} else {
+		s.logger.Infof("resuming value-log compaction from key '%s' of index '%s'", checkpoint.lastKey, checkpoint.indexName)
+	}
+
+	throttler := newVLogCompactionThrottler(maxBytesPerSec)
+
+	start := 0
+
+	for i, indexer := range s.indexers {
+		if indexer.name == checkpoint.indexName {
+			start = i
+			break
+		}
+	}
+
+	if s.indexers[start].name != checkpoint.indexName {
+		// the index being compacted is no longer available, all the indexes are visited again
+		checkpoint.lastKey = nil
+	}
+
+	for i, indexer := range s.indexers[start:] {
+		if i > 0 || indexer.name != checkpoint.indexName {
+			checkpoint.indexName = indexer.name
+			checkpoint.lastKey = nil
+		}
+
+		err = s.compactValuesOf(ctx, indexer, checkpoint, throttler)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.discardValueLogs(checkpoint.tombstones)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(filepath.Join(s.path, vLogCompactionCheckpointFilename))
+	if err != nil {
+		return err
+	}
+
+	s.logger.Infof("value-log compaction completed, %d bytes moved", throttler.moved)
+
+	return nil
+}
+
+// compactValuesOf moves the live values referenced by the index which would be discarded by the checkpoint tombstones.
+// Relocations are persisted along with the checkpoint once the moved values are durably written
+func (s *ImmuStore) compactValuesOf(ctx context.Context, indexer *indexer, checkpoint *vLogCompactionCheckpoint, throttler *vLogCompactionThrottler) error {
+	for {
+		kvts, entries, err := s.collectValuesToCompact(indexer, checkpoint, vLogCompactionBatchSize)
+		if err != nil {
+			return err
+		}
+
+		if len(entries) > 0 {
+			offsets, err := s.appendValuesIntoAnyVLog(entries)
+			if err != nil {
+				return err
+			}
+
+			for i := range kvts {
+				binary.BigEndian.PutUint64(kvts[i].V, uint64(offsets[i]))
+
+				err = throttler.wait(ctx, len(entries[i].Value))
+				if err != nil {
+					return err
+				}
+			}
+
+			err = s.syncVLogs()
+			if err != nil {
+				return err
+			}
+
+			err = s.relocations.BulkInsert(kvts)
+			if err != nil {
+				return err
+			}
+
+			_, _, err = s.relocations.FlushWith(0, true)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = s.writeVLogCompactionCheckpoint(checkpoint)
+		if err != nil {
+			return err
+		}
+
+		if checkpoint.lastKey == nil {
+			// all the keys of the index were visited
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+	}
+}
+
+// collectValuesToCompact reads the values to be moved from the keys following the checkpoint,
+// which is then advanced up to the last visited key or reset if there are no more keys in the index
+func (s *ImmuStore) collectValuesToCompact(indexer *indexer, checkpoint *vLogCompactionCheckpoint, limit int) ([]*tbtree.KVT, []*EntrySpec, error) {
+	snap, err := indexer.SnapshotMustIncludeTxIDWithRenewalPeriod(indexer.Ts(), 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer snap.Close()
+
+	reader, err := snap.NewReader(tbtree.ReaderSpec{SeekKey: checkpoint.lastKey})
+	if err != nil {
+		return nil, nil, err
+	}
+	defer reader.Close()
+
+	var kvts []*tbtree.KVT
+	var entries []*EntrySpec
+
+	for i := 0; i < limit; i++ {
+		key, indexedVal, ts, hc, err := reader.Read()
+		if errors.Is(err, tbtree.ErrNoMoreEntries) {
+			checkpoint.lastKey = nil
+			return kvts, entries, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		checkpoint.lastKey = key
+
+		valRef, err := s.valueRefFrom(ts, hc, indexedVal)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		vRef := valRef.(*valueRef)
+
+		if vRef.valLen == 0 || (vRef.kvmd != nil && (vRef.kvmd.Deleted() || vRef.kvmd.ExpiredAt(time.Now()))) {
+			continue
+		}
+
+		vLogID, off, err := s.relocatedOffset(vRef.vOff)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		tombstone, ok := checkpoint.tombstones[vLogID]
+		if vLogID == 0 || !ok || off >= tombstone {
+			continue
+		}
+
+		val := make([]byte, vRef.valLen)
+
+		_, err = s.readValueAt(val, vRef.vOff, vRef.hVal, false)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var origOff [offsetSize]byte
+		binary.BigEndian.PutUint64(origOff[:], uint64(vRef.vOff))
+
+		kvts = append(kvts, &tbtree.KVT{K: origOff[:], V: make([]byte, offsetSize)})
+		entries = append(entries, &EntrySpec{Key: key, Value: val})
+	}
+
+	return kvts, entries, nil
+}
+
+// relocatedOffset returns the current location of the value originally written at off
+func (s *ImmuStore) relocatedOffset(off int64) (byte, int64, error) {
+	vLogID, offset := decodeOffset(off)
+
+	if s.relocations == nil || vLogID == 0 {
+		return vLogID, offset, nil
+	}
+
+	var origOff [offsetSize]byte
+	binary.BigEndian.PutUint64(origOff[:], uint64(off))
+
+	newOff, _, _, err := s.relocations.Get(origOff[:])
+	if errors.Is(err, tbtree.ErrKeyNotFound) {
+		return vLogID, offset, nil
+	}
+	if errors.Is(err, tbtree.ErrAlreadyClosed) {
+		return 0, 0, ErrAlreadyClosed
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+
+	vLogID, offset = decodeOffset(int64(binary.BigEndian.Uint64(newOff)))
+
+	return vLogID, offset, nil
+}
+
+func (s *ImmuStore) openRelocations() error {
+	s.relocationsMutex.Lock()
+	defer s.relocationsMutex.Unlock()
+
+	if s.relocations != nil {
+		return nil
+	}
+
+	relocations, err := tbtree.Open(filepath.Join(s.path, relocationsDirname), s.relocationsOpts)
+	if err != nil {
+		return fmt.Errorf("could not open relocation table: %w", err)
+	}
+
+	s.relocations = relocations
+
+	return nil
+}
+
+// syncVLogs ensures values appended into the value logs are durably written
+func (s *ImmuStore) syncVLogs() error {
+	for i := range s.vLogs {
+		err := s.syncVLog(i + 1)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *ImmuStore) syncVLog(vLogID byte) error {
+	vLog, err := s.fetchVLog(vLogID)
+	if err != nil {
+		return err
+	}
+	defer s.releaseVLog(vLogID)
+
+	err = vLog.Flush()
+	if err != nil {
+		return s.wrapAppendableErr(err, "flushing value log")
+	}
+
+	return s.wrapAppendableErr(vLog.Sync(), "syncing value log")
+}
+
+func (s *ImmuStore) readVLogCompactionCheckpoint() (*vLogCompactionCheckpoint, error) {
+	b, err := os.ReadFile(filepath.Join(s.path, vLogCompactionCheckpointFilename))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint := &vLogCompactionCheckpoint{
+		tombstones: make(map[byte]int64),
+	}
+
+	i := 0
+
+	if len(b) < 1 {
+		return nil, fmt.Errorf("%w: invalid value-log compaction checkpoint", ErrCorruptedData)
+	}
+
+	tombstonesCount := int(b[i])
+	i++
+
+	if len(b) < i+tombstonesCount*(1+offsetSize)+2*sszSize {
+		return nil, fmt.Errorf("%w: invalid value-log compaction checkpoint", ErrCorruptedData)
+	}
+
+	for j := 0; j < tombstonesCount; j++ {
+		vLogID := b[i]
+		i++
+
+		checkpoint.tombstones[vLogID] = int64(binary.BigEndian.Uint64(b[i:]))
+		i += offsetSize
+	}
+
+	nameLen := int(binary.BigEndian.Uint16(b[i:]))
+	i += sszSize
+
+	if len(b) < i+nameLen+sszSize {
+		return nil, fmt.Errorf("%w: invalid value-log compaction checkpoint", ErrCorruptedData)
+	}
+
+	checkpoint.indexName = string(b[i : i+nameLen])
+	i += nameLen
+
+	keyLen := int(binary.BigEndian.Uint16(b[i:]))
+	i += sszSize
+
+	if len(b) != i+keyLen {
+		return nil, fmt.Errorf("%w: invalid value-log compaction checkpoint", ErrCorruptedData)
+	}
+
+	if keyLen > 0 {
+		checkpoint.lastKey = b[i:]
+	}
+
+	return checkpoint, nil
+}
+
+// writeVLogCompactionCheckpoint atomically replaces the checkpoint file
+func (s *ImmuStore) writeVLogCompactionCheckpoint(checkpoint *vLogCompactionCheckpoint) error {
+	b := make([]byte, 1+len(checkpoint.tombstones)*(1+offsetSize)+sszSize+len(checkpoint.indexName)+sszSize+len(checkpoint.lastKey))
+
+	i := 0
+
+	b[i] = byte(len(checkpoint.tombstones))
+	i++
+
+	for vLogID, off := range checkpoint.tombstones {
+		b[i] = vLogID
+		i++
+
+		binary.BigEndian.PutUint64(b[i:], uint64(off))
+		i += offsetSize
+	}
+
+	binary.BigEndian.PutUint16(b[i:], uint16(len(checkpoint.indexName)))
+	i += sszSize
+
+	i += copy(b[i:], checkpoint.indexName)
+
+	binary.BigEndian.PutUint16(b[i:], uint16(len(checkpoint.lastKey)))
+	i += sszSize
+
+	copy(b[i:], checkpoint.lastKey)
+
+	path := filepath.Join(s.path, vLogCompactionCheckpointFilename)
+
+	f, err := os.OpenFile(path+".tmp", os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(b)
+	if err == nil {
+		err = f.Sync()
+	}
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(path+".tmp", path)
+}
+
+// vLogCompactionThrottler limits the rate at which values are moved
+type vLogCompactionThrottler struct {
+	maxBytesPerSec int
+	startedAt      time.Time
+	moved          int64
+}
+
+func newVLogCompactionThrottler(maxBytesPerSec int) *vLogCompactionThrottler {
+	return &vLogCompactionThrottler{
+		maxBytesPerSec: maxBytesPerSec,
+		startedAt:      time.Now(),
+	}
+}
+
+func (t *vLogCompactionThrottler) wait(ctx context.Context, n int) error {
+	t.moved += int64(n)
+
+	if t.maxBytesPerSec == 0 {
+		return nil
+	}
+
+	expected := time.Duration(float64(t.moved) / float64(t.maxBytesPerSec) * float64(time.Second))
+
+	delay := expected - time.Since(t.startedAt)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
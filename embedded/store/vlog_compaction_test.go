@@ -0,0 +1,171 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompactValueLogs(t *testing.T) {
+	fileSize := 1024
+
+	dir := t.TempDir()
+
+	opts := DefaultOptions().
+		WithFileSize(fileSize).
+		WithMaxIOConcurrency(1)
+
+	st, err := Open(dir, opts)
+	require.NoError(t, err)
+
+	set := func(key string, value []byte) *TxHeader {
+		tx, err := st.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte(key), nil, value)
+		require.NoError(t, err)
+
+		hdr, err := tx.Commit(context.Background())
+		require.NoError(t, err)
+
+		return hdr
+	}
+
+	value := func(i int) []byte {
+		v := make([]byte, fileSize)
+		copy(v, fmt.Sprintf("value_%d", i))
+		return v
+	}
+
+	firstHdr := set("long-lived", value(0))
+
+	for i := 1; i <= 10; i++ {
+		set(fmt.Sprintf("key_%d", i%3), value(i))
+	}
+
+	lastHdr := set("key_0", value(11))
+
+	err = st.CompactValueLogs(context.Background(), 0, -1)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = st.CompactValueLogs(context.Background(), lastHdr.ID, 0)
+	require.NoError(t, err)
+
+	_, err = os.Stat(filepath.Join(dir, "val_0", "00000000.val"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	_, err = os.Stat(filepath.Join(dir, vLogCompactionCheckpointFilename))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	requireValue := func(t *testing.T, key string, expectedTx uint64, expectedValue []byte) {
+		valRef, err := st.Get([]byte(key))
+		require.NoError(t, err)
+		require.Equal(t, expectedTx, valRef.Tx())
+
+		val, err := valRef.Resolve()
+		require.NoError(t, err)
+		require.Equal(t, expectedValue, val)
+
+		tx := NewTx(st.MaxTxEntries(), st.MaxKeyLen())
+
+		err = st.ReadTx(expectedTx, false, tx)
+		require.NoError(t, err)
+
+		val, err = st.ReadValue(tx.Entries()[0])
+		require.NoError(t, err)
+		require.Equal(t, expectedValue, val)
+	}
+
+	t.Run("live values should be preserved", func(t *testing.T) {
+		requireValue(t, "long-lived", firstHdr.ID, value(0))
+		requireValue(t, "key_1", firstHdr.ID+10, value(10))
+		requireValue(t, "key_2", firstHdr.ID+8, value(8))
+		requireValue(t, "key_0", lastHdr.ID, value(11))
+	})
+
+	t.Run("outdated values should be discarded", func(t *testing.T) {
+		tx := NewTx(st.MaxTxEntries(), st.MaxKeyLen())
+
+		err = st.ReadTx(firstHdr.ID+1, false, tx)
+		require.NoError(t, err)
+
+		_, err = st.ReadValue(tx.Entries()[0])
+		require.Error(t, err)
+	})
+
+	t.Run("transactions should remain verifiable", func(t *testing.T) {
+		proof, err := st.DualProof(firstHdr, lastHdr)
+		require.NoError(t, err)
+
+		verifies := VerifyDualProof(proof, firstHdr.ID, lastHdr.ID, firstHdr.Alh(), lastHdr.Alh())
+		require.True(t, verifies)
+	})
+
+	t.Run("interrupted compaction should be resumed", func(t *testing.T) {
+		for i := 12; i <= 20; i++ {
+			set(fmt.Sprintf("key_%d", i%3), value(i))
+		}
+
+		hdr := set("key_0", value(21))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		err = st.CompactValueLogs(ctx, hdr.ID, 1)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		_, err = os.Stat(filepath.Join(dir, vLogCompactionCheckpointFilename))
+		require.NoError(t, err)
+
+		err = st.CompactValueLogs(context.Background(), hdr.ID, 0)
+		require.NoError(t, err)
+
+		_, err = os.Stat(filepath.Join(dir, vLogCompactionCheckpointFilename))
+		require.ErrorIs(t, err, os.ErrNotExist)
+
+		requireValue(t, "long-lived", firstHdr.ID, value(0))
+		requireValue(t, "key_1", hdr.ID-2, value(19))
+		requireValue(t, "key_2", hdr.ID-1, value(20))
+	})
+
+	t.Run("relocated values should be readable after reopening the store", func(t *testing.T) {
+		err = st.Close()
+		require.NoError(t, err)
+
+		st, err = Open(dir, opts)
+		require.NoError(t, err)
+		defer immustoreClose(t, st)
+
+		requireValue(t, "long-lived", firstHdr.ID, value(0))
+	})
+}
+
+func TestCompactValueLogsWithEmbeddedValues(t *testing.T) {
+	st, err := Open(t.TempDir(), DefaultOptions().WithEmbeddedValues(true))
+	require.NoError(t, err)
+	defer immustoreClose(t, st)
+
+	err = st.CompactValueLogs(context.Background(), 1, 0)
+	require.NoError(t, err)
+}
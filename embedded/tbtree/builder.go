@@ -0,0 +1,426 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tbtree
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+)
+
+// Builder fills an empty tree bottom-up.
+// Entries must be added in key order and, for the same key, in increasing time order.
+// Leaf nodes are written as soon as they get full and inner nodes are built on top of them
+// level by level, thus only the node being filled at each level is kept in memory.
+// The tree must not be modified until the builder is committed.
+type Builder struct {
+	t *TBtree
+
+	// value being built, it's added into the current leaf once all the updates of its key were provided
+	pending *leafValue
+
+	leaf   *leafNode
+	levels []*innerNode // inner node being filled at each level, from the bottom
+
+	lastNode     node
+	lastNodeSize int
+
+	// amount of data written into the nodes and history logs
+	wN int64
+	wH int64
+
+	buf            []byte
+	reportProgress writeProgressOutputFunc
+	finishOutput   writeFinnishOutputFunc
+
+	closed bool
+}
+
+// NewBuilder returns a builder for the tree, which must be empty
+func (t *TBtree) NewBuilder() (*Builder, error) {
+	t.rwmutex.Lock()
+	defer t.rwmutex.Unlock()
+
+	if t.closed {
+		return nil, ErrAlreadyClosed
+	}
+
+	if t.root.ts() > 0 || t.root.minKey() != nil {
+		return nil, fmt.Errorf("%w: only empty indexes can be built", ErrIllegalState)
+	}
+
+	// will overwrite partially written and uncommitted data
+	err := t.hLog.SetOffset(t.committedHLogSize)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.nLog.SetOffset(t.committedNLogSize)
+	if err != nil {
+		return nil, err
+	}
+
+	reportProgress, finishOutput := t.buildWriteProgressOutput(
+		metricsFlushedNodesLastCycle,
+		metricsFlushedNodesTotal,
+		metricsFlushedEntriesLastCycle,
+		metricsFlushedEntriesTotal,
+		"Building",
+		0,
+		time.Minute,
+	)
+
+	return &Builder{
+		t:              t,
+		buf:            make([]byte, t.maxNodeSize),
+		reportProgress: reportProgress,
+		finishOutput:   finishOutput,
+	}, nil
+}
+
+// Add adds an entry into the tree being built
+func (b *Builder) Add(kvt *KVT) error {
+	b.t.rwmutex.Lock()
+	defer b.t.rwmutex.Unlock()
+
+	if b.t.closed {
+		return ErrAlreadyClosed
+	}
+
+	if b.closed {
+		return fmt.Errorf("%w: builder already committed", ErrIllegalState)
+	}
+
+	if kvt == nil || len(kvt.K) == 0 || len(kvt.V) == 0 || kvt.T == 0 {
+		return ErrIllegalArguments
+	}
+
+	if len(kvt.K) > b.t.maxKeySize {
+		return ErrorMaxKeySizeExceeded
+	}
+
+	if len(kvt.V) > b.t.maxValueSize {
+		return ErrorMaxValueSizeExceeded
+	}
+
+	cmp := 1
+	if b.pending != nil {
+		cmp = bytes.Compare(kvt.K, b.pending.key)
+	}
+
+	if cmp < 0 || (cmp == 0 && kvt.T <= b.pending.ts) {
+		return fmt.Errorf("%w: entries must be added in key and time order", ErrIllegalArguments)
+	}
+
+	v := make([]byte, len(kvt.V))
+	copy(v, kvt.V)
+
+	if cmp == 0 {
+		b.pending.value = v
+		b.pending.ts = kvt.T
+		b.pending.tss = append(b.pending.tss, kvt.T)
+
+		return nil
+	}
+
+	if b.pending != nil {
+		err := b.addValue(b.pending)
+		if err != nil {
+			return err
+		}
+	}
+
+	k := make([]byte, len(kvt.K))
+	copy(k, kvt.K)
+
+	b.pending = &leafValue{
+		key:   k,
+		value: v,
+		ts:    kvt.T,
+		tss:   []uint64{kvt.T},
+		hOff:  -1,
+	}
+
+	return nil
+}
+
+// addValue appends a value into the current leaf, the leaf is written beforehand if the value doesn't fit in
+func (b *Builder) addValue(lv *leafValue) error {
+	// timestamps are kept in descending order by leaf nodes
+	for i, j := 0, len(lv.tss)-1; i < j; i, j = i+1, j-1 {
+		lv.tss[i], lv.tss[j] = lv.tss[j], lv.tss[i]
+	}
+
+	if b.leaf != nil {
+		b.leaf.values = append(b.leaf.values, lv)
+
+		size, err := b.leaf.size()
+		if err != nil {
+			return err
+		}
+
+		if size <= b.t.maxNodeSize {
+			if b.leaf._ts < lv.ts {
+				b.leaf._ts = lv.ts
+			}
+
+			return nil
+		}
+
+		b.leaf.values = b.leaf.values[:len(b.leaf.values)-1]
+
+		err = b.writeLeaf()
+		if err != nil {
+			return err
+		}
+	}
+
+	b.leaf = &leafNode{
+		t:      b.t,
+		values: []*leafValue{lv},
+		_ts:    lv.ts,
+		mut:    true,
+	}
+
+	return nil
+}
+
+func (b *Builder) writeLeaf() error {
+	ref, err := b.write(b.leaf)
+	if err != nil {
+		return err
+	}
+
+	b.leaf = nil
+
+	return b.addRef(0, ref)
+}
+
+// addRef appends a reference to a written node into the inner node being filled at the given level,
+// the inner node is written beforehand if the reference doesn't fit in
+func (b *Builder) addRef(level int, ref *nodeRef) error {
+	if level == len(b.levels) {
+		b.levels = append(b.levels, &innerNode{t: b.t, mut: true})
+	}
+
+	n := b.levels[level]
+
+	n.nodes = append(n.nodes, ref)
+
+	size, err := n.size()
+	if err != nil {
+		return err
+	}
+
+	if size <= b.t.maxNodeSize {
+		if n._ts < ref._ts {
+			n._ts = ref._ts
+		}
+
+		return nil
+	}
+
+	n.nodes = n.nodes[:len(n.nodes)-1]
+
+	parentRef, err := b.write(n)
+	if err != nil {
+		return err
+	}
+
+	b.levels[level] = &innerNode{
+		t:     b.t,
+		nodes: []node{ref},
+		_ts:   ref._ts,
+		mut:   true,
+	}
+
+	return b.addRef(level+1, parentRef)
+}
+
+// write appends the node into the nodes log, children of inner nodes were already written
+func (b *Builder) write(n node) (*nodeRef, error) {
+	wopts := &WriteOpts{
+		OnlyMutated:    true,
+		BaseNLogOffset: b.t.committedNLogSize + b.wN,
+		BaseHLogOffset: b.t.committedHLogSize + b.wH,
+		commitLog:      true,
+		reportProgress: b.reportProgress,
+	}
+
+	off, minOff, wN, wH, err := n.writeTo(&appendableWriter{b.t.nLog}, &appendableWriter{b.t.hLog}, wopts, b.buf)
+	if err != nil {
+		return nil, err
+	}
+
+	b.wN += wN
+	b.wH += wH
+
+	size, err := n.size()
+	if err != nil {
+		return nil, err
+	}
+
+	b.lastNode = n
+	b.lastNodeSize = size
+
+	return &nodeRef{
+		t:       b.t,
+		_minKey: n.minKey(),
+		_ts:     n.ts(),
+		off:     off,
+		_minOff: minOff,
+	}, nil
+}
+
+// Commit writes the nodes being filled and the commit log entry referencing the root of the new tree
+func (b *Builder) Commit() error {
+	b.t.rwmutex.Lock()
+	defer b.t.rwmutex.Unlock()
+
+	if b.t.closed {
+		return ErrAlreadyClosed
+	}
+
+	if b.closed {
+		return fmt.Errorf("%w: builder already committed", ErrIllegalState)
+	}
+
+	b.closed = true
+
+	defer b.finishOutput()
+
+	if b.pending == nil {
+		// no entries were added
+		return nil
+	}
+
+	err := b.addValue(b.pending)
+	if err != nil {
+		return err
+	}
+
+	err = b.writeLeaf()
+	if err != nil {
+		return err
+	}
+
+	// inner nodes are written up to the root, which is the last written node
+	for level := 0; level < len(b.levels); level++ {
+		n := b.levels[level]
+
+		if level == len(b.levels)-1 && len(n.nodes) == 1 {
+			break
+		}
+
+		ref, err := b.write(n)
+		if err != nil {
+			return err
+		}
+
+		err = b.addRef(level+1, ref)
+		if err != nil {
+			return err
+		}
+	}
+
+	return b.t.commitBuild(b.lastNode, b.lastNodeSize, b.wN, b.wH)
+}
+
+// commitBuild syncs the data written by a builder and appends a commit log entry with root as the new root
+func (t *TBtree) commitBuild(root node, rootSize int, wN, wH int64) error {
+	err := t.hLog.Flush()
+	if err != nil {
+		return err
+	}
+
+	err = t.nLog.Flush()
+	if err != nil {
+		return err
+	}
+
+	err = t.hLog.Sync()
+	if err != nil {
+		return err
+	}
+
+	err = t.nLog.Sync()
+	if err != nil {
+		return err
+	}
+
+	cLogEntry := &cLogEntry{
+		synced: true,
+
+		initialNLogSize: t.committedNLogSize,
+		finalNLogSize:   t.committedNLogSize + wN,
+		rootNodeSize:    rootSize,
+
+		initialHLogSize: t.committedHLogSize,
+		finalHLogSize:   t.committedHLogSize + wH,
+	}
+
+	cLogEntry.nLogChecksum, err = appendable.Checksum(t.nLog, t.committedNLogSize, wN)
+	if err != nil {
+		return err
+	}
+
+	cLogEntry.hLogChecksum, err = appendable.Checksum(t.hLog, t.committedHLogSize, wH)
+	if err != nil {
+		return err
+	}
+
+	// will overwrite partially written and uncommitted data
+	err = t.cLog.SetOffset(t.committedLogSize)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = t.cLog.Append(cLogEntry.serialize())
+	if err != nil {
+		return err
+	}
+
+	err = t.cLog.Flush()
+	if err != nil {
+		return err
+	}
+
+	err = t.cLog.Sync()
+	if err != nil {
+		return err
+	}
+
+	t.root = root
+	t.minOffset = root.minOffset()
+	t.committedLogSize += cLogEntrySize
+	t.committedNLogSize += wN
+	t.committedHLogSize += wH
+
+	t.insertionCountSinceFlush = 0
+	t.insertionCountSinceSync = 0
+
+	metricsBtreeNodesDataEndOffset.WithLabelValues(t.path).Set(float64(t.committedNLogSize))
+
+	t.lastSnapRoot = t.root
+	t.lastSnapRootAt = time.Now()
+
+	t.logger.Infof("Index '%s' {ts=%d} successfully built", t.path, t.root.ts())
+
+	return nil
+}
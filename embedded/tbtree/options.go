@@ -80,6 +80,7 @@ type Options struct {
 	fileSize     int
 
 	appFactory AppFactoryFunc
+	appWrapper multiapp.AppendableWrapper
 }
 
 func DefaultOptions() *Options {
@@ -195,6 +196,12 @@ func (opts *Options) WithAppFactory(appFactory AppFactoryFunc) *Options {
 	return opts
 }
 
+// WithAppendableWrapper sets the wrapper of the appendables of the tree, e.g. to encrypt them
+func (opts *Options) WithAppendableWrapper(appWrapper multiapp.AppendableWrapper) *Options {
+	opts.appWrapper = appWrapper
+	return opts
+}
+
 func (opts *Options) WithFlushThld(flushThld int) *Options {
 	opts.flushThld = flushThld
 	return opts
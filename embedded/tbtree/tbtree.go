@@ -192,6 +192,7 @@ type TBtree struct {
 	cacheSize                  int
 	fileSize                   int
 	fileMode                   os.FileMode
+	appWrapper                 multiapp.AppendableWrapper
 	maxKeySize                 int
 	maxValueSize               int
 	compactionThld             int
@@ -315,7 +316,8 @@ func Open(path string, opts *Options) (*TBtree, error) {
 		WithFileSize(opts.fileSize).
 		WithFileMode(opts.fileMode).
 		WithWriteBufferSize(opts.flushBufferSize).
-		WithMetadata(metadata.Bytes())
+		WithMetadata(metadata.Bytes()).
+		WithAppendableWrapper(opts.appWrapper)
 
 	appFactory := opts.appFactory
 	if appFactory == nil {
@@ -567,6 +569,7 @@ func OpenWith(path string, nLog, hLog, cLog appendable.Appendable, opts *Options
 		fileSize:                 opts.fileSize,
 		cacheSize:                opts.cacheSize,
 		fileMode:                 opts.fileMode,
+		appWrapper:               opts.appWrapper,
 		compactionThld:           opts.compactionThld,
 		delayDuringCompaction:    opts.delayDuringCompaction,
 		nodesLogMaxOpenedFiles:   opts.nodesLogMaxOpenedFiles,
@@ -691,6 +694,7 @@ func (t *TBtree) GetOptions() *Options {
 	return DefaultOptions().
 		WithReadOnly(t.readOnly).
 		WithFileMode(t.fileMode).
+		WithAppendableWrapper(t.appWrapper).
 		WithFileSize(t.fileSize).
 		WithMaxKeySize(t.maxKeySize).
 		WithMaxValueSize(t.maxValueSize).
@@ -1378,7 +1382,8 @@ func (t *TBtree) fullDump(snap *Snapshot, progressOutput writeProgressOutputFunc
 		WithFileSize(t.fileSize).
 		WithFileMode(t.fileMode).
 		WithWriteBufferSize(t.flushBufferSize).
-		WithMetadata(t.cLog.Metadata())
+		WithMetadata(t.cLog.Metadata()).
+		WithAppendableWrapper(t.appWrapper)
 
 	appendableOpts.WithFileExt("n")
 	nLogPath := filepath.Join(t.path, snapFolder(nodesFolderPrefix, snap.Ts()))
@@ -1577,7 +1582,7 @@ func (t *TBtree) Insert(key []byte, value []byte) error {
 	t.lock()
 	defer t.unlock()
 
-	return t.bulkInsert([]*KVT{{K: key, V: value}})
+	return t.bulkInsert([]*KVT{{K: key, V: value}}, false)
 }
 
 // BulkInsert inserts multiple entries atomically.
@@ -1589,10 +1594,21 @@ func (t *TBtree) BulkInsert(kvts []*KVT) error {
 	t.lock()
 	defer t.unlock()
 
-	return t.bulkInsert(kvts)
+	return t.bulkInsert(kvts, false)
 }
 
-func (t *TBtree) bulkInsert(kvts []*KVT) error {
+// BulkLoad inserts multiple entries into a tree being populated from scratch.
+// Unlike BulkInsert, timestamps may be older than the root's current timestamp,
+// so entries can be loaded grouped by key instead of by time.
+// Timestamps must still be increased for each additional entry for a key.
+func (t *TBtree) BulkLoad(kvts []*KVT) error {
+	t.lock()
+	defer t.unlock()
+
+	return t.bulkInsert(kvts, true)
+}
+
+func (t *TBtree) bulkInsert(kvts []*KVT, allowOlderTs bool) error {
 	if t.closed {
 		return ErrAlreadyClosed
 	}
@@ -1633,7 +1649,7 @@ func (t *TBtree) bulkInsert(kvts []*KVT) error {
 		if t == 0 {
 			// zero-valued timestamps are associated with current time plus one
 			t = currTs + 1
-		} else if kvt.T < currTs {
+		} else if kvt.T < currTs && !allowOlderTs {
 			return fmt.Errorf("%w: specific timestamp is older than root's current timestamp", ErrIllegalArguments)
 		}
 
@@ -1648,6 +1664,11 @@ func (t *TBtree) bulkInsert(kvts []*KVT) error {
 		}
 	}
 
+	if newTs < currTs {
+		// entries loaded with older timestamps do not move the logical time backwards
+		newTs = currTs
+	}
+
 	nodes, depth, err := t.root.insert(immutableKVTs)
 	if err != nil {
 		// INVARIANT: if !node.mutated() then for every node 'n' in the subtree with node as root !n.mutated() also holds
@@ -1529,6 +1529,174 @@ func TestMultiTimedBulkInsertion(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestBulkLoad(t *testing.T) {
+	tbtree, err := Open(t.TempDir(), DefaultOptions())
+	require.NoError(t, err)
+
+	defer tbtree.Close()
+
+	err = tbtree.BulkLoad([]*KVT{
+		{K: []byte("key1"), V: []byte("value1_5"), T: 5},
+		{K: []byte("key1"), V: []byte("value1_9"), T: 9},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(9), tbtree.Ts())
+
+	t.Run("entries older than root's timestamp should be loaded", func(t *testing.T) {
+		err = tbtree.BulkLoad([]*KVT{
+			{K: []byte("key0"), V: []byte("value0_2"), T: 2},
+			{K: []byte("key2"), V: []byte("value2_1"), T: 1},
+			{K: []byte("key2"), V: []byte("value2_7"), T: 7},
+		})
+		require.NoError(t, err)
+
+		// logical time is not moved backwards
+		require.Equal(t, uint64(9), tbtree.Ts())
+
+		v, ts, hc, err := tbtree.Get([]byte("key2"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("value2_7"), v)
+		require.Equal(t, uint64(7), ts)
+		require.Equal(t, uint64(2), hc)
+
+		tss, _, err := tbtree.History([]byte("key1"), 0, false, 2)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{5, 9}, tss)
+	})
+
+	t.Run("bulk insertion should not accept older timestamps", func(t *testing.T) {
+		err = tbtree.BulkInsert([]*KVT{
+			{K: []byte("key3"), V: []byte("value3_4"), T: 4},
+		})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	t.Run("entries of the same key should be loaded with increasing timestamp", func(t *testing.T) {
+		err = tbtree.BulkLoad([]*KVT{
+			{K: []byte("key1"), V: []byte("value1_3"), T: 3},
+		})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+}
+
+func TestBuilder(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := DefaultOptions().
+		WithMaxKeySize(16).
+		WithMaxValueSize(16).
+		WithMaxNodeSize(256)
+
+	tbtree, err := Open(dir, opts)
+	require.NoError(t, err)
+
+	builder, err := tbtree.NewBuilder()
+	require.NoError(t, err)
+
+	keyCount := 1000
+	versions := 3
+
+	for i := 0; i < keyCount; i++ {
+		for j := 0; j < versions; j++ {
+			err = builder.Add(&KVT{
+				K: []byte(fmt.Sprintf("key%04d", i)),
+				V: []byte(fmt.Sprintf("value%04d_%d", i, j)),
+				T: uint64(keyCount*j + i + 1),
+			})
+			require.NoError(t, err)
+		}
+	}
+
+	t.Run("entries should be added in key and time order", func(t *testing.T) {
+		err := builder.Add(&KVT{K: []byte("key0000"), V: []byte("value"), T: uint64(keyCount*versions + 1)})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = builder.Add(&KVT{K: []byte(fmt.Sprintf("key%04d", keyCount-1)), V: []byte("value"), T: 1})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	err = builder.Commit()
+	require.NoError(t, err)
+
+	err = builder.Add(&KVT{K: []byte("key9999"), V: []byte("value"), T: uint64(keyCount*versions + 1)})
+	require.ErrorIs(t, err, ErrIllegalState)
+
+	checkTree := func(t *testing.T, tbtree *TBtree) {
+		require.Equal(t, uint64(keyCount*versions), tbtree.Ts())
+
+		for i := 0; i < keyCount; i++ {
+			key := []byte(fmt.Sprintf("key%04d", i))
+
+			v, ts, hc, err := tbtree.Get(key)
+			require.NoError(t, err)
+			require.Equal(t, []byte(fmt.Sprintf("value%04d_%d", i, versions-1)), v)
+			require.Equal(t, uint64(keyCount*(versions-1)+i+1), ts)
+			require.Equal(t, uint64(versions), hc)
+
+			tss, _, err := tbtree.History(key, 0, false, versions)
+			require.NoError(t, err)
+			require.Equal(t, []uint64{uint64(i + 1), uint64(keyCount + i + 1), uint64(2*keyCount + i + 1)}, tss)
+		}
+
+		snap, err := tbtree.Snapshot()
+		require.NoError(t, err)
+
+		defer snap.Close()
+
+		reader, err := snap.NewReader(ReaderSpec{})
+		require.NoError(t, err)
+
+		defer reader.Close()
+
+		for i := 0; i < keyCount; i++ {
+			k, _, _, _, err := reader.Read()
+			require.NoError(t, err)
+			require.Equal(t, []byte(fmt.Sprintf("key%04d", i)), k)
+		}
+
+		_, _, _, _, err = reader.Read()
+		require.ErrorIs(t, err, ErrNoMoreEntries)
+	}
+
+	t.Run("built tree should include all the entries", func(t *testing.T) {
+		checkTree(t, tbtree)
+	})
+
+	t.Run("only empty trees should be built", func(t *testing.T) {
+		_, err := tbtree.NewBuilder()
+		require.ErrorIs(t, err, ErrIllegalState)
+	})
+
+	t.Run("built tree should be reopened", func(t *testing.T) {
+		err := tbtree.Close()
+		require.NoError(t, err)
+
+		tbtree, err = Open(dir, opts)
+		require.NoError(t, err)
+
+		checkTree(t, tbtree)
+	})
+
+	t.Run("built tree should accept further insertions", func(t *testing.T) {
+		err := tbtree.BulkInsert([]*KVT{
+			{K: []byte("key0000"), V: []byte("value0000_3"), T: uint64(keyCount*versions + 1)},
+			{K: []byte("key9999"), V: []byte("value9999_0"), T: uint64(keyCount*versions + 1)},
+		})
+		require.NoError(t, err)
+
+		_, _, hc, err := tbtree.Get([]byte("key0000"))
+		require.NoError(t, err)
+		require.Equal(t, uint64(versions+1), hc)
+
+		v, _, _, err := tbtree.Get([]byte("key9999"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("value9999_0"), v)
+	})
+
+	err = tbtree.Close()
+	require.NoError(t, err)
+}
+
 func TestGetWithPrefix(t *testing.T) {
 	tbtree, err := Open(t.TempDir(), DefaultOptions())
 	require.NoError(t, err)
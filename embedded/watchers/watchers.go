@@ -24,6 +24,7 @@ import (
 
 var ErrMaxWaitessLimitExceeded = errors.New("watchers: max waiting limit exceeded")
 var ErrAlreadyClosed = errors.New("watchers: already closed")
+var ErrIllegalArguments = errors.New("watchers: illegal arguments")
 
 type WatchersHub struct {
 	wpoints map[uint64]*waitingPoint
@@ -94,6 +95,24 @@ func (w *WatchersHub) DoneUpto(t uint64) error {
 	return nil
 }
 
+// Reset moves doneUpto back to t, callers waiting for greater values are kept waiting
+func (w *WatchersHub) Reset(t uint64) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.closed {
+		return ErrAlreadyClosed
+	}
+
+	if t > w.doneUpto {
+		return ErrIllegalArguments
+	}
+
+	w.doneUpto = t
+
+	return nil
+}
+
 func (w *WatchersHub) WaitFor(ctx context.Context, t uint64) error {
 	w.mutex.Lock()
 	defer w.mutex.Unlock()
@@ -174,3 +174,43 @@ func TestSimultaneousCancellationAndNotification(t *testing.T) {
 	assert.Zero(t, wHub.waiting)
 	assert.Empty(t, wHub.wpoints)
 }
+
+func TestWatchersHubReset(t *testing.T) {
+	wHub := New(0, 10)
+
+	err := wHub.DoneUpto(10)
+	require.NoError(t, err)
+
+	err = wHub.Reset(11)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = wHub.Reset(0)
+	require.NoError(t, err)
+
+	doneUpto, _, err := wHub.Status()
+	require.NoError(t, err)
+	require.Zero(t, doneUpto)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err = wHub.WaitFor(ctx, 5)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	done := make(chan error)
+
+	go func() {
+		done <- wHub.WaitFor(context.Background(), 5)
+	}()
+
+	err = wHub.DoneUpto(5)
+	require.NoError(t, err)
+
+	require.NoError(t, <-done)
+
+	err = wHub.Close()
+	require.NoError(t, err)
+
+	err = wHub.Reset(0)
+	require.ErrorIs(t, err, ErrAlreadyClosed)
+}
@@ -32,6 +32,9 @@ type DocumentServiceClient interface {
 	CountDocuments(ctx context.Context, in *CountDocumentsRequest, opts ...grpc.CallOption) (*CountDocumentsResponse, error)
 	AuditDocument(ctx context.Context, in *AuditDocumentRequest, opts ...grpc.CallOption) (*AuditDocumentResponse, error)
 	ProofDocument(ctx context.Context, in *ProofDocumentRequest, opts ...grpc.CallOption) (*ProofDocumentResponse, error)
+	WatchCollection(ctx context.Context, in *WatchCollectionRequest, opts ...grpc.CallOption) (DocumentService_WatchCollectionClient, error)
+	ImportDocuments(ctx context.Context, opts ...grpc.CallOption) (DocumentService_ImportDocumentsClient, error)
+	ExportDocuments(ctx context.Context, in *ExportDocumentsRequest, opts ...grpc.CallOption) (DocumentService_ExportDocumentsClient, error)
 }
 
 type documentServiceClient struct {
@@ -168,6 +171,104 @@ func (c *documentServiceClient) ProofDocument(ctx context.Context, in *ProofDocu
 	return out, nil
 }
 
+func (c *documentServiceClient) WatchCollection(ctx context.Context, in *WatchCollectionRequest, opts ...grpc.CallOption) (DocumentService_WatchCollectionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DocumentService_ServiceDesc.Streams[0], "/immudb.model.DocumentService/WatchCollection", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &documentServiceWatchCollectionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DocumentService_WatchCollectionClient interface {
+	Recv() (*WatchCollectionResponse, error)
+	grpc.ClientStream
+}
+
+type documentServiceWatchCollectionClient struct {
+	grpc.ClientStream
+}
+
+func (x *documentServiceWatchCollectionClient) Recv() (*WatchCollectionResponse, error) {
+	m := new(WatchCollectionResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *documentServiceClient) ImportDocuments(ctx context.Context, opts ...grpc.CallOption) (DocumentService_ImportDocumentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DocumentService_ServiceDesc.Streams[1], "/immudb.model.DocumentService/ImportDocuments", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &documentServiceImportDocumentsClient{stream}
+	return x, nil
+}
+
+type DocumentService_ImportDocumentsClient interface {
+	Send(*ImportDocumentsRequest) error
+	CloseAndRecv() (*ImportDocumentsResponse, error)
+	grpc.ClientStream
+}
+
+type documentServiceImportDocumentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *documentServiceImportDocumentsClient) Send(m *ImportDocumentsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *documentServiceImportDocumentsClient) CloseAndRecv() (*ImportDocumentsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportDocumentsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *documentServiceClient) ExportDocuments(ctx context.Context, in *ExportDocumentsRequest, opts ...grpc.CallOption) (DocumentService_ExportDocumentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DocumentService_ServiceDesc.Streams[2], "/immudb.model.DocumentService/ExportDocuments", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &documentServiceExportDocumentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DocumentService_ExportDocumentsClient interface {
+	Recv() (*ExportDocumentsResponse, error)
+	grpc.ClientStream
+}
+
+type documentServiceExportDocumentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *documentServiceExportDocumentsClient) Recv() (*ExportDocumentsResponse, error) {
+	m := new(ExportDocumentsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // DocumentServiceServer is the server API for DocumentService service.
 // All implementations should embed UnimplementedDocumentServiceServer
 // for forward compatibility
@@ -186,6 +287,9 @@ type DocumentServiceServer interface {
 	CountDocuments(context.Context, *CountDocumentsRequest) (*CountDocumentsResponse, error)
 	AuditDocument(context.Context, *AuditDocumentRequest) (*AuditDocumentResponse, error)
 	ProofDocument(context.Context, *ProofDocumentRequest) (*ProofDocumentResponse, error)
+	WatchCollection(*WatchCollectionRequest, DocumentService_WatchCollectionServer) error
+	ImportDocuments(DocumentService_ImportDocumentsServer) error
+	ExportDocuments(*ExportDocumentsRequest, DocumentService_ExportDocumentsServer) error
 }
 
 // UnimplementedDocumentServiceServer should be embedded to have forward compatible implementations.
@@ -234,6 +338,15 @@ func (UnimplementedDocumentServiceServer) AuditDocument(context.Context, *AuditD
 func (UnimplementedDocumentServiceServer) ProofDocument(context.Context, *ProofDocumentRequest) (*ProofDocumentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProofDocument not implemented")
 }
+func (UnimplementedDocumentServiceServer) WatchCollection(*WatchCollectionRequest, DocumentService_WatchCollectionServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCollection not implemented")
+}
+func (UnimplementedDocumentServiceServer) ImportDocuments(DocumentService_ImportDocumentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportDocuments not implemented")
+}
+func (UnimplementedDocumentServiceServer) ExportDocuments(*ExportDocumentsRequest, DocumentService_ExportDocumentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportDocuments not implemented")
+}
 
 // UnsafeDocumentServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to DocumentServiceServer will
@@ -498,6 +611,74 @@ func _DocumentService_ProofDocument_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DocumentService_WatchCollection_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCollectionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DocumentServiceServer).WatchCollection(m, &documentServiceWatchCollectionServer{stream})
+}
+
+type DocumentService_WatchCollectionServer interface {
+	Send(*WatchCollectionResponse) error
+	grpc.ServerStream
+}
+
+type documentServiceWatchCollectionServer struct {
+	grpc.ServerStream
+}
+
+func (x *documentServiceWatchCollectionServer) Send(m *WatchCollectionResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DocumentService_ImportDocuments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DocumentServiceServer).ImportDocuments(&documentServiceImportDocumentsServer{stream})
+}
+
+type DocumentService_ImportDocumentsServer interface {
+	SendAndClose(*ImportDocumentsResponse) error
+	Recv() (*ImportDocumentsRequest, error)
+	grpc.ServerStream
+}
+
+type documentServiceImportDocumentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *documentServiceImportDocumentsServer) SendAndClose(m *ImportDocumentsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *documentServiceImportDocumentsServer) Recv() (*ImportDocumentsRequest, error) {
+	m := new(ImportDocumentsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _DocumentService_ExportDocuments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportDocumentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DocumentServiceServer).ExportDocuments(m, &documentServiceExportDocumentsServer{stream})
+}
+
+type DocumentService_ExportDocumentsServer interface {
+	Send(*ExportDocumentsResponse) error
+	grpc.ServerStream
+}
+
+type documentServiceExportDocumentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *documentServiceExportDocumentsServer) Send(m *ExportDocumentsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // DocumentService_ServiceDesc is the grpc.ServiceDesc for DocumentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -562,6 +743,22 @@ var DocumentService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _DocumentService_ProofDocument_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchCollection",
+			Handler:       _DocumentService_WatchCollection_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportDocuments",
+			Handler:       _DocumentService_ImportDocuments_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportDocuments",
+			Handler:       _DocumentService_ExportDocuments_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "documents.proto",
 }
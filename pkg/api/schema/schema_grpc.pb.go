@@ -112,6 +112,20 @@ type ImmuServiceClient interface {
 	DescribeTable(ctx context.Context, in *Table, opts ...grpc.CallOption) (*SQLQueryResult, error)
 	VerifiableSQLGet(ctx context.Context, in *VerifiableSQLGetRequest, opts ...grpc.CallOption) (*VerifiableSQLEntry, error)
 	TruncateDatabase(ctx context.Context, in *TruncateDatabaseRequest, opts ...grpc.CallOption) (*TruncateDatabaseResponse, error)
+	// Bulk loading
+	StreamBulkLoad(ctx context.Context, opts ...grpc.CallOption) (ImmuService_StreamBulkLoadClient, error)
+	// Replica promotion, used on failover
+	PromoteReplica(ctx context.Context, in *PromoteReplicaRequest, opts ...grpc.CallOption) (*PromoteReplicaResponse, error)
+	// Partial replication
+	ExportPartialTx(ctx context.Context, in *ExportPartialTxRequest, opts ...grpc.CallOption) (*PartialTx, error)
+	PartialTxProof(ctx context.Context, in *PartialTxProofRequest, opts ...grpc.CallOption) (*PartialTx, error)
+	// Replication monitoring
+	ReplicationStatus(ctx context.Context, in *ReplicationStatusRequest, opts ...grpc.CallOption) (*ReplicationStatusResponse, error)
+	// Role-based access control
+	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListRoles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RoleList, error)
+	ChangeRole(ctx context.Context, in *ChangeRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type immuServiceClient struct {
@@ -1039,6 +1053,112 @@ func (c *immuServiceClient) TruncateDatabase(ctx context.Context, in *TruncateDa
 	return out, nil
 }
 
+func (c *immuServiceClient) StreamBulkLoad(ctx context.Context, opts ...grpc.CallOption) (ImmuService_StreamBulkLoadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[11], "/immudb.schema.ImmuService/streamBulkLoad", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &immuServiceStreamBulkLoadClient{stream}
+	return x, nil
+}
+
+type ImmuService_StreamBulkLoadClient interface {
+	Send(*Chunk) error
+	CloseAndRecv() (*BulkLoadResponse, error)
+	grpc.ClientStream
+}
+
+type immuServiceStreamBulkLoadClient struct {
+	grpc.ClientStream
+}
+
+func (x *immuServiceStreamBulkLoadClient) Send(m *Chunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *immuServiceStreamBulkLoadClient) CloseAndRecv() (*BulkLoadResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(BulkLoadResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *immuServiceClient) PromoteReplica(ctx context.Context, in *PromoteReplicaRequest, opts ...grpc.CallOption) (*PromoteReplicaResponse, error) {
+	out := new(PromoteReplicaResponse)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/PromoteReplica", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) ExportPartialTx(ctx context.Context, in *ExportPartialTxRequest, opts ...grpc.CallOption) (*PartialTx, error) {
+	out := new(PartialTx)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/ExportPartialTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) PartialTxProof(ctx context.Context, in *PartialTxProofRequest, opts ...grpc.CallOption) (*PartialTx, error) {
+	out := new(PartialTx)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/PartialTxProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) ReplicationStatus(ctx context.Context, in *ReplicationStatusRequest, opts ...grpc.CallOption) (*ReplicationStatusResponse, error) {
+	out := new(ReplicationStatusResponse)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/ReplicationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/CreateRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/DeleteRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) ListRoles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RoleList, error) {
+	out := new(RoleList)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/ListRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) ChangeRole(ctx context.Context, in *ChangeRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/ChangeRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ImmuServiceServer is the server API for ImmuService service.
 // All implementations should embed UnimplementedImmuServiceServer
 // for forward compatibility
@@ -1136,6 +1256,20 @@ type ImmuServiceServer interface {
 	DescribeTable(context.Context, *Table) (*SQLQueryResult, error)
 	VerifiableSQLGet(context.Context, *VerifiableSQLGetRequest) (*VerifiableSQLEntry, error)
 	TruncateDatabase(context.Context, *TruncateDatabaseRequest) (*TruncateDatabaseResponse, error)
+	// Bulk loading
+	StreamBulkLoad(ImmuService_StreamBulkLoadServer) error
+	// Replica promotion, used on failover
+	PromoteReplica(context.Context, *PromoteReplicaRequest) (*PromoteReplicaResponse, error)
+	// Partial replication
+	ExportPartialTx(context.Context, *ExportPartialTxRequest) (*PartialTx, error)
+	PartialTxProof(context.Context, *PartialTxProofRequest) (*PartialTx, error)
+	// Replication monitoring
+	ReplicationStatus(context.Context, *ReplicationStatusRequest) (*ReplicationStatusResponse, error)
+	// Role-based access control
+	CreateRole(context.Context, *CreateRoleRequest) (*emptypb.Empty, error)
+	DeleteRole(context.Context, *DeleteRoleRequest) (*emptypb.Empty, error)
+	ListRoles(context.Context, *emptypb.Empty) (*RoleList, error)
+	ChangeRole(context.Context, *ChangeRoleRequest) (*emptypb.Empty, error)
 }
 
 // UnimplementedImmuServiceServer should be embedded to have forward compatible implementations.
@@ -1358,6 +1492,33 @@ func (UnimplementedImmuServiceServer) VerifiableSQLGet(context.Context, *Verifia
 func (UnimplementedImmuServiceServer) TruncateDatabase(context.Context, *TruncateDatabaseRequest) (*TruncateDatabaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TruncateDatabase not implemented")
 }
+func (UnimplementedImmuServiceServer) StreamBulkLoad(ImmuService_StreamBulkLoadServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBulkLoad not implemented")
+}
+func (UnimplementedImmuServiceServer) PromoteReplica(context.Context, *PromoteReplicaRequest) (*PromoteReplicaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteReplica not implemented")
+}
+func (UnimplementedImmuServiceServer) ExportPartialTx(context.Context, *ExportPartialTxRequest) (*PartialTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPartialTx not implemented")
+}
+func (UnimplementedImmuServiceServer) PartialTxProof(context.Context, *PartialTxProofRequest) (*PartialTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PartialTxProof not implemented")
+}
+func (UnimplementedImmuServiceServer) ReplicationStatus(context.Context, *ReplicationStatusRequest) (*ReplicationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplicationStatus not implemented")
+}
+func (UnimplementedImmuServiceServer) CreateRole(context.Context, *CreateRoleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (UnimplementedImmuServiceServer) DeleteRole(context.Context, *DeleteRoleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
+}
+func (UnimplementedImmuServiceServer) ListRoles(context.Context, *emptypb.Empty) (*RoleList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
+}
+func (UnimplementedImmuServiceServer) ChangeRole(context.Context, *ChangeRoleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeRole not implemented")
+}
 
 // UnsafeImmuServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ImmuServiceServer will
@@ -2724,6 +2885,176 @@ func _ImmuService_TruncateDatabase_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImmuService_StreamBulkLoad_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ImmuServiceServer).StreamBulkLoad(&immuServiceStreamBulkLoadServer{stream})
+}
+
+type ImmuService_StreamBulkLoadServer interface {
+	SendAndClose(*BulkLoadResponse) error
+	Recv() (*Chunk, error)
+	grpc.ServerStream
+}
+
+type immuServiceStreamBulkLoadServer struct {
+	grpc.ServerStream
+}
+
+func (x *immuServiceStreamBulkLoadServer) SendAndClose(m *BulkLoadResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *immuServiceStreamBulkLoadServer) Recv() (*Chunk, error) {
+	m := new(Chunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ImmuService_PromoteReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteReplicaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).PromoteReplica(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/PromoteReplica",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).PromoteReplica(ctx, req.(*PromoteReplicaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_ExportPartialTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPartialTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).ExportPartialTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/ExportPartialTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).ExportPartialTx(ctx, req.(*ExportPartialTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_PartialTxProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PartialTxProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).PartialTxProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/PartialTxProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).PartialTxProof(ctx, req.(*PartialTxProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_ReplicationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplicationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).ReplicationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/ReplicationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).ReplicationStatus(ctx, req.(*ReplicationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).CreateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/CreateRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).CreateRole(ctx, req.(*CreateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).DeleteRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/DeleteRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).DeleteRole(ctx, req.(*DeleteRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).ListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/ListRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).ListRoles(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_ChangeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).ChangeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/ChangeRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).ChangeRole(ctx, req.(*ChangeRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ImmuService_ServiceDesc is the grpc.ServiceDesc for ImmuService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2975,6 +3306,38 @@ var ImmuService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TruncateDatabase",
 			Handler:    _ImmuService_TruncateDatabase_Handler,
 		},
+		{
+			MethodName: "PromoteReplica",
+			Handler:    _ImmuService_PromoteReplica_Handler,
+		},
+		{
+			MethodName: "ExportPartialTx",
+			Handler:    _ImmuService_ExportPartialTx_Handler,
+		},
+		{
+			MethodName: "PartialTxProof",
+			Handler:    _ImmuService_PartialTxProof_Handler,
+		},
+		{
+			MethodName: "ReplicationStatus",
+			Handler:    _ImmuService_ReplicationStatus_Handler,
+		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _ImmuService_CreateRole_Handler,
+		},
+		{
+			MethodName: "DeleteRole",
+			Handler:    _ImmuService_DeleteRole_Handler,
+		},
+		{
+			MethodName: "ListRoles",
+			Handler:    _ImmuService_ListRoles_Handler,
+		},
+		{
+			MethodName: "ChangeRole",
+			Handler:    _ImmuService_ChangeRole_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3033,6 +3396,11 @@ var ImmuService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "streamBulkLoad",
+			Handler:       _ImmuService_StreamBulkLoad_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "schema.proto",
 }
@@ -4,3 +4,11 @@ import "github.com/codenotary/immudb/pkg/errors"
 
 var ErrNoAuthData = errors.New("no authentication data provided").WithCode(errors.CodProtocolViolation)
 var ErrNotLoggedIn = errors.New("not logged in").WithCode(errors.CodInvalidAuthorizationSpecification)
+var ErrUnknownPrivilege = errors.New("unknown privilege").WithCode(errors.CodInvalidParameterValue)
+var ErrInvalidRolePrivilege = errors.New("privilege can not be restricted to tables").WithCode(errors.CodInvalidParameterValue)
+var ErrInvalidJWT = errors.New("invalid token").WithCode(errors.CodInvalidAuthorizationSpecification)
+var ErrInvalidRoleMapping = errors.New("invalid role mapping").WithCode(errors.CodInvalidParameterValue)
+var ErrLocalUsername = errors.New("username belongs to a local user").WithCode(errors.CodInvalidAuthorizationSpecification)
+var ErrIllegalArguments = errors.New("illegal arguments").WithCode(errors.CodInvalidParameterValue)
+var ErrInvalidLDAPCredentials = errors.New("invalid ldap credentials").WithCode(errors.CodInvalidAuthorizationSpecification)
+var ErrLDAPUserNotFound = errors.New("ldap user not found").WithCode(errors.CodInvalidAuthorizationSpecification)
@@ -0,0 +1,655 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultJWTUsernameClaim    = "sub"
+	DefaultJWTRolesClaim       = "groups"
+	DefaultJWKSRefreshInterval = 15 * time.Minute
+	DefaultJWTLeeway           = time.Minute
+	jwksMinRefreshInterval     = 10 * time.Second
+	jwksMaxResponseSize        = 1 << 20
+)
+
+// JWTOptions configure the validation of externally issued JSON Web Tokens
+type JWTOptions struct {
+	JWKSFile            string        // local file containing the JSON Web Key Set
+	JWKSURL             string        // url the JSON Web Key Set is fetched from, e.g. the jwks_uri of an OpenID provider
+	JWKSRefreshInterval time.Duration // only if JWKSURL is set
+	Issuer              string        // expected "iss" claim, not checked when empty
+	Audience            string        // expected "aud" claim, not checked when empty
+	UsernameClaim       string        // claim holding the immudb username
+	RolesClaim          string        // claim holding the groups matched against the role mappings
+	RoleMappings        []RoleMapping
+	Leeway              time.Duration // clock skew tolerated when checking "exp", "nbf" and "iat"
+}
+
+// DefaultJWTOptions returns the default jwt validation options
+func DefaultJWTOptions() *JWTOptions {
+	return &JWTOptions{
+		JWKSRefreshInterval: DefaultJWKSRefreshInterval,
+		UsernameClaim:       DefaultJWTUsernameClaim,
+		RolesClaim:          DefaultJWTRolesClaim,
+		Leeway:              DefaultJWTLeeway,
+	}
+}
+
+// Enabled returns true when a key set is configured
+func (opts *JWTOptions) Enabled() bool {
+	return opts != nil && (opts.JWKSFile != "" || opts.JWKSURL != "")
+}
+
+func (opts *JWTOptions) WithJWKSFile(jwksFile string) *JWTOptions {
+	opts.JWKSFile = jwksFile
+	return opts
+}
+
+func (opts *JWTOptions) WithJWKSURL(jwksURL string) *JWTOptions {
+	opts.JWKSURL = jwksURL
+	return opts
+}
+
+func (opts *JWTOptions) WithJWKSRefreshInterval(interval time.Duration) *JWTOptions {
+	opts.JWKSRefreshInterval = interval
+	return opts
+}
+
+func (opts *JWTOptions) WithIssuer(issuer string) *JWTOptions {
+	opts.Issuer = issuer
+	return opts
+}
+
+func (opts *JWTOptions) WithAudience(audience string) *JWTOptions {
+	opts.Audience = audience
+	return opts
+}
+
+func (opts *JWTOptions) WithUsernameClaim(claim string) *JWTOptions {
+	opts.UsernameClaim = claim
+	return opts
+}
+
+func (opts *JWTOptions) WithRolesClaim(claim string) *JWTOptions {
+	opts.RolesClaim = claim
+	return opts
+}
+
+func (opts *JWTOptions) WithRoleMappings(mappings []RoleMapping) *JWTOptions {
+	opts.RoleMappings = mappings
+	return opts
+}
+
+func (opts *JWTOptions) WithLeeway(leeway time.Duration) *JWTOptions {
+	opts.Leeway = leeway
+	return opts
+}
+
+// JWTValidator validates tokens issued by an external identity provider and maps them to immudb users
+type JWTValidator struct {
+	opts   *JWTOptions
+	client *http.Client
+	now    func() time.Time
+
+	mutex    sync.Mutex
+	keys     []jsonWebKey
+	loadedAt time.Time
+	// closed once the key set being fetched is loaded, nil when no fetch is in progress
+	fetching chan struct{}
+}
+
+// NewJWTValidator creates a validator and loads its key set
+func NewJWTValidator(opts *JWTOptions) (*JWTValidator, error) {
+	if !opts.Enabled() {
+		return nil, fmt.Errorf("%w: either a jwks file or a jwks url is required", ErrIllegalArguments)
+	}
+	if opts.JWKSFile != "" && opts.JWKSURL != "" {
+		return nil, fmt.Errorf("%w: jwks file and jwks url are mutually exclusive", ErrIllegalArguments)
+	}
+	if opts.UsernameClaim == "" {
+		return nil, fmt.Errorf("%w: username claim is required", ErrIllegalArguments)
+	}
+
+	v := &JWTValidator{
+		opts:   opts,
+		client: &http.Client{Timeout: 10 * time.Second},
+		now:    time.Now,
+	}
+
+	keys, err := v.fetchKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	v.keys = keys
+	v.loadedAt = v.now()
+
+	return v, nil
+}
+
+// Validate checks the signature and the registered claims of the token and
+// returns the user it identifies, with the permissions and roles granted by the role mappings
+func (v *JWTValidator) Validate(token string) (*User, error) {
+	header, claims, signedContent, signature, err := splitJWT(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := v.verifySignature(header, signedContent, signature); err != nil {
+		return nil, err
+	}
+
+	if err := v.validateClaims(claims); err != nil {
+		return nil, err
+	}
+
+	return v.userFromClaims(claims)
+}
+
+// IsJWT returns true if the secret has the shape of a JSON Web Token,
+// so it can be told apart from a plain password
+func IsJWT(secret string) bool {
+	header, _, _, _, err := splitJWT(secret)
+	return err == nil && header.Alg != ""
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+func splitJWT(token string) (*jwtHeader, map[string]interface{}, []byte, []byte, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, nil, nil, nil, fmt.Errorf("%w: malformed token", ErrInvalidJWT)
+	}
+
+	rawHeader, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("%w: malformed header", ErrInvalidJWT)
+	}
+
+	var header jwtHeader
+	if err := json.Unmarshal(rawHeader, &header); err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("%w: malformed header", ErrInvalidJWT)
+	}
+
+	rawClaims, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("%w: malformed claims", ErrInvalidJWT)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(rawClaims))
+	dec.UseNumber()
+
+	var claims map[string]interface{}
+	if err := dec.Decode(&claims); err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("%w: malformed claims", ErrInvalidJWT)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("%w: malformed signature", ErrInvalidJWT)
+	}
+
+	return &header, claims, []byte(parts[0] + "." + parts[1]), signature, nil
+}
+
+func (v *JWTValidator) verifySignature(header *jwtHeader, signedContent, signature []byte) error {
+	verify, ok := jwtAlgorithms[header.Alg]
+	if !ok {
+		return fmt.Errorf("%w: unsupported signing algorithm '%s'", ErrInvalidJWT, header.Alg)
+	}
+
+	keys := v.keysFor(header.Kid)
+	if len(keys) == 0 {
+		return fmt.Errorf("%w: unknown signing key '%s'", ErrInvalidJWT, header.Kid)
+	}
+
+	for _, key := range keys {
+		if key.Alg != "" && key.Alg != header.Alg {
+			continue
+		}
+		if verify(key.publicKey, signedContent, signature) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: signature verification failed", ErrInvalidJWT)
+}
+
+// keysFor returns the keys matching the key id, the key set is reloaded when
+// it's outdated or when the key id is unknown, e.g. after the provider rotated its keys.
+// The key set is fetched without holding the mutex, meanwhile the cached keys are served
+func (v *JWTValidator) keysFor(kid string) []jsonWebKey {
+	v.mutex.Lock()
+
+	keys := matchingKeys(v.keys, kid)
+
+	if v.opts.JWKSURL == "" {
+		v.mutex.Unlock()
+		return keys
+	}
+
+	fetching := v.fetching
+
+	sinceLoad := v.now().Sub(v.loadedAt)
+
+	if fetching == nil &&
+		((len(keys) == 0 && sinceLoad >= jwksMinRefreshInterval) ||
+			(v.opts.JWKSRefreshInterval > 0 && sinceLoad >= v.opts.JWKSRefreshInterval)) {
+
+		// on failure the previous key set is kept and the refresh is retried later on
+		v.loadedAt = v.now()
+
+		fetching = make(chan struct{})
+		v.fetching = fetching
+
+		v.mutex.Unlock()
+
+		reloaded, err := v.fetchKeys()
+
+		v.mutex.Lock()
+		defer v.mutex.Unlock()
+
+		if err == nil {
+			v.keys = reloaded
+		}
+
+		v.fetching = nil
+		close(fetching)
+
+		return matchingKeys(v.keys, kid)
+	}
+
+	v.mutex.Unlock()
+
+	if fetching == nil || len(keys) > 0 {
+		return keys
+	}
+
+	// the key may be part of the key set being fetched
+	<-fetching
+
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	return matchingKeys(v.keys, kid)
+}
+
+func matchingKeys(keys []jsonWebKey, kid string) []jsonWebKey {
+	if kid == "" {
+		return keys
+	}
+
+	var matching []jsonWebKey
+
+	for _, key := range keys {
+		if key.Kid == kid {
+			matching = append(matching, key)
+		}
+	}
+
+	return matching
+}
+
+func (v *JWTValidator) validateClaims(claims map[string]interface{}) error {
+	now := v.now()
+	leeway := v.opts.Leeway
+
+	exp, ok, err := numericDateClaim(claims, "exp")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("%w: missing expiration time", ErrInvalidJWT)
+	}
+	if now.After(exp.Add(leeway)) {
+		return fmt.Errorf("%w: token is expired", ErrInvalidJWT)
+	}
+
+	nbf, ok, err := numericDateClaim(claims, "nbf")
+	if err != nil {
+		return err
+	}
+	if ok && now.Add(leeway).Before(nbf) {
+		return fmt.Errorf("%w: token is not valid yet", ErrInvalidJWT)
+	}
+
+	iat, ok, err := numericDateClaim(claims, "iat")
+	if err != nil {
+		return err
+	}
+	if ok && now.Add(leeway).Before(iat) {
+		return fmt.Errorf("%w: token issued in the future", ErrInvalidJWT)
+	}
+
+	if v.opts.Issuer != "" {
+		iss, _ := claims["iss"].(string)
+		if iss != v.opts.Issuer {
+			return fmt.Errorf("%w: unexpected issuer '%s'", ErrInvalidJWT, iss)
+		}
+	}
+
+	if v.opts.Audience != "" {
+		audiences, err := stringsClaim(claims, "aud")
+		if err != nil {
+			return err
+		}
+
+		found := false
+		for _, aud := range audiences {
+			if aud == v.opts.Audience {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%w: unexpected audience", ErrInvalidJWT)
+		}
+	}
+
+	return nil
+}
+
+func (v *JWTValidator) userFromClaims(claims map[string]interface{}) (*User, error) {
+	username, _ := claims[v.opts.UsernameClaim].(string)
+	if username == "" {
+		return nil, fmt.Errorf("%w: missing username claim '%s'", ErrInvalidJWT, v.opts.UsernameClaim)
+	}
+	if username == SysAdminUsername {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidJWT, ErrLocalUsername)
+	}
+
+	user := &User{
+		Username:  username,
+		Active:    true,
+		CreatedBy: v.opts.Issuer,
+	}
+
+	if v.opts.RolesClaim == "" {
+		return user, nil
+	}
+
+	groups, err := stringsClaim(claims, v.opts.RolesClaim)
+	if err != nil {
+		return nil, err
+	}
+
+	ApplyRoleMappings(user, groups, v.opts.RoleMappings)
+
+	return user, nil
+}
+
+func numericDateClaim(claims map[string]interface{}, name string) (time.Time, bool, error) {
+	raw, ok := claims[name]
+	if !ok {
+		return time.Time{}, false, nil
+	}
+
+	n, ok := raw.(json.Number)
+	if !ok {
+		return time.Time{}, false, fmt.Errorf("%w: malformed '%s' claim", ErrInvalidJWT, name)
+	}
+
+	secs, err := n.Float64()
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("%w: malformed '%s' claim", ErrInvalidJWT, name)
+	}
+
+	return time.Unix(0, int64(secs*float64(time.Second))), true, nil
+}
+
+// stringsClaim returns the values of a claim which may be either a string or an array of strings
+func stringsClaim(claims map[string]interface{}, name string) ([]string, error) {
+	switch c := claims[name].(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{c}, nil
+	case []interface{}:
+		values := make([]string, len(c))
+		for i, e := range c {
+			s, ok := e.(string)
+			if !ok {
+				return nil, fmt.Errorf("%w: malformed '%s' claim", ErrInvalidJWT, name)
+			}
+			values[i] = s
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("%w: malformed '%s' claim", ErrInvalidJWT, name)
+	}
+}
+
+type jwtVerifier func(key crypto.PublicKey, signedContent, signature []byte) bool
+
+var jwtAlgorithms = map[string]jwtVerifier{
+	"RS256": rsaPKCS1Verifier(crypto.SHA256),
+	"RS384": rsaPKCS1Verifier(crypto.SHA384),
+	"RS512": rsaPKCS1Verifier(crypto.SHA512),
+	"PS256": rsaPSSVerifier(crypto.SHA256),
+	"PS384": rsaPSSVerifier(crypto.SHA384),
+	"PS512": rsaPSSVerifier(crypto.SHA512),
+	"ES256": ecdsaVerifier(crypto.SHA256),
+	"ES384": ecdsaVerifier(crypto.SHA384),
+	"ES512": ecdsaVerifier(crypto.SHA512),
+	"EdDSA": ed25519Verifier,
+}
+
+func rsaPKCS1Verifier(hash crypto.Hash) jwtVerifier {
+	return func(key crypto.PublicKey, signedContent, signature []byte) bool {
+		pub, ok := key.(*rsa.PublicKey)
+		return ok && rsa.VerifyPKCS1v15(pub, hash, digest(hash, signedContent), signature) == nil
+	}
+}
+
+func rsaPSSVerifier(hash crypto.Hash) jwtVerifier {
+	return func(key crypto.PublicKey, signedContent, signature []byte) bool {
+		pub, ok := key.(*rsa.PublicKey)
+		return ok && rsa.VerifyPSS(pub, hash, digest(hash, signedContent), signature, nil) == nil
+	}
+}
+
+func ecdsaVerifier(hash crypto.Hash) jwtVerifier {
+	return func(key crypto.PublicKey, signedContent, signature []byte) bool {
+		pub, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return false
+		}
+
+		// the signature is the concatenation of the fixed size r and s values
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return false
+		}
+
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+
+		return ecdsa.Verify(pub, digest(hash, signedContent), r, s)
+	}
+}
+
+func ed25519Verifier(key crypto.PublicKey, signedContent, signature []byte) bool {
+	pub, ok := key.(ed25519.PublicKey)
+	return ok && ed25519.Verify(pub, signedContent, signature)
+}
+
+func digest(hash crypto.Hash, content []byte) []byte {
+	h := hash.New()
+	h.Write(content)
+	return h.Sum(nil)
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+
+	publicKey crypto.PublicKey
+}
+
+// parseJWKS parses a JSON Web Key Set, keys not meant for signatures or of unsupported types are skipped
+func parseJWKS(data []byte) ([]jsonWebKey, error) {
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return nil, fmt.Errorf("%w: malformed jwks: %v", ErrIllegalArguments, err)
+	}
+
+	var keys []jsonWebKey
+
+	for _, key := range jwks.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+
+		pub, err := key.parsePublicKey()
+		if err != nil {
+			return nil, fmt.Errorf("%w: jwks key '%s': %v", ErrIllegalArguments, key.Kid, err)
+		}
+		if pub == nil {
+			continue
+		}
+
+		key.publicKey = pub
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w: jwks contains no signing keys", ErrIllegalArguments)
+	}
+
+	return keys, nil
+}
+
+func (k *jsonWebKey) parsePublicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("invalid rsa exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve '%s'", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, fmt.Errorf("invalid ec point")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve '%s'", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid ed25519 key")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, nil
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(b) == 0 {
+		return nil, fmt.Errorf("invalid key parameter")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+func (v *JWTValidator) fetchKeys() ([]jsonWebKey, error) {
+	if v.opts.JWKSFile != "" {
+		data, err := ioutil.ReadFile(v.opts.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading jwks file: %w", err)
+		}
+		return parseJWKS(data)
+	}
+
+	resp, err := v.client.Get(v.opts.JWKSURL)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching jwks: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error fetching jwks: unexpected status %s", resp.Status)
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, jwksMaxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("error fetching jwks: %w", err)
+	}
+
+	return parseJWKS(data)
+}
@@ -0,0 +1,310 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testJWTKey struct {
+	kid  string
+	alg  string
+	priv crypto.Signer
+}
+
+func (k *testJWTKey) jwk() map[string]string {
+	b64 := base64.RawURLEncoding.EncodeToString
+
+	switch pub := k.priv.Public().(type) {
+	case *rsa.PublicKey:
+		return map[string]string{"kty": "RSA", "kid": k.kid, "use": "sig",
+			"n": b64(pub.N.Bytes()), "e": b64(big.NewInt(int64(pub.E)).Bytes())}
+	case *ecdsa.PublicKey:
+		return map[string]string{"kty": "EC", "kid": k.kid, "crv": pub.Curve.Params().Name,
+			"x": b64(pub.X.Bytes()), "y": b64(pub.Y.Bytes())}
+	case ed25519.PublicKey:
+		return map[string]string{"kty": "OKP", "kid": k.kid, "crv": "Ed25519", "x": b64(pub)}
+	}
+	panic("unsupported key")
+}
+
+func (k *testJWTKey) sign(t *testing.T, claims map[string]interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": k.alg, "kid": k.kid, "typ": "JWT"})
+	require.NoError(t, err)
+
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	signedContent := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	var signature []byte
+
+	switch priv := k.priv.(type) {
+	case *rsa.PrivateKey:
+		signature, err = rsa.SignPKCS1v15(rand.Reader, priv, crypto.SHA256, digest(crypto.SHA256, []byte(signedContent)))
+		require.NoError(t, err)
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, priv, digest(crypto.SHA256, []byte(signedContent)))
+		require.NoError(t, err)
+		signature = make([]byte, 64)
+		r.FillBytes(signature[:32])
+		s.FillBytes(signature[32:])
+	case ed25519.PrivateKey:
+		signature = ed25519.Sign(priv, []byte(signedContent))
+	}
+
+	return signedContent + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func newTestJWTKeys(t *testing.T) []*testJWTKey {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	return []*testJWTKey{
+		{kid: "rsa", alg: "RS256", priv: rsaKey},
+		{kid: "ec", alg: "ES256", priv: ecKey},
+		{kid: "ed", alg: "EdDSA", priv: edKey},
+	}
+}
+
+func testJWKS(t *testing.T, keys ...*testJWTKey) []byte {
+	jwks := map[string][]map[string]string{"keys": {}}
+	for _, k := range keys {
+		jwks["keys"] = append(jwks["keys"], k.jwk())
+	}
+
+	data, err := json.Marshal(jwks)
+	require.NoError(t, err)
+
+	return data
+}
+
+func TestJWTValidator(t *testing.T) {
+	keys := newTestJWTKeys(t)
+
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(jwksFile, testJWKS(t, keys...), 0600))
+
+	mappings, err := ParseRoleMappings("admins::sysadmin,dev:db1:read,leads:db1:readwrite,bi:db2:analyst")
+	require.NoError(t, err)
+
+	_, err = NewJWTValidator(DefaultJWTOptions())
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = NewJWTValidator(DefaultJWTOptions().WithJWKSFile(filepath.Join(t.TempDir(), "missing.json")))
+	require.Error(t, err)
+
+	v, err := NewJWTValidator(DefaultJWTOptions().
+		WithJWKSFile(jwksFile).
+		WithIssuer("https://idp.example.com").
+		WithAudience("immudb").
+		WithUsernameClaim("preferred_username").
+		WithRoleMappings(mappings))
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	claims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"iss":                "https://idp.example.com",
+			"aud":                []string{"immudb", "other"},
+			"sub":                "00u1abc",
+			"preferred_username": "alice",
+			"groups":             []string{"dev", "leads", "bi"},
+			"iat":                now.Unix(),
+			"exp":                now.Add(time.Hour).Unix(),
+		}
+	}
+
+	for _, k := range keys {
+		t.Run(k.alg, func(t *testing.T) {
+			user, err := v.Validate(k.sign(t, claims()))
+			require.NoError(t, err)
+			require.Equal(t, "alice", user.Username)
+			require.True(t, user.Active)
+			require.False(t, user.IsSysAdmin)
+			require.Equal(t, uint32(PermissionRW), user.WhichPermission("db1"))
+			require.Equal(t, uint32(PermissionNone), user.WhichPermission("db2"))
+			require.Equal(t, []string{"analyst"}, user.WhichRoles("db2"))
+		})
+	}
+
+	t.Run("sysadmin mapping", func(t *testing.T) {
+		c := claims()
+		c["groups"] = "admins"
+
+		user, err := v.Validate(keys[0].sign(t, c))
+		require.NoError(t, err)
+		require.True(t, user.IsSysAdmin)
+	})
+
+	t.Run("invalid tokens", func(t *testing.T) {
+		for name, mutate := range map[string]func(c map[string]interface{}){
+			"expired":          func(c map[string]interface{}) { c["exp"] = now.Add(-time.Hour).Unix() },
+			"missing exp":      func(c map[string]interface{}) { delete(c, "exp") },
+			"not yet valid":    func(c map[string]interface{}) { c["nbf"] = now.Add(time.Hour).Unix() },
+			"wrong issuer":     func(c map[string]interface{}) { c["iss"] = "https://evil.example.com" },
+			"wrong audience":   func(c map[string]interface{}) { c["aud"] = "other" },
+			"missing username": func(c map[string]interface{}) { delete(c, "preferred_username") },
+			"sysadmin":         func(c map[string]interface{}) { c["preferred_username"] = SysAdminUsername },
+			"malformed groups": func(c map[string]interface{}) { c["groups"] = 1 },
+		} {
+			c := claims()
+			mutate(c)
+
+			_, err := v.Validate(keys[0].sign(t, c))
+			require.ErrorIs(t, err, ErrInvalidJWT, name)
+		}
+
+		token := keys[0].sign(t, claims())
+
+		_, err := v.Validate(token[:len(token)-4] + "AAAA")
+		require.ErrorIs(t, err, ErrInvalidJWT)
+
+		_, err = v.Validate("not.a.token")
+		require.ErrorIs(t, err, ErrInvalidJWT)
+
+		unknownKey := &testJWTKey{kid: "unknown", alg: "RS256", priv: keys[0].priv}
+		_, err = v.Validate(unknownKey.sign(t, claims()))
+		require.ErrorIs(t, err, ErrInvalidJWT)
+
+		// a key can not be used with the algorithm of another key type
+		mismatch := &testJWTKey{kid: "rsa", alg: "ES256", priv: keys[1].priv}
+		_, err = v.Validate(mismatch.sign(t, claims()))
+		require.ErrorIs(t, err, ErrInvalidJWT)
+
+		none := &testJWTKey{kid: "rsa", alg: "none", priv: keys[0].priv}
+		_, err = v.Validate(none.sign(t, claims()))
+		require.ErrorIs(t, err, ErrInvalidJWT)
+	})
+}
+
+func TestJWTValidatorWithJWKSURL(t *testing.T) {
+	keys := newTestJWTKeys(t)
+
+	var rotated int32
+	var requests int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+
+		if atomic.LoadInt32(&rotated) == 1 {
+			w.Write(testJWKS(t, keys[1]))
+			return
+		}
+		w.Write(testJWKS(t, keys[0]))
+	}))
+	defer srv.Close()
+
+	v, err := NewJWTValidator(DefaultJWTOptions().WithJWKSURL(srv.URL))
+	require.NoError(t, err)
+
+	now := time.Now()
+	v.now = func() time.Time { return now }
+
+	claims := map[string]interface{}{"sub": "bob", "exp": now.Add(time.Hour).Unix()}
+
+	user, err := v.Validate(keys[0].sign(t, claims))
+	require.NoError(t, err)
+	require.Equal(t, "bob", user.Username)
+	require.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	atomic.StoreInt32(&rotated, 1)
+
+	// unknown keys do not trigger a reload too often
+	_, err = v.Validate(keys[1].sign(t, claims))
+	require.ErrorIs(t, err, ErrInvalidJWT)
+	require.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	now = now.Add(jwksMinRefreshInterval)
+
+	_, err = v.Validate(keys[1].sign(t, claims))
+	require.NoError(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(&requests))
+
+	_, err = v.Validate(keys[0].sign(t, claims))
+	require.ErrorIs(t, err, ErrInvalidJWT)
+}
+
+func TestJWTValidatorServesCachedKeysWhileFetching(t *testing.T) {
+	keys := newTestJWTKeys(t)
+
+	var fetches int32
+	unblock := make(chan struct{})
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&fetches, 1) > 1 {
+			<-unblock
+		}
+		w.Write(testJWKS(t, keys[0], keys[1]))
+	}))
+	defer srv.Close()
+
+	v, err := NewJWTValidator(DefaultJWTOptions().WithJWKSURL(srv.URL).WithJWKSRefreshInterval(time.Minute))
+	require.NoError(t, err)
+
+	now := time.Now()
+	v.now = func() time.Time { return now }
+
+	claims := map[string]interface{}{"sub": "bob", "exp": now.Add(time.Hour).Unix()}
+
+	now = now.Add(time.Minute)
+
+	refreshed := make(chan error)
+
+	go func() {
+		_, err := v.Validate(keys[0].sign(t, claims))
+		refreshed <- err
+	}()
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&fetches) == 2
+	}, 5*time.Second, time.Millisecond)
+
+	// the cached key set is served while the refresh is in progress
+	user, err := v.Validate(keys[1].sign(t, claims))
+	require.NoError(t, err)
+	require.Equal(t, "bob", user.Username)
+
+	close(unblock)
+
+	require.NoError(t, <-refreshed)
+	require.Equal(t, int32(2), atomic.LoadInt32(&fetches))
+}
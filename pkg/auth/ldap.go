@@ -0,0 +1,307 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+const (
+	DefaultLDAPUserFilter     = "(uid=%s)"
+	DefaultLDAPGroupFilter    = "(member=%s)"
+	DefaultLDAPGroupAttribute = "cn"
+	DefaultLDAPTimeout        = 10 * time.Second
+)
+
+// LDAPOptions configure the authentication of users against an LDAP directory
+type LDAPOptions struct {
+	URL                string // ldap://host:389 or ldaps://host:636, ldap authentication is disabled when empty
+	StartTLS           bool   // only if URL uses the ldap scheme
+	CACert             string // file containing the certificates of the authorities trusted to sign the directory certificate
+	InsecureSkipVerify bool
+	BindDN             string // service account used to search users and groups, anonymous searches when empty
+	BindPassword       string `json:"-"`
+	UserBaseDN         string
+	UserFilter         string // %s is replaced by the escaped username
+	GroupBaseDN        string // group membership is not looked up when empty
+	GroupFilter        string // %s is replaced by the escaped user dn
+	GroupAttribute     string // attribute of the group entries holding the group name matched against the role mappings
+	RoleMappings       []RoleMapping
+	Timeout            time.Duration
+}
+
+// DefaultLDAPOptions returns the default ldap authentication options
+func DefaultLDAPOptions() *LDAPOptions {
+	return &LDAPOptions{
+		UserFilter:     DefaultLDAPUserFilter,
+		GroupFilter:    DefaultLDAPGroupFilter,
+		GroupAttribute: DefaultLDAPGroupAttribute,
+		Timeout:        DefaultLDAPTimeout,
+	}
+}
+
+// Enabled returns true when a directory is configured
+func (opts *LDAPOptions) Enabled() bool {
+	return opts != nil && opts.URL != ""
+}
+
+func (opts *LDAPOptions) WithURL(url string) *LDAPOptions {
+	opts.URL = url
+	return opts
+}
+
+func (opts *LDAPOptions) WithStartTLS(startTLS bool) *LDAPOptions {
+	opts.StartTLS = startTLS
+	return opts
+}
+
+func (opts *LDAPOptions) WithCACert(caCert string) *LDAPOptions {
+	opts.CACert = caCert
+	return opts
+}
+
+func (opts *LDAPOptions) WithInsecureSkipVerify(insecureSkipVerify bool) *LDAPOptions {
+	opts.InsecureSkipVerify = insecureSkipVerify
+	return opts
+}
+
+func (opts *LDAPOptions) WithBindDN(bindDN string) *LDAPOptions {
+	opts.BindDN = bindDN
+	return opts
+}
+
+func (opts *LDAPOptions) WithBindPassword(bindPassword string) *LDAPOptions {
+	opts.BindPassword = bindPassword
+	return opts
+}
+
+func (opts *LDAPOptions) WithUserBaseDN(userBaseDN string) *LDAPOptions {
+	opts.UserBaseDN = userBaseDN
+	return opts
+}
+
+func (opts *LDAPOptions) WithUserFilter(userFilter string) *LDAPOptions {
+	opts.UserFilter = userFilter
+	return opts
+}
+
+func (opts *LDAPOptions) WithGroupBaseDN(groupBaseDN string) *LDAPOptions {
+	opts.GroupBaseDN = groupBaseDN
+	return opts
+}
+
+func (opts *LDAPOptions) WithGroupFilter(groupFilter string) *LDAPOptions {
+	opts.GroupFilter = groupFilter
+	return opts
+}
+
+func (opts *LDAPOptions) WithGroupAttribute(groupAttribute string) *LDAPOptions {
+	opts.GroupAttribute = groupAttribute
+	return opts
+}
+
+func (opts *LDAPOptions) WithRoleMappings(mappings []RoleMapping) *LDAPOptions {
+	opts.RoleMappings = mappings
+	return opts
+}
+
+func (opts *LDAPOptions) WithTimeout(timeout time.Duration) *LDAPOptions {
+	opts.Timeout = timeout
+	return opts
+}
+
+// LDAPAuthenticator authenticates users against an LDAP directory: the user entry is searched
+// with the service account, then the user password is verified by binding with the user dn
+type LDAPAuthenticator struct {
+	opts      *LDAPOptions
+	tlsConfig *tls.Config
+}
+
+// NewLDAPAuthenticator creates an authenticator, no connection is made until a user authenticates
+func NewLDAPAuthenticator(opts *LDAPOptions) (*LDAPAuthenticator, error) {
+	if !opts.Enabled() {
+		return nil, fmt.Errorf("%w: ldap url is required", ErrIllegalArguments)
+	}
+	if !strings.HasPrefix(opts.URL, "ldap://") && !strings.HasPrefix(opts.URL, "ldaps://") {
+		return nil, fmt.Errorf("%w: unsupported ldap url '%s'", ErrIllegalArguments, opts.URL)
+	}
+	if opts.StartTLS && strings.HasPrefix(opts.URL, "ldaps://") {
+		return nil, fmt.Errorf("%w: start tls can not be used with ldaps", ErrIllegalArguments)
+	}
+	if opts.UserBaseDN == "" {
+		return nil, fmt.Errorf("%w: ldap user base dn is required", ErrIllegalArguments)
+	}
+	if strings.Count(opts.UserFilter, "%s") != 1 {
+		return nil, fmt.Errorf("%w: ldap user filter must contain a single %%s", ErrIllegalArguments)
+	}
+	if opts.GroupBaseDN != "" && strings.Count(opts.GroupFilter, "%s") != 1 {
+		return nil, fmt.Errorf("%w: ldap group filter must contain a single %%s", ErrIllegalArguments)
+	}
+
+	tlsConfig := &tls.Config{InsecureSkipVerify: opts.InsecureSkipVerify}
+
+	if opts.CACert != "" {
+		pem, err := ioutil.ReadFile(opts.CACert)
+		if err != nil {
+			return nil, fmt.Errorf("error reading ldap ca certificate: %w", err)
+		}
+
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("%w: no certificates found in '%s'", ErrIllegalArguments, opts.CACert)
+		}
+	}
+
+	return &LDAPAuthenticator{opts: opts, tlsConfig: tlsConfig}, nil
+}
+
+// Authenticate verifies the credentials against the directory and returns the user,
+// with the permissions and roles mapped from its groups.
+// ErrLDAPUserNotFound is returned when the directory has no entry for the username
+func (a *LDAPAuthenticator) Authenticate(username string, password string) (*User, error) {
+	// an empty password would result in an unauthenticated bind that always succeeds
+	if username == "" || password == "" {
+		return nil, ErrInvalidLDAPCredentials
+	}
+	if username == SysAdminUsername {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLDAPCredentials, ErrLocalUsername)
+	}
+
+	conn, err := a.connect()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err := a.bindServiceAccount(conn); err != nil {
+		return nil, err
+	}
+
+	res, err := conn.Search(ldap.NewSearchRequest(
+		a.opts.UserBaseDN,
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 2, int(a.opts.Timeout.Seconds()), false,
+		fmt.Sprintf(a.opts.UserFilter, ldap.EscapeFilter(username)),
+		[]string{"dn"},
+		nil,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("error searching ldap user: %w", err)
+	}
+	if len(res.Entries) == 0 {
+		return nil, ErrLDAPUserNotFound
+	}
+	if len(res.Entries) > 1 {
+		return nil, fmt.Errorf("%w: ldap user filter matches multiple entries", ErrInvalidLDAPCredentials)
+	}
+
+	userDN := res.Entries[0].DN
+
+	err = conn.Bind(userDN, password)
+	if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+		return nil, ErrInvalidLDAPCredentials
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error binding ldap user: %w", err)
+	}
+
+	user := &User{
+		Username:  username,
+		Active:    true,
+		CreatedBy: a.opts.URL,
+	}
+
+	if a.opts.GroupBaseDN == "" {
+		return user, nil
+	}
+
+	groups, err := a.searchGroups(conn, userDN)
+	if err != nil {
+		return nil, err
+	}
+
+	ApplyRoleMappings(user, groups, a.opts.RoleMappings)
+
+	return user, nil
+}
+
+func (a *LDAPAuthenticator) connect() (*ldap.Conn, error) {
+	conn, err := ldap.DialURL(
+		a.opts.URL,
+		ldap.DialWithDialer(&net.Dialer{Timeout: a.opts.Timeout}),
+		ldap.DialWithTLSConfig(a.tlsConfig),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to ldap server: %w", err)
+	}
+
+	conn.SetTimeout(a.opts.Timeout)
+
+	if a.opts.StartTLS {
+		if err := conn.StartTLS(a.tlsConfig); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("error starting tls with ldap server: %w", err)
+		}
+	}
+
+	return conn, nil
+}
+
+func (a *LDAPAuthenticator) bindServiceAccount(conn *ldap.Conn) error {
+	if a.opts.BindDN == "" {
+		return nil
+	}
+
+	if err := conn.Bind(a.opts.BindDN, a.opts.BindPassword); err != nil {
+		return fmt.Errorf("error binding ldap service account: %w", err)
+	}
+
+	return nil
+}
+
+// searchGroups returns the names of the groups the user is a member of,
+// groups are searched with the service account as users may lack the rights to read them
+func (a *LDAPAuthenticator) searchGroups(conn *ldap.Conn, userDN string) ([]string, error) {
+	if err := a.bindServiceAccount(conn); err != nil {
+		return nil, err
+	}
+
+	res, err := conn.Search(ldap.NewSearchRequest(
+		a.opts.GroupBaseDN,
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, int(a.opts.Timeout.Seconds()), false,
+		fmt.Sprintf(a.opts.GroupFilter, ldap.EscapeFilter(userDN)),
+		[]string{a.opts.GroupAttribute},
+		nil,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("error searching ldap groups: %w", err)
+	}
+
+	var groups []string
+	for _, entry := range res.Entries {
+		groups = append(groups, entry.GetAttributeValues(a.opts.GroupAttribute)...)
+	}
+
+	return groups, nil
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/auth/ldaptest"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDirectory(t *testing.T) *ldaptest.Server {
+	srv, err := ldaptest.NewServer(
+		&ldaptest.Entry{
+			DN:       "cn=immudb,ou=services,dc=example,dc=com",
+			Password: "service-secret",
+		},
+		&ldaptest.Entry{
+			DN:         "uid=alice,ou=people,dc=example,dc=com",
+			Password:   "alice-secret",
+			Attributes: map[string][]string{"uid": {"alice"}, "objectClass": {"person"}},
+		},
+		&ldaptest.Entry{
+			DN:         "uid=immudb,ou=people,dc=example,dc=com",
+			Password:   "immudb-secret",
+			Attributes: map[string][]string{"uid": {"immudb"}, "objectClass": {"person"}},
+		},
+		&ldaptest.Entry{
+			DN:         "uid=bob,ou=people,dc=example,dc=com",
+			Password:   "bob-secret",
+			Attributes: map[string][]string{"uid": {"bob"}, "objectClass": {"person"}},
+		},
+		&ldaptest.Entry{
+			DN: "cn=developers,ou=groups,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"cn":     {"developers"},
+				"member": {"uid=alice,ou=people,dc=example,dc=com", "uid=bob,ou=people,dc=example,dc=com"},
+			},
+		},
+		&ldaptest.Entry{
+			DN: "cn=dbas,ou=groups,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"cn":     {"dbas"},
+				"member": {"uid=alice,ou=people,dc=example,dc=com", "uid=immudb,ou=people,dc=example,dc=com"},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { srv.Close() })
+
+	return srv
+}
+
+func TestLDAPAuthenticator(t *testing.T) {
+	srv := newTestDirectory(t)
+
+	mappings, err := ParseRoleMappings("developers:db1:read,dbas:db1:admin,dbas:db2:analyst")
+	require.NoError(t, err)
+
+	opts := DefaultLDAPOptions().
+		WithURL(srv.URL()).
+		WithBindDN("cn=immudb,ou=services,dc=example,dc=com").
+		WithBindPassword("service-secret").
+		WithUserBaseDN("ou=people,dc=example,dc=com").
+		WithUserFilter("(&(objectClass=person)(uid=%s))").
+		WithGroupBaseDN("ou=groups,dc=example,dc=com").
+		WithRoleMappings(mappings)
+
+	a, err := NewLDAPAuthenticator(opts)
+	require.NoError(t, err)
+
+	user, err := a.Authenticate("alice", "alice-secret")
+	require.NoError(t, err)
+	require.Equal(t, "alice", user.Username)
+	require.True(t, user.Active)
+	require.False(t, user.IsSysAdmin)
+	require.Equal(t, uint32(PermissionAdmin), user.WhichPermission("db1"))
+	require.Equal(t, []string{"analyst"}, user.WhichRoles("db2"))
+
+	user, err = a.Authenticate("bob", "bob-secret")
+	require.NoError(t, err)
+	require.Equal(t, uint32(PermissionR), user.WhichPermission("db1"))
+	require.Empty(t, user.WhichRoles("db2"))
+
+	_, err = a.Authenticate("alice", "bob-secret")
+	require.ErrorIs(t, err, ErrInvalidLDAPCredentials)
+
+	_, err = a.Authenticate("alice", "")
+	require.ErrorIs(t, err, ErrInvalidLDAPCredentials)
+
+	_, err = a.Authenticate("carol", "carol-secret")
+	require.ErrorIs(t, err, ErrLDAPUserNotFound)
+
+	// the sysadmin is never authenticated by the directory
+	_, err = a.Authenticate(SysAdminUsername, "immudb-secret")
+	require.ErrorIs(t, err, ErrInvalidLDAPCredentials)
+
+	// filter values are escaped
+	_, err = a.Authenticate("*", "alice-secret")
+	require.ErrorIs(t, err, ErrLDAPUserNotFound)
+
+	t.Run("wrong service account password", func(t *testing.T) {
+		a, err := NewLDAPAuthenticator(DefaultLDAPOptions().
+			WithURL(srv.URL()).
+			WithBindDN("cn=immudb,ou=services,dc=example,dc=com").
+			WithBindPassword("wrong").
+			WithUserBaseDN("ou=people,dc=example,dc=com"))
+		require.NoError(t, err)
+
+		_, err = a.Authenticate("alice", "alice-secret")
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrInvalidLDAPCredentials)
+	})
+
+	t.Run("anonymous search without groups", func(t *testing.T) {
+		a, err := NewLDAPAuthenticator(DefaultLDAPOptions().
+			WithURL(srv.URL()).
+			WithUserBaseDN("dc=example,dc=com"))
+		require.NoError(t, err)
+
+		user, err := a.Authenticate("bob", "bob-secret")
+		require.NoError(t, err)
+		require.Empty(t, user.Permissions)
+	})
+
+	t.Run("unreachable server", func(t *testing.T) {
+		a, err := NewLDAPAuthenticator(DefaultLDAPOptions().
+			WithURL("ldap://127.0.0.1:1").
+			WithUserBaseDN("dc=example,dc=com"))
+		require.NoError(t, err)
+
+		_, err = a.Authenticate("bob", "bob-secret")
+		require.Error(t, err)
+	})
+}
+
+func TestNewLDAPAuthenticatorInvalidOptions(t *testing.T) {
+	for _, opts := range []*LDAPOptions{
+		DefaultLDAPOptions(),
+		DefaultLDAPOptions().WithURL("http://localhost").WithUserBaseDN("dc=example,dc=com"),
+		DefaultLDAPOptions().WithURL("ldaps://localhost").WithUserBaseDN("dc=example,dc=com").WithStartTLS(true),
+		DefaultLDAPOptions().WithURL("ldap://localhost"),
+		DefaultLDAPOptions().WithURL("ldap://localhost").WithUserBaseDN("dc=example,dc=com").WithUserFilter("(uid=alice)"),
+		DefaultLDAPOptions().WithURL("ldap://localhost").WithUserBaseDN("dc=example,dc=com").WithGroupBaseDN("dc=example,dc=com").WithGroupFilter("(member=*)"),
+	} {
+		_, err := NewLDAPAuthenticator(opts)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	}
+
+	_, err := NewLDAPAuthenticator(DefaultLDAPOptions().
+		WithURL("ldap://localhost").
+		WithUserBaseDN("dc=example,dc=com").
+		WithCACert("missing.pem"))
+	require.Error(t, err)
+}
@@ -0,0 +1,343 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ldaptest provides an in-memory LDAP server supporting the subset of the protocol
+// used by the ldap authentication provider: simple binds and searches with
+// and, or, not, equality and presence filters.
+package ldaptest
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+)
+
+const (
+	appBindRequest       = 0
+	appBindResponse      = 1
+	appUnbindRequest     = 2
+	appSearchRequest     = 3
+	appSearchResultEntry = 4
+	appSearchResultDone  = 5
+	appExtendedRequest   = 23
+	appExtendedResponse  = 24
+	filterAnd            = 0
+	filterOr             = 1
+	filterNot            = 2
+	filterEqualityMatch  = 3
+	filterPresent        = 7
+	scopeBaseObject      = 0
+	scopeSingleLevel     = 1
+	resultSuccess        = 0
+	resultProtocolError  = 2
+	resultInvalidCreds   = 49
+)
+
+// Entry is a directory entry, only entries with a password can be bound to
+type Entry struct {
+	DN         string
+	Password   string
+	Attributes map[string][]string
+}
+
+// Server is an in-memory LDAP server listening on a random local port
+type Server struct {
+	listener net.Listener
+	entries  []*Entry
+
+	mutex  sync.Mutex
+	conns  map[net.Conn]struct{}
+	closed bool
+
+	wg sync.WaitGroup
+}
+
+// NewServer starts a server holding the given entries
+func NewServer(entries ...*Entry) (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		listener: listener,
+		entries:  entries,
+		conns:    make(map[net.Conn]struct{}),
+	}
+
+	s.wg.Add(1)
+	go s.serve()
+
+	return s, nil
+}
+
+// URL returns the ldap url the server is reachable at
+func (s *Server) URL() string {
+	return "ldap://" + s.listener.Addr().String()
+}
+
+// Close stops the server and closes all the client connections
+func (s *Server) Close() error {
+	s.mutex.Lock()
+	s.closed = true
+	for conn := range s.conns {
+		conn.Close()
+	}
+	s.mutex.Unlock()
+
+	err := s.listener.Close()
+	s.wg.Wait()
+
+	return err
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		s.mutex.Lock()
+		if s.closed {
+			s.mutex.Unlock()
+			conn.Close()
+			return
+		}
+		s.conns[conn] = struct{}{}
+		s.mutex.Unlock()
+
+		s.wg.Add(1)
+		go s.handle(conn)
+	}
+}
+
+func (s *Server) handle(conn net.Conn) {
+	defer s.wg.Done()
+
+	defer func() {
+		s.mutex.Lock()
+		delete(s.conns, conn)
+		s.mutex.Unlock()
+
+		conn.Close()
+	}()
+
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+
+		messageID, _ := packet.Children[0].Value.(int64)
+		op := packet.Children[1]
+
+		if op.ClassType != ber.ClassApplication {
+			return
+		}
+
+		var responses []*ber.Packet
+
+		switch op.Tag {
+		case appBindRequest:
+			responses = append(responses, result(appBindResponse, s.bind(op)))
+		case appUnbindRequest:
+			return
+		case appSearchRequest:
+			responses = append(responses, s.search(op)...)
+			responses = append(responses, result(appSearchResultDone, resultSuccess))
+		case appExtendedRequest:
+			responses = append(responses, result(appExtendedResponse, resultProtocolError))
+		default:
+			return
+		}
+
+		for _, response := range responses {
+			envelope := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+			envelope.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "MessageID"))
+			envelope.AppendChild(response)
+
+			if _, err := conn.Write(envelope.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// bind verifies simple bind credentials, anonymous binds are accepted and anonymous searches allowed
+func (s *Server) bind(op *ber.Packet) int64 {
+	if len(op.Children) < 3 {
+		return resultProtocolError
+	}
+
+	dn := op.Children[1].Data.String()
+	password := op.Children[2].Data.String()
+
+	if dn == "" && password == "" {
+		return resultSuccess
+	}
+
+	for _, e := range s.entries {
+		if strings.EqualFold(e.DN, dn) && e.Password != "" && e.Password == password {
+			return resultSuccess
+		}
+	}
+
+	return resultInvalidCreds
+}
+
+func (s *Server) search(op *ber.Packet) []*ber.Packet {
+	if len(op.Children) < 8 {
+		return nil
+	}
+
+	baseDN := strings.ToLower(op.Children[0].Data.String())
+	scope, _ := op.Children[1].Value.(int64)
+	filter := op.Children[6]
+
+	var attributes []string
+	for _, attr := range op.Children[7].Children {
+		attributes = append(attributes, attr.Data.String())
+	}
+
+	var entries []*ber.Packet
+
+	for _, e := range s.entries {
+		dn := strings.ToLower(e.DN)
+
+		switch scope {
+		case scopeBaseObject:
+			if dn != baseDN {
+				continue
+			}
+		case scopeSingleLevel:
+			parts := strings.SplitN(dn, ",", 2)
+			if len(parts) != 2 || parts[1] != baseDN {
+				continue
+			}
+		default:
+			if dn != baseDN && !strings.HasSuffix(dn, ","+baseDN) {
+				continue
+			}
+		}
+
+		if !e.matches(filter) {
+			continue
+		}
+
+		entries = append(entries, e.encode(attributes))
+	}
+
+	return entries
+}
+
+func (e *Entry) attribute(name string) []string {
+	for attr, values := range e.Attributes {
+		if strings.EqualFold(attr, name) {
+			return values
+		}
+	}
+	return nil
+}
+
+func (e *Entry) matches(filter *ber.Packet) bool {
+	switch filter.Tag {
+	case filterAnd:
+		for _, f := range filter.Children {
+			if !e.matches(f) {
+				return false
+			}
+		}
+		return true
+	case filterOr:
+		for _, f := range filter.Children {
+			if e.matches(f) {
+				return true
+			}
+		}
+		return false
+	case filterNot:
+		return len(filter.Children) == 1 && !e.matches(filter.Children[0])
+	case filterEqualityMatch:
+		if len(filter.Children) != 2 {
+			return false
+		}
+		expected := filter.Children[1].Data.String()
+		for _, v := range e.attribute(filter.Children[0].Data.String()) {
+			if strings.EqualFold(v, expected) {
+				return true
+			}
+		}
+		return false
+	case filterPresent:
+		return len(e.attribute(filter.Data.String())) > 0
+	default:
+		return false
+	}
+}
+
+func (e *Entry) encode(attributes []string) *ber.Packet {
+	entry := ber.Encode(ber.ClassApplication, ber.TypeConstructed, appSearchResultEntry, nil, "Search Result Entry")
+	entry.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.DN, "Object Name"))
+
+	attrs := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+
+	for name, values := range e.Attributes {
+		if !requested(name, attributes) {
+			continue
+		}
+
+		attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+		attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+
+		vals := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, v := range values {
+			vals.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "Value"))
+		}
+
+		attr.AppendChild(vals)
+		attrs.AppendChild(attr)
+	}
+
+	entry.AppendChild(attrs)
+
+	return entry
+}
+
+func requested(name string, attributes []string) bool {
+	if len(attributes) == 0 {
+		return true
+	}
+	for _, attr := range attributes {
+		if attr == "*" || strings.EqualFold(attr, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func result(tag ber.Tag, code int64) *ber.Packet {
+	res := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, fmt.Sprintf("Result %d", code))
+	res.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, "Result Code"))
+	res.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	res.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Diagnostic Message"))
+	return res
+}
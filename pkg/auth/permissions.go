@@ -32,6 +32,7 @@ var maintenanceMethods = map[string]struct{}{
 	"StreamHistory":       {},
 	"TxByID":              {},
 	"TxScan":              {},
+	"PartialTxProof":      {},
 	"ExportTx":            {},
 	"ReplicateTx":         {},
 	"Count":               {},
@@ -57,12 +58,18 @@ var maintenanceMethods = map[string]struct{}{
 	"CountDocuments":      {},
 	"AuditDocument":       {},
 	"ProofDocument":       {},
+	"WatchCollection":     {},
+	"ImportDocuments":     {},
+	"ExportDocuments":     {},
 
 	// admin methods
 	"ListUsers":    {},
 	"Dump":         {},
 	"FlushIndex":   {},
 	"CompactIndex": {},
+
+	// replication monitoring
+	"ReplicationStatus": {},
 }
 
 // PermissionSysAdmin the admin permission byte
@@ -106,6 +113,7 @@ var methodsPermissions = map[string][]uint32{
 	"StreamHistory":          {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"TxByID":                 {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"TxScan":                 {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"PartialTxProof":         {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"Count":                  {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"CountAll":               {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"DatabaseList":           {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
@@ -133,25 +141,35 @@ var methodsPermissions = map[string][]uint32{
 	"CountDocuments":   {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"AuditDocument":    {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"ProofDocument":    {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"WatchCollection":  {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"ImportDocuments":  {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"ExportDocuments":  {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 
 	// admin methods
-	"ListUsers":        {PermissionSysAdmin, PermissionAdmin},
-	"CreateUser":       {PermissionSysAdmin, PermissionAdmin},
-	"ChangePassword":   {PermissionSysAdmin, PermissionAdmin},
-	"SetPermission":    {PermissionSysAdmin, PermissionAdmin},
-	"DeactivateUser":   {PermissionSysAdmin, PermissionAdmin},
-	"SetActiveUser":    {PermissionSysAdmin, PermissionAdmin},
-	"UpdateAuthConfig": {PermissionSysAdmin},
-	"UpdateMTLSConfig": {PermissionSysAdmin},
-	"CreateDatabase":   {PermissionSysAdmin},
-	"CreateDatabaseV2": {PermissionSysAdmin},
-	"UpdateDatabase":   {PermissionSysAdmin},
-	"UpdateDatabaseV2": {PermissionSysAdmin},
-	"Dump":             {PermissionSysAdmin, PermissionAdmin},
-	"FlushIndex":       {PermissionSysAdmin, PermissionAdmin},
-	"CompactIndex":     {PermissionSysAdmin, PermissionAdmin},
-	"ExportTx":         {PermissionSysAdmin, PermissionAdmin},
-	"ReplicateTx":      {PermissionSysAdmin, PermissionAdmin},
+	"ListUsers":         {PermissionSysAdmin, PermissionAdmin},
+	"CreateUser":        {PermissionSysAdmin, PermissionAdmin},
+	"ChangePassword":    {PermissionSysAdmin, PermissionAdmin},
+	"SetPermission":     {PermissionSysAdmin, PermissionAdmin},
+	"DeactivateUser":    {PermissionSysAdmin, PermissionAdmin},
+	"SetActiveUser":     {PermissionSysAdmin, PermissionAdmin},
+	"UpdateAuthConfig":  {PermissionSysAdmin},
+	"UpdateMTLSConfig":  {PermissionSysAdmin},
+	"CreateDatabase":    {PermissionSysAdmin},
+	"CreateDatabaseV2":  {PermissionSysAdmin},
+	"UpdateDatabase":    {PermissionSysAdmin},
+	"UpdateDatabaseV2":  {PermissionSysAdmin},
+	"Dump":              {PermissionSysAdmin, PermissionAdmin},
+	"FlushIndex":        {PermissionSysAdmin, PermissionAdmin},
+	"CompactIndex":      {PermissionSysAdmin, PermissionAdmin},
+	"ExportTx":          {PermissionSysAdmin, PermissionAdmin},
+	"ReplicateTx":       {PermissionSysAdmin, PermissionAdmin},
+	"StreamBulkLoad":    {PermissionSysAdmin, PermissionAdmin},
+	"PromoteReplica":    {PermissionSysAdmin, PermissionAdmin},
+	"ReplicationStatus": {PermissionSysAdmin, PermissionAdmin},
+	"CreateRole":        {PermissionSysAdmin},
+	"DeleteRole":        {PermissionSysAdmin},
+	"ListRoles":         {PermissionSysAdmin, PermissionAdmin},
+	"ChangeRole":        {PermissionSysAdmin, PermissionAdmin},
 }
 
 // HasPermissionForMethod checks if userPermission can access method name
@@ -0,0 +1,100 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	sysAdminMappingPermission  = "sysadmin"
+	roleMappingSeparator       = ","
+	roleMappingFieldsSeparator = ":"
+)
+
+// RoleMapping grants a permission or a custom role on a database to the users
+// authenticated by an external identity provider as members of Group
+type RoleMapping struct {
+	Group      string
+	Database   string
+	Permission uint32 // PermissionNone when Role is set
+	Role       string
+}
+
+// ParseRoleMappings parses a comma separated list of group:database:permission entries,
+// where permission is one of read, readwrite, admin or the name of a custom role.
+// The sysadmin permission does not refer to any database: group::sysadmin
+func ParseRoleMappings(s string) ([]RoleMapping, error) {
+	var mappings []RoleMapping
+
+	for _, entry := range strings.Split(s, roleMappingSeparator) {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		fields := strings.Split(entry, roleMappingFieldsSeparator)
+		if len(fields) != 3 || fields[0] == "" || fields[2] == "" {
+			return nil, fmt.Errorf("%w: '%s', expected group:database:permission", ErrInvalidRoleMapping, entry)
+		}
+
+		mapping := RoleMapping{Group: fields[0], Database: fields[1]}
+
+		switch fields[2] {
+		case sysAdminMappingPermission:
+			mapping.Permission = PermissionSysAdmin
+		case "read":
+			mapping.Permission = PermissionR
+		case "readwrite":
+			mapping.Permission = PermissionRW
+		case "admin":
+			mapping.Permission = PermissionAdmin
+		default:
+			mapping.Role = fields[2]
+		}
+
+		if mapping.Permission != PermissionSysAdmin && mapping.Database == "" {
+			return nil, fmt.Errorf("%w: '%s', database is required", ErrInvalidRoleMapping, entry)
+		}
+
+		mappings = append(mappings, mapping)
+	}
+
+	return mappings, nil
+}
+
+// ApplyRoleMappings grants to the user the permissions and roles mapped to its groups,
+// the highest permission is kept when several groups are mapped to the same database
+func ApplyRoleMappings(user *User, groups []string, mappings []RoleMapping) {
+	for _, group := range groups {
+		for _, mapping := range mappings {
+			if mapping.Group != group {
+				continue
+			}
+
+			switch {
+			case mapping.Permission == PermissionSysAdmin:
+				user.IsSysAdmin = true
+			case mapping.Role != "":
+				user.GrantRole(mapping.Database, mapping.Role)
+			case mapping.Permission > user.WhichPermission(mapping.Database):
+				user.GrantPermission(mapping.Database, mapping.Permission)
+			}
+		}
+	}
+}
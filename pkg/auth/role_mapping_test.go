@@ -0,0 +1,60 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRoleMappings(t *testing.T) {
+	mappings, err := ParseRoleMappings("admins::sysadmin, dev:db1:readwrite,ops:db1:admin,audit:db2:read,bi:db2:analyst")
+	require.NoError(t, err)
+	require.Equal(t, []RoleMapping{
+		{Group: "admins", Permission: PermissionSysAdmin},
+		{Group: "dev", Database: "db1", Permission: PermissionRW},
+		{Group: "ops", Database: "db1", Permission: PermissionAdmin},
+		{Group: "audit", Database: "db2", Permission: PermissionR},
+		{Group: "bi", Database: "db2", Role: "analyst"},
+	}, mappings)
+
+	mappings, err = ParseRoleMappings("")
+	require.NoError(t, err)
+	require.Empty(t, mappings)
+
+	_, err = ParseRoleMappings("dev:db1")
+	require.ErrorIs(t, err, ErrInvalidRoleMapping)
+
+	_, err = ParseRoleMappings("dev::read")
+	require.ErrorIs(t, err, ErrInvalidRoleMapping)
+}
+
+func TestApplyRoleMappings(t *testing.T) {
+	mappings, err := ParseRoleMappings("dev:db1:read,leads:db1:readwrite,bi:db2:analyst,admins::sysadmin")
+	require.NoError(t, err)
+
+	user := &User{Username: "alice"}
+	ApplyRoleMappings(user, []string{"leads", "dev", "bi", "guests"}, mappings)
+	require.False(t, user.IsSysAdmin)
+	require.Equal(t, uint32(PermissionRW), user.WhichPermission("db1"))
+	require.Equal(t, []string{"analyst"}, user.WhichRoles("db2"))
+
+	user = &User{Username: "bob"}
+	ApplyRoleMappings(user, []string{"admins"}, mappings)
+	require.True(t, user.IsSysAdmin)
+}
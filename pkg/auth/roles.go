@@ -0,0 +1,243 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"fmt"
+	"time"
+)
+
+// Privilege is a fine-grained operation granted to users through roles
+type Privilege string
+
+const (
+	PrivilegeReadKV            Privilege = "read-kv"
+	PrivilegeWriteKV           Privilege = "write-kv"
+	PrivilegeSQLDDL            Privilege = "sql-ddl"
+	PrivilegeSQLSelect         Privilege = "sql-select"
+	PrivilegeSQLDML            Privilege = "sql-dml"
+	PrivilegeReadDocuments     Privilege = "read-documents"
+	PrivilegeWriteDocuments    Privilege = "write-documents"
+	PrivilegeManageCollections Privilege = "manage-collections"
+	PrivilegeAdmin             Privilege = "admin"
+)
+
+var privileges = map[Privilege]struct{}{
+	PrivilegeReadKV:            {},
+	PrivilegeWriteKV:           {},
+	PrivilegeSQLDDL:            {},
+	PrivilegeSQLSelect:         {},
+	PrivilegeSQLDML:            {},
+	PrivilegeReadDocuments:     {},
+	PrivilegeWriteDocuments:    {},
+	PrivilegeManageCollections: {},
+	PrivilegeAdmin:             {},
+}
+
+// IsTableScoped returns true if the privilege can be restricted to a set of tables
+func (p Privilege) IsTableScoped() bool {
+	return p == PrivilegeSQLSelect || p == PrivilegeSQLDML
+}
+
+// RolePrivilege a privilege granted by a role, optionally restricted to some SQL tables
+type RolePrivilege struct {
+	Privilege Privilege `json:"privilege"`
+	Tables    []string  `json:"tables,omitempty"` //tables the privilege is restricted to, all tables when empty
+}
+
+// Role a named set of privileges, roles are granted to users per database
+type Role struct {
+	Name       string          `json:"name"`
+	Privileges []RolePrivilege `json:"privileges"`
+	CreatedBy  string          `json:"createdBy"` //user which created this role
+	CreatedAt  time.Time       `json:"createdat"` //time in which this role was created
+}
+
+// RoleGrant role granted to a user on a database
+type RoleGrant struct {
+	Database string `json:"database"`
+	Role     string `json:"role"`
+}
+
+// IsValidRoleName checks role name requirements, same as the ones for usernames
+var IsValidRoleName = IsValidUsername
+
+// Validate checks that all the privileges of the role are known
+func (r *Role) Validate() error {
+	if !IsValidRoleName(r.Name) {
+		return fmt.Errorf("role name can only contain letters, digits and underscores")
+	}
+
+	for _, p := range r.Privileges {
+		if _, ok := privileges[p.Privilege]; !ok {
+			return fmt.Errorf("%w: '%s'", ErrUnknownPrivilege, p.Privilege)
+		}
+
+		if len(p.Tables) > 0 && !p.Privilege.IsTableScoped() {
+			return fmt.Errorf("%w: '%s'", ErrInvalidRolePrivilege, p.Privilege)
+		}
+	}
+
+	return nil
+}
+
+// HasPrivilege checks if the role grants the privilege, regardless of table restrictions
+func (r *Role) HasPrivilege(privilege Privilege) bool {
+	for _, p := range r.Privileges {
+		if p.Privilege == privilege {
+			return true
+		}
+	}
+	return false
+}
+
+// HasTablePrivilege checks if the role grants the privilege on the table
+func (r *Role) HasTablePrivilege(privilege Privilege, table string) bool {
+	for _, p := range r.Privileges {
+		if p.Privilege != privilege {
+			continue
+		}
+
+		if len(p.Tables) == 0 {
+			return true
+		}
+
+		for _, t := range p.Tables {
+			if t == table {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// methodsPrivileges privileges granting access to each method, any role is enough for methods without privileges.
+// Methods not listed here can not be accessed through roles
+var methodsPrivileges = map[string][]Privilege{
+	"Get":                 {PrivilegeReadKV},
+	"VerifiableGet":       {PrivilegeReadKV},
+	"StreamGet":           {PrivilegeReadKV},
+	"StreamVerifiableGet": {PrivilegeReadKV},
+	"GetAll":              {PrivilegeReadKV},
+	"ZScan":               {PrivilegeReadKV},
+	"StreamZScan":         {PrivilegeReadKV},
+	"VerifiableTxByID":    {PrivilegeReadKV},
+	"IScan":               {PrivilegeReadKV},
+	"Scan":                {PrivilegeReadKV},
+	"StreamScan":          {PrivilegeReadKV},
+	"History":             {PrivilegeReadKV},
+	"StreamHistory":       {PrivilegeReadKV},
+	"TxByID":              {PrivilegeReadKV},
+	"TxScan":              {PrivilegeReadKV},
+	"PartialTxProof":      {PrivilegeReadKV},
+	"Count":               {PrivilegeReadKV},
+	"CountAll":            {PrivilegeReadKV},
+
+	"Set":                    {PrivilegeWriteKV},
+	"Delete":                 {PrivilegeWriteKV},
+	"VerifiableSet":          {PrivilegeWriteKV},
+	"StreamSet":              {PrivilegeWriteKV},
+	"StreamVerifiableSet":    {PrivilegeWriteKV},
+	"ExecAll":                {PrivilegeWriteKV},
+	"StreamExecAll":          {PrivilegeWriteKV},
+	"SetReference":           {PrivilegeWriteKV},
+	"VerifiableSetReference": {PrivilegeWriteKV},
+	"ZAdd":                   {PrivilegeWriteKV},
+	"VerifiableZAdd":         {PrivilegeWriteKV},
+
+	// statements executed through SQLExec are checked one by one
+	"SQLExec":          {PrivilegeSQLDDL, PrivilegeSQLDML},
+	"SQLQuery":         {PrivilegeSQLSelect},
+	"VerifiableSQLGet": {PrivilegeSQLSelect},
+	"ListTables":       {PrivilegeSQLDDL, PrivilegeSQLSelect, PrivilegeSQLDML},
+	"DescribeTable":    {PrivilegeSQLDDL, PrivilegeSQLSelect, PrivilegeSQLDML},
+
+	"GetCollection":   {PrivilegeReadDocuments, PrivilegeManageCollections},
+	"GetCollections":  {PrivilegeReadDocuments, PrivilegeManageCollections},
+	"SearchDocuments": {PrivilegeReadDocuments},
+	"CountDocuments":  {PrivilegeReadDocuments},
+	"AuditDocument":   {PrivilegeReadDocuments},
+	"ProofDocument":   {PrivilegeReadDocuments},
+	"WatchCollection": {PrivilegeReadDocuments},
+	"ExportDocuments": {PrivilegeReadDocuments},
+
+	"InsertDocuments":  {PrivilegeWriteDocuments},
+	"ReplaceDocuments": {PrivilegeWriteDocuments},
+	"DeleteDocuments":  {PrivilegeWriteDocuments},
+	"ImportDocuments":  {PrivilegeWriteDocuments},
+
+	"CreateCollection": {PrivilegeManageCollections},
+	"UpdateCollection": {PrivilegeManageCollections},
+	"DeleteCollection": {PrivilegeManageCollections},
+	"CreateIndex":      {PrivilegeManageCollections},
+	"DeleteIndex":      {PrivilegeManageCollections},
+
+	"Dump":              {PrivilegeAdmin},
+	"FlushIndex":        {PrivilegeAdmin},
+	"CompactIndex":      {PrivilegeAdmin},
+	"ExportTx":          {PrivilegeAdmin},
+	"ReplicateTx":       {PrivilegeAdmin},
+	"StreamBulkLoad":    {PrivilegeAdmin},
+	"ReplicationStatus": {PrivilegeAdmin},
+
+	"DatabaseList":     {},
+	"CurrentState":     {},
+	"DatabaseHealth":   {},
+	"DatabaseSettings": {},
+	"UseSnapshot":      {},
+}
+
+// HasPrivilegeForMethod checks if any of the roles grants access to method name
+func HasPrivilegeForMethod(roles []*Role, method string) bool {
+	methodPrivileges, ok := methodsPrivileges[method]
+	if !ok || len(roles) == 0 {
+		return false
+	}
+
+	if len(methodPrivileges) == 0 {
+		return true
+	}
+
+	for _, role := range roles {
+		for _, p := range methodPrivileges {
+			if role.HasPrivilege(p) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// HasPrivilege checks if any of the roles grants the privilege
+func HasPrivilege(roles []*Role, privilege Privilege) bool {
+	for _, role := range roles {
+		if role.HasPrivilege(privilege) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasTablePrivilege checks if any of the roles grants the privilege on the table
+func HasTablePrivilege(roles []*Role, privilege Privilege, table string) bool {
+	for _, role := range roles {
+		if role.HasTablePrivilege(privilege, table) {
+			return true
+		}
+	}
+	return false
+}
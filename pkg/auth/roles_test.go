@@ -0,0 +1,61 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoleValidate(t *testing.T) {
+	role := &Role{
+		Name: "analyst",
+		Privileges: []RolePrivilege{
+			{Privilege: PrivilegeReadKV},
+			{Privilege: PrivilegeSQLSelect, Tables: []string{"orders"}},
+		},
+	}
+	require.NoError(t, role.Validate())
+
+	role.Name = "analyst!"
+	require.Error(t, role.Validate())
+
+	role.Name = "analyst"
+	role.Privileges = append(role.Privileges, RolePrivilege{Privilege: "drop-everything"})
+	require.ErrorIs(t, role.Validate(), ErrUnknownPrivilege)
+
+	role.Privileges = []RolePrivilege{{Privilege: PrivilegeReadKV, Tables: []string{"orders"}}}
+	require.ErrorIs(t, role.Validate(), ErrInvalidRolePrivilege)
+}
+
+func TestHasPrivilegeForMethod(t *testing.T) {
+	reader := &Role{Name: "reader", Privileges: []RolePrivilege{{Privilege: PrivilegeReadKV}}}
+	writer := &Role{Name: "writer", Privileges: []RolePrivilege{{Privilege: PrivilegeSQLDML, Tables: []string{"orders"}}}}
+
+	require.False(t, HasPrivilegeForMethod(nil, "CurrentState"))
+	require.True(t, HasPrivilegeForMethod([]*Role{reader}, "CurrentState"))
+	require.True(t, HasPrivilegeForMethod([]*Role{reader}, "Get"))
+	require.False(t, HasPrivilegeForMethod([]*Role{reader}, "Set"))
+	require.False(t, HasPrivilegeForMethod([]*Role{reader}, "SQLExec"))
+	require.True(t, HasPrivilegeForMethod([]*Role{reader, writer}, "SQLExec"))
+	require.False(t, HasPrivilegeForMethod([]*Role{reader, writer}, "CreateUser"))
+
+	require.True(t, HasTablePrivilege([]*Role{reader, writer}, PrivilegeSQLDML, "orders"))
+	require.False(t, HasTablePrivilege([]*Role{reader, writer}, PrivilegeSQLDML, "customers"))
+	require.False(t, HasTablePrivilege([]*Role{reader, writer}, PrivilegeSQLSelect, "orders"))
+}
@@ -33,6 +33,7 @@ type User struct {
 	Username       string       `json:"username"`
 	HashedPassword []byte       `json:"hashedpassword"`
 	Permissions    []Permission `json:"permissions"`
+	Roles          []RoleGrant  `json:"roles,omitempty"`
 	Active         bool         `json:"active"`
 	IsSysAdmin     bool         `json:"-"`         //for the sysadmin we'll use this instead of adding all db and permissions to Permissions, to save some cpu cycles
 	CreatedBy      string       `json:"createdBy"` //user which created this user
@@ -121,3 +122,45 @@ func (u *User) GrantPermission(database string, permission uint32) bool {
 	u.Permissions = append(u.Permissions, perm)
 	return true
 }
+
+//HasRole checks if the role is granted to the user on this database
+func (u *User) HasRole(database string, role string) bool {
+	for _, val := range u.Roles {
+		if val.Database == database && val.Role == role {
+			return true
+		}
+	}
+	return false
+}
+
+//WhichRoles returns the names of the roles granted to this user on this database
+func (u *User) WhichRoles(database string) []string {
+	var roles []string
+	for _, val := range u.Roles {
+		if val.Database == database {
+			roles = append(roles, val.Role)
+		}
+	}
+	return roles
+}
+
+//GrantRole grants the role to the user on database, returns false if it was already granted
+func (u *User) GrantRole(database string, role string) bool {
+	if u.HasRole(database, role) {
+		return false
+	}
+
+	u.Roles = append(u.Roles, RoleGrant{Database: database, Role: role})
+	return true
+}
+
+//RevokeRole revokes the role from the user on database, returns false if it was not granted
+func (u *User) RevokeRole(database string, role string) bool {
+	for i, val := range u.Roles {
+		if val.Database == database && val.Role == role {
+			u.Roles = append(u.Roles[:i], u.Roles[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
@@ -70,3 +70,20 @@ func TestUser(t *testing.T) {
 		t.Errorf("WhichPermission sysadmin fail")
 	}
 }
+
+func TestUserRoles(t *testing.T) {
+	u := User{}
+
+	require.True(t, u.GrantRole("db1", "analyst"))
+	require.False(t, u.GrantRole("db1", "analyst"))
+	require.True(t, u.GrantRole("db2", "writer"))
+
+	require.True(t, u.HasRole("db1", "analyst"))
+	require.False(t, u.HasRole("db2", "analyst"))
+	require.Equal(t, []string{"analyst"}, u.WhichRoles("db1"))
+	require.Empty(t, u.WhichRoles("db3"))
+
+	require.True(t, u.RevokeRole("db1", "analyst"))
+	require.False(t, u.RevokeRole("db1", "analyst"))
+	require.Empty(t, u.WhichRoles("db1"))
+}
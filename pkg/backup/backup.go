@@ -0,0 +1,272 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/remotestorage"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/database"
+	"github.com/codenotary/immudb/pkg/logger"
+)
+
+const DefaultMaxSegmentTxs = 10_000
+
+var (
+	ErrIllegalArguments     = errors.New("illegal arguments")
+	ErrBackupAlreadyRunning = errors.New("backup already running")
+	ErrBackupAlreadyStopped = errors.New("backup already stopped")
+	ErrMalformedCatalog     = errors.New("malformed backup catalog")
+	ErrMalformedSegment     = errors.New("malformed backup segment")
+	ErrCorruptedSegment     = errors.New("corrupted backup segment")
+	ErrMissingSignature     = fmt.Errorf("%w: state is not signed", ErrCorruptedSegment)
+	ErrInvalidSignature     = fmt.Errorf("%w: invalid state signature", ErrCorruptedSegment)
+	ErrTxNotBackedUp        = errors.New("transaction not backed up")
+	ErrDatabaseDiverged     = errors.New("database diverged from the backup")
+	ErrBackupDiverged       = errors.New("backup diverged from the database")
+)
+
+// StateSigner signs the state placed at the end of every backup segment
+type StateSigner interface {
+	Sign(state *schema.ImmutableState) error
+}
+
+// Backup periodically uploads transactions committed since the last backup
+// as a new segment into a remote storage
+type Backup struct {
+	mu sync.Mutex
+
+	hasStarted  bool
+	backupMutex sync.Mutex
+
+	db      database.DB
+	storage remotestorage.Storage
+	signer  StateSigner
+
+	frequency     time.Duration
+	maxSegmentTxs int
+
+	logger logger.Logger
+
+	donech chan struct{}
+	stopch chan struct{}
+}
+
+// NewBackup creates a backup scheduler for the database,
+// states are not signed if the signer is nil
+func NewBackup(
+	db database.DB,
+	storage remotestorage.Storage,
+	signer StateSigner,
+	frequency time.Duration,
+	logger logger.Logger) *Backup {
+
+	return &Backup{
+		db:            db,
+		storage:       storage,
+		signer:        signer,
+		frequency:     frequency,
+		maxSegmentTxs: DefaultMaxSegmentTxs,
+		logger:        logger,
+		donech:        make(chan struct{}),
+		stopch:        make(chan struct{}),
+	}
+}
+
+// WithMaxSegmentTxs sets the maximum number of transactions stored in a single segment
+func (b *Backup) WithMaxSegmentTxs(maxSegmentTxs int) *Backup {
+	b.maxSegmentTxs = maxSegmentTxs
+	return b
+}
+
+// Start triggers periodic backups of the database
+func (b *Backup) Start() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.hasStarted {
+		return ErrBackupAlreadyRunning
+	}
+
+	b.hasStarted = true
+
+	b.logger.Infof("starting backup of database '%s' to '%s' with frequency '%vs'", b.db.GetName(), b.storage, b.frequency.Seconds())
+
+	go func() {
+		ticker := time.NewTicker(b.frequency)
+
+		for {
+			select {
+			case <-b.stopch:
+				ticker.Stop()
+				b.donech <- struct{}{}
+				return
+			case <-ticker.C:
+				_, err := b.Run(context.Background())
+				if err != nil {
+					b.logger.Errorf("failed to backup database '%s': %v", b.db.GetName(), err)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (b *Backup) Stop() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.hasStarted {
+		return ErrBackupAlreadyStopped
+	}
+
+	b.logger.Infof("Stopping backup of database '%s'...", b.db.GetName())
+
+	b.stopch <- struct{}{}
+	<-b.donech
+
+	b.hasStarted = false
+
+	b.logger.Infof("Backup of database '%s' successfully stopped", b.db.GetName())
+
+	return nil
+}
+
+// Run uploads all transactions committed since the last backup,
+// the catalog is updated after every uploaded segment
+func (b *Backup) Run(ctx context.Context) ([]*Segment, error) {
+	b.backupMutex.Lock()
+	defer b.backupMutex.Unlock()
+
+	dbName := b.db.GetName()
+
+	catalog, err := ReadCatalog(ctx, b.storage, dbName)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := b.db.CurrentState()
+	if err != nil {
+		return nil, err
+	}
+
+	if state.TxId < catalog.LastTx() {
+		return nil, fmt.Errorf("%w: backup contains transaction %d but database is at transaction %d",
+			ErrBackupDiverged, catalog.LastTx(), state.TxId)
+	}
+
+	var segments []*Segment
+
+	for firstTx := catalog.LastTx() + 1; firstTx <= state.TxId; {
+		lastTx := firstTx + uint64(b.maxSegmentTxs) - 1
+		if lastTx > state.TxId {
+			lastTx = state.TxId
+		}
+
+		seg, err := b.backupSegment(ctx, catalog, firstTx, lastTx)
+		if err != nil {
+			return segments, err
+		}
+
+		catalog.Segments = append(catalog.Segments, seg)
+
+		err = writeCatalog(ctx, b.storage, catalog)
+		if err != nil {
+			return segments, err
+		}
+
+		b.logger.Infof("backup segment '%s' of database '%s' uploaded {txs = %d..%d}", seg.Name, dbName, seg.FirstTx, seg.LastTx)
+
+		segments = append(segments, seg)
+		firstTx = lastTx + 1
+	}
+
+	return segments, nil
+}
+
+func (b *Backup) backupSegment(ctx context.Context, catalog *Catalog, firstTx, lastTx uint64) (*Segment, error) {
+	txs := make([][]byte, 0, lastTx-firstTx+1)
+
+	seg := &Segment{
+		Name:    path.Base(segmentPath(catalog.Database, firstTx, lastTx)),
+		FirstTx: firstTx,
+		LastTx:  lastTx,
+	}
+
+	for txID := firstTx; txID <= lastTx; txID++ {
+		tx, _, _, err := b.db.ExportTxByID(ctx, &schema.ExportTxRequest{Tx: txID})
+		if err != nil {
+			return nil, err
+		}
+
+		hdr, err := exportedTxHeader(tx)
+		if err != nil {
+			return nil, err
+		}
+
+		if txID == firstTx {
+			if len(catalog.Segments) > 0 {
+				prevHash := catalog.Segments[len(catalog.Segments)-1].TxHash
+				if !bytes.Equal(hdr.PrevAlh[:], prevHash) {
+					return nil, fmt.Errorf("%w: transaction %d does not follow the last backed up transaction",
+						ErrBackupDiverged, txID)
+				}
+			}
+			seg.FirstTs = hdr.Ts
+		}
+
+		if txID == lastTx {
+			alh := hdr.Alh()
+			seg.TxHash = alh[:]
+			seg.LastTs = hdr.Ts
+		}
+
+		txs = append(txs, tx)
+	}
+
+	state := &schema.ImmutableState{
+		Db:     catalog.Database,
+		TxId:   lastTx,
+		TxHash: seg.TxHash,
+	}
+
+	if b.signer != nil {
+		err := b.signer.Sign(state)
+		if err != nil {
+			return nil, err
+		}
+		seg.Signed = true
+	}
+
+	err := putFile(ctx, b.storage, segmentPath(catalog.Database, firstTx, lastTx), func(f *os.File) error {
+		return writeSegment(f, state, txs)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,365 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/remotestorage"
+	"github.com/codenotary/immudb/embedded/remotestorage/memory"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/database"
+	"github.com/codenotary/immudb/pkg/logger"
+	"github.com/codenotary/immudb/pkg/signer"
+	"github.com/stretchr/testify/require"
+)
+
+type testSigner struct {
+	signer signer.Signer
+}
+
+func (s *testSigner) Sign(state *schema.ImmutableState) error {
+	signature, publicKey, err := s.signer.Sign(state.ToBytes())
+	state.Signature = &schema.Signature{Signature: signature, PublicKey: publicKey}
+	return err
+}
+
+func newTestSigner(t *testing.T) (*testSigner, *ecdsa.PublicKey) {
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	return &testSigner{signer: signer.NewSignerFromPKey(rand.Reader, pk)}, &pk.PublicKey
+}
+
+func makeDbWith(t *testing.T, dbName string, opts *database.Options) database.DB {
+	d, err := database.NewDB(dbName, nil, opts, logger.NewSimpleLogger("immudb ", os.Stderr))
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		err := d.Close()
+		if !t.Failed() {
+			require.NoError(t, err)
+		}
+	})
+
+	return d
+}
+
+func makeSourceDb(t *testing.T, txCount int) database.DB {
+	db := makeDbWith(t, "db", database.DefaultOption().WithDBRootPath(t.TempDir()))
+
+	addTxs(t, db, txCount)
+
+	return db
+}
+
+func addTxs(t *testing.T, db database.DB, txCount int) {
+	for i := 0; i < txCount; i++ {
+		_, err := db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{{
+			Key:   []byte(fmt.Sprintf("key_%d", i)),
+			Value: []byte(fmt.Sprintf("value_%d", i)),
+		}}})
+		require.NoError(t, err)
+	}
+}
+
+func makeReplicaDb(t *testing.T) (database.DB, ReplicateFunc) {
+	db := makeDbWith(t, "db", database.DefaultOption().WithDBRootPath(t.TempDir()).AsReplica(true))
+
+	return db, func(ctx context.Context, exportedTx []byte) (*schema.TxHeader, error) {
+		return db.ReplicateTx(ctx, exportedTx, false, false)
+	}
+}
+
+func putData(t *testing.T, storage remotestorage.Storage, name string, data []byte) {
+	fileName := filepath.Join(t.TempDir(), "data")
+	err := ioutil.WriteFile(fileName, data, 0644)
+	require.NoError(t, err)
+
+	err = storage.Put(context.Background(), name, fileName)
+	require.NoError(t, err)
+}
+
+func requireSameState(t *testing.T, expected, actual database.DB) {
+	expectedState, err := expected.CurrentState()
+	require.NoError(t, err)
+
+	actualState, err := actual.CurrentState()
+	require.NoError(t, err)
+
+	require.Equal(t, expectedState.TxId, actualState.TxId)
+	require.Equal(t, expectedState.TxHash, actualState.TxHash)
+}
+
+func TestBackupIncremental(t *testing.T) {
+	ctx := context.Background()
+	storage := memory.Open()
+	sig, pk := newTestSigner(t)
+
+	db := makeSourceDb(t, 25)
+
+	b := NewBackup(db, storage, sig, time.Hour, logger.NewSimpleLogger("immudb ", os.Stderr)).
+		WithMaxSegmentTxs(10)
+
+	segments, err := b.Run(ctx)
+	require.NoError(t, err)
+	require.Len(t, segments, 3)
+
+	state, err := db.CurrentState()
+	require.NoError(t, err)
+
+	catalog, err := ReadCatalog(ctx, storage, "db")
+	require.NoError(t, err)
+	require.Len(t, catalog.Segments, 3)
+	require.EqualValues(t, 1, catalog.Segments[0].FirstTx)
+	require.EqualValues(t, 10, catalog.Segments[0].LastTx)
+	require.Equal(t, state.TxId, catalog.LastTx())
+	require.Equal(t, state.TxHash, catalog.Segments[2].TxHash)
+	require.True(t, catalog.Segments[2].Signed)
+
+	t.Run("nothing to backup", func(t *testing.T) {
+		segments, err := b.Run(ctx)
+		require.NoError(t, err)
+		require.Empty(t, segments)
+	})
+
+	t.Run("only new transactions are backed up", func(t *testing.T) {
+		addTxs(t, db, 3)
+
+		segments, err := b.Run(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+		require.Equal(t, catalog.LastTx()+1, segments[0].FirstTx)
+		require.Equal(t, catalog.LastTx()+3, segments[0].LastTx)
+	})
+
+	t.Run("verify all segments", func(t *testing.T) {
+		catalog, err := Verify(ctx, storage, "db", pk)
+		require.NoError(t, err)
+		require.Len(t, catalog.Segments, 4)
+	})
+
+	t.Run("backup of a different database", func(t *testing.T) {
+		other := makeDbWith(t, "db", database.DefaultOption().WithDBRootPath(t.TempDir()))
+		addTxs(t, other, 40)
+
+		_, err := NewBackup(other, storage, sig, time.Hour, logger.NewSimpleLogger("immudb ", os.Stderr)).Run(ctx)
+		require.ErrorIs(t, err, ErrBackupDiverged)
+	})
+}
+
+func TestBackupScheduler(t *testing.T) {
+	storage := memory.Open()
+	db := makeSourceDb(t, 5)
+
+	b := NewBackup(db, storage, nil, 10*time.Millisecond, logger.NewSimpleLogger("immudb ", os.Stderr))
+
+	err := b.Stop()
+	require.ErrorIs(t, err, ErrBackupAlreadyStopped)
+
+	err = b.Start()
+	require.NoError(t, err)
+
+	err = b.Start()
+	require.ErrorIs(t, err, ErrBackupAlreadyRunning)
+
+	state, err := db.CurrentState()
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		catalog, err := ReadCatalog(context.Background(), storage, "db")
+		return err == nil && catalog.LastTx() == state.TxId
+	}, 10*time.Second, 10*time.Millisecond)
+
+	err = b.Stop()
+	require.NoError(t, err)
+
+	catalog, err := ReadCatalog(context.Background(), storage, "db")
+	require.NoError(t, err)
+	require.False(t, catalog.Segments[0].Signed)
+}
+
+func TestRestore(t *testing.T) {
+	ctx := context.Background()
+	storage := memory.Open()
+	sig, pk := newTestSigner(t)
+
+	db := makeSourceDb(t, 25)
+
+	_, err := NewBackup(db, storage, sig, time.Hour, logger.NewSimpleLogger("immudb ", os.Stderr)).
+		WithMaxSegmentTxs(10).
+		Run(ctx)
+	require.NoError(t, err)
+
+	t.Run("restore everything", func(t *testing.T) {
+		replica, replicate := makeReplicaDb(t)
+
+		lastTx, err := Restore(ctx, storage, "db", replicate, DefaultRestoreOptions().WithPublicKey(pk))
+		require.NoError(t, err)
+
+		requireSameState(t, db, replica)
+
+		state, err := db.CurrentState()
+		require.NoError(t, err)
+		require.Equal(t, state.TxId, lastTx)
+	})
+
+	t.Run("restore up to tx and continue", func(t *testing.T) {
+		replica, replicate := makeReplicaDb(t)
+
+		lastTx, err := Restore(ctx, storage, "db", replicate, DefaultRestoreOptions().WithToTx(15).WithPublicKey(pk))
+		require.NoError(t, err)
+		require.EqualValues(t, 15, lastTx)
+
+		state, err := replica.CurrentState()
+		require.NoError(t, err)
+		require.EqualValues(t, 15, state.TxId)
+
+		expectedTx, err := db.TxByID(ctx, &schema.TxRequest{Tx: 15})
+		require.NoError(t, err)
+		expectedAlh := schema.TxHeaderFromProto(expectedTx.Header).Alh()
+		require.Equal(t, expectedAlh[:], state.TxHash)
+
+		var alh [32]byte
+		copy(alh[:], state.TxHash)
+
+		_, err = Restore(ctx, storage, "db", replicate, DefaultRestoreOptions().WithLastTx(state.TxId, alh).WithPublicKey(pk))
+		require.NoError(t, err)
+
+		requireSameState(t, db, replica)
+	})
+
+	t.Run("restore up to time", func(t *testing.T) {
+		catalog, err := ReadCatalog(ctx, storage, "db")
+		require.NoError(t, err)
+
+		replica, replicate := makeReplicaDb(t)
+
+		lastTx, err := Restore(ctx, storage, "db", replicate,
+			DefaultRestoreOptions().WithToTime(time.Unix(catalog.Segments[0].FirstTs-1, 0)))
+		require.NoError(t, err)
+		require.Zero(t, lastTx)
+
+		lastTx, err = Restore(ctx, storage, "db", replicate,
+			DefaultRestoreOptions().WithToTime(time.Unix(catalog.Segments[2].LastTs, 0)))
+		require.NoError(t, err)
+		require.Equal(t, catalog.LastTx(), lastTx)
+
+		requireSameState(t, db, replica)
+	})
+
+	t.Run("restore past the last backed up tx", func(t *testing.T) {
+		_, replicate := makeReplicaDb(t)
+
+		_, err := Restore(ctx, storage, "db", replicate, DefaultRestoreOptions().WithToTx(1000))
+		require.ErrorIs(t, err, ErrTxNotBackedUp)
+	})
+
+	t.Run("restore into a diverged database", func(t *testing.T) {
+		_, replicate := makeReplicaDb(t)
+
+		_, err := Restore(ctx, storage, "db", replicate, DefaultRestoreOptions().WithLastTx(5, [32]byte{1}))
+		require.ErrorIs(t, err, ErrDatabaseDiverged)
+	})
+
+	t.Run("signature verified with a different key", func(t *testing.T) {
+		_, otherPk := newTestSigner(t)
+
+		_, err := Verify(ctx, storage, "db", otherPk)
+		require.ErrorIs(t, err, ErrInvalidSignature)
+	})
+}
+
+func TestRestoreCorruptedSegment(t *testing.T) {
+	ctx := context.Background()
+	storage := memory.Open()
+	sig, pk := newTestSigner(t)
+
+	db := makeSourceDb(t, 25)
+
+	_, err := NewBackup(db, storage, sig, time.Hour, logger.NewSimpleLogger("immudb ", os.Stderr)).
+		WithMaxSegmentTxs(10).
+		Run(ctx)
+	require.NoError(t, err)
+
+	catalog, err := ReadCatalog(ctx, storage, "db")
+	require.NoError(t, err)
+
+	// replace the second segment with a re-signed segment containing transactions in a wrong order
+	seg, err := fetchSegment(ctx, storage, "db", catalog.Segments[1])
+	require.NoError(t, err)
+
+	seg.txs[2], seg.txs[3] = seg.txs[3], seg.txs[2]
+
+	segFile := filepath.Join(t.TempDir(), "segment")
+	f, err := os.Create(segFile)
+	require.NoError(t, err)
+	err = writeSegment(f, seg.state, seg.txs)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	err = storage.Put(ctx, segmentPath("db", catalog.Segments[1].FirstTx, catalog.Segments[1].LastTx), segFile)
+	require.NoError(t, err)
+
+	_, err = Verify(ctx, storage, "db", pk)
+	require.ErrorIs(t, err, ErrCorruptedSegment)
+
+	replica, replicate := makeReplicaDb(t)
+
+	lastTx, err := Restore(ctx, storage, "db", replicate, DefaultRestoreOptions().WithPublicKey(pk))
+	require.ErrorIs(t, err, ErrCorruptedSegment)
+
+	// no transaction from the corrupted segment is restored
+	require.Equal(t, catalog.Segments[0].LastTx, lastTx)
+
+	state, err := replica.CurrentState()
+	require.NoError(t, err)
+	require.Equal(t, catalog.Segments[0].LastTx, state.TxId)
+
+	t.Run("malformed segment", func(t *testing.T) {
+		putData(t, storage, segmentPath("db", catalog.Segments[1].FirstTx, catalog.Segments[1].LastTx), []byte("garbage"))
+
+		_, err = Verify(ctx, storage, "db", pk)
+		require.ErrorIs(t, err, ErrMalformedSegment)
+	})
+
+	t.Run("malformed catalog", func(t *testing.T) {
+		putData(t, storage, catalogPath("db"), []byte("{"))
+
+		_, err = ReadCatalog(ctx, storage, "db")
+		require.ErrorIs(t, err, ErrMalformedCatalog)
+	})
+}
+
+func TestReadCatalogEmpty(t *testing.T) {
+	catalog, err := ReadCatalog(context.Background(), memory.Open(), "db")
+	require.NoError(t, err)
+	require.Empty(t, catalog.Segments)
+	require.Zero(t, catalog.LastTx())
+
+	_, err = ReadCatalog(context.Background(), nil, "db")
+	require.ErrorIs(t, err, ErrIllegalArguments)
+}
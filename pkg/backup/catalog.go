@@ -0,0 +1,129 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/codenotary/immudb/embedded/remotestorage"
+)
+
+const catalogName = "catalog.json"
+
+// Catalog lists backup segments of a database, ordered by transaction ID
+type Catalog struct {
+	Database string     `json:"database"`
+	Segments []*Segment `json:"segments"`
+}
+
+// Segment describes a backup segment stored in the remote storage
+type Segment struct {
+	Name    string `json:"name"`
+	FirstTx uint64 `json:"firstTx"`
+	LastTx  uint64 `json:"lastTx"`
+	FirstTs int64  `json:"firstTs"` // timestamp of the first transaction (unix seconds)
+	LastTs  int64  `json:"lastTs"`  // timestamp of the last transaction (unix seconds)
+	TxHash  []byte `json:"txHash"`  // alh of the last transaction
+	Signed  bool   `json:"signed"`
+}
+
+// LastTx returns the ID of the last backed up transaction
+func (c *Catalog) LastTx() uint64 {
+	if len(c.Segments) == 0 {
+		return 0
+	}
+	return c.Segments[len(c.Segments)-1].LastTx
+}
+
+func catalogPath(dbName string) string {
+	return dbName + "/" + catalogName
+}
+
+func segmentPath(dbName string, firstTx, lastTx uint64) string {
+	return fmt.Sprintf("%s/segments/%020d-%020d.seg", dbName, firstTx, lastTx)
+}
+
+// ReadCatalog reads the catalog of backups of a database,
+// an empty catalog is returned if the database was never backed up
+func ReadCatalog(ctx context.Context, storage remotestorage.Storage, dbName string) (*Catalog, error) {
+	if storage == nil || dbName == "" {
+		return nil, ErrIllegalArguments
+	}
+
+	in, err := storage.Get(ctx, catalogPath(dbName), 0, -1)
+	if errors.Is(err, remotestorage.ErrNotFound) {
+		return &Catalog{Database: dbName}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer in.Close()
+
+	catalog := &Catalog{}
+
+	err = json.NewDecoder(in).Decode(catalog)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedCatalog, err)
+	}
+
+	if catalog.Database != dbName {
+		return nil, fmt.Errorf("%w: catalog belongs to database '%s'", ErrMalformedCatalog, catalog.Database)
+	}
+
+	for i, seg := range catalog.Segments {
+		if seg.FirstTx > seg.LastTx || (i > 0 && seg.FirstTx != catalog.Segments[i-1].LastTx+1) {
+			return nil, fmt.Errorf("%w: segment '%s' is out of order", ErrMalformedCatalog, seg.Name)
+		}
+	}
+
+	return catalog, nil
+}
+
+func writeCatalog(ctx context.Context, storage remotestorage.Storage, catalog *Catalog) error {
+	return putFile(ctx, storage, catalogPath(catalog.Database), func(f *os.File) error {
+		enc := json.NewEncoder(f)
+		enc.SetIndent("", "  ")
+		return enc.Encode(catalog)
+	})
+}
+
+// putFile uploads the content produced by the write function through a temporary file
+func putFile(ctx context.Context, storage remotestorage.Storage, name string, write func(f *os.File) error) error {
+	f, err := ioutil.TempFile("", "immudb_backup_")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	err = write(f)
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+
+	return storage.Put(ctx, name, f.Name())
+}
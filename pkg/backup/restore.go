@@ -0,0 +1,218 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/remotestorage"
+	"github.com/codenotary/immudb/pkg/api/schema"
+)
+
+// ReplicateFunc applies an exported transaction to the restored database
+type ReplicateFunc func(ctx context.Context, exportedTx []byte) (*schema.TxHeader, error)
+
+type RestoreOptions struct {
+	toTx      uint64
+	toTime    time.Time
+	publicKey *ecdsa.PublicKey
+
+	// state of the restored database
+	lastTxID  uint64
+	lastTxAlh [sha256.Size]byte
+}
+
+func DefaultRestoreOptions() *RestoreOptions {
+	return &RestoreOptions{}
+}
+
+// WithToTx restores transactions up to the given one, all backed up transactions are restored when zero
+func (opts *RestoreOptions) WithToTx(toTx uint64) *RestoreOptions {
+	opts.toTx = toTx
+	return opts
+}
+
+// WithToTime restores transactions committed up to the given time
+func (opts *RestoreOptions) WithToTime(toTime time.Time) *RestoreOptions {
+	opts.toTime = toTime
+	return opts
+}
+
+// WithPublicKey sets the key used to verify signed states, signatures are not checked when nil
+func (opts *RestoreOptions) WithPublicKey(publicKey *ecdsa.PublicKey) *RestoreOptions {
+	opts.publicKey = publicKey
+	return opts
+}
+
+// WithLastTx sets the state of a non-empty restored database,
+// only transactions that follow it are restored
+func (opts *RestoreOptions) WithLastTx(txID uint64, alh [sha256.Size]byte) *RestoreOptions {
+	opts.lastTxID = txID
+	opts.lastTxAlh = alh
+	return opts
+}
+
+// Restore verifies backup segments of the database and replays backed up transactions.
+// Every segment is verified against its signed state before any of its transactions is applied.
+// The ID of the last restored transaction is returned
+func Restore(
+	ctx context.Context,
+	storage remotestorage.Storage,
+	dbName string,
+	replicate ReplicateFunc,
+	opts *RestoreOptions,
+) (uint64, error) {
+
+	if replicate == nil || opts == nil {
+		return 0, ErrIllegalArguments
+	}
+
+	catalog, err := ReadCatalog(ctx, storage, dbName)
+	if err != nil {
+		return 0, err
+	}
+
+	if opts.lastTxID > catalog.LastTx() {
+		return 0, fmt.Errorf("%w: database is at transaction %d but the backup ends at transaction %d",
+			ErrDatabaseDiverged, opts.lastTxID, catalog.LastTx())
+	}
+
+	if opts.toTx > catalog.LastTx() {
+		return 0, fmt.Errorf("%w: transaction %d, last backed up transaction is %d",
+			ErrTxNotBackedUp, opts.toTx, catalog.LastTx())
+	}
+
+	if opts.toTx > 0 && opts.toTx < opts.lastTxID {
+		return 0, fmt.Errorf("%w: database is already past transaction %d", ErrIllegalArguments, opts.toTx)
+	}
+
+	lastTxID := opts.lastTxID
+
+	// segments are verified starting from the one containing the last transaction of
+	// the restored database, its alh anchors the verification of the following ones
+	var prevTxID uint64
+	var prevAlh [sha256.Size]byte
+
+	for _, segInfo := range catalog.Segments {
+		if segInfo.LastTx < opts.lastTxID {
+			continue
+		}
+
+		seg, err := fetchSegment(ctx, storage, dbName, segInfo)
+		if err != nil {
+			return lastTxID, err
+		}
+
+		err = seg.verify(dbName, prevTxID, prevAlh, opts.publicKey)
+		if err != nil {
+			return lastTxID, fmt.Errorf("%w: segment '%s'", err, segInfo.Name)
+		}
+
+		for i, hdr := range seg.hdrs {
+			if hdr.ID < opts.lastTxID {
+				continue
+			}
+
+			if hdr.ID == opts.lastTxID {
+				if hdr.Alh() != opts.lastTxAlh {
+					return lastTxID, fmt.Errorf("%w: transaction %d differs", ErrDatabaseDiverged, hdr.ID)
+				}
+				continue
+			}
+
+			if opts.toTx > 0 && hdr.ID > opts.toTx {
+				return lastTxID, nil
+			}
+			if !opts.toTime.IsZero() && hdr.Ts > opts.toTime.Unix() {
+				return lastTxID, nil
+			}
+
+			restoredHdr, err := replicate(ctx, seg.txs[i])
+			if err != nil {
+				return lastTxID, err
+			}
+
+			if schema.TxHeaderFromProto(restoredHdr).Alh() != hdr.Alh() {
+				return lastTxID, fmt.Errorf("%w: restored transaction %d does not match the backup", ErrCorruptedSegment, hdr.ID)
+			}
+
+			lastTxID = hdr.ID
+		}
+
+		prevTxID = seg.state.TxId
+		copy(prevAlh[:], seg.state.TxHash)
+	}
+
+	return lastTxID, nil
+}
+
+// Verify checks all backup segments of the database without restoring them
+func Verify(ctx context.Context, storage remotestorage.Storage, dbName string, publicKey *ecdsa.PublicKey) (*Catalog, error) {
+	catalog, err := ReadCatalog(ctx, storage, dbName)
+	if err != nil {
+		return nil, err
+	}
+
+	var prevTxID uint64
+	var prevAlh [sha256.Size]byte
+
+	for _, segInfo := range catalog.Segments {
+		seg, err := fetchSegment(ctx, storage, dbName, segInfo)
+		if err != nil {
+			return nil, err
+		}
+
+		err = seg.verify(dbName, prevTxID, prevAlh, publicKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w: segment '%s'", err, segInfo.Name)
+		}
+
+		prevTxID = seg.state.TxId
+		copy(prevAlh[:], seg.state.TxHash)
+	}
+
+	return catalog, nil
+}
+
+func fetchSegment(ctx context.Context, storage remotestorage.Storage, dbName string, segInfo *Segment) (*segment, error) {
+	in, err := storage.Get(ctx, segmentPath(dbName, segInfo.FirstTx, segInfo.LastTx), 0, -1)
+	if err != nil {
+		return nil, err
+	}
+	defer in.Close()
+
+	bs, err := ioutil.ReadAll(in)
+	if err != nil {
+		return nil, err
+	}
+
+	seg, err := readSegment(bs)
+	if err != nil {
+		return nil, fmt.Errorf("%w: segment '%s'", err, segInfo.Name)
+	}
+
+	if seg.hdrs[0].ID != segInfo.FirstTx || seg.state.TxId != segInfo.LastTx {
+		return nil, fmt.Errorf("%w: segment '%s' does not match the catalog", ErrCorruptedSegment, segInfo.Name)
+	}
+
+	return seg, nil
+}
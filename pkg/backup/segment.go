@@ -0,0 +1,222 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	segmentPrefix        = "IMMUSEGMENT"
+	latestSegmentVersion = 1
+)
+
+// segment holds the content of a backup segment, a range of exported
+// transactions followed by the signed state of the last one
+type segment struct {
+	state *schema.ImmutableState
+	txs   [][]byte
+	hdrs  []*store.TxHeader
+}
+
+// writeSegment serializes the segment:
+//
+//	prefix | version(4) | stateLen(4) | state | { txLen(4) | exportedTx }*
+func writeSegment(w io.Writer, state *schema.ImmutableState, txs [][]byte) error {
+	stateBs, err := proto.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	var b [4]byte
+
+	_, err = w.Write([]byte(segmentPrefix))
+	if err != nil {
+		return err
+	}
+
+	binary.BigEndian.PutUint32(b[:], latestSegmentVersion)
+	_, err = w.Write(b[:])
+	if err != nil {
+		return err
+	}
+
+	binary.BigEndian.PutUint32(b[:], uint32(len(stateBs)))
+	_, err = w.Write(b[:])
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(stateBs)
+	if err != nil {
+		return err
+	}
+
+	for _, tx := range txs {
+		binary.BigEndian.PutUint32(b[:], uint32(len(tx)))
+		_, err = w.Write(b[:])
+		if err != nil {
+			return err
+		}
+
+		_, err = w.Write(tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readSegment(bs []byte) (*segment, error) {
+	i := 0
+
+	if len(bs) < len(segmentPrefix)+8 || !bytes.Equal(bs[:len(segmentPrefix)], []byte(segmentPrefix)) {
+		return nil, ErrMalformedSegment
+	}
+	i += len(segmentPrefix)
+
+	if binary.BigEndian.Uint32(bs[i:]) != latestSegmentVersion {
+		return nil, fmt.Errorf("%w: unsupported version", ErrMalformedSegment)
+	}
+	i += 4
+
+	stateLen := int(binary.BigEndian.Uint32(bs[i:]))
+	i += 4
+
+	if len(bs) < i+stateLen {
+		return nil, ErrMalformedSegment
+	}
+
+	seg := &segment{state: &schema.ImmutableState{}}
+
+	err := proto.Unmarshal(bs[i:i+stateLen], seg.state)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedSegment, err)
+	}
+	i += stateLen
+
+	for i < len(bs) {
+		if len(bs) < i+4 {
+			return nil, ErrMalformedSegment
+		}
+
+		txLen := int(binary.BigEndian.Uint32(bs[i:]))
+		i += 4
+
+		if len(bs) < i+txLen {
+			return nil, ErrMalformedSegment
+		}
+
+		tx := bs[i : i+txLen]
+		i += txLen
+
+		hdr, err := exportedTxHeader(tx)
+		if err != nil {
+			return nil, err
+		}
+
+		seg.txs = append(seg.txs, tx)
+		seg.hdrs = append(seg.hdrs, hdr)
+	}
+
+	if len(seg.txs) == 0 {
+		return nil, fmt.Errorf("%w: no transactions", ErrMalformedSegment)
+	}
+
+	return seg, nil
+}
+
+// exportedTxHeader decodes the header placed at the beginning of an exported transaction
+func exportedTxHeader(tx []byte) (*store.TxHeader, error) {
+	if len(tx) < 4 {
+		return nil, ErrMalformedSegment
+	}
+
+	hdrLen := int(binary.BigEndian.Uint32(tx))
+	if len(tx) < 4+hdrLen {
+		return nil, ErrMalformedSegment
+	}
+
+	hdr := &store.TxHeader{}
+
+	err := hdr.ReadFrom(tx[4 : 4+hdrLen])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedSegment, err)
+	}
+
+	return hdr, nil
+}
+
+// verify checks that transactions in the segment form a chain starting right after
+// prevTxID and prevAlh and ending at the state stored in the segment.
+// The state signature is checked when a public key is provided.
+// The link to the previous transaction is not checked if prevTxID is zero and
+// the segment doesn't start at the first transaction
+func (seg *segment) verify(dbName string, prevTxID uint64, prevAlh [sha256.Size]byte, publicKey *ecdsa.PublicKey) error {
+	if seg.state.Db != dbName {
+		return fmt.Errorf("%w: segment belongs to database '%s'", ErrCorruptedSegment, seg.state.Db)
+	}
+
+	checkPrev := prevTxID > 0 || seg.hdrs[0].ID == 1
+
+	if prevTxID == 0 {
+		// the first transaction is linked to the hash of an empty log
+		prevAlh = sha256.Sum256(nil)
+	}
+
+	for _, hdr := range seg.hdrs {
+		if checkPrev && (hdr.ID != prevTxID+1 || hdr.PrevAlh != prevAlh) {
+			return fmt.Errorf("%w: transaction %d does not follow transaction %d", ErrCorruptedSegment, hdr.ID, prevTxID)
+		}
+
+		prevTxID = hdr.ID
+		prevAlh = hdr.Alh()
+		checkPrev = true
+	}
+
+	if seg.state.TxId != prevTxID || !bytes.Equal(seg.state.TxHash, prevAlh[:]) {
+		return fmt.Errorf("%w: transactions do not match the state of transaction %d", ErrCorruptedSegment, seg.state.TxId)
+	}
+
+	if publicKey == nil {
+		return nil
+	}
+
+	if seg.state.Signature == nil {
+		return ErrMissingSignature
+	}
+
+	ok, err := seg.state.CheckSignature(publicKey)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	if !ok {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
@@ -39,6 +39,7 @@ import (
 	"google.golang.org/grpc/grpclog"
 
 	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/protomodel"
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/client/cache"
@@ -184,6 +185,9 @@ type ImmuClient interface {
 	// GetServiceClient returns low-level GRPC service client.
 	GetServiceClient() schema.ImmuServiceClient
 
+	// GetDocumentServiceClient returns low-level GRPC document service client.
+	GetDocumentServiceClient() protomodel.DocumentServiceClient
+
 	// GetOptions returns current client options.
 	GetOptions() *Options
 
@@ -469,6 +473,10 @@ type ImmuClient interface {
 	// using stream API to overcome limits of large keys and values.
 	StreamExecAll(ctx context.Context, req *stream.ExecAllRequest) (*schema.TxHeader, error)
 
+	// StreamBulkLoad writes a large amount of key-values using a bulk load, bypassing regular transaction processing.
+	// Key-values don't need to be sorted and are split into as many transactions as needed.
+	StreamBulkLoad(ctx context.Context, kvs []*stream.KeyValue) (*schema.BulkLoadResponse, error)
+
 	// ExportTx retrieves serialized transaction object.
 	ExportTx(ctx context.Context, req *schema.ExportTxRequest) (schema.ImmuService_ExportTxClient, error)
 
@@ -513,6 +521,50 @@ type ImmuClient interface {
 	//
 	// This call requires SysAdmin permission level or admin permission to the database.
 	TruncateDatabase(ctx context.Context, db string, retentionPeriod time.Duration) error
+
+	// PromoteReplica turns a replica database into a primary one.
+	// Precommitted transactions after the specified one are discarded.
+	//
+	// This call requires SysAdmin permission level or admin permission to the database.
+	PromoteReplica(ctx context.Context, req *schema.PromoteReplicaRequest) (*schema.PromoteReplicaResponse, error)
+
+	// ExportPartialTx returns the entries of a transaction matching the given key prefixes or SQL tables,
+	// along with their inclusion proofs. It's used by partial replicas.
+	//
+	// This call requires SysAdmin permission level or admin permission to the database.
+	ExportPartialTx(ctx context.Context, req *schema.ExportPartialTxRequest) (*schema.PartialTx, error)
+
+	// PartialTxProof returns the header of the primary transaction and the inclusion proofs
+	// of the entries replicated in a transaction of a partial replica.
+	// Entries can be verified with database.VerifyPartialTxEntry, the header should then
+	// be checked against a signed state of the primary database.
+	PartialTxProof(ctx context.Context, tx uint64) (*schema.PartialTx, error)
+
+	// ReplicationStatus returns the replicas fetching transactions from the database along with their lag and,
+	// when the database is a replica, the state of the replication from its primary.
+	//
+	// This call requires SysAdmin permission level or admin permission to the database.
+	ReplicationStatus(ctx context.Context, db string) (*schema.ReplicationStatusResponse, error)
+
+	// CreateRole creates a new role granting the given privileges, roles are shared by all databases.
+	//
+	// This call requires SysAdmin permission level.
+	CreateRole(ctx context.Context, name string, privileges []*schema.RolePrivilege) error
+
+	// DeleteRole deletes a role, roles granted to users can not be deleted.
+	//
+	// This call requires SysAdmin permission level.
+	DeleteRole(ctx context.Context, name string) error
+
+	// ListRoles returns all the roles along with their privileges.
+	//
+	// This call requires SysAdmin or admin permission to any database.
+	ListRoles(ctx context.Context) (*schema.RoleList, error)
+
+	// ChangeRole grants or revokes a role to the given user on one database.
+	//
+	// This call requires SysAdmin or admin permission to the database where the role is granted.
+	ChangeRole(ctx context.Context, action schema.PermissionAction, username string, database string, role string) error
 }
 
 type ErrorHandler func(sessionID string, err error)
@@ -635,35 +687,47 @@ func (c *immuClient) SetupDialOptions(options *Options) []grpc.DialOption {
 	opts := options.DialOptions
 	//---------- TLS Setting -----------//
 	if options.MTLs {
-		//LoadX509KeyPair reads and parses a public/private key pair from a pair of files.
-		//The files must contain PEM encoded data.
-		//The certificate file may contain intermediate certificates following the leaf certificate to form a certificate chain.
-		//On successful return, Certificate.Leaf will be nil because the parsed form of the certificate is not retained.
-		cert, err := tls.LoadX509KeyPair(
-			//certificate signed by intermediary for the client. It contains the public key.
-			options.MTLsOptions.Certificate,
-			//client key (needed to sign the requests. Only the public key can open the data)
-			options.MTLsOptions.Pkey,
-		)
-		if err != nil {
-			grpclog.Errorf("failed to read credentials: %s", err)
+		var certificates []tls.Certificate
+
+		// client certificate is only presented when configured, plain TLS is used otherwise
+		if options.MTLsOptions.Certificate != "" || options.MTLsOptions.Pkey != "" {
+			//LoadX509KeyPair reads and parses a public/private key pair from a pair of files.
+			//The files must contain PEM encoded data.
+			//The certificate file may contain intermediate certificates following the leaf certificate to form a certificate chain.
+			//On successful return, Certificate.Leaf will be nil because the parsed form of the certificate is not retained.
+			cert, err := tls.LoadX509KeyPair(
+				//certificate signed by intermediary for the client. It contains the public key.
+				options.MTLsOptions.Certificate,
+				//client key (needed to sign the requests. Only the public key can open the data)
+				options.MTLsOptions.Pkey,
+			)
+			if err != nil {
+				grpclog.Errorf("failed to read credentials: %s", err)
+			}
+
+			certificates = []tls.Certificate{cert}
 		}
 
-		certPool := x509.NewCertPool()
-
-		// chain is composed by default by ca.cert.pem and intermediate.cert.pem
-		bs, err := ioutil.ReadFile(options.MTLsOptions.ClientCAs)
-		if err != nil {
-			grpclog.Errorf("failed to read ca cert: %s", err)
-		}
-
-		// AppendCertsFromPEM attempts to parse a series of PEM encoded certificates.
-		// It appends any certificates found to s and reports whether any certificates were successfully parsed.
-		// On many Linux systems, /etc/ssl/cert.pem will contain the system wide set of root CAs
-		// in a format suitable for this function.
-		ok := certPool.AppendCertsFromPEM(bs)
-		if !ok {
-			grpclog.Errorf("failed to append certs")
+		var certPool *x509.CertPool
+
+		// host's root CA set is used when no CA is configured
+		if options.MTLsOptions.ClientCAs != "" {
+			certPool = x509.NewCertPool()
+
+			// chain is composed by default by ca.cert.pem and intermediate.cert.pem
+			bs, err := ioutil.ReadFile(options.MTLsOptions.ClientCAs)
+			if err != nil {
+				grpclog.Errorf("failed to read ca cert: %s", err)
+			}
+
+			// AppendCertsFromPEM attempts to parse a series of PEM encoded certificates.
+			// It appends any certificates found to s and reports whether any certificates were successfully parsed.
+			// On many Linux systems, /etc/ssl/cert.pem will contain the system wide set of root CAs
+			// in a format suitable for this function.
+			ok := certPool.AppendCertsFromPEM(bs)
+			if !ok {
+				grpclog.Errorf("failed to append certs")
+			}
 		}
 
 		transportCreds := credentials.NewTLS(&tls.Config{
@@ -678,7 +742,7 @@ func (c *immuClient) SetupDialOptions(options *Options) []grpc.DialOption {
 			// Server configurations must set one of Certificates, GetCertificate or
 			// GetConfigForClient. Clients doing client-authentication may set either
 			// Certificates or GetClientCertificate.
-			Certificates: []tls.Certificate{cert},
+			Certificates: certificates,
 			// Safe store, trusted certificate list
 			// Server need to use one certificate presents in this lists.
 			// RootCAs defines the set of root certificate authorities
@@ -781,6 +845,11 @@ func (c *immuClient) GetServiceClient() schema.ImmuServiceClient {
 	return c.ServiceClient
 }
 
+// GetDocumentServiceClient returns low-level GRPC document service client.
+func (c *immuClient) GetDocumentServiceClient() protomodel.DocumentServiceClient {
+	return protomodel.NewDocumentServiceClient(c.clientConn)
+}
+
 // GetOptions returns current client options.
 func (c *immuClient) GetOptions() *Options {
 	return c.Options
@@ -2349,3 +2418,130 @@ func (c *immuClient) TruncateDatabase(ctx context.Context, db string, retentionP
 
 	return err
 }
+
+// PromoteReplica turns a replica database into a primary one.
+func (c *immuClient) PromoteReplica(ctx context.Context, req *schema.PromoteReplicaRequest) (*schema.PromoteReplicaResponse, error) {
+	start := time.Now()
+
+	if !c.IsConnected() {
+		return nil, ErrNotConnected
+	}
+
+	res, err := c.ServiceClient.PromoteReplica(ctx, req)
+
+	c.Logger.Debugf("PromoteReplica finished in %s", time.Since(start))
+
+	return res, err
+}
+
+// ExportPartialTx returns the entries of a transaction matching the given key prefixes or SQL tables.
+func (c *immuClient) ExportPartialTx(ctx context.Context, req *schema.ExportPartialTxRequest) (*schema.PartialTx, error) {
+	start := time.Now()
+
+	if !c.IsConnected() {
+		return nil, ErrNotConnected
+	}
+
+	res, err := c.ServiceClient.ExportPartialTx(ctx, req)
+
+	c.Logger.Debugf("ExportPartialTx finished in %s", time.Since(start))
+
+	return res, err
+}
+
+// PartialTxProof returns the provenance of the entries replicated in a transaction of a partial replica.
+func (c *immuClient) PartialTxProof(ctx context.Context, tx uint64) (*schema.PartialTx, error) {
+	start := time.Now()
+
+	if !c.IsConnected() {
+		return nil, ErrNotConnected
+	}
+
+	res, err := c.ServiceClient.PartialTxProof(ctx, &schema.PartialTxProofRequest{Tx: tx})
+
+	c.Logger.Debugf("PartialTxProof finished in %s", time.Since(start))
+
+	return res, err
+}
+
+// ReplicationStatus returns the replication status of the database.
+func (c *immuClient) ReplicationStatus(ctx context.Context, db string) (*schema.ReplicationStatusResponse, error) {
+	start := time.Now()
+
+	if !c.IsConnected() {
+		return nil, ErrNotConnected
+	}
+
+	res, err := c.ServiceClient.ReplicationStatus(ctx, &schema.ReplicationStatusRequest{Database: db})
+
+	c.Logger.Debugf("ReplicationStatus finished in %s", time.Since(start))
+
+	return res, err
+}
+
+// CreateRole creates a new role granting the given privileges.
+func (c *immuClient) CreateRole(ctx context.Context, name string, privileges []*schema.RolePrivilege) error {
+	start := time.Now()
+
+	if !c.IsConnected() {
+		return ErrNotConnected
+	}
+
+	_, err := c.ServiceClient.CreateRole(ctx, &schema.CreateRoleRequest{Name: name, Privileges: privileges})
+
+	c.Logger.Debugf("CreateRole finished in %s", time.Since(start))
+
+	return err
+}
+
+// DeleteRole deletes a role which is not granted to any user.
+func (c *immuClient) DeleteRole(ctx context.Context, name string) error {
+	start := time.Now()
+
+	if !c.IsConnected() {
+		return ErrNotConnected
+	}
+
+	_, err := c.ServiceClient.DeleteRole(ctx, &schema.DeleteRoleRequest{Name: name})
+
+	c.Logger.Debugf("DeleteRole finished in %s", time.Since(start))
+
+	return err
+}
+
+// ListRoles returns all the roles.
+func (c *immuClient) ListRoles(ctx context.Context) (*schema.RoleList, error) {
+	start := time.Now()
+
+	if !c.IsConnected() {
+		return nil, ErrNotConnected
+	}
+
+	res, err := c.ServiceClient.ListRoles(ctx, &empty.Empty{})
+
+	c.Logger.Debugf("ListRoles finished in %s", time.Since(start))
+
+	return res, err
+}
+
+// ChangeRole grants or revokes a role to the given user on one database.
+func (c *immuClient) ChangeRole(ctx context.Context, action schema.PermissionAction, username string, database string, role string) error {
+	start := time.Now()
+
+	if !c.IsConnected() {
+		return ErrNotConnected
+	}
+
+	in := &schema.ChangeRoleRequest{
+		Action:   action,
+		Username: username,
+		Database: database,
+		Role:     role,
+	}
+
+	_, err := c.ServiceClient.ChangeRole(ctx, in)
+
+	c.Logger.Debugf("ChangeRole finished in %s", time.Since(start))
+
+	return err
+}
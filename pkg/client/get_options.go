@@ -86,3 +86,29 @@ func AtRevision(rev int64) GetOption {
 		return nil
 	}
 }
+
+// ValueRange option is used to read only a part of the value, which
+// is useful when dealing with large values such as documents or images.
+//
+// At most length bytes of the value are returned starting at the given offset,
+// the remaining part of the value is returned if length is 0.
+//
+// Only the requested range is read by the server, thus the digest of the value
+// is not validated unless the VerifyValue option is also used.
+// Value ranges can not be used with verified reads.
+func ValueRange(offset, length uint64) GetOption {
+	return func(req *schema.KeyRequest) error {
+		req.ValueOffset = offset
+		req.ValueLength = length
+		return nil
+	}
+}
+
+// VerifyValue option requires the server to read the whole value and validate
+// its digest even if only a range of the value is returned.
+func VerifyValue(verify bool) GetOption {
+	return func(req *schema.KeyRequest) error {
+		req.VerifyValue = verify
+		return nil
+	}
+}
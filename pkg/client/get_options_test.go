@@ -63,3 +63,24 @@ func TestGetOptionsAtRevision(t *testing.T) {
 		AtRevision: 102,
 	}, req)
 }
+
+func TestGetOptionsValueRange(t *testing.T) {
+	req := &schema.KeyRequest{Key: []byte("key")}
+	err := client.ValueRange(10, 20)(req)
+	require.NoError(t, err)
+	require.Equal(t, &schema.KeyRequest{
+		Key:         []byte("key"),
+		ValueOffset: 10,
+		ValueLength: 20,
+	}, req)
+}
+
+func TestGetOptionsVerifyValue(t *testing.T) {
+	req := &schema.KeyRequest{Key: []byte("key")}
+	err := client.VerifyValue(true)(req)
+	require.NoError(t, err)
+	require.Equal(t, &schema.KeyRequest{
+		Key:         []byte("key"),
+		VerifyValue: true,
+	}, req)
+}
@@ -83,6 +83,13 @@ func (c *immuClient) StreamExecAll(ctx context.Context, req *stream.ExecAllReque
 	return txhdr, errors.FromError(err)
 }
 
+// StreamBulkLoad writes a large amount of key-values using a bulk load, bypassing regular transaction processing.
+// Key-values don't need to be sorted and are split into as many transactions as needed.
+func (c *immuClient) StreamBulkLoad(ctx context.Context, kvs []*stream.KeyValue) (*schema.BulkLoadResponse, error) {
+	res, err := c._streamBulkLoad(ctx, kvs)
+	return res, errors.FromError(err)
+}
+
 func (c *immuClient) _streamSet(ctx context.Context, kvs []*stream.KeyValue) (*schema.TxHeader, error) {
 	s, err := c.streamSet(ctx)
 	if err != nil {
@@ -101,6 +108,24 @@ func (c *immuClient) _streamSet(ctx context.Context, kvs []*stream.KeyValue) (*s
 	return s.CloseAndRecv()
 }
 
+func (c *immuClient) _streamBulkLoad(ctx context.Context, kvs []*stream.KeyValue) (*schema.BulkLoadResponse, error) {
+	s, err := c.streamBulkLoad(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	kvss := c.StreamServiceFactory.NewKvStreamSender(c.StreamServiceFactory.NewMsgSender(s))
+
+	for _, kv := range kvs {
+		err = kvss.Send(kv)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s.CloseAndRecv()
+}
+
 func (c *immuClient) _streamGet(ctx context.Context, k *schema.KeyRequest) (*schema.Entry, error) {
 	gs, err := c.streamGet(ctx, k)
 	if err != nil {
@@ -557,3 +582,10 @@ func (c *immuClient) streamExecAll(ctx context.Context) (schema.ImmuService_Stre
 	}
 	return c.ServiceClient.StreamExecAll(ctx)
 }
+
+func (c *immuClient) streamBulkLoad(ctx context.Context) (schema.ImmuService_StreamBulkLoadClient, error) {
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+	return c.ServiceClient.StreamBulkLoad(ctx)
+}
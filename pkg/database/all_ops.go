@@ -37,6 +37,11 @@ func (d *db) ExecAll(ctx context.Context, req *schema.ExecAllRequest) (*schema.T
 		return nil, err
 	}
 
+	err := d.waitForReplicationEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	d.mutex.Lock()
 	defer d.mutex.Unlock()
 
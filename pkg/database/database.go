@@ -22,6 +22,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"os"
 	"path/filepath"
@@ -63,11 +64,17 @@ type DB interface {
 	IsSyncReplicationEnabled() bool
 	SetSyncReplication(enabled bool)
 
+	ReplicationEpoch() uint64
+	SetReplicationEpoch(epoch uint64)
+
+	TakeDownstreamReplicaStates() []*schema.ReplicaState
+
 	MaxResultSize() int
 	UseTimeFunc(timeFunc store.TimeFunc) error
 
 	// State
 	Health() (waitingCount int, lastReleaseAt time.Time)
+	IndexRebuildProgress() (inProgress bool, processedTxs, totalTxs uint64)
 	CurrentState() (*schema.ImmutableState, error)
 
 	Size() (uint64, error)
@@ -91,6 +98,8 @@ type DB interface {
 
 	ExecAll(ctx context.Context, operations *schema.ExecAllRequest) (*schema.TxHeader, error)
 
+	BulkLoad(ctx context.Context, next func() (*schema.KeyValue, error)) (*schema.BulkLoadResponse, error)
+
 	Count(ctx context.Context, prefix *schema.KeyPrefix) (*schema.EntryCount, error)
 	CountAll(ctx context.Context) (*schema.EntryCount, error)
 
@@ -126,6 +135,11 @@ type DB interface {
 	AllowCommitUpto(txID uint64, alh [sha256.Size]byte) error
 	DiscardPrecommittedTxsSince(txID uint64) error
 
+	ExportPartialTx(ctx context.Context, req *schema.ExportPartialTxRequest) (*schema.PartialTx, error)
+	ReplicatePartialTx(ctx context.Context, ptx *schema.PartialTx) (*schema.TxHeader, error)
+	PartialReplicationState() (txID uint64, alh [sha256.Size]byte, err error)
+	PartialTxProof(ctx context.Context, req *schema.PartialTxProofRequest) (*schema.PartialTx, error)
+
 	VerifiableTxByID(ctx context.Context, req *schema.VerifiableTxRequest) (*schema.VerifiableTx, error)
 	TxScan(ctx context.Context, req *schema.TxScanRequest) (*schema.TxList, error)
 
@@ -167,6 +181,13 @@ type db struct {
 
 	replicaStates      map[uuid]*replicaState
 	replicaStatesMutex sync.Mutex
+
+	// states informed by synchronous replicas fetching from this replica, forwarded to its primary
+	downstreamReplicaStates map[uuid]*schema.ReplicaState
+
+	// closed once enough replicas confirmed the replication epoch, nil when writes are not held
+	epochConfirmed     chan struct{}
+	epochConfirmations map[uuid]struct{}
 }
 
 // OpenDB Opens an existing Database from disk
@@ -192,6 +213,11 @@ func OpenDB(dbName string, multidbHandler sql.MultiDBHandler, op *Options, log l
 		mutex:         &instrumentedRWMutex{},
 	}
 
+	if replicaStates != nil {
+		// the primary may have been superseded by a failover while it was down
+		dbi.holdWritesUntilEpochConfirmed()
+	}
+
 	dbDir := dbi.Path()
 	_, err := os.Stat(dbDir)
 	if os.IsNotExist(err) {
@@ -211,7 +237,8 @@ func OpenDB(dbName string, multidbHandler sql.MultiDBHandler, op *Options, log l
 
 	sqlOpts := sql.DefaultOptions().
 		WithPrefix([]byte{SQLPrefix}).
-		WithMultiDBHandler(multidbHandler)
+		WithMultiDBHandler(multidbHandler).
+		WithPrivilegeChecker(checkTablePrivileges)
 
 	dbi.sqlEngine, err = sql.NewEngine(dbi.st, sqlOpts)
 	if err != nil {
@@ -219,7 +246,7 @@ func OpenDB(dbName string, multidbHandler sql.MultiDBHandler, op *Options, log l
 		return nil, err
 	}
 
-	dbi.documentEngine, err = document.NewEngine(dbi.st, document.DefaultOptions().WithPrefix([]byte{DocumentPrefix}))
+	dbi.documentEngine, err = document.NewEngine(dbi.st, documentOptions())
 	if err != nil {
 		return nil, err
 	}
@@ -307,7 +334,8 @@ func NewDB(dbName string, multidbHandler sql.MultiDBHandler, op *Options, log lo
 
 	sqlOpts := sql.DefaultOptions().
 		WithPrefix([]byte{SQLPrefix}).
-		WithMultiDBHandler(multidbHandler)
+		WithMultiDBHandler(multidbHandler).
+		WithPrivilegeChecker(checkTablePrivileges)
 
 	dbi.Logger.Infof("Loading SQL Engine for database '%s' {replica = %v}...", dbName, op.replica)
 
@@ -317,7 +345,7 @@ func NewDB(dbName string, multidbHandler sql.MultiDBHandler, op *Options, log lo
 		return nil, err
 	}
 
-	dbi.documentEngine, err = document.NewEngine(dbi.st, document.DefaultOptions().WithPrefix([]byte{DocumentPrefix}))
+	dbi.documentEngine, err = document.NewEngine(dbi.st, documentOptions())
 	if err != nil {
 		return nil, logErr(dbi.Logger, "Unable to open database: %s", err)
 	}
@@ -353,6 +381,11 @@ func (d *db) CompactIndex() error {
 
 // Set ...
 func (d *db) Set(ctx context.Context, req *schema.SetRequest) (*schema.TxHeader, error) {
+	err := d.waitForReplicationEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	d.mutex.RLock()
 	defer d.mutex.RUnlock()
 
@@ -427,6 +460,69 @@ func (d *db) set(ctx context.Context, req *schema.SetRequest) (*schema.TxHeader,
 	return schema.TxHeaderToProto(hdr), nil
 }
 
+// BulkLoad writes all the key-values provided by next, until it returns io.EOF,
+// bypassing regular transaction processing. Loaded entries are indexed at once when the load is completed
+func (d *db) BulkLoad(ctx context.Context, next func() (*schema.KeyValue, error)) (*schema.BulkLoadResponse, error) {
+	err := d.waitForReplicationEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	if d.isReplica() {
+		return nil, ErrIsReplica
+	}
+
+	if next == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	loader, err := d.st.BulkLoad(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		kv, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err == nil && len(kv.GetKey()) == 0 {
+			err = ErrIllegalArguments
+		}
+		if err != nil {
+			loader.Cancel()
+			return nil, err
+		}
+
+		e := EncodeEntrySpec(
+			kv.Key,
+			schema.KVMetadataFromProto(kv.Metadata),
+			kv.Value,
+		)
+
+		err = loader.Set(ctx, e.Key, e.Metadata, e.Value)
+		if err != nil {
+			loader.Cancel()
+			return nil, err
+		}
+	}
+
+	res, err := loader.Commit(ctx)
+	if err != nil {
+		loader.Cancel()
+		return nil, err
+	}
+
+	return &schema.BulkLoadResponse{
+		FirstTxId: res.FirstTxID,
+		LastTxId:  res.LastTxID,
+		Entries:   res.Entries,
+	}, nil
+}
+
 func checkKeyRequest(req *schema.KeyRequest) error {
 	if req == nil {
 		return fmt.Errorf(
@@ -458,6 +554,13 @@ func checkKeyRequest(req *schema.KeyRequest) error {
 		}
 	}
 
+	if req.ValueOffset > math.MaxInt32 || req.ValueLength > math.MaxInt32 {
+		return fmt.Errorf(
+			"%w: invalid value range",
+			ErrIllegalArguments,
+		)
+	}
+
 	return nil
 }
 
@@ -488,6 +591,10 @@ func (d *db) Get(ctx context.Context, req *schema.KeyRequest) (*schema.Entry, er
 		}
 	}
 
+	if req.ValueOffset > 0 || req.ValueLength > 0 {
+		return d.getValueRange(EncodeKey(req.Key), req)
+	}
+
 	if req.AtRevision != 0 {
 		return d.getAtRevision(EncodeKey(req.Key), req.AtRevision, true)
 	}
@@ -542,6 +649,21 @@ func (d *db) getAtTx(
 }
 
 func (d *db) getAtRevision(key []byte, atRevision int64, skipIntegrityCheck bool) (entry *schema.Entry, err error) {
+	txID, revision, err := d.txAtRevision(key, atRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err = d.getAtTx(key, txID, 0, d.st, revision, skipIntegrityCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, err
+}
+
+// txAtRevision returns the transaction in which the given revision of the key was set
+func (d *db) txAtRevision(key []byte, atRevision int64) (txID uint64, revision uint64, err error) {
 	var offset uint64
 	var desc bool
 
@@ -555,22 +677,17 @@ func (d *db) getAtRevision(key []byte, atRevision int64, skipIntegrityCheck bool
 
 	txs, hCount, err := d.st.History(key, offset, desc, 1)
 	if errors.Is(err, store.ErrNoMoreEntries) || errors.Is(err, store.ErrOffsetOutOfRange) {
-		return nil, ErrInvalidRevision
+		return 0, 0, ErrInvalidRevision
 	}
 	if err != nil {
-		return nil, err
+		return 0, 0, err
 	}
 
 	if atRevision < 0 {
 		atRevision = int64(hCount) + atRevision
 	}
 
-	entry, err = d.getAtTx(key, txs[0], 0, d.st, uint64(atRevision), skipIntegrityCheck)
-	if err != nil {
-		return nil, err
-	}
-
-	return entry, err
+	return txs[0], uint64(atRevision), nil
 }
 
 func (d *db) resolveValue(
@@ -660,6 +777,10 @@ func (d *db) Health() (waitingCount int, lastReleaseAt time.Time) {
 	return d.mutex.State()
 }
 
+func (d *db) IndexRebuildProgress() (inProgress bool, processedTxs, totalTxs uint64) {
+	return d.st.IndexRebuildProgress()
+}
+
 // CurrentState ...
 func (d *db) CurrentState() (*schema.ImmutableState, error) {
 	lastTxID, lastTxAlh := d.st.CommittedAlh()
@@ -739,6 +860,13 @@ func (d *db) VerifiableGet(ctx context.Context, req *schema.VerifiableGetRequest
 		return nil, ErrIllegalArguments
 	}
 
+	if req.KeyRequest != nil && (req.KeyRequest.ValueOffset > 0 || req.KeyRequest.ValueLength > 0) {
+		return nil, fmt.Errorf(
+			"%w: value ranges can not be verified",
+			ErrIllegalArguments,
+		)
+	}
+
 	lastTxID, _ := d.st.CommittedAlh()
 	if lastTxID < req.ProveSinceTx {
 		return nil, ErrIllegalState
@@ -820,6 +948,11 @@ func (d *db) Delete(ctx context.Context, req *schema.DeleteKeysRequest) (*schema
 		return nil, ErrIllegalArguments
 	}
 
+	err := d.waitForReplicationEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	d.mutex.RLock()
 	defer d.mutex.RUnlock()
 
@@ -1179,15 +1312,94 @@ func (d *db) mayUpdateReplicaState(committedTxID uint64, newReplicaState *schema
 	return nil
 }
 
+// mayUpdateDownstreamReplicaState takes into account the acknowledgement of a replica fetching from another replica
+func (d *db) mayUpdateDownstreamReplicaState(committedTxID, preCommittedTxID uint64, st *schema.ReplicaState) error {
+	if st.PrecommittedTxID <= committedTxID {
+		// as far as the primary is concerned, nothing really new has happened
+		return nil
+	}
+
+	if st.PrecommittedTxID > preCommittedTxID {
+		d.Logger.Warningf("state of downstream replica '%s' is ahead of database '%s', acknowledgement discarded", st.UUID, d.name)
+		return nil
+	}
+
+	hdr, err := d.st.ReadTxHeader(st.PrecommittedTxID, true, false)
+	if err != nil {
+		return err
+	}
+
+	if hdr.Alh() != schema.DigestFromProto(st.PrecommittedAlh) {
+		d.Logger.Warningf("state of downstream replica '%s' does not match database '%s', acknowledgement discarded", st.UUID, d.name)
+		return nil
+	}
+
+	return d.mayUpdateReplicaState(committedTxID, st)
+}
+
+// trackDownstreamReplicaStates keeps the latest state informed by each downstream replica of the current epoch
+func (d *db) trackDownstreamReplicaStates(req *schema.ExportTxRequest) {
+	d.replicaStatesMutex.Lock()
+	defer d.replicaStatesMutex.Unlock()
+
+	if req.ReplicationEpoch != d.options.replicationEpoch {
+		return
+	}
+
+	if d.downstreamReplicaStates == nil {
+		d.downstreamReplicaStates = make(map[uuid]*schema.ReplicaState)
+	}
+
+	d.downstreamReplicaStates[req.ReplicaState.UUID] = req.ReplicaState
+
+	for _, st := range req.DownstreamReplicaStates {
+		d.downstreamReplicaStates[st.UUID] = st
+	}
+}
+
+// TakeDownstreamReplicaStates returns the states informed by downstream replicas since the previous call
+func (d *db) TakeDownstreamReplicaStates() []*schema.ReplicaState {
+	d.replicaStatesMutex.Lock()
+	defer d.replicaStatesMutex.Unlock()
+
+	if len(d.downstreamReplicaStates) == 0 {
+		return nil
+	}
+
+	states := make([]*schema.ReplicaState, 0, len(d.downstreamReplicaStates))
+	for _, st := range d.downstreamReplicaStates {
+		states = append(states, st)
+	}
+
+	d.downstreamReplicaStates = nil
+
+	return states
+}
+
 func (d *db) ExportTxByID(ctx context.Context, req *schema.ExportTxRequest) (txbs []byte, mayCommitUpToTxID uint64, mayCommitUpToAlh [sha256.Size]byte, err error) {
 	if req == nil {
 		return nil, 0, mayCommitUpToAlh, ErrIllegalArguments
 	}
 
-	if d.replicaStates == nil && req.ReplicaState != nil {
+	// replicas may serve downstream replicas (cascading replication),
+	// acknowledgements of synchronous downstream replicas are forwarded to the primary
+	isReplica := d.IsReplica()
+
+	if isReplica && !d.IsSyncReplicationEnabled() && req.ReplicaState != nil {
+		return nil, 0, mayCommitUpToAlh, fmt.Errorf("%w: replica state was NOT expected by an asynchronous replica", ErrIllegalState)
+	}
+
+	if !isReplica && d.replicaStates == nil && req.ReplicaState != nil {
 		return nil, 0, mayCommitUpToAlh, fmt.Errorf("%w: replica state was NOT expected", ErrIllegalState)
 	}
 
+	if !isReplica {
+		err = d.checkReplicationEpoch(req)
+		if err != nil {
+			return nil, 0, mayCommitUpToAlh, err
+		}
+	}
+
 	tx, err := d.allocTx()
 	if err != nil {
 		return nil, 0, mayCommitUpToAlh, err
@@ -1252,9 +1464,22 @@ func (d *db) ExportTxByID(ctx context.Context, req *schema.ExportTxRequest) (txb
 			}
 		}
 
-		err = d.mayUpdateReplicaState(committedTxID, req.ReplicaState)
-		if err != nil {
-			return nil, mayCommitUpToTxID, mayCommitUpToAlh, err
+		if isReplica {
+			// the commit state of the replica flows down the chain while
+			// the acknowledgement is forwarded to the primary
+			d.trackDownstreamReplicaStates(req)
+		} else {
+			err = d.mayUpdateReplicaState(committedTxID, req.ReplicaState)
+			if err != nil {
+				return nil, mayCommitUpToTxID, mayCommitUpToAlh, err
+			}
+
+			for _, st := range req.DownstreamReplicaStates {
+				err = d.mayUpdateDownstreamReplicaState(committedTxID, preCommittedTxID, st)
+				if err != nil {
+					return nil, mayCommitUpToTxID, mayCommitUpToAlh, err
+				}
+			}
 		}
 	}
 
@@ -1616,6 +1841,10 @@ func (d *db) AsReplica(asReplica, syncReplication bool, syncAcks int) {
 		d.replicaStates = make(map[uuid]*replicaState, syncAcks)
 	}
 
+	if asReplica || syncAcks == 0 {
+		d.releaseWrites()
+	}
+
 	d.st.SetExternalCommitAllowance(syncReplication)
 }
 
@@ -1655,6 +1884,12 @@ func logErr(log logger.Logger, formattedMessage string, err error) error {
 
 // CopyCatalog creates a copy of the sql catalog and returns a transaction
 // that can be used to commit the copy.
+func documentOptions() *document.Options {
+	return document.DefaultOptions().
+		WithPrefix([]byte{DocumentPrefix}).
+		WithPrivilegeChecker(checkTablePrivileges)
+}
+
 func (d *db) CopyCatalogToTx(ctx context.Context, tx *store.OngoingTx) error {
 	// copy the sql catalog
 	err := d.sqlEngine.CopyCatalogToTx(ctx, tx)
@@ -21,6 +21,7 @@ import (
 	"crypto/sha256"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"math"
 	"os"
@@ -506,6 +507,57 @@ func TestSetGetAll(t *testing.T) {
 	}
 }
 
+func TestBulkLoad(t *testing.T) {
+	db := makeDb(t)
+
+	_, err := db.BulkLoad(context.Background(), nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	pending := kvs
+
+	next := func() (*schema.KeyValue, error) {
+		if len(pending) == 0 {
+			return nil, io.EOF
+		}
+
+		kv := pending[0]
+		pending = pending[1:]
+
+		return kv, nil
+	}
+
+	res, err := db.BulkLoad(context.Background(), next)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.FirstTxId)
+	require.Equal(t, uint64(1), res.LastTxId)
+
+	for _, kv := range kvs {
+		entry, err := db.Get(context.Background(), &schema.KeyRequest{Key: kv.Key, SinceTx: res.LastTxId})
+		require.NoError(t, err)
+		require.Equal(t, kv.Value, entry.Value)
+		require.Equal(t, res.LastTxId, entry.Tx)
+	}
+
+	t.Run("failed bulk load should not prevent further loads", func(t *testing.T) {
+		_, err := db.BulkLoad(context.Background(), func() (*schema.KeyValue, error) {
+			return &schema.KeyValue{}, nil
+		})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		errNext := errors.New("next error")
+
+		_, err = db.BulkLoad(context.Background(), func() (*schema.KeyValue, error) {
+			return nil, errNext
+		})
+		require.ErrorIs(t, err, errNext)
+
+		_, err = db.BulkLoad(context.Background(), func() (*schema.KeyValue, error) {
+			return nil, io.EOF
+		})
+		require.ErrorIs(t, err, store.ErrNoEntriesProvided)
+	})
+}
+
 func TestTxByID(t *testing.T) {
 	db := makeDb(t)
 
@@ -38,6 +38,8 @@ type Options struct {
 	syncReplication bool
 	syncAcks        int // only if !replica
 
+	replicationEpoch uint64
+
 	corruptionChecker bool
 
 	readTxPoolSize int
@@ -47,6 +49,13 @@ type Options struct {
 
 	// RetentionPeriod determines how long to store data in the database.
 	RetentionPeriod time.Duration
+
+	// CompactLiveValues determines if values still referenced by the index are moved
+	// during truncation, so value-log files are discarded regardless of long-lived keys.
+	CompactLiveValues bool
+
+	// CompactionMaxBytesPerSec limits the rate at which live values are moved, zero means unlimited.
+	CompactionMaxBytesPerSec int
 }
 
 // DefaultOption Initialise Db Optionts to default values
@@ -108,6 +117,12 @@ func (o *Options) WithSyncAcks(syncAcks int) *Options {
 	return o
 }
 
+// WithReplicationEpoch sets the replication epoch of the database, increased every time a replica gets promoted
+func (o *Options) WithReplicationEpoch(epoch uint64) *Options {
+	o.replicationEpoch = epoch
+	return o
+}
+
 func (o *Options) WithReadTxPoolSize(txPoolSize int) *Options {
 	o.readTxPoolSize = txPoolSize
 	return o
@@ -126,3 +141,13 @@ func (o *Options) WithRetentionPeriod(c time.Duration) *Options {
 	o.RetentionPeriod = c
 	return o
 }
+
+func (o *Options) WithCompactLiveValues(compactLiveValues bool) *Options {
+	o.CompactLiveValues = compactLiveValues
+	return o
+}
+
+func (o *Options) WithCompactionMaxBytesPerSec(maxBytesPerSec int) *Options {
+	o.CompactionMaxBytesPerSec = maxBytesPerSec
+	return o
+}
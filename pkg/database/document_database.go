@@ -16,13 +16,21 @@ limitations under the License.
 package database
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/document"
 	"github.com/codenotary/immudb/embedded/store"
 	"github.com/codenotary/immudb/pkg/api/protomodel"
 	"github.com/codenotary/immudb/pkg/api/schema"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 // DocumentDatabase is the interface for document database
@@ -47,18 +55,29 @@ type DocumentDatabase interface {
 	ReplaceDocuments(ctx context.Context, req *protomodel.ReplaceDocumentsRequest) (*protomodel.ReplaceDocumentsResponse, error)
 	// AuditDocument returns the document audit history
 	AuditDocument(ctx context.Context, req *protomodel.AuditDocumentRequest) (*protomodel.AuditDocumentResponse, error)
-	// SearchDocuments returns the documents matching the query
-	SearchDocuments(ctx context.Context, query *protomodel.Query, offset int64) (document.DocumentReader, error)
+	// SearchDocuments returns the documents matching the query, restricted to the projected fields if any
+	SearchDocuments(ctx context.Context, query *protomodel.Query, projection *protomodel.Projection, offset int64) (document.DocumentReader, error)
 	// CountDocuments returns the number of documents matching the query
 	CountDocuments(ctx context.Context, req *protomodel.CountDocumentsRequest) (*protomodel.CountDocumentsResponse, error)
 	// DeleteDocuments deletes documents maching the query
 	DeleteDocuments(ctx context.Context, req *protomodel.DeleteDocumentsRequest) (*protomodel.DeleteDocumentsResponse, error)
 	// ProofDocument returns the proofs for a document
 	ProofDocument(ctx context.Context, req *protomodel.ProofDocumentRequest) (*protomodel.ProofDocumentResponse, error)
+	// WatchCollection notifies the changes made to the documents of a collection
+	WatchCollection(ctx context.Context, req *protomodel.WatchCollectionRequest, onChange func(*protomodel.WatchCollectionResponse) error) error
+	// ImportDocuments inserts the newline-delimited JSON documents read from the reader into a collection
+	ImportDocuments(ctx context.Context, collectionName string, ndjson io.Reader) (*protomodel.ImportDocumentsResponse, error)
+	// ExportDocuments sends the documents of a collection as chunks of newline-delimited JSON
+	ExportDocuments(ctx context.Context, req *protomodel.ExportDocumentsRequest, chunkSize int, onChunk func(*protomodel.ExportDocumentsResponse) error) error
 }
 
 // CreateCollection creates a new collection
 func (d *db) CreateCollection(ctx context.Context, req *protomodel.CreateCollectionRequest) (*protomodel.CreateCollectionResponse, error) {
+	err := d.waitForReplicationEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	d.mutex.RLock()
 	defer d.mutex.RUnlock()
 
@@ -70,7 +89,7 @@ func (d *db) CreateCollection(ctx context.Context, req *protomodel.CreateCollect
 		return nil, ErrIllegalArguments
 	}
 
-	err := d.documentEngine.CreateCollection(ctx, req.Name, req.DocumentIdFieldName, req.Fields, req.Indexes)
+	err = d.documentEngine.CreateCollectionWithTTL(ctx, req.Name, req.DocumentIdFieldName, req.Fields, req.Indexes, time.Duration(req.Ttl)*time.Second)
 	if err != nil {
 		return nil, err
 	}
@@ -103,6 +122,11 @@ func (d *db) GetCollections(ctx context.Context, _ *protomodel.GetCollectionsReq
 
 // UpdateCollection updates an existing collection
 func (d *db) UpdateCollection(ctx context.Context, req *protomodel.UpdateCollectionRequest) (*protomodel.UpdateCollectionResponse, error) {
+	err := d.waitForReplicationEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	d.mutex.RLock()
 	defer d.mutex.RUnlock()
 
@@ -114,7 +138,7 @@ func (d *db) UpdateCollection(ctx context.Context, req *protomodel.UpdateCollect
 		return nil, ErrIllegalArguments
 	}
 
-	err := d.documentEngine.UpdateCollection(ctx, req.Name, req.DocumentIdFieldName)
+	err = d.documentEngine.UpdateCollection(ctx, req.Name, req.DocumentIdFieldName)
 	if err != nil {
 		return nil, err
 	}
@@ -124,6 +148,11 @@ func (d *db) UpdateCollection(ctx context.Context, req *protomodel.UpdateCollect
 
 // DeleteCollection deletes a collection
 func (d *db) DeleteCollection(ctx context.Context, req *protomodel.DeleteCollectionRequest) (*protomodel.DeleteCollectionResponse, error) {
+	err := d.waitForReplicationEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	d.mutex.RLock()
 	defer d.mutex.RUnlock()
 
@@ -135,7 +164,7 @@ func (d *db) DeleteCollection(ctx context.Context, req *protomodel.DeleteCollect
 		return nil, ErrIllegalArguments
 	}
 
-	err := d.documentEngine.DeleteCollection(ctx, req.Name)
+	err = d.documentEngine.DeleteCollection(ctx, req.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -145,6 +174,11 @@ func (d *db) DeleteCollection(ctx context.Context, req *protomodel.DeleteCollect
 
 // CreateIndex creates an index for a collection
 func (d *db) CreateIndex(ctx context.Context, req *protomodel.CreateIndexRequest) (*protomodel.CreateIndexResponse, error) {
+	err := d.waitForReplicationEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	d.mutex.RLock()
 	defer d.mutex.RUnlock()
 
@@ -156,7 +190,15 @@ func (d *db) CreateIndex(ctx context.Context, req *protomodel.CreateIndexRequest
 		return nil, ErrIllegalArguments
 	}
 
-	err := d.documentEngine.CreateIndex(ctx, req.CollectionName, req.Fields, req.IsUnique)
+	if req.IsFullText {
+		if len(req.Fields) != 1 || req.IsUnique {
+			return nil, fmt.Errorf("%w: full-text indexes must be non-unique and on a single field", ErrIllegalArguments)
+		}
+
+		err = d.documentEngine.CreateFullTextIndex(ctx, req.CollectionName, req.Fields[0])
+	} else {
+		err = d.documentEngine.CreateIndex(ctx, req.CollectionName, req.Fields, req.IsUnique)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -166,6 +208,11 @@ func (d *db) CreateIndex(ctx context.Context, req *protomodel.CreateIndexRequest
 
 // DeleteIndex deletes an index from a collection
 func (d *db) DeleteIndex(ctx context.Context, req *protomodel.DeleteIndexRequest) (*protomodel.DeleteIndexResponse, error) {
+	err := d.waitForReplicationEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	d.mutex.RLock()
 	defer d.mutex.RUnlock()
 
@@ -177,7 +224,15 @@ func (d *db) DeleteIndex(ctx context.Context, req *protomodel.DeleteIndexRequest
 		return nil, ErrIllegalArguments
 	}
 
-	err := d.documentEngine.DeleteIndex(ctx, req.CollectionName, req.Fields)
+	if req.IsFullText {
+		if len(req.Fields) != 1 {
+			return nil, fmt.Errorf("%w: full-text indexes are on a single field", ErrIllegalArguments)
+		}
+
+		err = d.documentEngine.DeleteFullTextIndex(ctx, req.CollectionName, req.Fields[0])
+	} else {
+		err = d.documentEngine.DeleteIndex(ctx, req.CollectionName, req.Fields)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -187,6 +242,11 @@ func (d *db) DeleteIndex(ctx context.Context, req *protomodel.DeleteIndexRequest
 
 // InsertDocuments inserts multiple documents
 func (d *db) InsertDocuments(ctx context.Context, req *protomodel.InsertDocumentsRequest) (*protomodel.InsertDocumentsResponse, error) {
+	err := d.waitForReplicationEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	d.mutex.RLock()
 	defer d.mutex.RUnlock()
 
@@ -198,7 +258,17 @@ func (d *db) InsertDocuments(ctx context.Context, req *protomodel.InsertDocument
 		return nil, ErrIllegalArguments
 	}
 
-	txID, docIDs, err := d.documentEngine.InsertDocuments(ctx, req.CollectionName, req.Documents)
+	if req.ExpiresAt < 0 {
+		return nil, fmt.Errorf("%w: invalid expiration time", ErrIllegalArguments)
+	}
+
+	var expiresAt time.Time
+
+	if req.ExpiresAt > 0 {
+		expiresAt = time.Unix(req.ExpiresAt, 0)
+	}
+
+	txID, docIDs, err := d.documentEngine.InsertDocumentsWithExpiration(ctx, req.CollectionName, req.Documents, expiresAt)
 	if err != nil {
 		return nil, err
 	}
@@ -216,6 +286,11 @@ func (d *db) InsertDocuments(ctx context.Context, req *protomodel.InsertDocument
 
 // ReplaceDocuments replaces documents matching the query
 func (d *db) ReplaceDocuments(ctx context.Context, req *protomodel.ReplaceDocumentsRequest) (*protomodel.ReplaceDocumentsResponse, error) {
+	err := d.waitForReplicationEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	d.mutex.RLock()
 	defer d.mutex.RUnlock()
 
@@ -271,8 +346,8 @@ func (d *db) AuditDocument(ctx context.Context, req *protomodel.AuditDocumentReq
 }
 
 // SearchDocuments returns the documents matching the search request constraints
-func (d *db) SearchDocuments(ctx context.Context, query *protomodel.Query, offset int64) (document.DocumentReader, error) {
-	return d.documentEngine.GetDocuments(ctx, query, offset)
+func (d *db) SearchDocuments(ctx context.Context, query *protomodel.Query, projection *protomodel.Projection, offset int64) (document.DocumentReader, error) {
+	return d.documentEngine.GetProjectedDocuments(ctx, query, projection, offset)
 }
 
 // CountDocuments returns the number of documents matching the query
@@ -365,3 +440,190 @@ func (d *db) ProofDocument(ctx context.Context, req *protomodel.ProofDocumentReq
 		},
 	}, nil
 }
+
+// WatchCollection notifies the insertions, replacements and deletions of documents
+// in a collection until the context is cancelled or the callback returns an error
+func (d *db) WatchCollection(ctx context.Context, req *protomodel.WatchCollectionRequest, onChange func(*protomodel.WatchCollectionResponse) error) error {
+	if req == nil || onChange == nil {
+		return ErrIllegalArguments
+	}
+
+	return d.documentEngine.WatchCollection(ctx, req.Query, req.SinceTransactionId,
+		func(changeType protomodel.DocumentChangeType, revision *protomodel.DocumentAtRevision) error {
+			return onChange(&protomodel.WatchCollectionResponse{
+				ChangeType: changeType,
+				Revision:   revision,
+			})
+		})
+}
+
+// ImportDocuments inserts the newline-delimited JSON documents read from the reader into a collection.
+// Documents are inserted in batches, each one committed in its own transaction without exceeding
+// the max number of entries per transaction, thus an import is not atomic. Documents keep their ids
+// when provided, so exported documents can be imported back.
+func (d *db) ImportDocuments(ctx context.Context, collectionName string, ndjson io.Reader) (*protomodel.ImportDocumentsResponse, error) {
+	if ndjson == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	collection, err := d.documentEngine.GetCollection(ctx, collectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	// each document requires an entry per index, including the primary one
+	batchSize := d.st.MaxTxEntries()
+	if len(collection.Indexes) > 1 {
+		batchSize /= len(collection.Indexes)
+	}
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	res := &protomodel.ImportDocumentsResponse{}
+
+	r := bufio.NewReader(ndjson)
+	batch := make([]*structpb.Struct, 0, batchSize)
+
+	for lineNumber := 1; ; lineNumber++ {
+		line, err := r.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return res, err
+		}
+
+		eof := errors.Is(err, io.EOF)
+
+		line = bytes.TrimSpace(line)
+
+		if len(line) > 0 {
+			doc := &structpb.Struct{}
+
+			err = protojson.Unmarshal(line, doc)
+			if err != nil {
+				return res, fmt.Errorf("%w: invalid document at line %d: %v", ErrIllegalArguments, lineNumber, err)
+			}
+
+			batch = append(batch, doc)
+		}
+
+		if len(batch) == batchSize || (eof && len(batch) > 0) {
+			err = d.importDocumentBatch(ctx, collectionName, batch, res)
+			if err != nil {
+				return res, err
+			}
+
+			batch = make([]*structpb.Struct, 0, batchSize)
+		}
+
+		if eof {
+			return res, nil
+		}
+	}
+}
+
+func (d *db) importDocumentBatch(ctx context.Context, collectionName string, docs []*structpb.Struct, res *protomodel.ImportDocumentsResponse) error {
+	txID, err := d.importDocuments(ctx, collectionName, docs)
+	if errors.Is(err, store.ErrMaxTxEntriesLimitExceeded) && len(docs) > 1 {
+		// full-text indexed fields may require more entries than estimated
+		err = d.importDocumentBatch(ctx, collectionName, docs[:len(docs)/2], res)
+		if err != nil {
+			return err
+		}
+
+		return d.importDocumentBatch(ctx, collectionName, docs[len(docs)/2:], res)
+	}
+	if err != nil {
+		return err
+	}
+
+	if res.FirstTransactionId == 0 {
+		res.FirstTransactionId = txID
+	}
+
+	res.LastTransactionId = txID
+	res.DocumentCount += uint64(len(docs))
+
+	return nil
+}
+
+func (d *db) importDocuments(ctx context.Context, collectionName string, docs []*structpb.Struct) (uint64, error) {
+	err := d.waitForReplicationEpoch(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	if d.isReplica() {
+		return 0, ErrIsReplica
+	}
+
+	txID, _, err := d.documentEngine.ImportDocuments(ctx, collectionName, docs)
+	return txID, err
+}
+
+// ExportDocuments sends the documents of a collection, as they were right after the requested
+// transaction was committed, as chunks of newline-delimited JSON of at most chunkSize bytes.
+// At least one chunk is sent, so the transaction used for the export is always notified.
+func (d *db) ExportDocuments(ctx context.Context, req *protomodel.ExportDocumentsRequest, chunkSize int, onChunk func(*protomodel.ExportDocumentsResponse) error) error {
+	if req == nil || chunkSize < 1 || onChunk == nil {
+		return ErrIllegalArguments
+	}
+
+	reader, txID, err := d.documentEngine.ExportDocuments(ctx, req.CollectionName, req.TransactionId)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	var buf bytes.Buffer
+	sent := false
+
+	sendChunk := func(size int) error {
+		chunk := make([]byte, size)
+
+		_, err := buf.Read(chunk)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+
+		sent = true
+
+		return onChunk(&protomodel.ExportDocumentsResponse{
+			Ndjson:        chunk,
+			TransactionId: txID,
+		})
+	}
+
+	for {
+		revision, err := reader.Read(ctx)
+		if errors.Is(err, document.ErrNoMoreDocuments) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		line, err := protojson.Marshal(revision.Document)
+		if err != nil {
+			return err
+		}
+
+		buf.Write(line)
+		buf.WriteByte('\n')
+
+		for buf.Len() >= chunkSize {
+			err = sendChunk(chunkSize)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if buf.Len() > 0 || !sent {
+		return sendChunk(buf.Len())
+	}
+
+	return nil
+}
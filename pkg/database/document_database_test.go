@@ -16,10 +16,14 @@ limitations under the License.
 package database
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/codenotary/immudb/pkg/api/protomodel"
 	"github.com/codenotary/immudb/pkg/logger"
@@ -142,6 +146,43 @@ func TestDocumentDB_WithCollections(t *testing.T) {
 		}
 	})
 
+	t.Run("should pass when adding and deleting a full-text index", func(t *testing.T) {
+		_, err := db.CreateIndex(context.Background(), &protomodel.CreateIndexRequest{
+			CollectionName: defaultCollectionName,
+			Fields:         []string{"name", "country"},
+			IsFullText:     true,
+		})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = db.CreateIndex(context.Background(), &protomodel.CreateIndexRequest{
+			CollectionName: defaultCollectionName,
+			Fields:         []string{"name"},
+			IsFullText:     true,
+		})
+		require.NoError(t, err)
+
+		cinfo, err := db.GetCollection(context.Background(), &protomodel.GetCollectionRequest{
+			Name: defaultCollectionName,
+		})
+		require.NoError(t, err)
+		require.Len(t, cinfo.Collection.Indexes, 2)
+		require.Equal(t, []string{"name"}, cinfo.Collection.Indexes[1].Fields)
+		require.True(t, cinfo.Collection.Indexes[1].IsFullText)
+
+		_, err = db.DeleteIndex(context.Background(), &protomodel.DeleteIndexRequest{
+			CollectionName: defaultCollectionName,
+			Fields:         []string{"name"},
+			IsFullText:     true,
+		})
+		require.NoError(t, err)
+
+		cinfo, err = db.GetCollection(context.Background(), &protomodel.GetCollectionRequest{
+			Name: defaultCollectionName,
+		})
+		require.NoError(t, err)
+		require.Len(t, cinfo.Collection.Indexes, 1)
+	})
+
 	t.Run("should pass when updating a collection", func(t *testing.T) {
 		_, err := db.UpdateCollection(context.Background(), &protomodel.UpdateCollectionRequest{
 			Name:                defaultCollectionName,
@@ -277,6 +318,7 @@ func TestDocumentDB_WithDocuments(t *testing.T) {
 				},
 			},
 		},
+			nil,
 			0,
 		)
 		require.NoError(t, err)
@@ -346,6 +388,7 @@ func TestDocumentDB_WithDocuments(t *testing.T) {
 				},
 			},
 		},
+			nil,
 			0,
 		)
 		require.NoError(t, err)
@@ -375,6 +418,156 @@ func TestDocumentDB_WithDocuments(t *testing.T) {
 	})
 }
 
+func TestDocumentDB_WithExpiration(t *testing.T) {
+	db := makeDocumentDb(t)
+
+	collectionName := "mycollection"
+
+	_, err := db.CreateCollection(context.Background(), &protomodel.CreateCollectionRequest{
+		Name: collectionName,
+		Fields: []*protomodel.Field{
+			{Name: "pincode", Type: protomodel.FieldType_INTEGER},
+		},
+		Ttl: 3600,
+	})
+	require.NoError(t, err)
+
+	cinfo, err := db.GetCollection(context.Background(), &protomodel.GetCollectionRequest{Name: collectionName})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3600), cinfo.Collection.Ttl)
+
+	_, err = db.InsertDocuments(context.Background(), &protomodel.InsertDocumentsRequest{
+		CollectionName: collectionName,
+		Documents:      []*structpb.Struct{{Fields: map[string]*structpb.Value{"pincode": structpb.NewNumberValue(1)}}},
+		ExpiresAt:      -1,
+	})
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = db.InsertDocuments(context.Background(), &protomodel.InsertDocumentsRequest{
+		CollectionName: collectionName,
+		Documents:      []*structpb.Struct{{Fields: map[string]*structpb.Value{"pincode": structpb.NewNumberValue(1)}}},
+	})
+	require.NoError(t, err)
+
+	expiredRes, err := db.InsertDocuments(context.Background(), &protomodel.InsertDocumentsRequest{
+		CollectionName: collectionName,
+		Documents:      []*structpb.Struct{{Fields: map[string]*structpb.Value{"pincode": structpb.NewNumberValue(2)}}},
+		ExpiresAt:      time.Now().Add(-time.Second).Unix(),
+	})
+	require.NoError(t, err)
+
+	countRes, err := db.CountDocuments(context.Background(), &protomodel.CountDocumentsRequest{
+		Query: &protomodel.Query{CollectionName: collectionName},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), countRes.Count)
+
+	auditRes, err := db.AuditDocument(context.Background(), &protomodel.AuditDocumentRequest{
+		CollectionName: collectionName,
+		DocumentId:     expiredRes.DocumentIds[0],
+		Page:           1,
+		PageSize:       10,
+	})
+	require.NoError(t, err)
+	require.Len(t, auditRes.Revisions, 1)
+	require.NotZero(t, auditRes.Revisions[0].Metadata.ExpiresAt)
+}
+
+func TestDocumentDB_ImportAndExport(t *testing.T) {
+	db := makeDocumentDb(t)
+
+	for _, name := range []string{"source", "target"} {
+		_, err := db.CreateCollection(context.Background(), &protomodel.CreateCollectionRequest{
+			Name: name,
+			Fields: []*protomodel.Field{
+				{Name: "idx", Type: protomodel.FieldType_INTEGER},
+				{Name: "text", Type: protomodel.FieldType_STRING},
+			},
+			Indexes: []*protomodel.Index{
+				{Fields: []string{"text"}, IsFullText: true},
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	docCount := db.st.MaxTxEntries()
+
+	var ndjson strings.Builder
+
+	for i := 0; i < docCount; i++ {
+		ndjson.WriteString(fmt.Sprintf("{\"idx\": %d, \"text\": \"immutable document number %d\"}\n\n", i, i))
+	}
+
+	t.Run("importing invalid documents should fail", func(t *testing.T) {
+		_, err := db.ImportDocuments(context.Background(), "source", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = db.ImportDocuments(context.Background(), "source", strings.NewReader("{\"idx\": 1}\n{invalid"))
+		require.ErrorIs(t, err, ErrIllegalArguments)
+		require.Contains(t, err.Error(), "line 2")
+	})
+
+	importRes, err := db.ImportDocuments(context.Background(), "source", strings.NewReader(ndjson.String()))
+	require.NoError(t, err)
+	require.Equal(t, uint64(docCount), importRes.DocumentCount)
+	require.Greater(t, importRes.LastTransactionId, importRes.FirstTransactionId)
+
+	_, err = db.InsertDocuments(context.Background(), &protomodel.InsertDocumentsRequest{
+		CollectionName: "source",
+		Documents:      []*structpb.Struct{{Fields: map[string]*structpb.Value{"idx": structpb.NewNumberValue(-1)}}},
+	})
+	require.NoError(t, err)
+
+	t.Run("exporting with invalid arguments should fail", func(t *testing.T) {
+		err := db.ExportDocuments(context.Background(), nil, 1, func(*protomodel.ExportDocumentsResponse) error { return nil })
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = db.ExportDocuments(context.Background(), &protomodel.ExportDocumentsRequest{CollectionName: "source"}, 0, func(*protomodel.ExportDocumentsResponse) error { return nil })
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	var exported bytes.Buffer
+
+	err = db.ExportDocuments(context.Background(), &protomodel.ExportDocumentsRequest{
+		CollectionName: "source",
+		TransactionId:  importRes.LastTransactionId,
+	}, 100, func(res *protomodel.ExportDocumentsResponse) error {
+		require.LessOrEqual(t, len(res.Ndjson), 100)
+		require.Equal(t, importRes.LastTransactionId, res.TransactionId)
+
+		exported.Write(res.Ndjson)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, strings.Split(strings.TrimSpace(exported.String()), "\n"), docCount)
+
+	reimportRes, err := db.ImportDocuments(context.Background(), "target", &exported)
+	require.NoError(t, err)
+	require.Equal(t, uint64(docCount), reimportRes.DocumentCount)
+
+	countRes, err := db.CountDocuments(context.Background(), &protomodel.CountDocumentsRequest{
+		Query: &protomodel.Query{CollectionName: "target"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(docCount), countRes.Count)
+
+	t.Run("exporting an empty collection should notify the transaction", func(t *testing.T) {
+		_, err := db.CreateCollection(context.Background(), &protomodel.CreateCollectionRequest{Name: "empty"})
+		require.NoError(t, err)
+
+		var chunks []*protomodel.ExportDocumentsResponse
+
+		err = db.ExportDocuments(context.Background(), &protomodel.ExportDocumentsRequest{CollectionName: "empty"}, 100, func(res *protomodel.ExportDocumentsResponse) error {
+			chunks = append(chunks, res)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, chunks, 1)
+		require.Empty(t, chunks[0].Ndjson)
+		require.Equal(t, db.st.LastCommittedTxID(), chunks[0].TransactionId)
+	})
+}
+
 func TestDocumentDB_WithSerializedJsonDocument(t *testing.T) {
 	db := makeDocumentDb(t)
 
@@ -28,6 +28,7 @@ const (
 	SortedSetKeyPrefix
 	SQLPrefix
 	DocumentPrefix
+	PartialTxProvenancePrefix
 )
 
 const (
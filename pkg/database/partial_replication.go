@@ -0,0 +1,391 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"google.golang.org/protobuf/proto"
+)
+
+var ErrPartialTxProvenanceNotFound = errors.New("transaction was not partially replicated")
+var ErrPartialTxVerificationFailed = errors.New("partial transaction verification failed")
+
+// sqlCatalogPrefix is the common prefix of the entries describing SQL tables, columns and indexes
+const sqlCatalogPrefix = "CTL."
+
+// ExportPartialTx returns the entries of a committed transaction matching the given key prefixes or SQL tables.
+// Every entry comes with its value and the proof of its inclusion in the transaction.
+// SQL catalog entries are always exported so partial replicas are able to resolve the replicated tables
+func (d *db) ExportPartialTx(ctx context.Context, req *schema.ExportPartialTxRequest) (*schema.PartialTx, error) {
+	if req == nil || req.Tx == 0 || (len(req.KeyPrefixes) == 0 && len(req.SqlTables) == 0) {
+		return nil, ErrIllegalArguments
+	}
+
+	// same as ExportTxByID, an empty response is returned if the transaction is not yet committed
+	waitCtx, cancel := context.WithTimeout(ctx, d.options.storeOpts.SyncFrequency*4)
+	defer cancel()
+
+	err := d.WaitForTx(waitCtx, req.Tx, false)
+	if waitCtx.Err() != nil && ctx.Err() == nil {
+		return &schema.PartialTx{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tableIDs, err := d.sqlTableIDs(ctx, req.Tx, req.SqlTables)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := d.allocTx()
+	if err != nil {
+		return nil, err
+	}
+	defer d.releaseTx(tx)
+
+	err = d.st.ReadTx(req.Tx, false, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	ptx := &schema.PartialTx{
+		Header: schema.TxHeaderToProto(tx.Header()),
+	}
+
+	for _, e := range tx.Entries() {
+		if !matchesPartialReplication(e.Key(), req.KeyPrefixes, len(req.SqlTables) > 0, tableIDs) {
+			continue
+		}
+
+		entry := schema.TxEntryToProto(e)
+
+		entry.Value, err = d.st.ReadValue(e)
+		if err != nil && !errors.Is(err, store.ErrExpiredEntry) {
+			return nil, err
+		}
+
+		inclusionProof, err := tx.Proof(e.Key())
+		if err != nil {
+			return nil, err
+		}
+
+		ptx.Entries = append(ptx.Entries, &schema.PartialTxEntry{
+			Entry:          entry,
+			InclusionProof: schema.InclusionProofToProto(inclusionProof),
+		})
+	}
+
+	return ptx, nil
+}
+
+// sqlTableIDs resolves the ids of the given tables using a catalog including the specified transaction.
+// Tables not yet created are ignored
+func (d *db) sqlTableIDs(ctx context.Context, txID uint64, tables []string) (map[uint32]struct{}, error) {
+	tableIDs := make(map[uint32]struct{}, len(tables))
+
+	if len(tables) == 0 {
+		return tableIDs, nil
+	}
+
+	sqlTx, err := d.sqlEngine.NewTx(ctx, sql.DefaultTxOptions().
+		WithReadOnly(true).
+		WithSnapshotMustIncludeTxID(func(_ uint64) uint64 { return txID }),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer sqlTx.Cancel()
+
+	for _, name := range tables {
+		table, err := sqlTx.Catalog().GetTableByName(name)
+		if errors.Is(err, sql.ErrTableDoesNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		tableIDs[table.ID()] = struct{}{}
+	}
+
+	return tableIDs, nil
+}
+
+// matchesPartialReplication checks if the entry is replicated. The whole SQL catalog is replicated along with
+// the SQL tables, even before they get created, as table ids are assigned sequentially by the catalog
+func matchesPartialReplication(key []byte, keyPrefixes [][]byte, sqlTables bool, tableIDs map[uint32]struct{}) bool {
+	if len(key) == 0 {
+		return false
+	}
+
+	switch key[0] {
+	case SetKeyPrefix:
+		for _, prefix := range keyPrefixes {
+			if bytes.HasPrefix(key[1:], prefix) {
+				return true
+			}
+		}
+	case SQLPrefix:
+		if !sqlTables {
+			return false
+		}
+
+		mkey := key[1:]
+
+		if bytes.HasPrefix(mkey, []byte(sqlCatalogPrefix)) {
+			return true
+		}
+
+		for _, prefix := range []string{sql.PIndexPrefix, sql.SIndexPrefix, sql.UIndexPrefix, sql.FIndexPrefix} {
+			if !bytes.HasPrefix(mkey, []byte(prefix)) {
+				continue
+			}
+
+			// {prefix}{1}{tableID}...
+			off := len(prefix) + sql.EncIDLen
+
+			if len(mkey) < off+sql.EncIDLen {
+				return false
+			}
+
+			_, ok := tableIDs[binary.BigEndian.Uint32(mkey[off:])]
+			return ok
+		}
+	}
+
+	return false
+}
+
+// VerifyPartialTxEntry checks the entry was included in the transaction described by the given header.
+// The value, when present, must match the digest of the entry
+func VerifyPartialTxEntry(hdr *schema.TxHeader, pe *schema.PartialTxEntry) error {
+	if hdr == nil || pe == nil || pe.Entry == nil || pe.InclusionProof == nil {
+		return ErrIllegalArguments
+	}
+
+	if len(pe.Entry.HValue) != sha256.Size {
+		return fmt.Errorf("%w: invalid value digest", ErrPartialTxVerificationFailed)
+	}
+
+	hVal := schema.DigestFromProto(pe.Entry.HValue)
+
+	if len(pe.Entry.Value) > 0 || pe.Entry.VLen == 0 {
+		if sha256.Sum256(pe.Entry.Value) != hVal || len(pe.Entry.Value) != int(pe.Entry.VLen) {
+			return fmt.Errorf("%w: value does not match its digest", ErrPartialTxVerificationFailed)
+		}
+	}
+
+	txHdr := schema.TxHeaderFromProto(hdr)
+
+	entryDigest, err := txHdr.TxEntryDigest()
+	if err != nil {
+		return err
+	}
+
+	digest, err := entryDigest(store.NewTxEntry(
+		pe.Entry.Key,
+		schema.KVMetadataFromProto(pe.Entry.Metadata),
+		int(pe.Entry.VLen),
+		hVal,
+		0,
+	))
+	if err != nil {
+		return err
+	}
+
+	if !store.VerifyInclusion(schema.InclusionProofFromProto(pe.InclusionProof), digest, txHdr.Eh) {
+		return fmt.Errorf("%w: inclusion proof of entry does not match", ErrPartialTxVerificationFailed)
+	}
+
+	return nil
+}
+
+// ReplicatePartialTx commits the entries exported from a primary transaction into a new transaction.
+// The transaction must follow the last one replicated and every entry must be included in it.
+// The primary transaction header and inclusion proofs are stored along with the entries,
+// so the origin of the replicated entries can be proven (see PartialTxProof)
+func (d *db) ReplicatePartialTx(ctx context.Context, ptx *schema.PartialTx) (*schema.TxHeader, error) {
+	if ptx == nil || ptx.Header == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	if !d.isReplica() {
+		return nil, ErrNotReplica
+	}
+
+	lastTxID, lastAlh, err := d.partialReplicationState()
+	if err != nil {
+		return nil, err
+	}
+
+	if ptx.Header.Id != lastTxID+1 {
+		return nil, fmt.Errorf("%w: expected transaction %d but %d was provided", ErrIllegalArguments, lastTxID+1, ptx.Header.Id)
+	}
+
+	if schema.DigestFromProto(ptx.Header.PrevAlh) != lastAlh {
+		return nil, fmt.Errorf("%w: partial replica diverged from primary at transaction %d", ErrReplicaDivergedFromPrimary, ptx.Header.Id)
+	}
+
+	if len(ptx.Entries) > int(ptx.Header.Nentries) {
+		return nil, fmt.Errorf("%w: invalid number of entries", ErrPartialTxVerificationFailed)
+	}
+
+	tx, err := d.st.NewWriteOnlyTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Cancel()
+
+	provenance := &schema.PartialTx{Header: ptx.Header}
+
+	for _, pe := range ptx.Entries {
+		err = VerifyPartialTxEntry(ptx.Header, pe)
+		if err != nil {
+			return nil, err
+		}
+
+		md := schema.KVMetadataFromProto(pe.Entry.Metadata)
+
+		if len(pe.Entry.Value) == 0 && pe.Entry.VLen > 0 && (md == nil || !md.ExpiredAt(time.Now())) {
+			return nil, fmt.Errorf("%w: missing value of non-expired entry", ErrPartialTxVerificationFailed)
+		}
+
+		err = tx.Set(pe.Entry.Key, md, pe.Entry.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		provenance.Entries = append(provenance.Entries, &schema.PartialTxEntry{
+			Entry: &schema.TxEntry{
+				Key:      pe.Entry.Key,
+				HValue:   pe.Entry.HValue,
+				VLen:     pe.Entry.VLen,
+				Metadata: pe.Entry.Metadata,
+			},
+			InclusionProof: pe.InclusionProof,
+		})
+	}
+
+	bprovenance, err := proto.Marshal(provenance)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Set(partialTxProvenanceKey(ptx.Header.Id), nil, bprovenance)
+	if err != nil {
+		return nil, err
+	}
+
+	hdr, err := tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.TxHeaderToProto(hdr), nil
+}
+
+// PartialReplicationState returns the id and accumulative hash of the last primary transaction replicated
+func (d *db) PartialReplicationState() (txID uint64, alh [sha256.Size]byte, err error) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	return d.partialReplicationState()
+}
+
+func (d *db) partialReplicationState() (uint64, [sha256.Size]byte, error) {
+	committedTxID, committedAlh := d.st.CommittedAlh()
+	if committedTxID == 0 {
+		return 0, committedAlh, nil
+	}
+
+	provenance, err := d.partialTxProvenance(committedTxID)
+	if errors.Is(err, ErrPartialTxProvenanceNotFound) {
+		// transactions were fully replicated up to this point
+		return committedTxID, committedAlh, nil
+	}
+	if err != nil {
+		return 0, committedAlh, err
+	}
+
+	return provenance.Header.Id, schema.TxHeaderFromProto(provenance.Header).Alh(), nil
+}
+
+// PartialTxProof returns the header of the primary transaction and the inclusion proofs of the entries
+// replicated in the specified transaction of a partial replica
+func (d *db) PartialTxProof(ctx context.Context, req *schema.PartialTxProofRequest) (*schema.PartialTx, error) {
+	if req == nil || req.Tx == 0 {
+		return nil, ErrIllegalArguments
+	}
+
+	return d.partialTxProvenance(req.Tx)
+}
+
+func (d *db) partialTxProvenance(txID uint64) (*schema.PartialTx, error) {
+	tx, err := d.allocTx()
+	if err != nil {
+		return nil, err
+	}
+	defer d.releaseTx(tx)
+
+	err = d.st.ReadTx(txID, false, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range tx.Entries() {
+		if e.Key()[0] != PartialTxProvenancePrefix {
+			continue
+		}
+
+		v, err := d.st.ReadValue(e)
+		if err != nil {
+			return nil, err
+		}
+
+		var provenance schema.PartialTx
+
+		err = proto.Unmarshal(v, &provenance)
+		if err != nil {
+			return nil, err
+		}
+
+		return &provenance, nil
+	}
+
+	return nil, fmt.Errorf("%w: transaction %d", ErrPartialTxProvenanceNotFound, txID)
+}
+
+func partialTxProvenanceKey(primaryTxID uint64) []byte {
+	var key [1 + 8]byte
+	key[0] = PartialTxProvenancePrefix
+	binary.BigEndian.PutUint64(key[1:], primaryTxID)
+	return key[:]
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartialReplication(t *testing.T) {
+	primary := makeDb(t)
+
+	replica := makeDbWith(t, "replica", DefaultOption().WithDBRootPath(t.TempDir()).AsReplica(true))
+
+	_, err := primary.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{
+		{Key: []byte("app/key1"), Value: []byte("value1")},
+		{Key: []byte("other/key1"), Value: []byte("value1")},
+	}})
+	require.NoError(t, err)
+
+	_, _, err = primary.SQLExec(context.Background(), nil, &schema.SQLExecRequest{Sql: `
+		CREATE TABLE table1(id INTEGER, title VARCHAR, PRIMARY KEY id);
+		CREATE TABLE table2(id INTEGER, title VARCHAR, PRIMARY KEY id);
+	`})
+	require.NoError(t, err)
+
+	_, _, err = primary.SQLExec(context.Background(), nil, &schema.SQLExecRequest{Sql: `
+		INSERT INTO table1(id, title) VALUES (1, 'title1'), (2, 'title2');
+		INSERT INTO table2(id, title) VALUES (1, 'title1');
+	`})
+	require.NoError(t, err)
+
+	_, err = primary.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{
+		{Key: []byte("other/key2"), Value: []byte("value2")},
+	}})
+	require.NoError(t, err)
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		_, err := primary.ExportPartialTx(context.Background(), nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = primary.ExportPartialTx(context.Background(), &schema.ExportPartialTxRequest{Tx: 1})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = replica.ReplicatePartialTx(context.Background(), nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = replica.PartialTxProof(context.Background(), nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	primaryState, err := primary.CurrentState()
+	require.NoError(t, err)
+
+	exportReq := func(txID uint64) *schema.ExportPartialTxRequest {
+		return &schema.ExportPartialTxRequest{
+			Tx:          txID,
+			KeyPrefixes: [][]byte{[]byte("app/")},
+			SqlTables:   []string{"table1"},
+		}
+	}
+
+	for txID := uint64(1); txID <= primaryState.TxId; txID++ {
+		ptx, err := primary.ExportPartialTx(context.Background(), exportReq(txID))
+		require.NoError(t, err)
+		require.Equal(t, txID, ptx.Header.Id)
+
+		for _, pe := range ptx.Entries {
+			require.NotEqual(t, []byte("other/key1"), pe.Entry.Key[1:])
+			require.NotEqual(t, []byte("other/key2"), pe.Entry.Key[1:])
+		}
+
+		if txID == primaryState.TxId {
+			require.Empty(t, ptx.Entries)
+		}
+
+		_, err = primary.ReplicatePartialTx(context.Background(), ptx)
+		require.ErrorIs(t, err, ErrNotReplica)
+
+		hdr, err := replica.ReplicatePartialTx(context.Background(), ptx)
+		require.NoError(t, err)
+		require.Equal(t, txID, hdr.Id)
+	}
+
+	t.Run("replication state should match the primary", func(t *testing.T) {
+		txID, alh, err := replica.PartialReplicationState()
+		require.NoError(t, err)
+		require.Equal(t, primaryState.TxId, txID)
+		require.Equal(t, primaryState.TxHash, alh[:])
+	})
+
+	t.Run("only matching entries should be replicated", func(t *testing.T) {
+		entry, err := replica.Get(context.Background(), &schema.KeyRequest{Key: []byte("app/key1"), SinceTx: primaryState.TxId})
+		require.NoError(t, err)
+		require.Equal(t, []byte("value1"), entry.Value)
+
+		_, err = replica.Get(context.Background(), &schema.KeyRequest{Key: []byte("other/key1"), SinceTx: primaryState.TxId})
+		require.ErrorIs(t, err, store.ErrKeyNotFound)
+
+		res, err := replica.SQLQuery(context.Background(), nil, &schema.SQLQueryRequest{Sql: "SELECT id, title FROM table1"})
+		require.NoError(t, err)
+		require.Len(t, res.Rows, 2)
+
+		res, err = replica.SQLQuery(context.Background(), nil, &schema.SQLQueryRequest{Sql: "SELECT id, title FROM table2"})
+		require.NoError(t, err)
+		require.Empty(t, res.Rows)
+	})
+
+	t.Run("replicated entries should be provable against the primary", func(t *testing.T) {
+		ptx, err := replica.PartialTxProof(context.Background(), &schema.PartialTxProofRequest{Tx: 1})
+		require.NoError(t, err)
+		require.Len(t, ptx.Entries, 1)
+
+		primaryTx, err := primary.TxByID(context.Background(), &schema.TxRequest{Tx: 1})
+		require.NoError(t, err)
+
+		require.Equal(t,
+			schema.TxHeaderFromProto(primaryTx.Header).Alh(),
+			schema.TxHeaderFromProto(ptx.Header).Alh(),
+		)
+
+		for _, pe := range ptx.Entries {
+			err = VerifyPartialTxEntry(ptx.Header, pe)
+			require.NoError(t, err)
+		}
+
+		_, err = primary.PartialTxProof(context.Background(), &schema.PartialTxProofRequest{Tx: 1})
+		require.ErrorIs(t, err, ErrPartialTxProvenanceNotFound)
+	})
+
+	t.Run("transactions should be replicated in order", func(t *testing.T) {
+		ptx, err := primary.ExportPartialTx(context.Background(), exportReq(1))
+		require.NoError(t, err)
+
+		_, err = replica.ReplicatePartialTx(context.Background(), ptx)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	_, err = primary.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{
+		{Key: []byte("app/key2"), Value: []byte("value2")},
+	}})
+	require.NoError(t, err)
+
+	t.Run("tampered entries should be rejected", func(t *testing.T) {
+		ptx, err := primary.ExportPartialTx(context.Background(), exportReq(primaryState.TxId+1))
+		require.NoError(t, err)
+		require.Len(t, ptx.Entries, 1)
+
+		ptx.Entries[0].Entry.Value = []byte("value3")
+
+		_, err = replica.ReplicatePartialTx(context.Background(), ptx)
+		require.ErrorIs(t, err, ErrPartialTxVerificationFailed)
+
+		ptx.Entries[0].Entry.Value = []byte("value2")
+		ptx.Entries[0].Entry.Key = []byte("app/key3")
+
+		_, err = replica.ReplicatePartialTx(context.Background(), ptx)
+		require.ErrorIs(t, err, ErrPartialTxVerificationFailed)
+	})
+
+	t.Run("diverged transactions should be rejected", func(t *testing.T) {
+		ptx, err := primary.ExportPartialTx(context.Background(), exportReq(primaryState.TxId+1))
+		require.NoError(t, err)
+
+		ptx.Header.PrevAlh = make([]byte, len(ptx.Header.PrevAlh))
+
+		_, err = replica.ReplicatePartialTx(context.Background(), ptx)
+		require.ErrorIs(t, err, ErrReplicaDivergedFromPrimary)
+	})
+
+	t.Run("non committed transactions should not be exported", func(t *testing.T) {
+		ptx, err := primary.ExportPartialTx(context.Background(), exportReq(primaryState.TxId+2))
+		require.NoError(t, err)
+		require.Nil(t, ptx.Header)
+	})
+}
+
+func TestPartialReplicationOfTablesCreatedLater(t *testing.T) {
+	primary := makeDb(t)
+
+	replica := makeDbWith(t, "replica", DefaultOption().WithDBRootPath(t.TempDir()).AsReplica(true))
+
+	lastReplicatedTxID := uint64(0)
+
+	replicate := func() {
+		primaryState, err := primary.CurrentState()
+		require.NoError(t, err)
+
+		for ; lastReplicatedTxID < primaryState.TxId; lastReplicatedTxID++ {
+			ptx, err := primary.ExportPartialTx(context.Background(), &schema.ExportPartialTxRequest{
+				Tx:        lastReplicatedTxID + 1,
+				SqlTables: []string{"table1"},
+			})
+			require.NoError(t, err)
+
+			_, err = replica.ReplicatePartialTx(context.Background(), ptx)
+			require.NoError(t, err)
+		}
+	}
+
+	// table2 gets created and replicated before the replicated table exists
+	_, _, err := primary.SQLExec(context.Background(), nil, &schema.SQLExecRequest{Sql: `
+		CREATE TABLE table2(id INTEGER, title VARCHAR, PRIMARY KEY id);
+	`})
+	require.NoError(t, err)
+
+	replicate()
+
+	_, _, err = primary.SQLExec(context.Background(), nil, &schema.SQLExecRequest{Sql: `
+		CREATE TABLE table1(id INTEGER, title VARCHAR, PRIMARY KEY id);
+	`})
+	require.NoError(t, err)
+
+	_, _, err = primary.SQLExec(context.Background(), nil, &schema.SQLExecRequest{Sql: `
+		INSERT INTO table1(id, title) VALUES (1, 'title1');
+		INSERT INTO table2(id, title) VALUES (1, 'title1');
+	`})
+	require.NoError(t, err)
+
+	replicate()
+
+	res, err := replica.SQLQuery(context.Background(), nil, &schema.SQLQueryRequest{Sql: "SELECT id, title FROM table1"})
+	require.NoError(t, err)
+	require.Len(t, res.Rows, 1)
+
+	res, err = replica.SQLQuery(context.Background(), nil, &schema.SQLQueryRequest{Sql: "SELECT id, title FROM table2"})
+	require.NoError(t, err)
+	require.Empty(t, res.Rows)
+}
+
+func TestMatchesPartialReplication(t *testing.T) {
+	tableIDs := map[uint32]struct{}{2: {}}
+
+	rowKey := func(prefix string, tableID byte) []byte {
+		return append([]byte{SQLPrefix}, append([]byte(prefix), 0, 0, 0, 1, 0, 0, 0, tableID, 0, 0, 0, 0)...)
+	}
+
+	require.False(t, matchesPartialReplication(nil, nil, true, tableIDs))
+	require.True(t, matchesPartialReplication([]byte{SetKeyPrefix, 'a', 'b'}, [][]byte{[]byte("a")}, false, nil))
+	require.False(t, matchesPartialReplication([]byte{SetKeyPrefix, 'b', 'a'}, [][]byte{[]byte("a")}, false, nil))
+	require.False(t, matchesPartialReplication([]byte{SortedSetKeyPrefix, 'a'}, [][]byte{[]byte("a")}, false, nil))
+	require.True(t, matchesPartialReplication(append([]byte{SQLPrefix}, "CTL.TABLE."...), nil, true, tableIDs))
+	require.True(t, matchesPartialReplication(append([]byte{SQLPrefix}, "CTL.TABLE."...), nil, true, nil))
+	require.False(t, matchesPartialReplication(append([]byte{SQLPrefix}, "CTL.TABLE."...), nil, false, nil))
+	require.True(t, matchesPartialReplication(rowKey("R.", 2), nil, true, tableIDs))
+	require.False(t, matchesPartialReplication(rowKey("R.", 3), nil, true, tableIDs))
+	require.False(t, matchesPartialReplication(rowKey("R.", 2)[:8], nil, true, tableIDs))
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/codenotary/immudb/embedded/sql"
+)
+
+// SQLUser is the user on behalf of whom SQL statements and document operations are executed
+type SQLUser struct {
+	Name string
+	// Admin users are not restricted by the privileges granted on tables and collections,
+	// and they are the only ones allowed to grant and revoke them
+	Admin bool
+}
+
+type sqlUserCtxKey struct{}
+
+// WithSQLUser returns a copy of ctx carrying the user on behalf of whom SQL statements and
+// document operations are executed. Privileges granted on tables and collections are only
+// enforced when such a user is provided.
+func WithSQLUser(ctx context.Context, user *SQLUser) context.Context {
+	return context.WithValue(ctx, sqlUserCtxKey{}, user)
+}
+
+func sqlUserFromContext(ctx context.Context) (*SQLUser, bool) {
+	user, ok := ctx.Value(sqlUserCtxKey{}).(*SQLUser)
+	return user, ok && user != nil
+}
+
+// checkTablePrivileges is the privilege checker used by the sql and document engines
+func checkTablePrivileges(ctx context.Context, catalog *sql.Catalog, privileges []sql.TablePrivilege) error {
+	user, ok := sqlUserFromContext(ctx)
+	if !ok || user.Admin {
+		return nil
+	}
+
+	for _, p := range privileges {
+		if p.Privilege == sql.SQLPrivilegeGrant {
+			return fmt.Errorf("%w: only admins can grant and revoke privileges", sql.ErrPermissionDenied)
+		}
+	}
+
+	return catalog.CheckPrivileges(user.Name, privileges)
+}
+
+// execCollectionPrivilegeStmts grants and revokes privileges on collections, which are
+// tables of the catalog managed by the document engine
+func (d *db) execCollectionPrivilegeStmts(ctx context.Context, stmts []sql.SQLStmt) error {
+	for _, stmt := range stmts {
+		var err error
+
+		switch s := stmt.(type) {
+		case *sql.GrantStmt:
+			err = d.documentEngine.GrantPrivileges(ctx, s.Table(), s.User(), s.Privileges())
+		case *sql.RevokeStmt:
+			err = d.documentEngine.RevokePrivileges(ctx, s.Table(), s.User(), s.Privileges())
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// collectionPrivilegeStmts returns true when stmts grant or revoke privileges on collections,
+// such statements can not be combined with other statements
+func collectionPrivilegeStmts(stmts []sql.SQLStmt) (bool, error) {
+	var collectionStmts int
+
+	for _, stmt := range stmts {
+		switch s := stmt.(type) {
+		case *sql.GrantStmt:
+			if s.OnCollection() {
+				collectionStmts++
+			}
+		case *sql.RevokeStmt:
+			if s.OnCollection() {
+				collectionStmts++
+			}
+		}
+	}
+
+	if collectionStmts > 0 && collectionStmts < len(stmts) {
+		return false, fmt.Errorf("%w: privileges on collections can not be granted or revoked along with other statements", ErrIllegalArguments)
+	}
+
+	return collectionStmts > 0, nil
+}
@@ -39,6 +39,11 @@ func (d *db) SetReference(ctx context.Context, req *schema.ReferenceRequest) (*s
 		return nil, store.ErrIllegalArguments
 	}
 
+	err := d.waitForReplicationEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	d.mutex.Lock()
 	defer d.mutex.Unlock()
 
@@ -47,7 +52,7 @@ func (d *db) SetReference(ctx context.Context, req *schema.ReferenceRequest) (*s
 	}
 
 	lastTxID, _ := d.st.CommittedAlh()
-	err := d.st.WaitForIndexingUpto(ctx, lastTxID)
+	err = d.st.WaitForIndexingUpto(ctx, lastTxID)
 	if err != nil {
 		return nil, err
 	}
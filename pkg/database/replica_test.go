@@ -18,8 +18,10 @@ package database
 
 import (
 	"context"
+	"crypto/sha256"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/embedded/store"
@@ -59,6 +61,11 @@ func TestReadOnlyReplica(t *testing.T) {
 	)
 	require.ErrorIs(t, err, ErrIsReplica)
 
+	_, err = replica.BulkLoad(context.Background(), func() (*schema.KeyValue, error) {
+		return &schema.KeyValue{Key: []byte("key1"), Value: []byte("value1")}, nil
+	})
+	require.ErrorIs(t, err, ErrIsReplica)
+
 	_, err = replica.SetReference(context.Background(), &schema.ReferenceRequest{
 		Key:           []byte("key"),
 		ReferencedKey: []byte("refkey"),
@@ -132,3 +139,251 @@ func TestSwitchToReplica(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestReplicaExportTxToDownstreamReplica(t *testing.T) {
+	rootPath := t.TempDir()
+
+	options := DefaultOption().WithDBRootPath(rootPath).AsReplica(false)
+
+	db := makeDbWith(t, "db", options)
+
+	_, err := db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key1"), Value: []byte("value1")}}})
+	require.NoError(t, err)
+
+	state1, err := db.CurrentState()
+	require.NoError(t, err)
+
+	_, err = db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key2"), Value: []byte("value2")}}})
+	require.NoError(t, err)
+
+	state2, err := db.CurrentState()
+	require.NoError(t, err)
+
+	replicaState := &schema.ReplicaState{
+		UUID:             "downstream",
+		CommittedTxID:    state1.TxId,
+		CommittedAlh:     state1.TxHash,
+		PrecommittedTxID: state1.TxId,
+		PrecommittedAlh:  state1.TxHash,
+	}
+
+	t.Run("primary without synchronous replication should not accept replica state", func(t *testing.T) {
+		_, _, _, err := db.ExportTxByID(context.Background(), &schema.ExportTxRequest{
+			Tx:                2,
+			ReplicaState:      replicaState,
+			AllowPreCommitted: true,
+		})
+		require.ErrorIs(t, err, ErrIllegalState)
+	})
+
+	db.AsReplica(true, true, 0)
+
+	t.Run("replica should provide its commit state to downstream replicas", func(t *testing.T) {
+		txbs, mayCommitUpToTxID, mayCommitUpToAlh, err := db.ExportTxByID(context.Background(), &schema.ExportTxRequest{
+			Tx:                2,
+			ReplicaState:      replicaState,
+			AllowPreCommitted: true,
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, txbs)
+		require.Equal(t, state1.TxId, mayCommitUpToTxID)
+		require.Equal(t, state1.TxHash, mayCommitUpToAlh[:])
+
+		replicaState.PrecommittedTxID = state2.TxId
+		replicaState.PrecommittedAlh = state2.TxHash
+
+		_, mayCommitUpToTxID, mayCommitUpToAlh, err = db.ExportTxByID(context.Background(), &schema.ExportTxRequest{
+			Tx:                2,
+			ReplicaState:      replicaState,
+			AllowPreCommitted: true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, state2.TxId, mayCommitUpToTxID)
+		require.Equal(t, state2.TxHash, mayCommitUpToAlh[:])
+	})
+
+	t.Run("replica should forward the states of downstream replicas", func(t *testing.T) {
+		states := db.TakeDownstreamReplicaStates()
+		require.Len(t, states, 1)
+		require.Equal(t, replicaState.UUID, states[0].UUID)
+		require.Equal(t, state2.TxId, states[0].PrecommittedTxID)
+
+		require.Empty(t, db.TakeDownstreamReplicaStates())
+	})
+
+	t.Run("downstream replica diverging from upstream replica should be detected", func(t *testing.T) {
+		replicaState.PrecommittedTxID = state2.TxId + 1
+
+		_, _, _, err := db.ExportTxByID(context.Background(), &schema.ExportTxRequest{
+			Tx:                2,
+			ReplicaState:      replicaState,
+			AllowPreCommitted: true,
+		})
+		require.ErrorIs(t, err, ErrReplicaDivergedFromPrimary)
+	})
+
+	db.AsReplica(true, false, 0)
+
+	t.Run("asynchronous replica should not accept replica state", func(t *testing.T) {
+		_, _, _, err := db.ExportTxByID(context.Background(), &schema.ExportTxRequest{
+			Tx:                2,
+			ReplicaState:      replicaState,
+			AllowPreCommitted: true,
+		})
+		require.ErrorIs(t, err, ErrIllegalState)
+	})
+}
+
+func TestPrimaryExportTxWithDownstreamReplicaStates(t *testing.T) {
+	rootPath := t.TempDir()
+
+	options := DefaultOption().
+		WithDBRootPath(rootPath).
+		WithSyncReplication(true).
+		WithSyncAcks(2)
+
+	primary := makeDbWith(t, "db", options)
+
+	errCh := make(chan error)
+	go func() {
+		_, err := primary.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key1"), Value: []byte("value1")}}})
+		errCh <- err
+	}()
+
+	require.Eventually(t, func() bool {
+		precommittedTxID, _ := primary.st.PrecommittedAlh()
+		return precommittedTxID == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	precommittedTxID, precommittedAlh := primary.st.PrecommittedAlh()
+
+	replicaState := &schema.ReplicaState{
+		UUID:             "replica",
+		PrecommittedTxID: precommittedTxID,
+		PrecommittedAlh:  precommittedAlh[:],
+	}
+
+	t.Run("diverged downstream replica should not be acknowledged", func(t *testing.T) {
+		_, _, _, err := primary.ExportTxByID(context.Background(), &schema.ExportTxRequest{
+			Tx:                1,
+			ReplicaState:      replicaState,
+			AllowPreCommitted: true,
+			DownstreamReplicaStates: []*schema.ReplicaState{{
+				UUID:             "diverged",
+				PrecommittedTxID: precommittedTxID,
+				PrecommittedAlh:  make([]byte, sha256.Size),
+			}},
+		})
+		require.NoError(t, err)
+
+		committedTxID, _ := primary.st.CommittedAlh()
+		require.Zero(t, committedTxID)
+	})
+
+	t.Run("downstream replicas should be acknowledged through the upstream replica", func(t *testing.T) {
+		_, _, _, err := primary.ExportTxByID(context.Background(), &schema.ExportTxRequest{
+			Tx:                1,
+			ReplicaState:      replicaState,
+			AllowPreCommitted: true,
+			DownstreamReplicaStates: []*schema.ReplicaState{{
+				UUID:             "downstream",
+				PrecommittedTxID: precommittedTxID,
+				PrecommittedAlh:  precommittedAlh[:],
+			}},
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, <-errCh)
+
+		committedTxID, _ := primary.st.CommittedAlh()
+		require.Equal(t, precommittedTxID, committedTxID)
+	})
+}
+
+func TestReplicationEpoch(t *testing.T) {
+	rootPath := t.TempDir()
+
+	options := DefaultOption().
+		WithDBRootPath(rootPath).
+		WithSyncReplication(true).
+		WithSyncAcks(1).
+		WithReplicationEpoch(2)
+
+	primary, err := NewDB("db", nil, options, logger.NewSimpleLogger("immudb ", os.Stderr))
+	require.NoError(t, err)
+
+	err = primary.Close()
+	require.NoError(t, err)
+
+	primary, err = OpenDB("db", nil, options, logger.NewSimpleLogger("immudb ", os.Stderr))
+	require.NoError(t, err)
+
+	defer primary.Close()
+
+	require.Equal(t, uint64(2), primary.ReplicationEpoch())
+
+	d := primary.(*db)
+
+	setWithTimeout := func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		_, err := primary.Set(ctx, &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key1"), Value: []byte("value1")}}})
+		return err
+	}
+
+	t.Run("writes should be held after opening a synchronous primary", func(t *testing.T) {
+		err := setWithTimeout()
+		require.ErrorIs(t, err, ErrReplicationEpochNotConfirmed)
+	})
+
+	t.Run("replicas of a previous epoch should not confirm the epoch", func(t *testing.T) {
+		err := d.checkReplicationEpoch(&schema.ExportTxRequest{
+			Tx:               1,
+			ReplicaState:     &schema.ReplicaState{UUID: "replica1"},
+			ReplicationEpoch: 1,
+		})
+		require.NoError(t, err)
+
+		err = setWithTimeout()
+		require.ErrorIs(t, err, ErrReplicationEpochNotConfirmed)
+	})
+
+	t.Run("replicas of a newer epoch should be refused", func(t *testing.T) {
+		err := d.checkReplicationEpoch(&schema.ExportTxRequest{
+			Tx:               1,
+			ReplicaState:     &schema.ReplicaState{UUID: "replica1"},
+			ReplicationEpoch: 3,
+		})
+		require.ErrorIs(t, err, ErrStaleReplicationEpoch)
+	})
+
+	t.Run("writes should be released once replicas confirm the epoch", func(t *testing.T) {
+		err := d.checkReplicationEpoch(&schema.ExportTxRequest{
+			Tx:               1,
+			ReplicaState:     &schema.ReplicaState{UUID: "replica1"},
+			ReplicationEpoch: 2,
+		})
+		require.NoError(t, err)
+
+		err = d.waitForReplicationEpoch(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("held writes should be refused once the primary is fenced", func(t *testing.T) {
+		primary.SetReplicationEpoch(3)
+		d.replicaStatesMutex.Lock()
+		d.holdWritesUntilEpochConfirmed()
+		d.replicaStatesMutex.Unlock()
+
+		errCh := make(chan error)
+		go func() {
+			_, err := primary.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key1"), Value: []byte("value1")}}})
+			errCh <- err
+		}()
+
+		primary.AsReplica(true, true, 0)
+
+		require.ErrorIs(t, <-errCh, ErrIsReplica)
+	})
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+)
+
+var ErrStaleReplicationEpoch = errors.New("database was superseded by a primary of a newer replication epoch")
+var ErrReplicationEpochNotConfirmed = errors.New("replication epoch not yet confirmed by synchronous replicas")
+
+// The replication epoch is increased every time a replica gets promoted.
+// Replicas inform their epoch when fetching transactions:
+//   - a primary of a lower epoch was superseded by a failover, it must be fenced
+//   - a synchronous primary holds writes until syncAcks replicas of its own epoch are connected,
+//     thus a primary superseded while it was unreachable does not accept writes after restarting
+
+func (d *db) ReplicationEpoch() uint64 {
+	d.replicaStatesMutex.Lock()
+	defer d.replicaStatesMutex.Unlock()
+
+	return d.options.replicationEpoch
+}
+
+func (d *db) SetReplicationEpoch(epoch uint64) {
+	d.replicaStatesMutex.Lock()
+	defer d.replicaStatesMutex.Unlock()
+
+	if epoch == d.options.replicationEpoch {
+		return
+	}
+
+	d.options.replicationEpoch = epoch
+
+	// states informed by downstream replicas of the previous epoch are not forwarded
+	d.downstreamReplicaStates = nil
+
+	if d.epochConfirmed != nil {
+		// confirmations of the previous epoch are no longer valid
+		d.epochConfirmations = make(map[uuid]struct{}, d.options.syncAcks)
+	}
+}
+
+// holdWritesUntilEpochConfirmed must be called with replicaStatesMutex locked
+func (d *db) holdWritesUntilEpochConfirmed() {
+	if d.epochConfirmed == nil {
+		d.epochConfirmed = make(chan struct{})
+	}
+
+	d.epochConfirmations = make(map[uuid]struct{}, d.options.syncAcks)
+}
+
+// releaseWrites must be called with replicaStatesMutex locked
+func (d *db) releaseWrites() {
+	if d.epochConfirmed != nil {
+		close(d.epochConfirmed)
+	}
+
+	d.epochConfirmed = nil
+	d.epochConfirmations = nil
+}
+
+// waitForReplicationEpoch blocks writes until the replication epoch of a synchronous primary is confirmed.
+// It must be called before locking the database so that replicas can keep replicating
+func (d *db) waitForReplicationEpoch(ctx context.Context) error {
+	d.replicaStatesMutex.Lock()
+	epochConfirmed := d.epochConfirmed
+	epoch := d.options.replicationEpoch
+	d.replicaStatesMutex.Unlock()
+
+	if epochConfirmed == nil {
+		return nil
+	}
+
+	select {
+	case <-epochConfirmed:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("%w: epoch %d. Reason: %v", ErrReplicationEpochNotConfirmed, epoch, ctx.Err())
+	}
+}
+
+// checkReplicationEpoch validates the epoch informed by a replica fetching transactions from this primary
+func (d *db) checkReplicationEpoch(req *schema.ExportTxRequest) error {
+	d.replicaStatesMutex.Lock()
+	defer d.replicaStatesMutex.Unlock()
+
+	if req.ReplicationEpoch > d.options.replicationEpoch {
+		return fmt.Errorf("%w: replica is on epoch %d while database '%s' is on epoch %d",
+			ErrStaleReplicationEpoch, req.ReplicationEpoch, d.name, d.options.replicationEpoch)
+	}
+
+	// replicas of a lower epoch adopt the epoch of the primary but do not confirm it yet
+	if d.epochConfirmed == nil || req.ReplicaState == nil || req.ReplicationEpoch < d.options.replicationEpoch {
+		return nil
+	}
+
+	d.epochConfirmations[req.ReplicaState.UUID] = struct{}{}
+
+	// replicas fetching from the replica are on the epoch of the replica
+	for _, st := range req.DownstreamReplicaStates {
+		d.epochConfirmations[st.UUID] = struct{}{}
+	}
+
+	if len(d.epochConfirmations) >= d.options.syncAcks {
+		d.Logger.Infof("replication epoch %d of database '%s' confirmed by %d replicas", d.options.replicationEpoch, d.name, len(d.epochConfirmations))
+		d.releaseWrites()
+	}
+
+	return nil
+}
@@ -46,6 +46,11 @@ func (d *db) ZAdd(ctx context.Context, req *schema.ZAddRequest) (*schema.TxHeade
 		return nil, store.ErrIllegalArguments
 	}
 
+	err := d.waitForReplicationEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	d.mutex.Lock()
 	defer d.mutex.Unlock()
 
@@ -54,7 +59,7 @@ func (d *db) ZAdd(ctx context.Context, req *schema.ZAddRequest) (*schema.TxHeade
 	}
 
 	lastTxID, _ := d.st.CommittedAlh()
-	err := d.st.WaitForIndexingUpto(ctx, lastTxID)
+	err = d.st.WaitForIndexingUpto(ctx, lastTxID)
 	if err != nil {
 		return nil, err
 	}
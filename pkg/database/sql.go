@@ -52,6 +52,11 @@ func (d *db) VerifiableSQLGet(ctx context.Context, req *schema.VerifiableSQLGetR
 		return nil, err
 	}
 
+	err = d.sqlEngine.CheckPrivileges(ctx, sqlTx, []sql.TablePrivilege{{Privilege: sql.SQLPrivilegeSelect, Table: table.Name()}})
+	if err != nil {
+		return nil, err
+	}
+
 	valbuf := bytes.Buffer{}
 
 	if len(req.SqlGetRequest.PkValues) != len(table.PrimaryIndex().Cols()) {
@@ -355,6 +360,11 @@ func (d *db) SQLExecPrepared(ctx context.Context, tx *sql.SQLTx, stmts []sql.SQL
 		return nil, nil, ErrIllegalArguments
 	}
 
+	err = d.waitForReplicationEpoch(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	d.mutex.RLock()
 	defer d.mutex.RUnlock()
 
@@ -362,6 +372,19 @@ func (d *db) SQLExecPrepared(ctx context.Context, tx *sql.SQLTx, stmts []sql.SQL
 		return nil, nil, ErrIsReplica
 	}
 
+	onCollections, err := collectionPrivilegeStmts(stmts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if onCollections {
+		if tx != nil && tx.IsExplicitCloseRequired() {
+			return nil, nil, fmt.Errorf("%w: privileges on collections can not be granted or revoked within transactions", ErrIllegalArguments)
+		}
+
+		return nil, nil, d.execCollectionPrivilegeStmts(ctx, stmts)
+	}
+
 	return d.sqlEngine.ExecPreparedStmts(ctx, tx, stmts, params)
 }
 
@@ -22,8 +22,10 @@ import (
 
 	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/protomodel"
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func TestSQLExecAndQuery(t *testing.T) {
@@ -225,3 +227,97 @@ func TestVerifiableSQLGet(t *testing.T) {
 		require.Contains(t, err.Error(), "incorrect number of primary key values")
 	})
 }
+
+func TestSQLPrivileges(t *testing.T) {
+	db := makeDb(t)
+
+	_, _, err := db.SQLExec(context.Background(), nil, &schema.SQLExecRequest{Sql: `
+		CREATE TABLE orders(id INTEGER AUTO_INCREMENT, amount INTEGER, PRIMARY KEY id);
+		CREATE TABLE customers(id INTEGER AUTO_INCREMENT, name VARCHAR, PRIMARY KEY id);
+
+		INSERT INTO orders(amount) VALUES (10);
+		INSERT INTO customers(name) VALUES ('c1');
+	`})
+	require.NoError(t, err)
+
+	_, err = db.CreateCollection(context.Background(), &protomodel.CreateCollectionRequest{
+		Name:   "invoices",
+		Fields: []*protomodel.Field{{Name: "number", Type: protomodel.FieldType_INTEGER}},
+	})
+	require.NoError(t, err)
+
+	adminCtx := WithSQLUser(context.Background(), &SQLUser{Name: "admin", Admin: true})
+	analyticsCtx := WithSQLUser(context.Background(), &SQLUser{Name: "analytics"})
+
+	t.Run("only admins can grant privileges", func(t *testing.T) {
+		_, _, err := db.SQLExec(analyticsCtx, nil, &schema.SQLExecRequest{Sql: "GRANT SELECT ON orders TO analytics"})
+		require.ErrorIs(t, err, sql.ErrPermissionDenied)
+
+		_, _, err = db.SQLExec(analyticsCtx, nil, &schema.SQLExecRequest{Sql: "GRANT SELECT ON COLLECTION invoices TO analytics"})
+		require.ErrorIs(t, err, sql.ErrPermissionDenied)
+	})
+
+	t.Run("collection privileges are granted in dedicated requests", func(t *testing.T) {
+		_, _, err := db.SQLExec(adminCtx, nil, &schema.SQLExecRequest{Sql: `
+			GRANT SELECT ON orders TO analytics;
+			GRANT SELECT ON COLLECTION invoices TO analytics;
+		`})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	_, _, err = db.SQLExec(adminCtx, nil, &schema.SQLExecRequest{Sql: "GRANT SELECT ON orders TO analytics"})
+	require.NoError(t, err)
+
+	_, _, err = db.SQLExec(adminCtx, nil, &schema.SQLExecRequest{Sql: "GRANT SELECT, INSERT ON COLLECTION invoices TO analytics"})
+	require.NoError(t, err)
+
+	t.Run("table privileges are enforced", func(t *testing.T) {
+		res, err := db.SQLQuery(analyticsCtx, nil, &schema.SQLQueryRequest{Sql: "SELECT id FROM orders"})
+		require.NoError(t, err)
+		require.Len(t, res.Rows, 1)
+
+		_, err = db.SQLQuery(analyticsCtx, nil, &schema.SQLQueryRequest{Sql: "SELECT id FROM customers"})
+		require.ErrorIs(t, err, sql.ErrPermissionDenied)
+
+		_, _, err = db.SQLExec(analyticsCtx, nil, &schema.SQLExecRequest{Sql: "INSERT INTO orders(amount) VALUES (20)"})
+		require.ErrorIs(t, err, sql.ErrPermissionDenied)
+
+		_, err = db.VerifiableSQLGet(analyticsCtx, &schema.VerifiableSQLGetRequest{
+			SqlGetRequest: &schema.SQLGetRequest{
+				Table:    "customers",
+				PkValues: []*schema.SQLValue{{Value: &schema.SQLValue_N{N: 1}}},
+			},
+		})
+		require.ErrorIs(t, err, sql.ErrPermissionDenied)
+
+		// the user is not restricted when acting on behalf of the server
+		res, err = db.SQLQuery(context.Background(), nil, &schema.SQLQueryRequest{Sql: "SELECT id FROM customers"})
+		require.NoError(t, err)
+		require.Len(t, res.Rows, 1)
+	})
+
+	t.Run("collection privileges are enforced", func(t *testing.T) {
+		_, err := db.InsertDocuments(analyticsCtx, &protomodel.InsertDocumentsRequest{
+			CollectionName: "invoices",
+			Documents: []*structpb.Struct{
+				{Fields: map[string]*structpb.Value{"number": structpb.NewNumberValue(1)}},
+			},
+		})
+		require.NoError(t, err)
+
+		_, _, err = db.SQLExec(adminCtx, nil, &schema.SQLExecRequest{Sql: "REVOKE INSERT ON COLLECTION invoices FROM analytics"})
+		require.NoError(t, err)
+
+		_, err = db.InsertDocuments(analyticsCtx, &protomodel.InsertDocumentsRequest{
+			CollectionName: "invoices",
+			Documents: []*structpb.Struct{
+				{Fields: map[string]*structpb.Value{"number": structpb.NewNumberValue(2)}},
+			},
+		})
+		require.ErrorIs(t, err, sql.ErrPermissionDenied)
+
+		reader, err := db.SearchDocuments(analyticsCtx, &protomodel.Query{CollectionName: "invoices"}, nil, 0)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+	})
+}
@@ -126,6 +126,16 @@ func (v *vlogTruncator) TruncateUptoTx(ctx context.Context, txID uint64) error {
 	}
 	v.db.Logger.Infof("committed sql catalog before truncation for database '%s' at tx %d", v.db.name, sqlCommitHdr.ID)
 
+	if v.db.options.CompactLiveValues {
+		// live values are moved so value-log files can be fully discarded
+		err = v.db.st.CompactValueLogs(ctx, txID, v.db.options.CompactionMaxBytesPerSec)
+		if err != nil {
+			v.db.Logger.Errorf("error during truncation for database '%s' {err = %v, id = %v, type=compact_value_logs}", v.db.name, err, txID)
+		}
+
+		return err
+	}
+
 	// truncate upto txID
 	err = v.db.st.TruncateUptoTx(txID)
 	if err != nil {
@@ -174,6 +174,63 @@ func Test_vlogCompactor_WithSingleIO(t *testing.T) {
 	}
 }
 
+// Test single log with live values being compacted
+func Test_vlogCompactor_WithLiveValuesCompaction(t *testing.T) {
+	rootPath := t.TempDir()
+
+	fileSize := 1024
+
+	options := DefaultOption().WithDBRootPath(rootPath).WithCompactLiveValues(true)
+	options.storeOpts.WithIndexOptions(options.storeOpts.IndexOpts.WithCompactionThld(2)).WithFileSize(fileSize)
+	options.storeOpts.MaxIOConcurrency = 1
+	options.storeOpts.MaxConcurrency = 500
+	options.storeOpts.VLogCacheSize = 0
+	options.storeOpts.EmbeddedValues = false
+
+	db := makeDbWith(t, "db", options)
+
+	_, err := db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{{
+		Key:   []byte("long-lived"),
+		Value: []byte("long-lived value"),
+	}}})
+	require.NoError(t, err)
+
+	for i := 0; i < 20; i++ {
+		kv := &schema.KeyValue{
+			Key:   []byte("updated"),
+			Value: make([]byte, fileSize),
+		}
+		_, err := db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{kv}})
+		require.NoError(t, err)
+	}
+
+	deletePointTx := uint64(15)
+
+	hdr, err := db.st.ReadTxHeader(deletePointTx, false, false)
+	require.NoError(t, err)
+
+	c := NewVlogTruncator(db)
+
+	require.NoError(t, c.TruncateUptoTx(context.Background(), hdr.ID))
+
+	entry, err := db.Get(context.Background(), &schema.KeyRequest{Key: []byte("long-lived")})
+	require.NoError(t, err)
+	require.Equal(t, []byte("long-lived value"), entry.Value)
+
+	// ensure outdated values are deleted
+	for i := uint64(5); i > 1; i-- {
+		tx := store.NewTx(db.st.MaxTxEntries(), db.st.MaxKeyLen())
+
+		err = db.st.ReadTx(i, false, tx)
+		require.NoError(t, err)
+
+		for _, e := range tx.Entries() {
+			_, err := db.st.ReadValue(e)
+			require.Error(t, err)
+		}
+	}
+}
+
 // Test single log with concurrent writers
 func Test_vlogCompactor_WithConcurrentWritersOnSingleIO(t *testing.T) {
 	rootPath := t.TempDir()
@@ -0,0 +1,154 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"fmt"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+)
+
+// getValueRange returns the entry of the key including only the requested range of its value.
+// The whole value is read when its digest needs to be validated, otherwise only the requested range is read
+func (d *db) getValueRange(key []byte, req *schema.KeyRequest) (*schema.Entry, error) {
+	offset := int(req.ValueOffset)
+	length := int(req.ValueLength)
+
+	if req.VerifyValue {
+		var entry *schema.Entry
+		var err error
+
+		if req.AtRevision != 0 {
+			entry, err = d.getAtRevision(key, req.AtRevision, true)
+		} else {
+			entry, err = d.getAtTx(key, req.AtTx, 0, d.st, 0, true)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if offset > len(entry.Value) {
+			return nil, store.ErrValueRangeOutOfBounds
+		}
+
+		if length == 0 || length > len(entry.Value)-offset {
+			length = len(entry.Value) - offset
+		}
+
+		entry.Value = entry.Value[offset : offset+length]
+
+		return entry, nil
+	}
+
+	atTx := req.AtTx
+	revision := uint64(0)
+
+	if req.AtRevision != 0 {
+		var err error
+
+		atTx, revision, err = d.txAtRevision(key, req.AtRevision)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return d.getValueRangeAtTx(key, atTx, 0, revision, offset, length)
+}
+
+func (d *db) getValueRangeAtTx(key []byte, atTx uint64, resolved int, revision uint64, offset, length int) (*schema.Entry, error) {
+	var txID uint64
+	var md *store.KVMetadata
+	var readRange func(offset, length int) ([]byte, error)
+
+	if atTx == 0 {
+		valRef, err := d.st.Get(key)
+		if err != nil {
+			return nil, err
+		}
+
+		txID = valRef.Tx()
+		md = valRef.KVMetadata()
+		readRange = valRef.ResolveRange
+
+		// Revision can be calculated from the history count
+		revision = valRef.HC()
+	} else {
+		entry, _, err := d.st.ReadTxEntry(atTx, key, false)
+		if err != nil {
+			return nil, err
+		}
+
+		txID = atTx
+		md = entry.Metadata()
+		readRange = func(offset, length int) ([]byte, error) {
+			return d.st.ReadValueRange(entry, offset, length)
+		}
+	}
+
+	if md != nil && md.Deleted() {
+		return nil, store.ErrKeyNotFound
+	}
+
+	prefix, err := readRange(0, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(prefix) < 1 {
+		return nil, fmt.Errorf(
+			"%w: internal value consistency error - missing value prefix",
+			store.ErrCorruptedData,
+		)
+	}
+
+	if prefix[0] == ReferenceValuePrefix {
+		// references are small, thus they are fully read before resolving the range of the referenced value
+		val, err := readRange(0, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		ref, err := d.resolveValue(key, val, resolved, txID, md, nil, revision, false)
+		if err != nil {
+			return nil, err
+		}
+
+		entry, err := d.getValueRangeAtTx(EncodeKey(ref.Key), ref.Tx, resolved+1, 0, offset, length)
+		if err != nil {
+			return nil, err
+		}
+
+		entry.ReferencedBy = ref.ReferencedBy
+
+		return entry, nil
+	}
+
+	// the value prefix is skipped
+	val, err := readRange(1+offset, length)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema.Entry{
+		Tx:       txID,
+		Key:      TrimPrefix(key),
+		Metadata: schema.KVMetadataToProto(md),
+		Value:    val,
+		Revision: revision,
+	}, nil
+}
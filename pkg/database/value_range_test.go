@@ -0,0 +1,94 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetValueRange(t *testing.T) {
+	db := makeDb(t)
+
+	hdr1, err := db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("doc"), Value: []byte("0123456789")}}})
+	require.NoError(t, err)
+
+	hdr2, err := db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("doc"), Value: []byte("abcdefghij")}}})
+	require.NoError(t, err)
+
+	_, err = db.SetReference(context.Background(), &schema.ReferenceRequest{Key: []byte("ref"), ReferencedKey: []byte("doc")})
+	require.NoError(t, err)
+
+	for _, verify := range []bool{false, true} {
+		entry, err := db.Get(context.Background(), &schema.KeyRequest{Key: []byte("doc"), ValueOffset: 2, ValueLength: 3, VerifyValue: verify})
+		require.NoError(t, err)
+		require.Equal(t, []byte("doc"), entry.Key)
+		require.Equal(t, []byte("cde"), entry.Value)
+		require.Equal(t, hdr2.Id, entry.Tx)
+		require.Equal(t, uint64(2), entry.Revision)
+
+		entry, err = db.Get(context.Background(), &schema.KeyRequest{Key: []byte("doc"), ValueOffset: 8, ValueLength: 10, VerifyValue: verify})
+		require.NoError(t, err)
+		require.Equal(t, []byte("ij"), entry.Value)
+
+		entry, err = db.Get(context.Background(), &schema.KeyRequest{Key: []byte("doc"), ValueLength: 4, VerifyValue: verify})
+		require.NoError(t, err)
+		require.Equal(t, []byte("abcd"), entry.Value)
+
+		entry, err = db.Get(context.Background(), &schema.KeyRequest{Key: []byte("doc"), AtTx: hdr1.Id, ValueOffset: 5, VerifyValue: verify})
+		require.NoError(t, err)
+		require.Equal(t, []byte("56789"), entry.Value)
+		require.Equal(t, hdr1.Id, entry.Tx)
+
+		entry, err = db.Get(context.Background(), &schema.KeyRequest{Key: []byte("doc"), AtRevision: -1, ValueOffset: 1, ValueLength: 1, VerifyValue: verify})
+		require.NoError(t, err)
+		require.Equal(t, []byte("1"), entry.Value)
+		require.Equal(t, uint64(1), entry.Revision)
+
+		entry, err = db.Get(context.Background(), &schema.KeyRequest{Key: []byte("ref"), ValueOffset: 3, ValueLength: 2, VerifyValue: verify})
+		require.NoError(t, err)
+		require.Equal(t, []byte("doc"), entry.Key)
+		require.Equal(t, []byte("de"), entry.Value)
+		require.NotNil(t, entry.ReferencedBy)
+		require.Equal(t, []byte("ref"), entry.ReferencedBy.Key)
+
+		_, err = db.Get(context.Background(), &schema.KeyRequest{Key: []byte("doc"), ValueOffset: 11, VerifyValue: verify})
+		require.ErrorIs(t, err, store.ErrIllegalArguments)
+
+		_, err = db.Get(context.Background(), &schema.KeyRequest{Key: []byte("missing"), ValueOffset: 1, VerifyValue: verify})
+		require.ErrorIs(t, err, store.ErrKeyNotFound)
+	}
+
+	_, err = db.Get(context.Background(), &schema.KeyRequest{Key: []byte("doc"), ValueLength: math.MaxUint64})
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = db.VerifiableGet(context.Background(), &schema.VerifiableGetRequest{
+		KeyRequest: &schema.KeyRequest{Key: []byte("doc"), ValueOffset: 1},
+	})
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = db.Delete(context.Background(), &schema.DeleteKeysRequest{Keys: [][]byte{[]byte("doc")}})
+	require.NoError(t, err)
+
+	_, err = db.Get(context.Background(), &schema.KeyRequest{Key: []byte("doc"), ValueOffset: 1})
+	require.ErrorIs(t, err, store.ErrKeyNotFound)
+}
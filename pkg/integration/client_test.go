@@ -1182,6 +1182,7 @@ func TestImmuClient_CurrentRoot(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, healthRes)
 	require.Equal(t, uint32(0x0), healthRes.PendingRequests)
+	require.False(t, healthRes.IndexRebuildInProgress)
 }
 
 func TestImmuClient_Count(t *testing.T) {
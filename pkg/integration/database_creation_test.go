@@ -93,6 +93,7 @@ func TestCreateDatabaseV2(t *testing.T) {
 			CommitLogMaxOpenedFiles:  &schema.NullableUint32{Value: 3},
 			MaxBulkSize:              &schema.NullableUint32{Value: 35},
 			BulkPreparationTimeout:   &schema.NullableMilliseconds{Value: 150},
+			RebuildParallelism:       &schema.NullableUint32{Value: 4},
 		},
 		AhtSettings: &schema.AHTNullableSettings{
 			SyncThreshold:   &schema.NullableUint32{Value: 10_000},
@@ -145,6 +146,7 @@ func TestCreateDatabaseV2(t *testing.T) {
 	require.Equal(t, dbNullableSettings.IndexSettings.CommitLogMaxOpenedFiles.Value, res.Settings.IndexSettings.CommitLogMaxOpenedFiles.Value)
 	require.Equal(t, dbNullableSettings.IndexSettings.MaxBulkSize.Value, res.Settings.IndexSettings.MaxBulkSize.Value)
 	require.Equal(t, dbNullableSettings.IndexSettings.BulkPreparationTimeout.Value, res.Settings.IndexSettings.BulkPreparationTimeout.Value)
+	require.Equal(t, dbNullableSettings.IndexSettings.RebuildParallelism.Value, res.Settings.IndexSettings.RebuildParallelism.Value)
 
 	require.Equal(t, dbNullableSettings.AhtSettings.SyncThreshold.Value, res.Settings.AhtSettings.SyncThreshold.Value)
 	require.Equal(t, dbNullableSettings.AhtSettings.WriteBufferSize.Value, res.Settings.AhtSettings.WriteBufferSize.Value)
@@ -0,0 +1,258 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	ic "github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/server"
+	"github.com/stretchr/testify/require"
+)
+
+type testCertificate struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM string
+	keyPEM  string
+}
+
+// issueTestCertificate creates a certificate for localhost signed by the given CA,
+// a self-signed CA certificate is created when no CA is provided
+func issueTestCertificate(t *testing.T, dir, name string, ca *testCertificate) *testCertificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	parent := template
+	signer := key
+
+	if ca == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		template.DNSNames = []string{"localhost"}
+		template.IPAddresses = []net.IP{net.ParseIP("127.0.0.1")}
+		template.KeyUsage = x509.KeyUsageDigitalSignature
+		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+
+		parent = ca.cert
+		signer = ca.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, signer)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	ret := &testCertificate{
+		cert:    cert,
+		key:     key,
+		certPEM: filepath.Join(dir, name+".cert.pem"),
+		keyPEM:  filepath.Join(dir, name+".key.pem"),
+	}
+
+	err = os.WriteFile(ret.certPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)
+	require.NoError(t, err)
+
+	err = os.WriteFile(ret.keyPEM, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600)
+	require.NoError(t, err)
+
+	return ret
+}
+
+func TestReplicationWithMTLS(t *testing.T) {
+	certsDir := t.TempDir()
+
+	ca := issueTestCertificate(t, certsDir, "ca", nil)
+	primaryCert := issueTestCertificate(t, certsDir, "primary", ca)
+	replicaCert := issueTestCertificate(t, certsDir, "replica", ca)
+
+	serverCert, err := tls.LoadX509KeyPair(primaryCert.certPEM, primaryCert.keyPEM)
+	require.NoError(t, err)
+
+	certPool := x509.NewCertPool()
+	certPool.AddCert(ca.cert)
+
+	//init primary server, only clients presenting a certificate signed by the CA are accepted
+	primaryServerOpts := server.DefaultOptions().
+		WithMetricsServer(false).
+		WithWebServer(false).
+		WithPgsqlServer(false).
+		WithPort(0).
+		WithDir(t.TempDir()).
+		WithTLS(&tls.Config{
+			Certificates: []tls.Certificate{serverCert},
+			ClientAuth:   tls.RequireAndVerifyClientCert,
+			ClientCAs:    certPool,
+		})
+
+	primaryServer := server.DefaultServer().WithOptions(primaryServerOpts).(*server.ImmuServer)
+
+	err = primaryServer.Initialize()
+	require.NoError(t, err)
+
+	//init replica server
+	replicaServerOpts := server.DefaultOptions().
+		WithMetricsServer(false).
+		WithWebServer(false).
+		WithPgsqlServer(false).
+		WithPort(0).
+		WithDir(t.TempDir())
+
+	replicaServer := server.DefaultServer().WithOptions(replicaServerOpts).(*server.ImmuServer)
+
+	err = replicaServer.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		primaryServer.Start()
+	}()
+
+	go func() {
+		replicaServer.Start()
+	}()
+
+	time.Sleep(1 * time.Second)
+
+	defer func() {
+		replicaServer.Stop()
+		primaryServer.Stop()
+	}()
+
+	// init primary client
+	primaryPort := primaryServer.Listener.Addr().(*net.TCPAddr).Port
+
+	primaryOpts := ic.DefaultOptions().
+		WithDir(t.TempDir()).
+		WithPort(primaryPort).
+		WithMTLs(true).
+		WithMTLsOptions(ic.MTLsOptions{}.
+			WithServername("localhost").
+			WithClientCAs(ca.certPEM).
+			WithCertificate(replicaCert.certPEM).
+			WithPkey(replicaCert.keyPEM),
+		)
+
+	primaryClient := ic.NewClient().WithOptions(primaryOpts)
+
+	err = primaryClient.OpenSession(context.Background(), []byte(`immudb`), []byte(`immudb`), "defaultdb")
+	require.NoError(t, err)
+	defer primaryClient.CloseSession(context.Background())
+
+	err = primaryClient.CreateUser(context.Background(), []byte("replicator"), []byte("replicator1Pwd!"), auth.PermissionAdmin, "defaultdb")
+	require.NoError(t, err)
+
+	err = primaryClient.SetActiveUser(context.Background(), &schema.SetActiveUserRequest{Active: true, Username: "replicator"})
+	require.NoError(t, err)
+
+	_, err = primaryClient.Set(context.Background(), []byte("key1"), []byte("value1"))
+	require.NoError(t, err)
+
+	// init replica client
+	replicaPort := replicaServer.Listener.Addr().(*net.TCPAddr).Port
+
+	replicaOpts := ic.DefaultOptions().
+		WithDir(t.TempDir()).
+		WithPort(replicaPort)
+
+	replicaClient := ic.NewClient().WithOptions(replicaOpts)
+
+	err = replicaClient.OpenSession(context.Background(), []byte(`immudb`), []byte(`immudb`), "defaultdb")
+	require.NoError(t, err)
+
+	replicationSettings := func(tlsSettings *schema.ReplicationNullableSettings) *schema.DatabaseNullableSettings {
+		tlsSettings.Replica = &schema.NullableBool{Value: true}
+		tlsSettings.PrimaryDatabase = &schema.NullableString{Value: "defaultdb"}
+		tlsSettings.PrimaryHost = &schema.NullableString{Value: "127.0.0.1"}
+		tlsSettings.PrimaryPort = &schema.NullableUint32{Value: uint32(primaryPort)}
+		tlsSettings.PrimaryUsername = &schema.NullableString{Value: "replicator"}
+		tlsSettings.PrimaryPassword = &schema.NullableString{Value: "replicator1Pwd!"}
+
+		return &schema.DatabaseNullableSettings{ReplicationSettings: tlsSettings}
+	}
+
+	t.Run("client certificate must be provided together with its private key", func(t *testing.T) {
+		_, err = replicaClient.CreateDatabaseV2(context.Background(), "invalidreplicadb", replicationSettings(&schema.ReplicationNullableSettings{
+			PrimaryTLS:     &schema.NullableBool{Value: true},
+			PrimaryTLSCert: &schema.NullableString{Value: replicaCert.certPEM},
+		}))
+		require.ErrorContains(t, err, server.ErrIllegalArguments.Error())
+	})
+
+	t.Run("replication should not progress without client certificate", func(t *testing.T) {
+		_, err = replicaClient.CreateDatabaseV2(context.Background(), "tlsreplicadb", replicationSettings(&schema.ReplicationNullableSettings{
+			PrimaryTLS:           &schema.NullableBool{Value: true},
+			PrimaryTLSServerName: &schema.NullableString{Value: "localhost"},
+			PrimaryTLSCACert:     &schema.NullableString{Value: ca.certPEM},
+		}))
+		require.NoError(t, err)
+
+		time.Sleep(1 * time.Second)
+
+		state, err := replicaClient.UseDatabase(context.Background(), &schema.Database{DatabaseName: "tlsreplicadb"})
+		require.NoError(t, err)
+		require.NotNil(t, state)
+
+		_, err = replicaClient.Get(context.Background(), []byte("key1"))
+		require.Error(t, err)
+	})
+
+	t.Run("replication should progress with mTLS", func(t *testing.T) {
+		_, err = replicaClient.CreateDatabaseV2(context.Background(), "mtlsreplicadb", replicationSettings(&schema.ReplicationNullableSettings{
+			PrimaryTLS:       &schema.NullableBool{Value: true},
+			PrimaryTLSCACert: &schema.NullableString{Value: ca.certPEM},
+			PrimaryTLSCert:   &schema.NullableString{Value: replicaCert.certPEM},
+			PrimaryTLSKey:    &schema.NullableString{Value: replicaCert.keyPEM},
+		}))
+		require.NoError(t, err)
+
+		_, err = replicaClient.UseDatabase(context.Background(), &schema.Database{DatabaseName: "mtlsreplicadb"})
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			entry, err := replicaClient.Get(context.Background(), []byte("key1"))
+			return err == nil && string(entry.Value) == "value1"
+		}, 10*time.Second, 100*time.Millisecond)
+	})
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replication
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type CascadingReplicationTestSuite struct {
+	baseReplicationTestSuite
+}
+
+func TestCascadingReplicationTestSuite(t *testing.T) {
+	suite.Run(t, &CascadingReplicationTestSuite{})
+}
+
+// primary -> replica 0 -> replica 1 (sync) -> replica 2 (async)
+//
+//	\-> replica 3 (async)
+func (suite *CascadingReplicationTestSuite) SetupTest() {
+	suite.baseReplicationTestSuite.SetupTest()
+	suite.SetupCluster(1, 1, 0)
+	suite.AddCascadingReplica(0, true)
+	suite.AddCascadingReplica(1, false)
+	suite.AddCascadingReplica(0, false)
+	suite.ValidateClusterSetup()
+}
+
+func (suite *CascadingReplicationTestSuite) TestCascadingReplication() {
+	ctx, client, cleanup := suite.ClientForPrimary()
+	defer cleanup()
+
+	var txID uint64
+
+	for i := 0; i < 10; i++ {
+		hdr, err := client.Set(ctx, []byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(suite.T(), err)
+
+		txID = hdr.Id
+	}
+
+	primaryState, err := client.CurrentState(ctx)
+	require.NoError(suite.T(), err)
+
+	for i := 0; i < suite.GetReplicasCount(); i++ {
+		suite.Run(fmt.Sprintf("replica %d", i), func() {
+			ctx, client, cleanup := suite.ClientForReplica(i)
+			defer cleanup()
+
+			suite.WaitForCommittedTx(ctx, client, txID, 10*time.Second)
+
+			state, err := client.CurrentState(ctx)
+			require.NoError(suite.T(), err)
+			require.Equal(suite.T(), primaryState.TxHash, state.TxHash)
+
+			entry, err := client.Get(ctx, []byte("key9"))
+			require.NoError(suite.T(), err)
+			require.Equal(suite.T(), []byte("value9"), entry.Value)
+		})
+	}
+}
+
+type SyncCascadingReplicationTestSuite struct {
+	baseReplicationTestSuite
+}
+
+func TestSyncCascadingReplicationTestSuite(t *testing.T) {
+	suite.Run(t, &SyncCascadingReplicationTestSuite{})
+}
+
+// primary (2 acks) -> replica 0 (sync) -> replica 1 (sync)
+//
+// acknowledgements of replica 1 reach the primary through replica 0
+func (suite *SyncCascadingReplicationTestSuite) SetupTest() {
+	suite.baseReplicationTestSuite.SetupTest()
+	suite.SetupCluster(1, 2, 0)
+	suite.AddCascadingReplica(0, true)
+	suite.ValidateClusterSetup()
+}
+
+func (suite *SyncCascadingReplicationTestSuite) TestSyncCascadingReplication() {
+	ctx, client, cleanup := suite.ClientForPrimary()
+	defer cleanup()
+
+	var txID uint64
+
+	for i := 0; i < 10; i++ {
+		hdr, err := client.Set(ctx, []byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(suite.T(), err)
+
+		txID = hdr.Id
+	}
+
+	ctx, replicaClient, replicaCleanup := suite.ClientForReplica(1)
+	defer replicaCleanup()
+
+	// the primary committed once replica 1 precommitted the transaction
+	state, err := replicaClient.CurrentState(ctx)
+	require.NoError(suite.T(), err)
+	require.GreaterOrEqual(suite.T(), state.PrecommittedTxId, txID)
+
+	suite.WaitForCommittedTx(ctx, replicaClient, txID, 10*time.Second)
+
+	entry, err := replicaClient.Get(ctx, []byte("key9"))
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), []byte("value9"), entry.Value)
+}
@@ -0,0 +1,273 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replication
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/logger"
+	"github.com/codenotary/immudb/pkg/replication"
+	"github.com/stretchr/testify/suite"
+)
+
+type FailoverTestSuite struct {
+	baseReplicationTestSuite
+}
+
+func TestFailoverTestSuite(t *testing.T) {
+	suite.Run(t, &FailoverTestSuite{})
+}
+
+func (suite *FailoverTestSuite) SetupTest() {
+	suite.baseReplicationTestSuite.SetupTest()
+	suite.SetupCluster(3, 2, 0)
+	suite.ValidateClusterSetup()
+}
+
+func (suite *FailoverTestSuite) failoverNode(srv TestServer, dbName string) *replication.FailoverNode {
+	host, port := srv.Address(suite.T())
+
+	return &replication.FailoverNode{
+		Database: dbName,
+		Host:     host,
+		Port:     port,
+	}
+}
+
+func (suite *FailoverTestSuite) failoverOptions() *replication.FailoverOptions {
+	suite.mu.Lock()
+	defer suite.mu.Unlock()
+
+	var replicas []*replication.FailoverNode
+
+	for i, srv := range suite.replicas {
+		replicas = append(replicas, suite.failoverNode(srv, suite.replicasDBName[i]))
+	}
+
+	return replication.DefaultFailoverOptions().
+		WithPrimary(suite.failoverNode(suite.primary, suite.primaryDBName)).
+		WithReplicas(replicas).
+		WithSyncAcks(2).
+		WithUsername("immudb").
+		WithPassword("immudb").
+		WithReplicationUsername("immudb").
+		WithReplicationPassword("immudb").
+		WithConnectTimeout(time.Second)
+}
+
+func (suite *FailoverTestSuite) writeTxs(n int) uint64 {
+	ctx, client, cleanup := suite.ClientForPrimary()
+	defer cleanup()
+
+	var txID uint64
+
+	for i := 0; i < n; i++ {
+		hdr, err := client.Set(ctx, []byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		suite.Require().NoError(err)
+
+		txID = hdr.Id
+	}
+
+	return txID
+}
+
+// replicaIndex returns the index of the replica served at the given node
+func (suite *FailoverTestSuite) replicaIndex(node *replication.FailoverNode) int {
+	for i, srv := range suite.replicas {
+		if *suite.failoverNode(srv, suite.replicasDBName[i]) == *node {
+			return i
+		}
+	}
+
+	suite.FailNow("promoted node is not a replica")
+	return -1
+}
+
+func (suite *FailoverTestSuite) TestFailoverWithPrimaryDown() {
+	txID := suite.writeTxs(10)
+
+	opts := suite.failoverOptions()
+
+	suite.StopPrimary()
+
+	coordinator, err := replication.NewFailoverCoordinator(opts, logger.NewMemoryLogger())
+	suite.Require().NoError(err)
+
+	report, err := coordinator.Run(context.Background())
+	suite.Require().NoError(err)
+
+	suite.Require().False(report.PrimaryFenced)
+	suite.Require().Empty(report.Unreachable)
+	suite.Require().Empty(report.Failed)
+	suite.Require().NotNil(report.NewPrimary)
+	suite.Require().Len(report.Repointed, 2)
+	suite.Require().GreaterOrEqual(report.CommittedTxID, txID)
+
+	promoted := suite.replicaIndex(report.NewPrimary)
+
+	ctx, client, cleanup := suite.ClientForReplica(promoted)
+	defer cleanup()
+
+	hdr, err := client.Set(ctx, []byte("key-after-failover"), []byte("value"))
+	suite.Require().NoError(err)
+
+	for i := 0; i < suite.GetReplicasCount(); i++ {
+		if i == promoted {
+			continue
+		}
+
+		suite.Run(fmt.Sprintf("replica %d", i), func() {
+			ctx, client, cleanup := suite.ClientForReplica(i)
+			defer cleanup()
+
+			suite.WaitForCommittedTx(ctx, client, hdr.Id, 10*time.Second)
+
+			entry, err := client.Get(ctx, []byte("key-after-failover"))
+			suite.Require().NoError(err)
+			suite.Require().Equal([]byte("value"), entry.Value)
+		})
+	}
+}
+
+func (suite *FailoverTestSuite) TestFailoverFencingReachablePrimary() {
+	suite.writeTxs(10)
+
+	coordinator, err := replication.NewFailoverCoordinator(suite.failoverOptions(), logger.NewMemoryLogger())
+	suite.Require().NoError(err)
+
+	report, err := coordinator.Run(context.Background())
+	suite.Require().NoError(err)
+
+	suite.Require().True(report.PrimaryFenced)
+	suite.Require().Len(report.Repointed, 3)
+
+	suite.Run("fenced primary should not accept writes", func() {
+		ctx, client, cleanup := suite.ClientForPrimary()
+		defer cleanup()
+
+		_, err := client.Set(ctx, []byte("key"), []byte("value"))
+		suite.Require().Error(err)
+	})
+
+	promoted := suite.replicaIndex(report.NewPrimary)
+
+	ctx, client, cleanup := suite.ClientForReplica(promoted)
+	defer cleanup()
+
+	hdr, err := client.Set(ctx, []byte("key-after-failover"), []byte("value"))
+	suite.Require().NoError(err)
+
+	suite.Run("fenced primary should replicate from the promoted replica", func() {
+		ctx, client, cleanup := suite.ClientForPrimary()
+		defer cleanup()
+
+		suite.WaitForCommittedTx(ctx, client, hdr.Id, 10*time.Second)
+	})
+}
+
+func (suite *FailoverTestSuite) TestFailoverWithoutQuorum() {
+	suite.writeTxs(1)
+
+	opts := suite.failoverOptions()
+
+	suite.StopPrimary()
+	suite.StopReplica(0)
+	suite.StopReplica(1)
+
+	coordinator, err := replication.NewFailoverCoordinator(opts, logger.NewMemoryLogger())
+	suite.Require().NoError(err)
+
+	report, err := coordinator.Run(context.Background())
+	suite.Require().ErrorIs(err, replication.ErrNoQuorum)
+	suite.Require().Len(report.Unreachable, 2)
+	suite.Require().Nil(report.NewPrimary)
+}
+
+func (suite *FailoverTestSuite) TestFailoverFencingReturningPrimary() {
+	suite.writeTxs(10)
+
+	opts := suite.failoverOptions()
+
+	suite.StopPrimary()
+
+	coordinator, err := replication.NewFailoverCoordinator(opts, logger.NewMemoryLogger())
+	suite.Require().NoError(err)
+
+	report, err := coordinator.Run(context.Background())
+	suite.Require().NoError(err)
+	suite.Require().False(report.PrimaryFenced)
+	suite.Require().Equal(uint64(1), report.ReplicationEpoch)
+
+	suite.mu.Lock()
+	suite.primary.Start(suite.T())
+	suite.primaryRunning = true
+	suite.mu.Unlock()
+
+	suite.Run("returning primary should hold writes", func() {
+		ctx, client, cleanup := suite.ClientForPrimary()
+		defer cleanup()
+
+		ctxTimeout, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+
+		_, err := client.Set(ctxTimeout, []byte("key"), []byte("value"))
+		suite.Require().Error(err)
+	})
+
+	promoted := suite.replicaIndex(report.NewPrimary)
+
+	replica := (promoted + 1) % suite.GetReplicasCount()
+
+	suite.Run("returning primary should be fenced by a replica of the new epoch", func() {
+		host, port := suite.primary.Address(suite.T())
+
+		ctx, client, cleanup := suite.ClientForReplica(replica)
+		defer cleanup()
+
+		_, err := client.UpdateDatabaseV2(ctx, suite.replicasDBName[replica], &schema.DatabaseNullableSettings{
+			ReplicationSettings: &schema.ReplicationNullableSettings{
+				PrimaryDatabase: &schema.NullableString{Value: suite.primaryDBName},
+				PrimaryHost:     &schema.NullableString{Value: host},
+				PrimaryPort:     &schema.NullableUint32{Value: uint32(port)},
+				PrimaryUsername: &schema.NullableString{Value: primaryUsername},
+				PrimaryPassword: &schema.NullableString{Value: primaryPassword},
+			},
+		})
+		suite.Require().NoError(err)
+
+		pctx, pclient, pcleanup := suite.ClientForPrimary()
+		defer pcleanup()
+
+		suite.Require().Eventually(func() bool {
+			res, err := pclient.GetDatabaseSettingsV2(pctx)
+			if err != nil {
+				return false
+			}
+
+			rs := res.Settings.ReplicationSettings
+
+			return rs.Replica.Value && rs.ReplicationEpoch.Value == report.ReplicationEpoch
+		}, 10*time.Second, 100*time.Millisecond)
+
+		_, err = pclient.Set(pctx, []byte("key"), []byte("value"))
+		suite.Require().Error(err)
+	})
+}
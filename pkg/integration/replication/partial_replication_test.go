@@ -0,0 +1,115 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replication
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/database"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type PartialReplicationTestSuite struct {
+	baseReplicationTestSuite
+}
+
+func TestPartialReplicationTestSuite(t *testing.T) {
+	suite.Run(t, &PartialReplicationTestSuite{})
+}
+
+// primary -> replica 0 (async, key prefix "app/" and table "table1")
+func (suite *PartialReplicationTestSuite) SetupTest() {
+	suite.baseReplicationTestSuite.SetupTest()
+	suite.SetupCluster(0, 0, 0)
+	suite.AddPartialReplica([]string{"app/"}, []string{"table1"})
+	suite.ValidateClusterSetup()
+}
+
+func (suite *PartialReplicationTestSuite) TestPartialReplication() {
+	ctx, client, cleanup := suite.ClientForPrimary()
+	defer cleanup()
+
+	for i := 0; i < 5; i++ {
+		_, err := client.Set(ctx, []byte(fmt.Sprintf("app/key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(suite.T(), err)
+
+		_, err = client.Set(ctx, []byte(fmt.Sprintf("other/key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(suite.T(), err)
+	}
+
+	_, err := client.SQLExec(ctx, `
+		CREATE TABLE table1(id INTEGER, title VARCHAR, PRIMARY KEY id);
+		CREATE TABLE table2(id INTEGER, title VARCHAR, PRIMARY KEY id);
+	`, nil)
+	require.NoError(suite.T(), err)
+
+	_, err = client.SQLExec(ctx, `
+		INSERT INTO table1(id, title) VALUES (1, 'title1'), (2, 'title2');
+		INSERT INTO table2(id, title) VALUES (1, 'title1');
+	`, nil)
+	require.NoError(suite.T(), err)
+
+	primaryState, err := client.CurrentState(ctx)
+	require.NoError(suite.T(), err)
+
+	rctx, rclient, rcleanup := suite.ClientForReplica(0)
+	defer rcleanup()
+
+	// every primary transaction is replicated into exactly one replica transaction
+	suite.WaitForCommittedTx(rctx, rclient, primaryState.TxId, 10*time.Second)
+
+	suite.Run("only matching entries should be replicated", func() {
+		entry, err := rclient.Get(rctx, []byte("app/key4"))
+		require.NoError(suite.T(), err)
+		require.Equal(suite.T(), []byte("value4"), entry.Value)
+
+		_, err = rclient.Get(rctx, []byte("other/key4"))
+		require.ErrorContains(suite.T(), err, "key not found")
+
+		res, err := rclient.SQLQuery(rctx, "SELECT id, title FROM table1", nil, true)
+		require.NoError(suite.T(), err)
+		require.Len(suite.T(), res.Rows, 2)
+
+		res, err = rclient.SQLQuery(rctx, "SELECT id, title FROM table2", nil, true)
+		require.NoError(suite.T(), err)
+		require.Empty(suite.T(), res.Rows)
+	})
+
+	suite.Run("replicated entries should be provable against the primary", func() {
+		entry, err := rclient.Get(rctx, []byte("app/key4"))
+		require.NoError(suite.T(), err)
+
+		ptx, err := rclient.PartialTxProof(rctx, entry.Tx)
+		require.NoError(suite.T(), err)
+		require.Len(suite.T(), ptx.Entries, 1)
+
+		primaryTx, err := client.TxByID(ctx, ptx.Header.Id)
+		require.NoError(suite.T(), err)
+
+		require.Equal(suite.T(),
+			schema.TxHeaderFromProto(primaryTx.Header).Alh(),
+			schema.TxHeaderFromProto(ptx.Header).Alh(),
+		)
+
+		err = database.VerifyPartialTxEntry(ptx.Header, ptx.Entries[0])
+		require.NoError(suite.T(), err)
+	})
+}
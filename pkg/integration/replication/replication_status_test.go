@@ -0,0 +1,95 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replication
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/replication"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReplicationStatusTestSuite struct {
+	baseReplicationTestSuite
+}
+
+func TestReplicationStatusTestSuite(t *testing.T) {
+	suite.Run(t, &ReplicationStatusTestSuite{})
+}
+
+// primary -> replica 0 (async)
+func (suite *ReplicationStatusTestSuite) SetupTest() {
+	suite.baseReplicationTestSuite.SetupTest()
+	suite.SetupCluster(0, 0, 1)
+	suite.ValidateClusterSetup()
+}
+
+func (suite *ReplicationStatusTestSuite) TestReplicationStatus() {
+	ctx, client, cleanup := suite.ClientForPrimary()
+	defer cleanup()
+
+	for i := 0; i < 5; i++ {
+		_, err := client.Set(ctx, []byte("key"), []byte("value"))
+		require.NoError(suite.T(), err)
+	}
+
+	primaryState, err := client.CurrentState(ctx)
+	require.NoError(suite.T(), err)
+
+	rctx, rclient, rcleanup := suite.ClientForReplica(0)
+	defer rcleanup()
+
+	suite.WaitForCommittedTx(rctx, rclient, primaryState.TxId, 10*time.Second)
+
+	suite.Run("primary should list the replica", func() {
+		require.Eventually(suite.T(), func() bool {
+			res, err := client.ReplicationStatus(ctx, suite.primaryDBName)
+			require.NoError(suite.T(), err)
+
+			return len(res.Replicas) == 1 && res.Replicas[0].LastAckTxID == res.CommittedTxID
+		}, 10*time.Second, 100*time.Millisecond)
+
+		res, err := client.ReplicationStatus(ctx, suite.primaryDBName)
+		require.NoError(suite.T(), err)
+		require.False(suite.T(), res.Replica)
+		require.Nil(suite.T(), res.PrimaryStatus)
+
+		r := res.Replicas[0]
+		require.Equal(suite.T(), suite.replicas[0].UUID(suite.T()).String(), r.UUID)
+		require.Equal(suite.T(), suite.replicasDBName[0], r.Database)
+		require.Zero(suite.T(), r.LagTxs)
+		require.Zero(suite.T(), r.LagSeconds)
+		require.NotZero(suite.T(), r.ConnectedAt)
+		require.False(suite.T(), r.SyncReplication)
+		require.False(suite.T(), r.Partial)
+	})
+
+	suite.Run("replica should show the replication from the primary", func() {
+		res, err := rclient.ReplicationStatus(rctx, suite.replicasDBName[0])
+		require.NoError(suite.T(), err)
+		require.True(suite.T(), res.Replica)
+		require.Equal(suite.T(), primaryState.TxId, res.CommittedTxID)
+		require.Empty(suite.T(), res.Replicas)
+
+		require.NotNil(suite.T(), res.PrimaryStatus)
+		require.Contains(suite.T(), res.PrimaryStatus.Primary, suite.primaryDBName+"@")
+		require.Equal(suite.T(), replication.StateReplicating, res.PrimaryStatus.State)
+		require.Empty(suite.T(), res.PrimaryStatus.LastError)
+	})
+}
@@ -93,28 +93,76 @@ func (suite *baseReplicationTestSuite) AddReplica(sync bool) int {
 	suite.replicasDBName = append(suite.replicasDBName, replicaDBName)
 	suite.replicasRunning = append(suite.replicasRunning, true)
 
+	suite.createReplicaDB(replica, suite.replicationSettings(suite.primary, suite.primaryDBName, primaryUsername, primaryPassword, sync))
+
+	return replicaNum
+}
+
+// AddPartialReplica adds an asynchronous replica of the primary only replicating the entries
+// matching the given key prefixes or SQL tables
+func (suite *baseReplicationTestSuite) AddPartialReplica(keyPrefixes, sqlTables []string) int {
+	suite.mu.Lock()
+	defer suite.mu.Unlock()
+
+	replica := suite.srvProvider.AddServer(suite.T())
+
+	replicaNum := len(suite.replicas)
+	suite.replicas = append(suite.replicas, replica)
+	suite.replicasDBName = append(suite.replicasDBName, replicaDBName)
+	suite.replicasRunning = append(suite.replicasRunning, true)
+
+	rs := suite.replicationSettings(suite.primary, suite.primaryDBName, primaryUsername, primaryPassword, false)
+	rs.KeyPrefixes = &schema.NullableStringList{Values: keyPrefixes}
+	rs.SqlTables = &schema.NullableStringList{Values: sqlTables}
+
+	suite.createReplicaDB(replica, rs)
+
+	return replicaNum
+}
+
+// AddCascadingReplica adds a replica replicating from the given replica instead of the primary
+func (suite *baseReplicationTestSuite) AddCascadingReplica(upstreamNum int, sync bool) int {
+	suite.mu.Lock()
+	defer suite.mu.Unlock()
+
+	replica := suite.srvProvider.AddServer(suite.T())
+
+	replicaNum := len(suite.replicas)
+	suite.replicas = append(suite.replicas, replica)
+	suite.replicasDBName = append(suite.replicasDBName, replicaDBName)
+	suite.replicasRunning = append(suite.replicasRunning, true)
+
+	// users are not replicated, downstream replicas use the default credentials of the upstream server
+	suite.createReplicaDB(replica, suite.replicationSettings(suite.replicas[upstreamNum], suite.replicasDBName[upstreamNum], "immudb", "immudb", sync))
+
+	return replicaNum
+}
+
+func (suite *baseReplicationTestSuite) replicationSettings(upstream TestServer, upstreamDBName, username, password string, sync bool) *schema.ReplicationNullableSettings {
+	upstreamHost, upstreamPort := upstream.Address(suite.T())
+
+	return &schema.ReplicationNullableSettings{
+		Replica:         &schema.NullableBool{Value: true},
+		SyncReplication: &schema.NullableBool{Value: sync},
+		PrimaryDatabase: &schema.NullableString{Value: upstreamDBName},
+		PrimaryHost:     &schema.NullableString{Value: upstreamHost},
+		PrimaryPort:     &schema.NullableUint32{Value: uint32(upstreamPort)},
+		PrimaryUsername: &schema.NullableString{Value: username},
+		PrimaryPassword: &schema.NullableString{Value: password},
+	}
+}
+
+func (suite *baseReplicationTestSuite) createReplicaDB(replica TestServer, rs *schema.ReplicationNullableSettings) {
 	rctx, replicaClient, cleanup := suite.internalClientFor(replica, client.DefaultDB)
 	defer cleanup()
 
-	primaryHost, primaryPort := suite.primary.Address(suite.T())
-
 	settings := &schema.DatabaseNullableSettings{
-		ReplicationSettings: &schema.ReplicationNullableSettings{
-			Replica:         &schema.NullableBool{Value: true},
-			SyncReplication: &schema.NullableBool{Value: sync},
-			PrimaryDatabase: &schema.NullableString{Value: suite.primaryDBName},
-			PrimaryHost:     &schema.NullableString{Value: primaryHost},
-			PrimaryPort:     &schema.NullableUint32{Value: uint32(primaryPort)},
-			PrimaryUsername: &schema.NullableString{Value: primaryUsername},
-			PrimaryPassword: &schema.NullableString{Value: primaryPassword},
-		},
+		ReplicationSettings: rs,
 	}
 
 	// init database on the replica to replicate
 	_, err := replicaClient.CreateDatabaseV2(rctx, replicaDBName, settings)
 	require.NoError(suite.T(), err)
-
-	return replicaNum
 }
 
 func (suite *baseReplicationTestSuite) StopReplica(replicaNum int) {
@@ -369,11 +417,13 @@ func (suite *baseReplicationTestSuite) TearDownTest() {
 		}
 	}
 	suite.replicas = []TestServer{}
+	suite.replicasDBName = nil
+	suite.replicasRunning = nil
 
 	// stop primary
-	if suite.primary != nil {
+	if suite.primary != nil && suite.primaryRunning {
 		suite.primary.Shutdown(suite.T())
-		suite.primary = nil
 	}
 	suite.primary = nil
+	suite.primaryRunning = false
 }
@@ -488,6 +488,59 @@ func TestImmuClient_ExecAll(t *testing.T) {
 	require.Equal(t, []byte(`exec-all-val2`), entry2.Value)
 }
 
+func TestImmuClient_StreamBulkLoad(t *testing.T) {
+	_, client := setupTest(t)
+
+	newKV := func(key, value []byte) *stream.KeyValue {
+		return &stream.KeyValue{
+			Key: &stream.ValueSize{
+				Content: bytes.NewBuffer(key),
+				Size:    len(key),
+			},
+			Value: &stream.ValueSize{
+				Content: bytes.NewBuffer(value),
+				Size:    len(value),
+			},
+		}
+	}
+
+	const keyCount = 100
+
+	var kvs []*stream.KeyValue
+
+	// keys are loaded in reverse order
+	for i := keyCount - 1; i >= 0; i-- {
+		kvs = append(kvs, newKV([]byte(fmt.Sprintf("bulk-key%03d", i)), []byte(fmt.Sprintf("bulk-val%d", i))))
+	}
+
+	kvs = append(kvs, newKV([]byte("bulk-key000"), []byte("bulk-val-updated")))
+
+	res, err := client.StreamBulkLoad(context.Background(), kvs)
+	require.NoError(t, err)
+	require.Equal(t, uint64(keyCount+1), res.Entries)
+	require.Equal(t, res.FirstTxId+1, res.LastTxId)
+
+	entry, err := client.VerifiedGet(context.Background(), []byte("bulk-key042"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("bulk-val42"), entry.Value)
+	require.Equal(t, res.FirstTxId, entry.Tx)
+
+	entry, err = client.VerifiedGet(context.Background(), []byte("bulk-key000"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("bulk-val-updated"), entry.Value)
+	require.Equal(t, res.LastTxId, entry.Tx)
+
+	scanned, err := client.Scan(context.Background(), &schema.ScanRequest{Prefix: []byte("bulk-key"), Limit: keyCount})
+	require.NoError(t, err)
+	require.Len(t, scanned.Entries, keyCount)
+
+	_, err = client.VerifiedSet(context.Background(), []byte("bulk-key001"), []byte("regular-val"))
+	require.NoError(t, err)
+
+	_, err = client.StreamBulkLoad(context.Background(), nil)
+	require.ErrorContains(t, err, "no entries provided")
+}
+
 func TestImmuClient_StreamWithSignature(t *testing.T) {
 	_, client := setupTestWithSignatures(t, "ec1.key", "ec1.pub")
 
@@ -30,6 +30,7 @@ var ErrUsernameNotprovided = errors.New("user name not provided")
 var ErrPwNotprovided = errors.New("password not provided")
 var ErrDBNotExists = errors.New("selected db doesn't exists")
 var ErrUsernameNotFound = errors.New("user not found")
+var ErrNoDatabasePermission = errors.New("user has no permission on the selected database")
 var ErrExpectedQueryMessage = errors.New("expected query message")
 var ErrUseDBStatementNotSupported = errors.New("SQL statement not supported. Please use `UseDatabase` operation instead")
 var ErrCreateDBStatementNotSupported = errors.New("SQL statement not supported. Please use `CreateDatabase` operation instead")
@@ -22,7 +22,6 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/codenotary/immudb/pkg/database"
 	pserr "github.com/codenotary/immudb/pkg/pgsql/errors"
@@ -161,13 +160,12 @@ func (s *session) HandleStartup(dbList database.DatabaseList) (err error) {
 		if !ok || pw.GetSecret() == "" {
 			return pserr.ErrPwNotprovided
 		}
-		usr, err := s.getUser([]byte(s.username))
+		usr, err := s.authenticate(pw.GetSecret())
 		if err != nil {
-			if strings.Contains(err.Error(), "key not found") {
-				return pserr.ErrUsernameNotFound
-			}
+			return err
 		}
-		if err := usr.ComparePasswords([]byte(pw.GetSecret())); err != nil {
+		s.sqlUser, err = s.resolveSQLUser(usr)
+		if err != nil {
 			return err
 		}
 		s.log.Debugf("authentication successful for %s", s.username)
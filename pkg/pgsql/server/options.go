@@ -17,8 +17,10 @@ limitations under the License.
 package server
 
 import (
+	"context"
 	"crypto/tls"
 
+	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/database"
 	"github.com/codenotary/immudb/pkg/logger"
 )
@@ -55,6 +57,31 @@ func SysDb(sysdb database.DB) Option {
 	}
 }
 
+// JWTValidator accepts externally issued tokens in place of passwords, disabled when nil
+func JWTValidator(v *auth.JWTValidator) Option {
+	return func(args *srv) {
+		args.jwtValidator = v
+	}
+}
+
+// LDAPAuthenticator verifies passwords against an ldap directory, disabled when nil
+func LDAPAuthenticator(a *auth.LDAPAuthenticator) Option {
+	return func(args *srv) {
+		args.ldapAuthenticator = a
+	}
+}
+
+// SQLUserResolver returns the user on behalf of whom the SQL statements of an authenticated user are executed
+type SQLUserResolver func(ctx context.Context, user *auth.User, db string) (*database.SQLUser, error)
+
+// SQLUsers sets the resolver of the users on behalf of whom SQL statements are executed,
+// privileges granted on tables are enforced as for users with no admin rights when nil
+func SQLUsers(r SQLUserResolver) Option {
+	return func(args *srv) {
+		args.sqlUserResolver = r
+	}
+}
+
 func TlsConfig(tlsConfig *tls.Config) Option {
 	return func(args *srv) {
 		args.tlsConfig = tlsConfig
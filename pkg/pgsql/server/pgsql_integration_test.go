@@ -18,17 +18,28 @@ package server_test
 
 import (
 	"context"
+	"crypto"
+	crand "crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/tls"
 	"database/sql"
+	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"math/big"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
 
 	isql "github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/auth/ldaptest"
 	"github.com/codenotary/immudb/pkg/pgsql/errors"
 	"github.com/codenotary/immudb/pkg/pgsql/server/pgmeta"
 	"github.com/codenotary/immudb/pkg/server"
@@ -37,6 +48,7 @@ import (
 	"github.com/lib/pq"
 	_ "github.com/lib/pq"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestPgsqlServer_SimpleQuery(t *testing.T) {
@@ -805,3 +817,217 @@ func TestPgsqlServer_ExtendedQueryPGMultiFieldsPreparedMultiInsertError(t *testi
 	_, err = db.Exec(context.Background(), fmt.Sprintf("INSERT INTO %s (id, amount, total, title, content, isPresent) VALUES (?, ?, ?, ?, ?, ?); INSERT INTO %s (id, amount, total, title, content, isPresent) VALUES (?, ?, ?, ?, ?, ?)", table, table), 1, 1000, 6000, "title 1", fmt.Sprintf("%s", blobContent), true, 2, 2000, 12000, "title 2", fmt.Sprintf("%s", blobContent2), true)
 	require.ErrorContains(t, err, errors.ErrMaxStmtNumberExceeded.Error())
 }
+
+func TestPgsqlServer_JWTAuthentication(t *testing.T) {
+	key, err := rsa.GenerateKey(crand.Reader, 2048)
+	require.NoError(t, err)
+
+	jwks, err := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "test",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	})
+	require.NoError(t, err)
+
+	td := t.TempDir()
+
+	jwksFile := filepath.Join(td, "jwks.json")
+	require.NoError(t, os.WriteFile(jwksFile, jwks, 0600))
+
+	mappings, err := auth.ParseRoleMappings("dba:defaultdb:admin")
+	require.NoError(t, err)
+
+	options := server.DefaultOptions().
+		WithDir(filepath.Join(td, "data")).
+		WithPgsqlServer(true).
+		WithPgsqlServerPort(0).
+		WithJWTOptions(auth.DefaultJWTOptions().WithJWKSFile(jwksFile).WithRoleMappings(mappings))
+
+	bs := servertest.NewBufconnServer(options)
+
+	bs.Start()
+	defer bs.Stop()
+
+	defer os.Remove(".state-")
+
+	bs.WaitForPgsqlListener()
+
+	token := func(sub string, groups ...string) string {
+		header, err := json.Marshal(map[string]string{"alg": "RS256", "kid": "test"})
+		require.NoError(t, err)
+
+		payload, err := json.Marshal(map[string]interface{}{
+			"sub":    sub,
+			"groups": groups,
+			"exp":    time.Now().Add(time.Hour).Unix(),
+		})
+		require.NoError(t, err)
+
+		signedContent := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+		digest := sha256.Sum256([]byte(signedContent))
+
+		signature, err := rsa.SignPKCS1v15(crand.Reader, key, crypto.SHA256, digest[:])
+		require.NoError(t, err)
+
+		return signedContent + "." + base64.RawURLEncoding.EncodeToString(signature)
+	}
+
+	connect := func(user, password string) error {
+		db, err := sql.Open("postgres", fmt.Sprintf("host=localhost port=%d sslmode=disable user=%s dbname=defaultdb password=%s", bs.Server.Srv.PgsqlSrv.GetPort(), user, password))
+		require.NoError(t, err)
+		defer db.Close()
+
+		table := getRandomTableName()
+		_, err = db.Exec(fmt.Sprintf("CREATE TABLE %s (id INTEGER, PRIMARY KEY id)", table))
+		return err
+	}
+
+	require.NoError(t, connect("alice", token("alice", "dba")))
+
+	// the token must be issued to the connecting user
+	require.Error(t, connect("bob", token("alice", "dba")))
+
+	// no permission on the database is mapped from the token
+	require.Error(t, connect("carol", token("carol", "guests")))
+
+	// tokens issued to local users are rejected
+	require.Error(t, connect("immudb", token("immudb", "dba")))
+	require.NoError(t, connect("immudb", "immudb"))
+}
+
+func TestPgsqlServer_LDAPAuthentication(t *testing.T) {
+	directory, err := ldaptest.NewServer(
+		&ldaptest.Entry{
+			DN:         "uid=alice,ou=people,dc=example,dc=com",
+			Password:   "alice-secret",
+			Attributes: map[string][]string{"uid": {"alice"}},
+		},
+		&ldaptest.Entry{
+			DN:         "uid=carol,ou=people,dc=example,dc=com",
+			Password:   "carol-secret",
+			Attributes: map[string][]string{"uid": {"carol"}},
+		},
+		&ldaptest.Entry{
+			DN:         "uid=immudb,ou=people,dc=example,dc=com",
+			Password:   "directory-secret",
+			Attributes: map[string][]string{"uid": {"immudb"}},
+		},
+		&ldaptest.Entry{
+			DN: "cn=dba,ou=groups,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"cn":     {"dba"},
+				"member": {"uid=alice,ou=people,dc=example,dc=com", "uid=immudb,ou=people,dc=example,dc=com"},
+			},
+		},
+	)
+	require.NoError(t, err)
+	defer directory.Close()
+
+	mappings, err := auth.ParseRoleMappings("dba:defaultdb:admin")
+	require.NoError(t, err)
+
+	td := t.TempDir()
+
+	options := server.DefaultOptions().
+		WithDir(filepath.Join(td, "data")).
+		WithPgsqlServer(true).
+		WithPgsqlServerPort(0).
+		WithLDAPOptions(auth.DefaultLDAPOptions().
+			WithURL(directory.URL()).
+			WithUserBaseDN("ou=people,dc=example,dc=com").
+			WithGroupBaseDN("ou=groups,dc=example,dc=com").
+			WithRoleMappings(mappings))
+
+	bs := servertest.NewBufconnServer(options)
+
+	bs.Start()
+	defer bs.Stop()
+
+	defer os.Remove(".state-")
+
+	bs.WaitForPgsqlListener()
+
+	connect := func(user, password string) error {
+		db, err := sql.Open("postgres", fmt.Sprintf("host=localhost port=%d sslmode=disable user=%s dbname=defaultdb password=%s", bs.Server.Srv.PgsqlSrv.GetPort(), user, password))
+		require.NoError(t, err)
+		defer db.Close()
+
+		table := getRandomTableName()
+		_, err = db.Exec(fmt.Sprintf("CREATE TABLE %s (id INTEGER, PRIMARY KEY id)", table))
+		return err
+	}
+
+	require.NoError(t, connect("alice", "alice-secret"))
+
+	require.Error(t, connect("alice", "carol-secret"))
+
+	// no permission on the database is mapped from the groups
+	require.Error(t, connect("carol", "carol-secret"))
+
+	// the sysadmin is only authenticated against the local store
+	require.Error(t, connect("immudb", "directory-secret"))
+	require.NoError(t, connect("immudb", "immudb"))
+}
+
+func TestPgsqlServer_TablePrivilegesOfRoleAdmins(t *testing.T) {
+	td := t.TempDir()
+	options := server.DefaultOptions().WithDir(td).WithPgsqlServer(true).WithPgsqlServerPort(0)
+	bs := servertest.NewBufconnServer(options)
+
+	bs.Start()
+	defer bs.Stop()
+
+	defer os.Remove(".state-")
+
+	bs.WaitForPgsqlListener()
+
+	resp, err := bs.Server.Srv.OpenSession(context.Background(), &schema.OpenSessionRequest{
+		Username:     []byte(auth.SysAdminUsername),
+		Password:     []byte(auth.SysAdminPassword),
+		DatabaseName: "defaultdb",
+	})
+	require.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"sessionid": resp.GetSessionID()}))
+
+	_, err = bs.Server.Srv.CreateRole(ctx, &schema.CreateRoleRequest{
+		Name:       "dbadmin",
+		Privileges: []*schema.RolePrivilege{{Privilege: string(auth.PrivilegeAdmin)}},
+	})
+	require.NoError(t, err)
+
+	_, err = bs.Server.Srv.CreateUser(ctx, &schema.CreateUserRequest{
+		User:       []byte("dave"),
+		Password:   []byte("Dave1234!"),
+		Permission: auth.PermissionR,
+		Database:   "defaultdb",
+	})
+	require.NoError(t, err)
+
+	_, err = bs.Server.Srv.ChangeRole(ctx, &schema.ChangeRoleRequest{
+		Action:   schema.PermissionAction_GRANT,
+		Username: "dave",
+		Database: "defaultdb",
+		Role:     "dbadmin",
+	})
+	require.NoError(t, err)
+
+	db, err := sql.Open("postgres", fmt.Sprintf("host=localhost port=%d sslmode=disable user=dave dbname=defaultdb password=Dave1234!", bs.Server.Srv.PgsqlSrv.GetPort()))
+	require.NoError(t, err)
+	defer db.Close()
+
+	table := getRandomTableName()
+
+	// a role with the admin privilege grants the same rights over pgsql and grpc
+	_, err = db.Exec(fmt.Sprintf("CREATE TABLE %s (id INTEGER, PRIMARY KEY id)", table))
+	require.NoError(t, err)
+
+	_, err = db.Exec(fmt.Sprintf("GRANT SELECT ON %s TO analytics", table))
+	require.NoError(t, err)
+
+	_, err = db.Exec(fmt.Sprintf("SELECT id FROM %s", table))
+	require.NoError(t, err)
+}
@@ -27,6 +27,7 @@ import (
 
 	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/database"
 	pserr "github.com/codenotary/immudb/pkg/pgsql/errors"
 	bm "github.com/codenotary/immudb/pkg/pgsql/server/bmessages"
 	fm "github.com/codenotary/immudb/pkg/pgsql/server/fmessages"
@@ -37,6 +38,11 @@ func (s *session) QueriesMachine(ctx context.Context) (err error) {
 	s.Lock()
 	defer s.Unlock()
 
+	if s.sqlUser != nil {
+		// privileges granted on tables are enforced by the database
+		ctx = database.WithSQLUser(ctx, s.sqlUser)
+	}
+
 	var waitForSync = false
 
 	if _, err = s.writeMessage(bm.ReadyForQuery()); err != nil {
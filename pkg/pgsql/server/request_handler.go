@@ -22,7 +22,7 @@ import (
 )
 
 func (s *srv) handleRequest(conn net.Conn) (err error) {
-	ss := s.SessionFactory.NewSession(conn, s.Logger, s.sysDb, s.tlsConfig)
+	ss := s.SessionFactory.NewSession(conn, s.Logger, s.sysDb, s.tlsConfig, s.jwtValidator, s.ldapAuthenticator, s.sqlUserResolver)
 
 	// initialize session
 	err = ss.InitializeSession()
@@ -24,23 +24,27 @@ import (
 	"os"
 	"sync"
 
+	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/database"
 	"github.com/codenotary/immudb/pkg/logger"
 	"golang.org/x/net/netutil"
 )
 
 type srv struct {
-	m              sync.RWMutex
-	running        bool
-	maxConnections int
-	tlsConfig      *tls.Config
-	SessionFactory SessionFactory
-	Logger         logger.Logger
-	Address        string
-	Port           int
-	dbList         database.DatabaseList
-	sysDb          database.DB
-	listener       net.Listener
+	m                 sync.RWMutex
+	running           bool
+	maxConnections    int
+	tlsConfig         *tls.Config
+	SessionFactory    SessionFactory
+	Logger            logger.Logger
+	Address           string
+	Port              int
+	dbList            database.DatabaseList
+	sysDb             database.DB
+	jwtValidator      *auth.JWTValidator
+	ldapAuthenticator *auth.LDAPAuthenticator
+	sqlUserResolver   SQLUserResolver
+	listener          net.Listener
 }
 
 type Server interface {
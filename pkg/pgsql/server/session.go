@@ -20,9 +20,13 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	goerrors "errors"
+	"fmt"
 	"net"
+	"strings"
 	"sync"
 
+	"github.com/codenotary/immudb/embedded/store"
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/database"
@@ -34,16 +38,20 @@ import (
 )
 
 type session struct {
-	tlsConfig       *tls.Config
-	log             logger.Logger
-	mr              MessageReader
-	username        string
-	database        database.DB
-	sysDb           database.DB
-	connParams      map[string]string
-	protocolVersion string
-	portals         map[string]*portal
-	statements      map[string]*statement
+	tlsConfig         *tls.Config
+	log               logger.Logger
+	mr                MessageReader
+	username          string
+	sqlUser           *database.SQLUser
+	database          database.DB
+	sysDb             database.DB
+	jwtValidator      *auth.JWTValidator
+	ldapAuthenticator *auth.LDAPAuthenticator
+	sqlUserResolver   SQLUserResolver
+	connParams        map[string]string
+	protocolVersion   string
+	portals           map[string]*portal
+	statements        map[string]*statement
 	sync.Mutex
 }
 
@@ -54,14 +62,17 @@ type Session interface {
 	ErrorHandle(err error)
 }
 
-func NewSession(c net.Conn, log logger.Logger, sysDb database.DB, tlsConfig *tls.Config) *session {
+func NewSession(c net.Conn, log logger.Logger, sysDb database.DB, tlsConfig *tls.Config, jwtValidator *auth.JWTValidator, ldapAuthenticator *auth.LDAPAuthenticator, sqlUserResolver SQLUserResolver) *session {
 	s := &session{
-		tlsConfig:  tlsConfig,
-		log:        log,
-		mr:         NewMessageReader(c),
-		sysDb:      sysDb,
-		portals:    make(map[string]*portal),
-		statements: make(map[string]*statement),
+		tlsConfig:         tlsConfig,
+		log:               log,
+		mr:                NewMessageReader(c),
+		sysDb:             sysDb,
+		jwtValidator:      jwtValidator,
+		ldapAuthenticator: ldapAuthenticator,
+		sqlUserResolver:   sqlUserResolver,
+		portals:           make(map[string]*portal),
+		statements:        make(map[string]*statement),
 	}
 	return s
 }
@@ -154,3 +165,79 @@ func (s *session) getUser(username []byte) (*auth.User, error) {
 
 	return &usr, nil
 }
+
+// authenticate verifies the password of the connecting user, the password may also be
+// a token issued by an external identity provider or be verified against an ldap directory
+func (s *session) authenticate(password string) (*auth.User, error) {
+	if s.jwtValidator != nil && auth.IsJWT(password) {
+		jwtUser, err := s.jwtValidator.Validate(password)
+		if err != nil {
+			return nil, err
+		}
+		if jwtUser.Username != s.username {
+			return nil, fmt.Errorf("%w: token issued to a different user", auth.ErrInvalidJWT)
+		}
+		return s.getExternalUser(jwtUser)
+	}
+
+	usr, err := s.getUser([]byte(s.username))
+
+	// local users, like the immudb sysadmin, are only authenticated against the local store,
+	// the directory only authenticates users without a local account
+	if s.ldapAuthenticator != nil && s.username != auth.SysAdminUsername && goerrors.Is(err, store.ErrKeyNotFound) {
+		ldapUser, err := s.ldapAuthenticator.Authenticate(s.username, password)
+		if err != nil {
+			if !goerrors.Is(err, auth.ErrLDAPUserNotFound) && !goerrors.Is(err, auth.ErrInvalidLDAPCredentials) {
+				s.log.Warningf("ldap authentication of user '%s' failed: %v", s.username, err)
+			}
+			return nil, err
+		}
+		return s.getExternalUser(ldapUser)
+	}
+	if err != nil {
+		if strings.Contains(err.Error(), "key not found") {
+			return nil, errors.ErrUsernameNotFound
+		}
+		return nil, err
+	}
+	if err := usr.ComparePasswords([]byte(password)); err != nil {
+		return nil, err
+	}
+
+	return usr, nil
+}
+
+// resolveSQLUser returns the user on behalf of whom the SQL statements of the session are executed
+func (s *session) resolveSQLUser(usr *auth.User) (*database.SQLUser, error) {
+	if s.sqlUserResolver == nil {
+		return &database.SQLUser{Name: usr.Username}, nil
+	}
+
+	return s.sqlUserResolver(context.Background(), usr, s.database.GetName())
+}
+
+// getExternalUser returns the user authenticated by an external identity provider, with the permissions
+// mapped from the provider groups. External identities are never merged with local users: usernames of
+// local users, like the immudb sysadmin, are rejected. External users must be granted a permission or a
+// role on the selected database
+func (s *session) getExternalUser(externalUser *auth.User) (*auth.User, error) {
+	if externalUser.Username == auth.SysAdminUsername {
+		return nil, auth.ErrLocalUsername
+	}
+
+	_, err := s.getUser([]byte(externalUser.Username))
+	if err == nil {
+		return nil, auth.ErrLocalUsername
+	}
+	if !goerrors.Is(err, store.ErrKeyNotFound) {
+		return nil, err
+	}
+
+	db := s.database.GetName()
+
+	if !externalUser.IsSysAdmin && externalUser.WhichPermission(db) == auth.PermissionNone && len(externalUser.WhichRoles(db)) == 0 {
+		return nil, errors.ErrNoDatabasePermission
+	}
+
+	return externalUser, nil
+}
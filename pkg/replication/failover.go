@@ -0,0 +1,551 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/logger"
+)
+
+var ErrNoQuorum = errors.New("not enough replicas reachable to safely determine committed transactions")
+var ErrNoReplicaAvailable = errors.New("no replica available to be promoted")
+var ErrFailoverIncomplete = errors.New("failover incomplete")
+
+const DefaultFailoverConnectTimeout = 5 * time.Second
+
+// FailoverNode identifies a database hosted by an immudb server i.e. db@host:port
+type FailoverNode struct {
+	Database string
+	Host     string
+	Port     int
+}
+
+// ParseFailoverNode parses a node in the form db@host:port
+func ParseFailoverNode(s string) (*FailoverNode, error) {
+	at := strings.Index(s, "@")
+	if at <= 0 {
+		return nil, fmt.Errorf("%w: node '%s' must be specified as db@host:port", ErrIllegalArguments, s)
+	}
+
+	db, address := s[:at], s[at+1:]
+
+	i := strings.LastIndex(address, ":")
+	if i <= 0 {
+		return nil, fmt.Errorf("%w: node '%s' must be specified as db@host:port", ErrIllegalArguments, s)
+	}
+
+	port, err := strconv.Atoi(address[i+1:])
+	if err != nil || port <= 0 {
+		return nil, fmt.Errorf("%w: invalid port in node '%s'", ErrIllegalArguments, s)
+	}
+
+	return &FailoverNode{
+		Database: db,
+		Host:     address[:i],
+		Port:     port,
+	}, nil
+}
+
+func (n *FailoverNode) String() string {
+	return fullAddress(n.Database, n.Host, n.Port)
+}
+
+type FailoverOptions struct {
+	primary  *FailoverNode
+	replicas []*FailoverNode
+
+	syncAcks int
+
+	username string
+	password string
+
+	mTLs        bool
+	mTLsOptions client.MTLsOptions
+
+	replicationUsername string
+	replicationPassword string
+
+	replicationTLS           bool
+	replicationTLSServerName string
+	replicationTLSCACert     string
+	replicationTLSCert       string
+	replicationTLSKey        string
+
+	connectTimeout time.Duration
+
+	force bool
+}
+
+func DefaultFailoverOptions() *FailoverOptions {
+	return &FailoverOptions{
+		connectTimeout: DefaultFailoverConnectTimeout,
+	}
+}
+
+func (opts *FailoverOptions) Validate() error {
+	if opts == nil {
+		return fmt.Errorf("%w: nil options", ErrInvalidOptions)
+	}
+
+	if len(opts.replicas) == 0 {
+		return fmt.Errorf("%w: no replicas specified", ErrInvalidOptions)
+	}
+
+	if opts.syncAcks <= 0 || opts.syncAcks > len(opts.replicas) {
+		return fmt.Errorf("%w: invalid SyncAcks", ErrInvalidOptions)
+	}
+
+	if opts.connectTimeout <= 0 {
+		return fmt.Errorf("%w: invalid ConnectTimeout", ErrInvalidOptions)
+	}
+
+	return nil
+}
+
+// WithPrimary sets the failed primary, it's fenced when still reachable
+func (o *FailoverOptions) WithPrimary(primary *FailoverNode) *FailoverOptions {
+	o.primary = primary
+	return o
+}
+
+// WithReplicas sets the replicas of the failed primary, one of them gets promoted
+func (o *FailoverOptions) WithReplicas(replicas []*FailoverNode) *FailoverOptions {
+	o.replicas = replicas
+	return o
+}
+
+// WithSyncAcks sets the number of confirmations the failed primary was waiting for before committing
+func (o *FailoverOptions) WithSyncAcks(syncAcks int) *FailoverOptions {
+	o.syncAcks = syncAcks
+	return o
+}
+
+// WithUsername sets the username used to connect to every node
+func (o *FailoverOptions) WithUsername(username string) *FailoverOptions {
+	o.username = username
+	return o
+}
+
+// WithPassword sets the password used to connect to every node
+func (o *FailoverOptions) WithPassword(password string) *FailoverOptions {
+	o.password = password
+	return o
+}
+
+// WithMTLs enables TLS on the connections to the nodes
+func (o *FailoverOptions) WithMTLs(mTLs bool) *FailoverOptions {
+	o.mTLs = mTLs
+	return o
+}
+
+// WithMTLsOptions sets the TLS settings used to connect to the nodes
+func (o *FailoverOptions) WithMTLsOptions(mTLsOptions client.MTLsOptions) *FailoverOptions {
+	o.mTLsOptions = mTLsOptions
+	return o
+}
+
+// WithReplicationUsername sets the username replicas use to connect to the promoted database.
+// Previous replication credentials are kept when not provided
+func (o *FailoverOptions) WithReplicationUsername(replicationUsername string) *FailoverOptions {
+	o.replicationUsername = replicationUsername
+	return o
+}
+
+// WithReplicationPassword sets the password replicas use to connect to the promoted database
+func (o *FailoverOptions) WithReplicationPassword(replicationPassword string) *FailoverOptions {
+	o.replicationPassword = replicationPassword
+	return o
+}
+
+// WithReplicationTLS enables TLS on the connections of replicas to the promoted database.
+// When not enabled, the TLS settings the promoted replica used to connect to the failed primary are reused
+func (o *FailoverOptions) WithReplicationTLS(replicationTLS bool) *FailoverOptions {
+	o.replicationTLS = replicationTLS
+	return o
+}
+
+// WithReplicationTLSServerName sets the server name replicas use to verify the certificate of the promoted database
+func (o *FailoverOptions) WithReplicationTLSServerName(serverName string) *FailoverOptions {
+	o.replicationTLSServerName = serverName
+	return o
+}
+
+// WithReplicationTLSCACert sets the CA certificate chain replicas use to verify the certificate of the promoted database
+func (o *FailoverOptions) WithReplicationTLSCACert(caCert string) *FailoverOptions {
+	o.replicationTLSCACert = caCert
+	return o
+}
+
+// WithReplicationTLSCert sets the client certificate replicas present to the promoted database
+func (o *FailoverOptions) WithReplicationTLSCert(cert string) *FailoverOptions {
+	o.replicationTLSCert = cert
+	return o
+}
+
+// WithReplicationTLSKey sets the private key of the client certificate replicas present to the promoted database
+func (o *FailoverOptions) WithReplicationTLSKey(key string) *FailoverOptions {
+	o.replicationTLSKey = key
+	return o
+}
+
+// WithConnectTimeout sets the time to wait for a node before considering it unreachable
+func (o *FailoverOptions) WithConnectTimeout(connectTimeout time.Duration) *FailoverOptions {
+	o.connectTimeout = connectTimeout
+	return o
+}
+
+// WithForce allows promoting a replica even when committed transactions can not be determined,
+// some of them may be lost
+func (o *FailoverOptions) WithForce(force bool) *FailoverOptions {
+	o.force = force
+	return o
+}
+
+// FailoverReport describes the outcome of a failover
+type FailoverReport struct {
+	NewPrimary    *FailoverNode
+	CommittedTxID uint64
+	DiscardedTxs  uint64
+
+	ReplicationEpoch uint64
+
+	PrimaryFenced bool
+
+	Repointed   []*FailoverNode
+	Unreachable []*FailoverNode
+	Failed      []*FailoverNode
+}
+
+type failoverCandidate struct {
+	node     *FailoverNode
+	client   client.ImmuClient
+	state    *schema.ImmutableState
+	settings *schema.ReplicationNullableSettings
+}
+
+// FailoverCoordinator promotes the most up-to-date replica of a failed primary.
+// Precommitted transactions not confirmed by a quorum of replicas are discarded,
+// then remaining replicas are repointed to the promoted database.
+type FailoverCoordinator struct {
+	opts   *FailoverOptions
+	logger logger.Logger
+}
+
+func NewFailoverCoordinator(opts *FailoverOptions, logger logger.Logger) (*FailoverCoordinator, error) {
+	if logger == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	err := opts.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &FailoverCoordinator{
+		opts:   opts,
+		logger: logger,
+	}, nil
+}
+
+func (fc *FailoverCoordinator) connect(ctx context.Context, node *FailoverNode) (client.ImmuClient, error) {
+	opts := client.DefaultOptions().
+		WithAddress(node.Host).
+		WithPort(node.Port).
+		WithDisableIdentityCheck(true).
+		WithMTLs(fc.opts.mTLs).
+		WithMTLsOptions(fc.opts.mTLsOptions)
+
+	cli := client.NewClient().WithOptions(opts)
+
+	ctx, cancel := context.WithTimeout(ctx, fc.opts.connectTimeout)
+	defer cancel()
+
+	err := cli.OpenSession(ctx, []byte(fc.opts.username), []byte(fc.opts.password), node.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	return cli, nil
+}
+
+// updateReplicationSettings returns the replication settings of the node once they are updated
+func (fc *FailoverCoordinator) updateReplicationSettings(ctx context.Context, cli client.ImmuClient, node *FailoverNode, settings *schema.ReplicationNullableSettings) (*schema.ReplicationNullableSettings, error) {
+	res, err := cli.UpdateDatabaseV2(ctx, node.Database, &schema.DatabaseNullableSettings{
+		ReplicationSettings: settings,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.GetSettings().GetReplicationSettings(), nil
+}
+
+// fence turns the failed primary into a replica without primary so it can no longer commit transactions.
+// Its replication epoch is increased so that it's refused as primary by the promoted database
+func (fc *FailoverCoordinator) fence(ctx context.Context, cli client.ImmuClient, node *FailoverNode) (uint64, error) {
+	res, err := cli.GetDatabaseSettingsV2(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	epoch := res.GetSettings().GetReplicationSettings().GetReplicationEpoch().GetValue() + 1
+
+	settings, err := fc.updateReplicationSettings(ctx, cli, node, &schema.ReplicationNullableSettings{
+		Replica:          &schema.NullableBool{Value: true},
+		SyncReplication:  &schema.NullableBool{Value: true},
+		ReplicationEpoch: &schema.NullableUint64{Value: epoch},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return settings.GetReplicationEpoch().GetValue(), nil
+}
+
+// detach stops replication from the failed primary, thus no further confirmations are provided to it.
+// Replication settings of the node are returned once updated
+func (fc *FailoverCoordinator) detach(ctx context.Context, cli client.ImmuClient, node *FailoverNode) (*schema.ReplicationNullableSettings, error) {
+	return fc.updateReplicationSettings(ctx, cli, node, &schema.ReplicationNullableSettings{
+		Replica:     &schema.NullableBool{Value: true},
+		PrimaryHost: &schema.NullableString{Value: ""},
+	})
+}
+
+// replicationTLSSettings returns the TLS settings used by repointed nodes to connect to the promoted database.
+// When TLS is not configured, the settings the promoted replica used to connect to the failed primary are reused,
+// except for the server name which identified the failed primary
+func (fc *FailoverCoordinator) replicationTLSSettings(promoted *schema.ReplicationNullableSettings) *schema.ReplicationNullableSettings {
+	if fc.opts.replicationTLS {
+		return &schema.ReplicationNullableSettings{
+			PrimaryTLS:           &schema.NullableBool{Value: true},
+			PrimaryTLSServerName: &schema.NullableString{Value: fc.opts.replicationTLSServerName},
+			PrimaryTLSCACert:     &schema.NullableString{Value: fc.opts.replicationTLSCACert},
+			PrimaryTLSCert:       &schema.NullableString{Value: fc.opts.replicationTLSCert},
+			PrimaryTLSKey:        &schema.NullableString{Value: fc.opts.replicationTLSKey},
+		}
+	}
+
+	if !promoted.GetPrimaryTLS().GetValue() {
+		return nil
+	}
+
+	return &schema.ReplicationNullableSettings{
+		PrimaryTLS:           &schema.NullableBool{Value: true},
+		PrimaryTLSServerName: &schema.NullableString{Value: ""},
+		PrimaryTLSCACert:     &schema.NullableString{Value: promoted.GetPrimaryTLSCACert().GetValue()},
+		PrimaryTLSCert:       &schema.NullableString{Value: promoted.GetPrimaryTLSCert().GetValue()},
+		PrimaryTLSKey:        &schema.NullableString{Value: promoted.GetPrimaryTLSKey().GetValue()},
+	}
+}
+
+func (fc *FailoverCoordinator) repoint(ctx context.Context, cli client.ImmuClient, node, primary *FailoverNode, syncReplication bool, epoch uint64, tlsSettings *schema.ReplicationNullableSettings) error {
+	settings := &schema.ReplicationNullableSettings{
+		Replica:           &schema.NullableBool{Value: true},
+		SyncReplication:   &schema.NullableBool{Value: syncReplication},
+		PrimaryDatabase:   &schema.NullableString{Value: primary.Database},
+		PrimaryHost:       &schema.NullableString{Value: primary.Host},
+		PrimaryPort:       &schema.NullableUint32{Value: uint32(primary.Port)},
+		AllowTxDiscarding: &schema.NullableBool{Value: true},
+		ReplicationEpoch:  &schema.NullableUint64{Value: epoch},
+	}
+
+	if fc.opts.replicationUsername != "" {
+		settings.PrimaryUsername = &schema.NullableString{Value: fc.opts.replicationUsername}
+		settings.PrimaryPassword = &schema.NullableString{Value: fc.opts.replicationPassword}
+	}
+
+	if tlsSettings != nil {
+		settings.PrimaryTLS = tlsSettings.PrimaryTLS
+		settings.PrimaryTLSServerName = tlsSettings.PrimaryTLSServerName
+		settings.PrimaryTLSCACert = tlsSettings.PrimaryTLSCACert
+		settings.PrimaryTLSCert = tlsSettings.PrimaryTLSCert
+		settings.PrimaryTLSKey = tlsSettings.PrimaryTLSKey
+	}
+
+	_, err := fc.updateReplicationSettings(ctx, cli, node, settings)
+	return err
+}
+
+// Run performs the failover:
+//   - the failed primary is fenced when still reachable
+//   - reachable replicas stop replicating from the failed primary
+//   - the replica with the highest precommitted transaction is promoted, transactions not confirmed by a quorum are discarded
+//   - the promoted database starts a new replication epoch, a failed primary not fenced here gets fenced
+//     as soon as a replica of the new epoch connects to it and it holds writes after restarting
+//   - the remaining replicas and the fenced primary are repointed to the promoted database,
+//     using the same replication TLS settings
+func (fc *FailoverCoordinator) Run(ctx context.Context) (*FailoverReport, error) {
+	report := &FailoverReport{}
+
+	var primaryClient client.ImmuClient
+
+	// highest replication epoch among reachable nodes
+	var epoch uint64
+
+	if fc.opts.primary != nil {
+		cli, err := fc.connect(ctx, fc.opts.primary)
+		if err == nil {
+			defer cli.CloseSession(context.Background())
+
+			epoch, err = fc.fence(ctx, cli, fc.opts.primary)
+		}
+		if err == nil {
+			fc.logger.Infof("primary '%s' successfully fenced", fc.opts.primary)
+
+			primaryClient = cli
+			report.PrimaryFenced = true
+		} else {
+			fc.logger.Warningf("primary '%s' could not be fenced. Reason: %v", fc.opts.primary, err)
+		}
+	}
+
+	var candidates []*failoverCandidate
+
+	for _, node := range fc.opts.replicas {
+		cli, err := fc.connect(ctx, node)
+		if err != nil {
+			fc.logger.Warningf("replica '%s' is not reachable. Reason: %v", node, err)
+			report.Unreachable = append(report.Unreachable, node)
+			continue
+		}
+		defer cli.CloseSession(context.Background())
+
+		settings, err := fc.detach(ctx, cli, node)
+		if err != nil {
+			fc.logger.Warningf("replica '%s' could not be detached from primary. Reason: %v", node, err)
+			report.Unreachable = append(report.Unreachable, node)
+			continue
+		}
+
+		state, err := cli.CurrentState(ctx)
+		if err != nil {
+			fc.logger.Warningf("state of replica '%s' could not be retrieved. Reason: %v", node, err)
+			report.Unreachable = append(report.Unreachable, node)
+			continue
+		}
+
+		fc.logger.Infof("replica '%s' committed up to tx %d and precommitted up to tx %d", node, state.TxId, state.PrecommittedTxId)
+
+		candidates = append(candidates, &failoverCandidate{
+			node:     node,
+			client:   cli,
+			state:    state,
+			settings: settings,
+		})
+
+		if settings.GetReplicationEpoch().GetValue() > epoch {
+			epoch = settings.GetReplicationEpoch().GetValue()
+		}
+	}
+
+	if len(candidates) == 0 {
+		return report, ErrNoReplicaAvailable
+	}
+
+	// every committed transaction was confirmed by syncAcks replicas,
+	// it's precommitted by at least quorum of the reachable ones
+	quorum := fc.opts.syncAcks - len(report.Unreachable)
+
+	if quorum <= 0 {
+		if !fc.opts.force {
+			return report, fmt.Errorf("%w: %d replicas unreachable while %d confirmations were required",
+				ErrNoQuorum, len(report.Unreachable), fc.opts.syncAcks)
+		}
+
+		fc.logger.Warningf("promoting replica without quorum, committed transactions may be lost")
+
+		quorum = 1
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].state.PrecommittedTxId > candidates[j].state.PrecommittedTxId
+	})
+
+	chosen := candidates[0]
+
+	quorumTxID := candidates[quorum-1].state.PrecommittedTxId
+	quorumAlh := candidates[quorum-1].state.PrecommittedTxHash
+
+	if quorumTxID < chosen.state.TxId {
+		quorumTxID = chosen.state.TxId
+		quorumAlh = chosen.state.TxHash
+	}
+
+	targets := append([]*failoverCandidate{}, candidates[1:]...)
+
+	if primaryClient != nil {
+		targets = append(targets, &failoverCandidate{node: fc.opts.primary, client: primaryClient})
+	}
+
+	syncAcks := fc.opts.syncAcks
+	if syncAcks > len(targets) {
+		syncAcks = len(targets)
+	}
+
+	fc.logger.Infof("promoting replica '%s' up to tx %d...", chosen.node, quorumTxID)
+
+	res, err := chosen.client.PromoteReplica(ctx, &schema.PromoteReplicaRequest{
+		Database:         chosen.node.Database,
+		PrecommittedTxID: quorumTxID,
+		PrecommittedAlh:  quorumAlh,
+		SyncReplication:  syncAcks > 0,
+		SyncAcks:         uint32(syncAcks),
+		ReplicationEpoch: epoch + 1,
+	})
+	if err != nil {
+		return report, fmt.Errorf("%w: while promoting replica '%s'", err, chosen.node)
+	}
+
+	report.NewPrimary = chosen.node
+	report.CommittedTxID = res.CommittedTxID
+	report.DiscardedTxs = res.DiscardedTxs
+
+	report.ReplicationEpoch = res.ReplicationEpoch
+
+	fc.logger.Infof("replica '%s' promoted on replication epoch %d, %d precommitted transactions discarded",
+		chosen.node, res.ReplicationEpoch, res.DiscardedTxs)
+
+	tlsSettings := fc.replicationTLSSettings(chosen.settings)
+
+	for _, t := range targets {
+		err = fc.repoint(ctx, t.client, t.node, chosen.node, syncAcks > 0, res.ReplicationEpoch, tlsSettings)
+		if err != nil {
+			fc.logger.Errorf("'%s' could not be repointed to '%s'. Reason: %v", t.node, chosen.node, err)
+			report.Failed = append(report.Failed, t.node)
+			continue
+		}
+
+		fc.logger.Infof("'%s' repointed to '%s'", t.node, chosen.node)
+
+		report.Repointed = append(report.Repointed, t.node)
+	}
+
+	if len(report.Failed) > 0 {
+		return report, fmt.Errorf("%w: %d nodes could not be repointed", ErrFailoverIncomplete, len(report.Failed))
+	}
+
+	return report, nil
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replication
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFailoverNode(t *testing.T) {
+	node, err := ParseFailoverNode("defaultdb@127.0.0.1:3322")
+	require.NoError(t, err)
+	require.Equal(t, &FailoverNode{Database: "defaultdb", Host: "127.0.0.1", Port: 3322}, node)
+	require.Equal(t, "defaultdb@127.0.0.1:3322", node.String())
+
+	for _, s := range []string{"", "defaultdb", "@127.0.0.1:3322", "defaultdb@127.0.0.1", "defaultdb@:3322", "defaultdb@127.0.0.1:port", "defaultdb@127.0.0.1:0"} {
+		_, err = ParseFailoverNode(s)
+		require.ErrorIs(t, err, ErrIllegalArguments, s)
+	}
+}
+
+func TestFailoverOptions(t *testing.T) {
+	var nilOpts *FailoverOptions
+	require.ErrorIs(t, nilOpts.Validate(), ErrInvalidOptions)
+
+	opts := DefaultFailoverOptions()
+	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
+
+	replicas := []*FailoverNode{
+		{Database: "replicadb", Host: "replica1", Port: 3322},
+		{Database: "replicadb", Host: "replica2", Port: 3322},
+	}
+
+	opts.WithReplicas(replicas)
+	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
+
+	opts.WithSyncAcks(3)
+	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
+
+	opts.WithSyncAcks(1).WithConnectTimeout(0)
+	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
+
+	opts.WithPrimary(&FailoverNode{Database: "defaultdb", Host: "primary", Port: 3322}).
+		WithUsername("immudb").
+		WithPassword("immudb").
+		WithMTLs(true).
+		WithReplicationUsername("replicator").
+		WithReplicationPassword("replicator1Pwd!").
+		WithConnectTimeout(time.Second).
+		WithForce(true)
+
+	require.NoError(t, opts.Validate())
+	require.Equal(t, "primary", opts.primary.Host)
+	require.Equal(t, replicas, opts.replicas)
+	require.Equal(t, 1, opts.syncAcks)
+	require.Equal(t, "immudb", opts.username)
+	require.Equal(t, "immudb", opts.password)
+	require.True(t, opts.mTLs)
+	require.Equal(t, "replicator", opts.replicationUsername)
+	require.Equal(t, "replicator1Pwd!", opts.replicationPassword)
+	require.Equal(t, time.Second, opts.connectTimeout)
+	require.True(t, opts.force)
+
+	_, err := NewFailoverCoordinator(opts, nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = NewFailoverCoordinator(DefaultFailoverOptions(), logger.NewMemoryLogger())
+	require.ErrorIs(t, err, ErrInvalidOptions)
+
+	fc, err := NewFailoverCoordinator(opts, logger.NewMemoryLogger())
+	require.NoError(t, err)
+	require.NotNil(t, fc)
+}
+
+func TestFailoverReplicationTLSSettings(t *testing.T) {
+	opts := DefaultFailoverOptions().
+		WithReplicas([]*FailoverNode{{Database: "replicadb", Host: "replica1", Port: 3322}}).
+		WithSyncAcks(1)
+
+	fc, err := NewFailoverCoordinator(opts, logger.NewMemoryLogger())
+	require.NoError(t, err)
+
+	promoted := &schema.ReplicationNullableSettings{
+		PrimaryTLS:           &schema.NullableBool{Value: true},
+		PrimaryTLSServerName: &schema.NullableString{Value: "primary"},
+		PrimaryTLSCACert:     &schema.NullableString{Value: "ca.pem"},
+		PrimaryTLSCert:       &schema.NullableString{Value: "replica.pem"},
+		PrimaryTLSKey:        &schema.NullableString{Value: "replica.key"},
+	}
+
+	t.Run("settings of the promoted replica should be reused", func(t *testing.T) {
+		settings := fc.replicationTLSSettings(promoted)
+		require.True(t, settings.PrimaryTLS.Value)
+		require.Empty(t, settings.PrimaryTLSServerName.Value)
+		require.Equal(t, "ca.pem", settings.PrimaryTLSCACert.Value)
+		require.Equal(t, "replica.pem", settings.PrimaryTLSCert.Value)
+		require.Equal(t, "replica.key", settings.PrimaryTLSKey.Value)
+
+		require.Nil(t, fc.replicationTLSSettings(&schema.ReplicationNullableSettings{}))
+		require.Nil(t, fc.replicationTLSSettings(nil))
+	})
+
+	t.Run("provided settings should take precedence", func(t *testing.T) {
+		opts.WithReplicationTLS(true).
+			WithReplicationTLSServerName("promoted").
+			WithReplicationTLSCACert("other-ca.pem").
+			WithReplicationTLSCert("other.pem").
+			WithReplicationTLSKey("other.key")
+
+		settings := fc.replicationTLSSettings(promoted)
+		require.True(t, settings.PrimaryTLS.Value)
+		require.Equal(t, "promoted", settings.PrimaryTLSServerName.Value)
+		require.Equal(t, "other-ca.pem", settings.PrimaryTLSCACert.Value)
+		require.Equal(t, "other.pem", settings.PrimaryTLSCert.Value)
+		require.Equal(t, "other.key", settings.PrimaryTLSKey.Value)
+	})
+}
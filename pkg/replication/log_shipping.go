@@ -0,0 +1,96 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replication
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/backup"
+)
+
+// fetchShippedTxs replicates the transactions shipped by the primary into the log shipping storage.
+// Shipped segments are verified and must be linked to the last replicated transaction before
+// any of their transactions is applied
+func (txr *TxReplicator) fetchShippedTxs() error {
+	storage := txr.opts.logShippingStorage
+
+	catalog, err := backup.ReadCatalog(txr.context, storage, txr.opts.primaryDatabase)
+	if err != nil {
+		return err
+	}
+
+	txr.setState(StateReplicating)
+
+	state, err := txr.db.CurrentState()
+	if err != nil {
+		return err
+	}
+
+	if catalog.LastTx() == state.TxId {
+		// no new transaction has been shipped
+		return txr.waitForShippedTxs()
+	}
+
+	var alh [sha256.Size]byte
+	copy(alh[:], state.TxHash)
+
+	lastTxID, err := backup.Restore(
+		txr.context,
+		storage,
+		txr.opts.primaryDatabase,
+		txr.replicateShippedTx,
+		backup.DefaultRestoreOptions().WithLastTx(state.TxId, alh),
+	)
+	if errors.Is(err, backup.ErrDatabaseDiverged) {
+		txr.logger.Errorf("replica '%s' diverged from the transactions shipped from '%s': %s", txr.db.GetName(), txr._primaryDB, err.Error())
+		return ErrReplicaDivergedFromPrimary
+	}
+	if err != nil {
+		return err
+	}
+
+	txr.logger.Debugf("shipped transactions %d..%d replicated into '%s'", state.TxId+1, lastTxID, txr.db.GetName())
+
+	return nil
+}
+
+func (txr *TxReplicator) replicateShippedTx(ctx context.Context, exportedTx []byte) (*schema.TxHeader, error) {
+	return txr.db.ReplicateTx(ctx, exportedTx, txr.skipIntegrityCheck, txr.waitForIndexing)
+}
+
+// waitForShippedTxs pauses until the log shipping storage has to be checked again
+func (txr *TxReplicator) waitForShippedTxs() error {
+	timer := time.NewTimer(txr.opts.logShippingPollInterval)
+
+	select {
+	case <-txr.context.Done():
+		timer.Stop()
+		return ErrAlreadyStopped
+	case <-timer.C:
+		return nil
+	}
+}
+
+// logShippingAddress denotes the shipped database i.e. db@storage
+func logShippingAddress(db string, opts *Options) string {
+	return fmt.Sprintf("%s@%s", db, opts.logShippingStorage)
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replication
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/remotestorage/memory"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/backup"
+	"github.com/codenotary/immudb/pkg/database"
+	"github.com/codenotary/immudb/pkg/logger"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/require"
+)
+
+func addLogShippingTxs(t *testing.T, db database.DB, from, to int) {
+	for i := from; i < to; i++ {
+		_, err := db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{{
+			Key:   []byte(fmt.Sprintf("key_%d", i)),
+			Value: []byte(fmt.Sprintf("value_%d", i)),
+		}}})
+		require.NoError(t, err)
+	}
+}
+
+func TestLogShippingReplication(t *testing.T) {
+	logger := logger.NewSimpleLogger("logger", os.Stdout)
+
+	storage := memory.Open()
+
+	primaryDB, err := database.NewDB("primarydb", nil, database.DefaultOption().WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer primaryDB.Close()
+
+	replicaDB, err := database.NewDB("replicadb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer replicaDB.Close()
+
+	shipper := backup.NewBackup(primaryDB, storage, nil, time.Hour, logger).WithMaxSegmentTxs(3)
+
+	addLogShippingTxs(t, primaryDB, 0, 5)
+
+	_, err = shipper.Run(context.Background())
+	require.NoError(t, err)
+
+	rOpts := DefaultOptions().
+		WithPrimaryDatabase("primarydb").
+		WithLogShippingStorage(storage).
+		WithLogShippingPollInterval(10 * time.Millisecond)
+
+	txReplicator, err := NewTxReplicator(xid.New(), replicaDB, rOpts, logger)
+	require.NoError(t, err)
+	require.Contains(t, txReplicator.Status().Primary, "primarydb@memory")
+
+	err = txReplicator.Start()
+	require.NoError(t, err)
+
+	waitForTx := func(txID uint64) {
+		require.Eventually(t, func() bool {
+			state, err := replicaDB.CurrentState()
+			require.NoError(t, err)
+			return state.TxId == txID
+		}, 10*time.Second, 10*time.Millisecond)
+	}
+
+	waitForTx(5)
+	require.Equal(t, StateReplicating, txReplicator.Status().State)
+
+	t.Run("transactions shipped later should be replicated", func(t *testing.T) {
+		addLogShippingTxs(t, primaryDB, 5, 12)
+
+		_, err = shipper.Run(context.Background())
+		require.NoError(t, err)
+
+		waitForTx(12)
+
+		primaryState, err := primaryDB.CurrentState()
+		require.NoError(t, err)
+
+		replicaState, err := replicaDB.CurrentState()
+		require.NoError(t, err)
+		require.Equal(t, primaryState.TxHash, replicaState.TxHash)
+
+		entry, err := replicaDB.Get(context.Background(), &schema.KeyRequest{Key: []byte("key_11")})
+		require.NoError(t, err)
+		require.Equal(t, []byte("value_11"), entry.Value)
+	})
+
+	err = txReplicator.Stop()
+	require.NoError(t, err)
+	require.Equal(t, StateStopped, txReplicator.Status().State)
+	require.NoError(t, txReplicator.Status().LastError)
+}
+
+func TestLogShippingReplicationDiverged(t *testing.T) {
+	logger := logger.NewSimpleLogger("logger", os.Stdout)
+
+	storage := memory.Open()
+
+	primaryDB, err := database.NewDB("primarydb", nil, database.DefaultOption().WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer primaryDB.Close()
+
+	addLogShippingTxs(t, primaryDB, 0, 3)
+
+	_, err = backup.NewBackup(primaryDB, storage, nil, time.Hour, logger).Run(context.Background())
+	require.NoError(t, err)
+
+	// a database with unrelated transactions can not replicate the shipped ones
+	replicaDB, err := database.NewDB("replicadb", nil, database.DefaultOption().WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer replicaDB.Close()
+
+	_, err = replicaDB.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{{
+		Key:   []byte("other_key"),
+		Value: []byte("other_value"),
+	}}})
+	require.NoError(t, err)
+
+	replicaDB.AsReplica(true, false, 0)
+
+	rOpts := DefaultOptions().
+		WithPrimaryDatabase("primarydb").
+		WithLogShippingStorage(storage).
+		WithLogShippingPollInterval(10 * time.Millisecond)
+
+	txReplicator, err := NewTxReplicator(xid.New(), replicaDB, rOpts, logger)
+	require.NoError(t, err)
+
+	err = txReplicator.Start()
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return txReplicator.Status().State == StateStopped
+	}, 10*time.Second, 10*time.Millisecond)
+
+	require.ErrorIs(t, txReplicator.Status().LastError, ErrReplicaDivergedFromPrimary)
+}
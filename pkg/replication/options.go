@@ -19,6 +19,9 @@ package replication
 import (
 	"fmt"
 	"time"
+
+	"github.com/codenotary/immudb/embedded/remotestorage"
+	"github.com/codenotary/immudb/pkg/client"
 )
 
 const DefaultChunkSize int = 64 * 1024 // 64 * 1024 64 KiB
@@ -27,6 +30,7 @@ const DefaultReplicationCommitConcurrency int = 10
 const DefaultAllowTxDiscarding = false
 const DefaultSkipIntegrityCheck = false
 const DefaultWaitForIndexing = false
+const DefaultLogShippingPollInterval = time.Second
 
 type Options struct {
 	primaryDatabase string
@@ -35,6 +39,9 @@ type Options struct {
 	primaryUsername string
 	primaryPassword string
 
+	primaryTLS        bool
+	primaryTLSOptions client.MTLsOptions
+
 	streamChunkSize int
 
 	prefetchTxBufferSize         int
@@ -44,6 +51,14 @@ type Options struct {
 	skipIntegrityCheck bool
 	waitForIndexing    bool
 
+	// partial replication, only matching entries are replicated
+	keyPrefixes [][]byte
+	sqlTables   []string
+
+	// log shipping, transactions are fetched from a remote storage instead of the primary
+	logShippingStorage      remotestorage.Storage
+	logShippingPollInterval time.Duration
+
 	delayer Delayer
 }
 
@@ -63,6 +78,7 @@ func DefaultOptions() *Options {
 		allowTxDiscarding:            DefaultAllowTxDiscarding,
 		skipIntegrityCheck:           DefaultSkipIntegrityCheck,
 		waitForIndexing:              DefaultWaitForIndexing,
+		logShippingPollInterval:      DefaultLogShippingPollInterval,
 	}
 }
 
@@ -87,6 +103,20 @@ func (opts *Options) Validate() error {
 		return fmt.Errorf("%w: invalid Delayer", ErrInvalidOptions)
 	}
 
+	if opts.isLogShipping() {
+		if opts.primaryDatabase == "" {
+			return fmt.Errorf("%w: PrimaryDatabase is required on log shipping", ErrInvalidOptions)
+		}
+
+		if opts.logShippingPollInterval <= 0 {
+			return fmt.Errorf("%w: invalid LogShippingPollInterval", ErrInvalidOptions)
+		}
+
+		if opts.isPartial() {
+			return fmt.Errorf("%w: partial replication is not supported on log shipping", ErrInvalidOptions)
+		}
+	}
+
 	return nil
 }
 
@@ -120,6 +150,19 @@ func (o *Options) WithPrimaryPassword(primaryPassword string) *Options {
 	return o
 }
 
+// WithPrimaryTLS enables TLS on the connection to the source database
+func (o *Options) WithPrimaryTLS(primaryTLS bool) *Options {
+	o.primaryTLS = primaryTLS
+	return o
+}
+
+// WithPrimaryTLSOptions sets the server name, CA and client certificate used to connect to the source database.
+// Client certificate is only presented (mTLS) when both certificate and private key are provided
+func (o *Options) WithPrimaryTLSOptions(primaryTLSOptions client.MTLsOptions) *Options {
+	o.primaryTLSOptions = primaryTLSOptions
+	return o
+}
+
 // WithStreamChunkSize sets streaming chunk size
 func (o *Options) WithStreamChunkSize(streamChunkSize int) *Options {
 	o.streamChunkSize = streamChunkSize
@@ -156,6 +199,43 @@ func (o *Options) WithWaitForIndexing(waitForIndexing bool) *Options {
 	return o
 }
 
+// WithKeyPrefixes sets the prefixes of the keys to replicate.
+// Only matching key-value entries are replicated when key prefixes or SQL tables are specified (partial replication)
+func (o *Options) WithKeyPrefixes(keyPrefixes [][]byte) *Options {
+	o.keyPrefixes = keyPrefixes
+	return o
+}
+
+// WithSQLTables sets the names of the SQL tables to replicate.
+// Only rows of matching tables are replicated when key prefixes or SQL tables are specified (partial replication)
+func (o *Options) WithSQLTables(sqlTables []string) *Options {
+	o.sqlTables = sqlTables
+	return o
+}
+
+// isPartial returns true when only a subset of the entries is replicated
+func (o *Options) isPartial() bool {
+	return len(o.keyPrefixes) > 0 || len(o.sqlTables) > 0
+}
+
+// WithLogShippingStorage sets the remote storage where the primary ships its transactions.
+// Transactions are fetched from the storage instead of connecting to the primary when specified (log shipping)
+func (o *Options) WithLogShippingStorage(logShippingStorage remotestorage.Storage) *Options {
+	o.logShippingStorage = logShippingStorage
+	return o
+}
+
+// WithLogShippingPollInterval sets the time to wait before checking again for shipped transactions
+func (o *Options) WithLogShippingPollInterval(logShippingPollInterval time.Duration) *Options {
+	o.logShippingPollInterval = logShippingPollInterval
+	return o
+}
+
+// isLogShipping returns true when transactions are fetched from the log shipping storage
+func (o *Options) isLogShipping() bool {
+	return o.logShippingStorage != nil
+}
+
 // WithDelayer sets delayer used to pause re-attempts
 func (o *Options) WithDelayer(delayer Delayer) *Options {
 	o.delayer = delayer
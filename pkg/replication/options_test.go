@@ -20,6 +20,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/codenotary/immudb/embedded/remotestorage/memory"
+	"github.com/codenotary/immudb/pkg/client"
 	"github.com/stretchr/testify/require"
 )
 
@@ -27,6 +29,8 @@ func TestOptions(t *testing.T) {
 	opts := &Options{}
 	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
 
+	tlsOpts := client.DefaultMTLsOptions().WithServername("primary")
+
 	delayer := &expBackoff{
 		retryMinDelay: time.Second,
 		retryMaxDelay: 2 * time.Minute,
@@ -39,12 +43,16 @@ func TestOptions(t *testing.T) {
 		WithPrimaryPort(3322).
 		WithPrimaryUsername("immudbUsr").
 		WithPrimaryPassword("immdubPwd").
+		WithPrimaryTLS(true).
+		WithPrimaryTLSOptions(tlsOpts).
 		WithStreamChunkSize(DefaultChunkSize).
 		WithPrefetchTxBufferSize(DefaultPrefetchTxBufferSize).
 		WithReplicationCommitConcurrency(DefaultReplicationCommitConcurrency).
 		WithAllowTxDiscarding(true).
 		WithSkipIntegrityCheck(true).
 		WithWaitForIndexing(true).
+		WithKeyPrefixes([][]byte{[]byte("edge.")}).
+		WithSQLTables([]string{"sensors"}).
 		WithDelayer(delayer)
 
 	require.Equal(t, "defaultdb", opts.primaryDatabase)
@@ -52,12 +60,17 @@ func TestOptions(t *testing.T) {
 	require.Equal(t, 3322, opts.primaryPort)
 	require.Equal(t, "immudbUsr", opts.primaryUsername)
 	require.Equal(t, "immdubPwd", opts.primaryPassword)
+	require.True(t, opts.primaryTLS)
+	require.Equal(t, tlsOpts, opts.primaryTLSOptions)
 	require.Equal(t, DefaultChunkSize, opts.streamChunkSize)
 	require.Equal(t, DefaultPrefetchTxBufferSize, opts.prefetchTxBufferSize)
 	require.Equal(t, DefaultReplicationCommitConcurrency, opts.replicationCommitConcurrency)
 	require.True(t, opts.allowTxDiscarding)
 	require.True(t, opts.skipIntegrityCheck)
 	require.True(t, opts.waitForIndexing)
+	require.Equal(t, [][]byte{[]byte("edge.")}, opts.keyPrefixes)
+	require.Equal(t, []string{"sensors"}, opts.sqlTables)
+	require.True(t, opts.isPartial())
 	require.Equal(t, delayer, opts.delayer)
 
 	require.NoError(t, opts.Validate())
@@ -65,4 +78,29 @@ func TestOptions(t *testing.T) {
 	defaultOpts := DefaultOptions()
 	require.NotNil(t, defaultOpts)
 	require.NoError(t, defaultOpts.Validate())
+	require.False(t, defaultOpts.isPartial())
+}
+
+func TestLogShippingOptions(t *testing.T) {
+	storage := memory.Open()
+
+	opts := DefaultOptions().WithLogShippingStorage(storage)
+	require.True(t, opts.isLogShipping())
+	require.Equal(t, DefaultLogShippingPollInterval, opts.logShippingPollInterval)
+
+	// the shipped database must be specified
+	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
+
+	opts.WithPrimaryDatabase("defaultdb")
+	require.NoError(t, opts.Validate())
+
+	opts.WithLogShippingPollInterval(0)
+	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
+
+	opts.WithLogShippingPollInterval(100 * time.Millisecond)
+	require.Equal(t, 100*time.Millisecond, opts.logShippingPollInterval)
+	require.NoError(t, opts.Validate())
+
+	opts.WithKeyPrefixes([][]byte{[]byte("edge.")})
+	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
 }
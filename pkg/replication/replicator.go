@@ -33,6 +33,7 @@ import (
 	"github.com/codenotary/immudb/pkg/logger"
 	"github.com/codenotary/immudb/pkg/stream"
 	"github.com/rs/xid"
+	"google.golang.org/grpc/metadata"
 )
 
 var ErrIllegalArguments = errors.New("illegal arguments")
@@ -43,6 +44,12 @@ var ErrReplicaDivergedFromPrimary = errors.New("replica diverged from primary")
 var ErrNoSynchronousReplicationOnPrimary = errors.New("primary is not running with synchronous replication")
 var ErrInvalidReplicationMetadata = errors.New("invalid replication metadata retrieved")
 
+// metadata sent by replicas when fetching transactions
+const (
+	ReplicaUUIDMetadataKey     = "replica-uuid"
+	ReplicaDatabaseMetadataKey = "replica-database"
+)
+
 type prefetchTxEntry struct {
 	data    []byte
 	addedAt time.Time
@@ -84,6 +91,10 @@ type TxReplicator struct {
 
 	mutex sync.Mutex
 
+	// status is guarded by its own mutex as the replication mutex is held while fetching transactions
+	status      Status
+	statusMutex sync.Mutex
+
 	metrics metrics
 }
 
@@ -97,12 +108,17 @@ func NewTxReplicator(uuid xid.ID, db database.DB, opts *Options, logger logger.L
 		return nil, err
 	}
 
+	primaryDB := fullAddress(opts.primaryDatabase, opts.primaryHost, opts.primaryPort)
+	if opts.isLogShipping() {
+		primaryDB = logShippingAddress(opts.primaryDatabase, opts)
+	}
+
 	return &TxReplicator{
 		uuid:                   uuid,
 		db:                     db,
 		opts:                   opts,
 		logger:                 logger,
-		_primaryDB:             fullAddress(opts.primaryDatabase, opts.primaryHost, opts.primaryPort),
+		_primaryDB:             primaryDB,
 		streamSrvFactory:       stream.NewStreamServiceFactory(opts.streamChunkSize),
 		prefetchTxBuffer:       make(chan prefetchTxEntry, opts.prefetchTxBufferSize),
 		replicationConcurrency: opts.replicationCommitConcurrency,
@@ -111,6 +127,10 @@ func NewTxReplicator(uuid xid.ID, db database.DB, opts *Options, logger logger.L
 		waitForIndexing:        opts.waitForIndexing,
 		delayer:                opts.delayer,
 		metrics:                metricsForDb(db.GetName()),
+		status: Status{
+			Primary: primaryDB,
+			State:   StateStopped,
+		},
 	}, nil
 }
 
@@ -123,6 +143,8 @@ func (txr *TxReplicator) handleError(err error) (terminate bool) {
 		return false
 	}
 
+	txr.setLastError(err)
+
 	if errors.Is(err, ErrAlreadyStopped) || errors.Is(err, ErrReplicaDivergedFromPrimary) {
 		return true
 	}
@@ -166,6 +188,8 @@ func (txr *TxReplicator) Start() error {
 
 	txr.running = true
 
+	txr.setState(StateConnecting)
+
 	go func() {
 		txr.logger.Infof("Replication for '%s' started fetching transaction from '%s'...", txr.db.GetName(), txr._primaryDB)
 
@@ -180,6 +204,8 @@ func (txr *TxReplicator) Start() error {
 
 		txr.logger.Infof("Replication for '%s' stopped fetching transaction from '%s'", txr.db.GetName(), txr._primaryDB)
 
+		txr.setState(StateStopped)
+
 		if errors.Is(err, ErrReplicaDivergedFromPrimary) {
 			txr.Stop()
 		}
@@ -187,7 +213,8 @@ func (txr *TxReplicator) Start() error {
 
 	txr.metrics.reset()
 
-	for i := 0; i < txr.replicationConcurrency; i++ {
+	// partially replicated and shipped transactions are sequentially committed while being fetched
+	for i := 0; !txr.opts.isPartial() && !txr.opts.isLogShipping() && i < txr.replicationConcurrency; i++ {
 		go func() {
 			txr.metrics.replicators.Inc()
 			defer txr.metrics.replicators.Dec()
@@ -230,6 +257,8 @@ func (txr *TxReplicator) replicateSingleTx(data []byte) bool {
 
 		txr.logger.Infof("Failed to replicate transaction from '%s' to '%s'. Reason: %s", txr._primaryDB, txr.db.GetName(), err.Error())
 
+		txr.setLastError(err)
+
 		consecutiveFailures++
 
 		if !txr.replicationFailureDelay(consecutiveFailures) {
@@ -270,7 +299,9 @@ func (txr *TxReplicator) connect() error {
 	opts := client.DefaultOptions().
 		WithAddress(txr.opts.primaryHost).
 		WithPort(txr.opts.primaryPort).
-		WithDisableIdentityCheck(true)
+		WithDisableIdentityCheck(true).
+		WithMTLs(txr.opts.primaryTLS).
+		WithMTLsOptions(txr.opts.primaryTLSOptions)
 
 	txr.client = client.NewClient().WithOptions(opts)
 
@@ -285,16 +316,29 @@ func (txr *TxReplicator) connect() error {
 		txr.opts.primaryPort,
 		txr.db.GetName())
 
-	txr.exportTxStream, err = txr.client.StreamExportTx(txr.context)
-	if err != nil {
-		return err
+	if !txr.opts.isPartial() {
+		// partial transactions are individually requested
+		txr.exportTxStream, err = txr.client.StreamExportTx(txr.exportContext())
+		if err != nil {
+			return err
+		}
+
+		txr.exportTxStreamReceiver = txr.streamSrvFactory.NewMsgReceiver(txr.exportTxStream)
 	}
 
-	txr.exportTxStreamReceiver = txr.streamSrvFactory.NewMsgReceiver(txr.exportTxStream)
+	txr.setState(StateReplicating)
 
 	return nil
 }
 
+// exportContext identifies the replica to the primary so it can be reported in the replication status
+func (txr *TxReplicator) exportContext() context.Context {
+	return metadata.AppendToOutgoingContext(txr.context,
+		ReplicaUUIDMetadataKey, txr.uuid.String(),
+		ReplicaDatabaseMetadataKey, txr.db.GetName(),
+	)
+}
+
 func (txr *TxReplicator) disconnect() {
 	if txr.client == nil {
 		return
@@ -310,6 +354,8 @@ func (txr *TxReplicator) disconnect() {
 	txr.client.CloseSession(txr.context)
 	txr.client = nil
 
+	txr.setState(StateConnecting)
+
 	txr.logger.Infof("Disconnected from '%s':'%d' for database '%s'", txr.opts.primaryHost, txr.opts.primaryPort, txr.db.GetName())
 }
 
@@ -321,6 +367,14 @@ func (txr *TxReplicator) fetchNextTx() error {
 		return ErrAlreadyStopped
 	}
 
+	if txr.opts.isPartial() {
+		return txr.fetchNextPartialTx()
+	}
+
+	if txr.opts.isLogShipping() {
+		return txr.fetchShippedTxs()
+	}
+
 	if txr.exportTxStream == nil {
 		err := txr.connect()
 		if err != nil {
@@ -342,6 +396,7 @@ func (txr *TxReplicator) fetchNextTx() error {
 	nextTx := txr.lastTx + 1
 
 	var state *schema.ReplicaState
+	var downstreamStates []*schema.ReplicaState
 
 	if syncReplicationEnabled {
 		state = &schema.ReplicaState{
@@ -351,13 +406,18 @@ func (txr *TxReplicator) fetchNextTx() error {
 			PrecommittedTxID: commitState.PrecommittedTxId,
 			PrecommittedAlh:  commitState.PrecommittedTxHash,
 		}
+
+		// synchronous replicas fetching from this replica are acknowledged through it
+		downstreamStates = txr.db.TakeDownstreamReplicaStates()
 	}
 
 	req := &schema.ExportTxRequest{
-		Tx:                 nextTx,
-		ReplicaState:       state,
-		AllowPreCommitted:  syncReplicationEnabled,
-		SkipIntegrityCheck: txr.skipIntegrityCheck,
+		Tx:                      nextTx,
+		ReplicaState:            state,
+		DownstreamReplicaStates: downstreamStates,
+		AllowPreCommitted:       syncReplicationEnabled,
+		SkipIntegrityCheck:      txr.skipIntegrityCheck,
+		ReplicationEpoch:        txr.db.ReplicationEpoch(),
 	}
 	if err != nil {
 		return err
@@ -425,7 +485,21 @@ func (txr *TxReplicator) fetchNextTx() error {
 		var mayCommitUpToAlh [sha256.Size]byte
 		copy(mayCommitUpToAlh[:], bmayCommitUpToAlh)
 
+		// the epoch of the primary is adopted, it's not provided by primaries of previous versions
+		bReplicationEpoch, ok := emd["replication-epoch-bin"]
+		if ok {
+			if len(bReplicationEpoch) != 8 {
+				return ErrInvalidReplicationMetadata
+			}
+
+			primaryEpoch := binary.BigEndian.Uint64(bReplicationEpoch)
+			if primaryEpoch > txr.db.ReplicationEpoch() {
+				txr.db.SetReplicationEpoch(primaryEpoch)
+			}
+		}
+
 		txr.metrics.primaryCommittedTxID.Set(float64(committedTxID))
+		txr.setPrimaryCommittedTxID(committedTxID)
 		txr.metrics.allowCommitUpToTxID.Set(float64(mayCommitUpToTxID))
 
 		if mayCommitUpToTxID > commitState.TxId {
@@ -453,6 +527,45 @@ func (txr *TxReplicator) fetchNextTx() error {
 	return nil
 }
 
+// fetchNextPartialTx replicates the entries of the next primary transaction matching
+// the configured key prefixes and SQL tables. Entries are verified against the primary
+// transaction header, which must be linked to the last replicated one
+func (txr *TxReplicator) fetchNextPartialTx() error {
+	if txr.client == nil {
+		err := txr.connect()
+		if err != nil {
+			return err
+		}
+	}
+
+	lastTxID, _, err := txr.db.PartialReplicationState()
+	if err != nil {
+		return err
+	}
+
+	ptx, err := txr.client.ExportPartialTx(txr.exportContext(), &schema.ExportPartialTxRequest{
+		Tx:          lastTxID + 1,
+		KeyPrefixes: txr.opts.keyPrefixes,
+		SqlTables:   txr.opts.sqlTables,
+	})
+	if err != nil {
+		return err
+	}
+
+	if ptx.Header == nil {
+		// transaction not yet committed in primary
+		return nil
+	}
+
+	_, err = txr.db.ReplicatePartialTx(txr.context, ptx)
+	if errors.Is(err, database.ErrReplicaDivergedFromPrimary) || errors.Is(err, database.ErrPartialTxVerificationFailed) {
+		txr.logger.Errorf("partial replica '%s' diverged from primary: %s", txr.db.GetName(), err.Error())
+		return ErrReplicaDivergedFromPrimary
+	}
+
+	return err
+}
+
 func (txr *TxReplicator) Stop() error {
 	if txr.cancelFunc != nil {
 		txr.cancelFunc()
@@ -473,6 +586,8 @@ func (txr *TxReplicator) Stop() error {
 
 	txr.running = false
 
+	txr.setState(StateStopped)
+
 	txr.logger.Infof("Replication of database '%s' successfully stopped", txr.db.GetName())
 
 	return nil
@@ -0,0 +1,85 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replication
+
+import (
+	"errors"
+	"time"
+)
+
+// Replication states reported by a replicator
+const (
+	StateConnecting  = "connecting"
+	StateReplicating = "replicating"
+	StateStopped     = "stopped"
+)
+
+// Status describes the replication from the primary database
+type Status struct {
+	// Primary is the replicated database as database@host:port
+	Primary string
+
+	// State is one of StateConnecting, StateReplicating or StateStopped
+	State string
+
+	// LastError is the most recent replication error, nil if no error occurred
+	LastError   error
+	LastErrorAt time.Time
+
+	// PrimaryCommittedTxID is the most recent transaction committed on the primary,
+	// only informed by the primary on synchronous replication
+	PrimaryCommittedTxID uint64
+}
+
+// Status returns the current status of the replication
+func (txr *TxReplicator) Status() Status {
+	txr.statusMutex.Lock()
+	defer txr.statusMutex.Unlock()
+
+	return txr.status
+}
+
+func (txr *TxReplicator) setState(state string) {
+	txr.statusMutex.Lock()
+	defer txr.statusMutex.Unlock()
+
+	txr.status.State = state
+}
+
+func (txr *TxReplicator) setLastError(err error) {
+	if err == nil || errors.Is(err, ErrAlreadyStopped) {
+		return
+	}
+
+	if txr.context != nil && txr.context.Err() != nil {
+		// errors caused by stopping the replication are not reported
+		return
+	}
+
+	txr.statusMutex.Lock()
+	defer txr.statusMutex.Unlock()
+
+	txr.status.LastError = err
+	txr.status.LastErrorAt = time.Now()
+}
+
+func (txr *TxReplicator) setPrimaryCommittedTxID(txID uint64) {
+	txr.statusMutex.Lock()
+	defer txr.statusMutex.Unlock()
+
+	txr.status.PrimaryCommittedTxID = txID
+}
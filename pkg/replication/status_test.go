@@ -0,0 +1,70 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replication
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/database"
+	"github.com/codenotary/immudb/pkg/logger"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplicatorStatus(t *testing.T) {
+	rOpts := DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(1).
+		WithPrimaryUsername("immudb").
+		WithPrimaryPassword("immudb").
+		WithStreamChunkSize(DefaultChunkSize)
+
+	logger := logger.NewSimpleLogger("logger", os.Stdout)
+
+	db, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+
+	txReplicator, err := NewTxReplicator(xid.New(), db, rOpts, logger)
+	require.NoError(t, err)
+
+	status := txReplicator.Status()
+	require.Equal(t, "defaultdb@127.0.0.1:1", status.Primary)
+	require.Equal(t, StateStopped, status.State)
+	require.NoError(t, status.LastError)
+
+	err = txReplicator.Start()
+	require.NoError(t, err)
+
+	// the primary is not reachable
+	require.Eventually(t, func() bool {
+		return txReplicator.Status().LastError != nil
+	}, 10*time.Second, 10*time.Millisecond)
+
+	status = txReplicator.Status()
+	require.Equal(t, StateConnecting, status.State)
+	require.False(t, status.LastErrorAt.IsZero())
+
+	err = txReplicator.Stop()
+	require.NoError(t, err)
+
+	status = txReplicator.Status()
+	require.Equal(t, StateStopped, status.State)
+	require.NotNil(t, status.LastError)
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"github.com/codenotary/immudb/embedded/remotestorage"
+	"github.com/codenotary/immudb/pkg/backup"
+	"github.com/codenotary/immudb/pkg/database"
+)
+
+func (s *ImmuServer) createBackupStorageInstance() (remotestorage.Storage, error) {
+	if s.Options.BackupOptions == nil || s.Options.BackupOptions.URL == "" {
+		return nil, nil
+	}
+
+	return OpenRemoteStorageURL(
+		s.Options.BackupOptions.URL,
+		s.Options.BackupOptions.AccessKeyID,
+		s.Options.BackupOptions.SecretKey,
+	)
+}
+
+func (s *ImmuServer) startBackupFor(db database.DB) error {
+	if s.backupStorage == nil {
+		return ErrBackupNotNeeded
+	}
+
+	s.backupMutex.Lock()
+	defer s.backupMutex.Unlock()
+
+	if _, ok := s.backups[db.GetName()]; ok {
+		return backup.ErrBackupAlreadyRunning
+	}
+
+	// states are left unsigned when no signing key is configured
+	b := backup.NewBackup(db, s.backupStorage, s.StateSigner, s.Options.BackupOptions.Frequency, s.Logger).
+		WithMaxSegmentTxs(s.Options.BackupOptions.MaxSegmentTxs)
+
+	err := b.Start()
+	if err != nil {
+		return err
+	}
+
+	s.backups[db.GetName()] = b
+
+	return nil
+}
+
+func (s *ImmuServer) stopBackupFor(db string) error {
+	s.backupMutex.Lock()
+	defer s.backupMutex.Unlock()
+
+	b, ok := s.backups[db]
+	if !ok {
+		return ErrBackupNotInProgress
+	}
+
+	err := b.Stop()
+	if err != nil && err != backup.ErrBackupAlreadyStopped {
+		return err
+	}
+
+	delete(s.backups, db)
+
+	return nil
+}
+
+func (s *ImmuServer) stopBackups() {
+	s.backupMutex.Lock()
+	defer s.backupMutex.Unlock()
+
+	for db, b := range s.backups {
+		err := b.Stop()
+		if err != nil {
+			s.Logger.Warningf("Error stopping backup for '%s'. Reason: %v", db, err)
+		} else {
+			delete(s.backups, db)
+		}
+	}
+}
@@ -19,6 +19,7 @@ package server
 import (
 	"context"
 	"crypto/sha256"
+	"io"
 	"path/filepath"
 	"time"
 
@@ -62,6 +63,17 @@ func (db *closedDB) IsSyncReplicationEnabled() bool {
 func (db *closedDB) SetSyncReplication(enabled bool) {
 }
 
+func (db *closedDB) ReplicationEpoch() uint64 {
+	return 0
+}
+
+func (db *closedDB) SetReplicationEpoch(epoch uint64) {
+}
+
+func (db *closedDB) TakeDownstreamReplicaStates() []*schema.ReplicaState {
+	return nil
+}
+
 func (db *closedDB) MaxResultSize() int {
 	return 1000
 }
@@ -74,6 +86,10 @@ func (db *closedDB) Health() (waitingCount int, lastReleaseAt time.Time) {
 	return
 }
 
+func (db *closedDB) IndexRebuildProgress() (inProgress bool, processedTxs, totalTxs uint64) {
+	return
+}
+
 func (db *closedDB) CurrentState() (*schema.ImmutableState, error) {
 	return nil, store.ErrAlreadyClosed
 }
@@ -126,6 +142,10 @@ func (db *closedDB) ExecAll(ctx context.Context, operations *schema.ExecAllReque
 	return nil, store.ErrAlreadyClosed
 }
 
+func (db *closedDB) BulkLoad(ctx context.Context, next func() (*schema.KeyValue, error)) (*schema.BulkLoadResponse, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
 func (db *closedDB) Count(ctx context.Context, prefix *schema.KeyPrefix) (*schema.EntryCount, error) {
 	return nil, store.ErrAlreadyClosed
 }
@@ -218,6 +238,22 @@ func (db *closedDB) DiscardPrecommittedTxsSince(txID uint64) error {
 	return store.ErrAlreadyClosed
 }
 
+func (db *closedDB) ExportPartialTx(ctx context.Context, req *schema.ExportPartialTxRequest) (*schema.PartialTx, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
+func (db *closedDB) ReplicatePartialTx(ctx context.Context, ptx *schema.PartialTx) (*schema.TxHeader, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
+func (db *closedDB) PartialReplicationState() (txID uint64, alh [sha256.Size]byte, err error) {
+	return 0, alh, store.ErrAlreadyClosed
+}
+
+func (db *closedDB) PartialTxProof(ctx context.Context, req *schema.PartialTxProofRequest) (*schema.PartialTx, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
 func (db *closedDB) VerifiableTxByID(ctx context.Context, req *schema.VerifiableTxRequest) (*schema.VerifiableTx, error) {
 	return nil, store.ErrAlreadyClosed
 }
@@ -288,7 +324,7 @@ func (d *closedDB) AuditDocument(ctx context.Context, req *protomodel.AuditDocum
 	return nil, store.ErrAlreadyClosed
 }
 
-func (d *closedDB) SearchDocuments(ctx context.Context, query *protomodel.Query, offset int64) (document.DocumentReader, error) {
+func (d *closedDB) SearchDocuments(ctx context.Context, query *protomodel.Query, projection *protomodel.Projection, offset int64) (document.DocumentReader, error) {
 	return nil, store.ErrAlreadyClosed
 }
 
@@ -300,6 +336,18 @@ func (d *closedDB) ProofDocument(ctx context.Context, req *protomodel.ProofDocum
 	return nil, store.ErrAlreadyClosed
 }
 
+func (d *closedDB) WatchCollection(ctx context.Context, req *protomodel.WatchCollectionRequest, onChange func(*protomodel.WatchCollectionResponse) error) error {
+	return store.ErrAlreadyClosed
+}
+
+func (d *closedDB) ImportDocuments(ctx context.Context, collectionName string, ndjson io.Reader) (*protomodel.ImportDocumentsResponse, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
+func (d *closedDB) ExportDocuments(ctx context.Context, req *protomodel.ExportDocumentsRequest, chunkSize int, onChunk func(*protomodel.ExportDocumentsResponse) error) error {
+	return store.ErrAlreadyClosed
+}
+
 func (d *closedDB) DeleteDocuments(ctx context.Context, req *protomodel.DeleteDocumentsRequest) (*protomodel.DeleteDocumentsResponse, error) {
 	return nil, store.ErrAlreadyClosed
 }
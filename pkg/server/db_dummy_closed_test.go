@@ -42,6 +42,9 @@ func TestDummyClosedDatabase(t *testing.T) {
 	waitingCount, _ := cdb.Health()
 	require.Equal(t, 0, waitingCount)
 
+	rebuildInProgress, _, _ := cdb.IndexRebuildProgress()
+	require.False(t, rebuildInProgress)
+
 	_, err = cdb.CurrentState()
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 
@@ -81,6 +84,9 @@ func TestDummyClosedDatabase(t *testing.T) {
 	_, err = cdb.ExecAll(context.Background(), nil)
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 
+	_, err = cdb.BulkLoad(context.Background(), nil)
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
 	_, err = cdb.Count(context.Background(), nil)
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 
@@ -36,10 +36,14 @@ func (s *ImmuServer) DatabaseHealth(ctx context.Context, _ *empty.Empty) (*schem
 	}
 
 	waitingRequests, lastReleaseAt := db.Health()
+	rebuildInProgress, rebuildProcessedTxs, rebuildTotalTxs := db.IndexRebuildProgress()
 
 	return &schema.DatabaseHealthResponse{
-		PendingRequests:        uint32(waitingRequests),
-		LastRequestCompletedAt: unixMilli(lastReleaseAt),
+		PendingRequests:          uint32(waitingRequests),
+		LastRequestCompletedAt:   unixMilli(lastReleaseAt),
+		IndexRebuildInProgress:   rebuildInProgress,
+		IndexRebuildProcessedTxs: rebuildProcessedTxs,
+		IndexRebuildTotalTxs:     rebuildTotalTxs,
 	}, nil
 }
 
@@ -24,9 +24,11 @@ import (
 	"time"
 
 	"github.com/codenotary/immudb/embedded/ahtree"
+	"github.com/codenotary/immudb/embedded/appendable"
 	"github.com/codenotary/immudb/embedded/store"
 	"github.com/codenotary/immudb/embedded/tbtree"
 	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client"
 	"github.com/codenotary/immudb/pkg/database"
 	"github.com/codenotary/immudb/pkg/replication"
 )
@@ -53,6 +55,21 @@ type dbOptions struct {
 	AllowTxDiscarding            bool   `json:"allowTxDiscarding"`
 	SkipIntegrityCheck           bool   `json:"skipIntegrityCheck"`
 	WaitForIndexing              bool   `json:"waitForIndexing"`
+	PrimaryTLS                   bool   `json:"primaryTLS"`
+	PrimaryTLSServerName         string `json:"primaryTLSServerName"`
+	PrimaryTLSCACert             string `json:"primaryTLSCACert"`
+	PrimaryTLSCert               string `json:"primaryTLSCert"`
+	PrimaryTLSKey                string `json:"primaryTLSKey"`
+
+	// partial replication, only matching entries are replicated
+	KeyPrefixes []string `json:"keyPrefixes"`
+	SQLTables   []string `json:"sqlTables"`
+
+	// transactions are shipped to the log shipping storage by primaries and fetched from it by replicas
+	LogShipping bool `json:"logShipping"`
+
+	// increased every time a replica gets promoted, primaries of a lower epoch were superseded by a failover
+	ReplicationEpoch uint64 `json:"replicationEpoch"`
 
 	// store options
 	EmbeddedValues bool `json:"embeddedValues"` // permanent
@@ -62,6 +79,10 @@ type dbOptions struct {
 	MaxValueLen    int  `json:"maxValueLen"`    // permanent
 	MaxTxEntries   int  `json:"maxTxEntries"`   // permanent
 
+	CompressionFormat     string `json:"compressionFormat"`     // permanent
+	CompressionLevel      int    `json:"compressionLevel"`      // permanent
+	CompressionDictionary []byte `json:"compressionDictionary"` // permanent
+
 	ExcludeCommitTime bool `json:"excludeCommitTime"`
 
 	MaxActiveTransactions int `json:"maxActiveTransactions"`
@@ -92,8 +113,10 @@ type dbOptions struct {
 	UpdatedBy string    `json:"updatedBy"`
 	UpdatedAt time.Time `json:"updatedAt"`
 
-	RetentionPeriod     Milliseconds `json:"retentionPeriod"`
-	TruncationFrequency Milliseconds `json:"truncationFrequency"` // ms
+	RetentionPeriod          Milliseconds `json:"retentionPeriod"`
+	TruncationFrequency      Milliseconds `json:"truncationFrequency"` // ms
+	CompactLiveValues        bool         `json:"compactLiveValues"`
+	CompactionMaxBytesPerSec int          `json:"compactionMaxBytesPerSec"`
 }
 
 type featureState int
@@ -124,6 +147,7 @@ type indexOptions struct {
 	CommitLogMaxOpenedFiles  int          `json:"commitLogMaxOpenedFiles"`
 	MaxBulkSize              int          `json:"maxBulkSize"`
 	BulkPreparationTimeout   Milliseconds `json:"bulkPreparationTimeout"` // ms
+	RebuildParallelism       int          `json:"rebuildParallelism"`
 }
 
 type ahtOptions struct {
@@ -148,6 +172,9 @@ func (s *ImmuServer) defaultDBOptions(dbName string) *dbOptions {
 		MaxValueLen:    DefaultMaxValueLen,
 		MaxTxEntries:   store.DefaultMaxTxEntries,
 
+		CompressionFormat: appendable.CompressionFormatName(store.DefaultCompressionFormat),
+		CompressionLevel:  store.DefaultCompressionLevel,
+
 		ExcludeCommitTime: false,
 
 		MaxActiveTransactions:   store.DefaultMaxActiveTransactions,
@@ -190,6 +217,11 @@ func (s *ImmuServer) defaultDBOptions(dbName string) *dbOptions {
 			dbOpts.ReplicationCommitConcurrency = repOpts.ReplicationCommitConcurrency
 			dbOpts.AllowTxDiscarding = repOpts.AllowTxDiscarding
 			dbOpts.SkipIntegrityCheck = repOpts.SkipIntegrityCheck
+			dbOpts.PrimaryTLS = repOpts.PrimaryTLS
+			dbOpts.PrimaryTLSServerName = repOpts.PrimaryTLSServerName
+			dbOpts.PrimaryTLSCACert = repOpts.PrimaryTLSCACert
+			dbOpts.PrimaryTLSCert = repOpts.PrimaryTLSCert
+			dbOpts.PrimaryTLSKey = repOpts.PrimaryTLSKey
 		} else {
 			dbOpts.SyncAcks = repOpts.SyncAcks
 		}
@@ -215,6 +247,7 @@ func (s *ImmuServer) defaultIndexOptions() *indexOptions {
 		CommitLogMaxOpenedFiles:  tbtree.DefaultCommitLogMaxOpenedFiles,
 		MaxBulkSize:              store.DefaultIndexingMaxBulkSize,
 		BulkPreparationTimeout:   Milliseconds(store.DefaultBulkPreparationTimeout.Milliseconds()),
+		RebuildParallelism:       store.DefaultIndexRebuildParallelism,
 	}
 }
 
@@ -232,9 +265,12 @@ func (s *ImmuServer) databaseOptionsFrom(opts *dbOptions) *database.Options {
 		AsReplica(opts.Replica).
 		WithSyncReplication(opts.SyncReplication).
 		WithSyncAcks(opts.SyncAcks).
+		WithReplicationEpoch(opts.ReplicationEpoch).
 		WithReadTxPoolSize(opts.ReadTxPoolSize).
 		WithRetentionPeriod(time.Millisecond * time.Duration(opts.RetentionPeriod)).
-		WithTruncationFrequency(time.Millisecond * time.Duration(opts.TruncationFrequency))
+		WithTruncationFrequency(time.Millisecond * time.Duration(opts.TruncationFrequency)).
+		WithCompactLiveValues(opts.CompactLiveValues).
+		WithCompactionMaxBytesPerSec(opts.CompactionMaxBytesPerSec)
 }
 
 func (opts *dbOptions) storeOptions() *store.Options {
@@ -256,7 +292,8 @@ func (opts *dbOptions) storeOptions() *store.Options {
 			WithHistoryLogMaxOpenedFiles(opts.IndexOptions.HistoryLogMaxOpenedFiles).
 			WithCommitLogMaxOpenedFiles(opts.IndexOptions.CommitLogMaxOpenedFiles).
 			WithMaxBulkSize(opts.IndexOptions.MaxBulkSize).
-			WithBulkPreparationTimeout(time.Millisecond * time.Duration(opts.IndexOptions.BulkPreparationTimeout))
+			WithBulkPreparationTimeout(time.Millisecond * time.Duration(opts.IndexOptions.BulkPreparationTimeout)).
+			WithRebuildParallelism(opts.IndexOptions.RebuildParallelism)
 	}
 
 	ahtOpts := store.DefaultAHTOptions()
@@ -266,9 +303,22 @@ func (opts *dbOptions) storeOptions() *store.Options {
 		ahtOpts.WithWriteBufferSize(opts.AHTOptions.WriteBufferSize)
 	}
 
+	// databases created before compression settings were introduced are not compressed
+	compressionFormat := store.DefaultCompressionFormat
+	compressionLevel := store.DefaultCompressionLevel
+
+	cf, err := appendable.CompressionFormatFromName(opts.CompressionFormat)
+	if err == nil {
+		compressionFormat = cf
+		compressionLevel = opts.CompressionLevel
+	}
+
 	stOpts := store.DefaultOptions().
 		WithEmbeddedValues(opts.EmbeddedValues).
 		WithPreallocFiles(opts.PreallocFiles).
+		WithCompressionFormat(compressionFormat).
+		WithCompresionLevel(compressionLevel).
+		WithCompressionDictionary(opts.CompressionDictionary).
 		WithSynced(opts.synced).
 		WithSyncFrequency(time.Millisecond * time.Duration(opts.SyncFrequency)).
 		WithFileSize(opts.FileSize).
@@ -314,6 +364,15 @@ func (opts *dbOptions) databaseNullableSettings() *schema.DatabaseNullableSettin
 			AllowTxDiscarding:            &schema.NullableBool{Value: opts.AllowTxDiscarding},
 			SkipIntegrityCheck:           &schema.NullableBool{Value: opts.SkipIntegrityCheck},
 			WaitForIndexing:              &schema.NullableBool{Value: opts.WaitForIndexing},
+			PrimaryTLS:                   &schema.NullableBool{Value: opts.PrimaryTLS},
+			PrimaryTLSServerName:         &schema.NullableString{Value: opts.PrimaryTLSServerName},
+			PrimaryTLSCACert:             &schema.NullableString{Value: opts.PrimaryTLSCACert},
+			PrimaryTLSCert:               &schema.NullableString{Value: opts.PrimaryTLSCert},
+			PrimaryTLSKey:                &schema.NullableString{Value: opts.PrimaryTLSKey},
+			KeyPrefixes:                  &schema.NullableStringList{Values: opts.KeyPrefixes},
+			SqlTables:                    &schema.NullableStringList{Values: opts.SQLTables},
+			LogShipping:                  &schema.NullableBool{Value: opts.LogShipping},
+			ReplicationEpoch:             &schema.NullableUint64{Value: opts.ReplicationEpoch},
 		},
 
 		SyncFrequency: &schema.NullableMilliseconds{Value: int64(opts.SyncFrequency)},
@@ -325,6 +384,8 @@ func (opts *dbOptions) databaseNullableSettings() *schema.DatabaseNullableSettin
 		EmbeddedValues: &schema.NullableBool{Value: opts.EmbeddedValues},
 		PreallocFiles:  &schema.NullableBool{Value: opts.PreallocFiles},
 
+		CompressionSettings: opts.compressionNullableSettings(),
+
 		ExcludeCommitTime: &schema.NullableBool{Value: opts.ExcludeCommitTime},
 
 		MaxActiveTransactions: &schema.NullableUint32{Value: uint32(opts.MaxActiveTransactions)},
@@ -357,6 +418,7 @@ func (opts *dbOptions) databaseNullableSettings() *schema.DatabaseNullableSettin
 			CommitLogMaxOpenedFiles:  &schema.NullableUint32{Value: uint32(opts.IndexOptions.CommitLogMaxOpenedFiles)},
 			MaxBulkSize:              &schema.NullableUint32{Value: uint32(opts.IndexOptions.MaxBulkSize)},
 			BulkPreparationTimeout:   &schema.NullableMilliseconds{Value: int64(opts.IndexOptions.BulkPreparationTimeout)},
+			RebuildParallelism:       &schema.NullableUint32{Value: uint32(opts.IndexOptions.RebuildParallelism)},
 		},
 
 		AhtSettings: &schema.AHTNullableSettings{
@@ -371,12 +433,33 @@ func (opts *dbOptions) databaseNullableSettings() *schema.DatabaseNullableSettin
 		ReadTxPoolSize: &schema.NullableUint32{Value: uint32(opts.ReadTxPoolSize)},
 
 		TruncationSettings: &schema.TruncationNullableSettings{
-			RetentionPeriod:     &schema.NullableMilliseconds{Value: int64(opts.RetentionPeriod)},
-			TruncationFrequency: &schema.NullableMilliseconds{Value: int64(opts.TruncationFrequency)},
+			RetentionPeriod:          &schema.NullableMilliseconds{Value: int64(opts.RetentionPeriod)},
+			TruncationFrequency:      &schema.NullableMilliseconds{Value: int64(opts.TruncationFrequency)},
+			CompactLiveValues:        &schema.NullableBool{Value: opts.CompactLiveValues},
+			CompactionMaxBytesPerSec: &schema.NullableUint32{Value: uint32(opts.CompactionMaxBytesPerSec)},
 		},
 	}
 }
 
+func (opts *dbOptions) compressionNullableSettings() *schema.CompressionNullableSettings {
+	format := opts.CompressionFormat
+	if format == "" {
+		format = appendable.CompressionFormatName(appendable.NoCompression)
+	}
+
+	level := opts.CompressionLevel
+	if level < 0 {
+		// default and huffman-only levels
+		level = 0
+	}
+
+	return &schema.CompressionNullableSettings{
+		Format:     &schema.NullableString{Value: format},
+		Level:      &schema.NullableUint32{Value: uint32(level)},
+		Dictionary: opts.CompressionDictionary,
+	}
+}
+
 // dbSettingsToDbSettingsV2 converts old schema.DatabaseSettings message into new schema.DatabaseSettingsV2
 // This is to add compatibility between old API using DatabaseSettings with new ones.
 // Only those fields that were present up to the 1.2.2 release are supported.
@@ -450,6 +533,11 @@ func (s *ImmuServer) overwriteWith(opts *dbOptions, settings *schema.DatabaseNul
 				"prealloc files", opts.Database)
 		}
 
+		if settings.CompressionSettings != nil {
+			return fmt.Errorf("%w: %s can not be changed after database creation ('%s')", ErrIllegalArguments,
+				"compression settings", opts.Database)
+		}
+
 		if settings.IndexSettings != nil && settings.IndexSettings.MaxNodeSize != nil {
 			return fmt.Errorf("%w: %s can not be changed after database creation ('%s')", ErrIllegalArguments, "max node size", opts.Database)
 		}
@@ -529,6 +617,51 @@ func (s *ImmuServer) overwriteWith(opts *dbOptions, settings *schema.DatabaseNul
 		if rs.WaitForIndexing != nil {
 			opts.WaitForIndexing = rs.WaitForIndexing.Value
 		}
+		if rs.PrimaryTLS != nil {
+			opts.PrimaryTLS = rs.PrimaryTLS.Value
+		} else if !opts.Replica {
+			opts.PrimaryTLS = false
+		}
+		if rs.PrimaryTLSServerName != nil {
+			opts.PrimaryTLSServerName = rs.PrimaryTLSServerName.Value
+		} else if !opts.Replica {
+			opts.PrimaryTLSServerName = ""
+		}
+		if rs.PrimaryTLSCACert != nil {
+			opts.PrimaryTLSCACert = rs.PrimaryTLSCACert.Value
+		} else if !opts.Replica {
+			opts.PrimaryTLSCACert = ""
+		}
+		if rs.PrimaryTLSCert != nil {
+			opts.PrimaryTLSCert = rs.PrimaryTLSCert.Value
+		} else if !opts.Replica {
+			opts.PrimaryTLSCert = ""
+		}
+		if rs.PrimaryTLSKey != nil {
+			opts.PrimaryTLSKey = rs.PrimaryTLSKey.Value
+		} else if !opts.Replica {
+			opts.PrimaryTLSKey = ""
+		}
+		if rs.KeyPrefixes != nil {
+			opts.KeyPrefixes = rs.KeyPrefixes.Values
+		} else if !opts.Replica {
+			opts.KeyPrefixes = nil
+		}
+		if rs.SqlTables != nil {
+			opts.SQLTables = rs.SqlTables.Values
+		} else if !opts.Replica {
+			opts.SQLTables = nil
+		}
+		if rs.LogShipping != nil {
+			opts.LogShipping = rs.LogShipping.Value
+		}
+		if rs.ReplicationEpoch != nil {
+			if rs.ReplicationEpoch.Value < opts.ReplicationEpoch {
+				return fmt.Errorf("%w: replication epoch of database '%s' can not be decreased (current epoch %d)",
+					ErrIllegalArguments, opts.Database, opts.ReplicationEpoch)
+			}
+			opts.ReplicationEpoch = rs.ReplicationEpoch.Value
+		}
 	}
 
 	// store options
@@ -561,6 +694,24 @@ func (s *ImmuServer) overwriteWith(opts *dbOptions, settings *schema.DatabaseNul
 		opts.PreallocFiles = settings.PreallocFiles.Value
 	}
 
+	if settings.CompressionSettings != nil {
+		if settings.CompressionSettings.Format != nil {
+			opts.CompressionFormat = settings.CompressionSettings.Format.Value
+		}
+
+		if settings.CompressionSettings.Level != nil {
+			opts.CompressionLevel = int(settings.CompressionSettings.Level.Value)
+
+			if opts.CompressionLevel == 0 {
+				opts.CompressionLevel = appendable.DefaultCompression
+			}
+		}
+
+		if len(settings.CompressionSettings.Dictionary) > 0 {
+			opts.CompressionDictionary = settings.CompressionSettings.Dictionary
+		}
+	}
+
 	if settings.ExcludeCommitTime != nil {
 		opts.ExcludeCommitTime = settings.ExcludeCommitTime.Value
 	}
@@ -619,6 +770,14 @@ func (s *ImmuServer) overwriteWith(opts *dbOptions, settings *schema.DatabaseNul
 		if settings.TruncationSettings.TruncationFrequency != nil {
 			opts.TruncationFrequency = Milliseconds(settings.TruncationSettings.TruncationFrequency.Value)
 		}
+
+		if settings.TruncationSettings.CompactLiveValues != nil {
+			opts.CompactLiveValues = settings.TruncationSettings.CompactLiveValues.Value
+		}
+
+		if settings.TruncationSettings.CompactionMaxBytesPerSec != nil {
+			opts.CompactionMaxBytesPerSec = int(settings.TruncationSettings.CompactionMaxBytesPerSec.Value)
+		}
 	}
 
 	// index options
@@ -672,6 +831,9 @@ func (s *ImmuServer) overwriteWith(opts *dbOptions, settings *schema.DatabaseNul
 		if settings.IndexSettings.BulkPreparationTimeout != nil {
 			opts.IndexOptions.BulkPreparationTimeout = Milliseconds(settings.IndexSettings.BulkPreparationTimeout.Value)
 		}
+		if settings.IndexSettings.RebuildParallelism != nil {
+			opts.IndexOptions.RebuildParallelism = int(settings.IndexSettings.RebuildParallelism.Value)
+		}
 	}
 
 	// aht options
@@ -717,6 +879,45 @@ func (opts *dbOptions) Validate() error {
 				ErrIllegalArguments, opts.Database)
 		}
 
+		if !opts.PrimaryTLS && (opts.PrimaryTLSServerName != "" || opts.PrimaryTLSCACert != "" ||
+			opts.PrimaryTLSCert != "" || opts.PrimaryTLSKey != "") {
+			return fmt.Errorf(
+				"%w: TLS settings require replication option PrimaryTLS to be enabled on replica database '%s'",
+				ErrIllegalArguments, opts.Database)
+		}
+
+		if (opts.PrimaryTLSCert == "") != (opts.PrimaryTLSKey == "") {
+			return fmt.Errorf(
+				"%w: replication options PrimaryTLSCert and PrimaryTLSKey must be provided together on replica database '%s'",
+				ErrIllegalArguments, opts.Database)
+		}
+
+		if opts.isPartialReplica() && opts.SyncReplication {
+			return fmt.Errorf(
+				"%w: partial replication can not be synchronous on replica database '%s'",
+				ErrIllegalArguments, opts.Database)
+		}
+
+		if opts.LogShipping && opts.SyncReplication {
+			return fmt.Errorf(
+				"%w: log shipping replication can not be synchronous on replica database '%s'",
+				ErrIllegalArguments, opts.Database)
+		}
+
+		if opts.LogShipping && opts.isPartialReplica() {
+			return fmt.Errorf(
+				"%w: log shipping replication can not be partial on replica database '%s'",
+				ErrIllegalArguments, opts.Database)
+		}
+
+		for _, prefix := range opts.KeyPrefixes {
+			if prefix == "" {
+				return fmt.Errorf(
+					"%w: invalid empty key prefix for partial replication on replica database '%s'",
+					ErrIllegalArguments, opts.Database)
+			}
+		}
+
 	} else {
 		if opts.SyncAcks < 0 {
 			return fmt.Errorf(
@@ -784,6 +985,19 @@ func (opts *dbOptions) Validate() error {
 				ErrIllegalArguments, opts.Database)
 		}
 
+		if opts.PrimaryTLS || opts.PrimaryTLSServerName != "" || opts.PrimaryTLSCACert != "" ||
+			opts.PrimaryTLSCert != "" || opts.PrimaryTLSKey != "" {
+			return fmt.Errorf(
+				"%w: invalid value for replication TLS options on primary database '%s'",
+				ErrIllegalArguments, opts.Database)
+		}
+
+		if opts.isPartialReplica() {
+			return fmt.Errorf(
+				"%w: invalid value for partial replication options on primary database '%s'",
+				ErrIllegalArguments, opts.Database)
+		}
+
 		if opts.SyncReplication && opts.SyncAcks == 0 {
 			return fmt.Errorf(
 				"%w: invalid replication options for primary database '%s'. It is necessary to have at least one sync replica",
@@ -797,6 +1011,25 @@ func (opts *dbOptions) Validate() error {
 		}
 	}
 
+	if opts.CompressionFormat != "" {
+		compressionFormat, err := appendable.CompressionFormatFromName(opts.CompressionFormat)
+		if err != nil {
+			return fmt.Errorf("%w: invalid compression format for database '%s'", ErrIllegalArguments, opts.Database)
+		}
+
+		if compressionFormat != appendable.NoCompression && opts.EmbeddedValues {
+			return fmt.Errorf(
+				"%w: compression requires values not to be embedded for database '%s'",
+				ErrIllegalArguments, opts.Database)
+		}
+
+		if len(opts.CompressionDictionary) > 0 && compressionFormat != appendable.ZstdCompression {
+			return fmt.Errorf(
+				"%w: a compression dictionary can only be used with zstd compression for database '%s'",
+				ErrIllegalArguments, opts.Database)
+		}
+	}
+
 	if opts.ReadTxPoolSize <= 0 {
 		return fmt.Errorf(
 			"%w: invalid read tx pool size (%d) for database '%s'",
@@ -816,14 +1049,86 @@ func (opts *dbOptions) Validate() error {
 			ErrIllegalArguments, opts.Database, store.MinimumTruncationFrequency.Hours())
 	}
 
+	if opts.CompactionMaxBytesPerSec < 0 {
+		return fmt.Errorf(
+			"%w: invalid compaction rate (%d) for database '%s'",
+			ErrIllegalArguments, opts.CompactionMaxBytesPerSec, opts.Database,
+		)
+	}
+
 	return opts.storeOptions().Validate()
 }
 
+// asPrimary clears replication settings only meaningful to replicas, as done when a replica gets promoted
+func (opts *dbOptions) asPrimary(syncReplication bool, syncAcks int) {
+	opts.Replica = false
+	opts.SyncReplication = syncReplication
+	opts.SyncAcks = syncAcks
+
+	opts.PrimaryDatabase = ""
+	opts.PrimaryHost = ""
+	opts.PrimaryPort = 0
+	opts.PrimaryUsername = ""
+	opts.PrimaryPassword = ""
+	opts.PrefetchTxBufferSize = 0
+	opts.ReplicationCommitConcurrency = 0
+	opts.AllowTxDiscarding = false
+	opts.SkipIntegrityCheck = false
+	opts.WaitForIndexing = false
+	opts.PrimaryTLS = false
+	opts.PrimaryTLSServerName = ""
+	opts.PrimaryTLSCACert = ""
+	opts.PrimaryTLSCert = ""
+	opts.PrimaryTLSKey = ""
+	opts.KeyPrefixes = nil
+	opts.SQLTables = nil
+
+	// LogShipping is kept, a promoted log shipping replica ships its own transactions
+}
+
+// primaryTLSOptions returns the TLS settings used by the replica to connect to the primary.
+// Server name defaults to the primary host when not provided
+func (opts *dbOptions) primaryTLSOptions() client.MTLsOptions {
+	serverName := opts.PrimaryTLSServerName
+	if serverName == "" {
+		serverName = opts.PrimaryHost
+	}
+
+	return client.MTLsOptions{}.
+		WithServername(serverName).
+		WithClientCAs(opts.PrimaryTLSCACert).
+		WithCertificate(opts.PrimaryTLSCert).
+		WithPkey(opts.PrimaryTLSKey)
+}
+
 func (opts *dbOptions) isReplicatorRequired() bool {
 	return opts.Replica &&
 		opts.PrimaryDatabase != "" &&
-		opts.PrimaryHost != "" &&
-		opts.PrimaryPort > 0
+		(opts.LogShipping || (opts.PrimaryHost != "" && opts.PrimaryPort > 0))
+}
+
+// isLogShippingRequired returns true when the primary database ships its transactions to the log shipping storage
+func (opts *dbOptions) isLogShippingRequired() bool {
+	return !opts.Replica && opts.LogShipping
+}
+
+// isPartialReplica returns true when only the entries matching the key prefixes or SQL tables are replicated
+func (opts *dbOptions) isPartialReplica() bool {
+	return len(opts.KeyPrefixes) > 0 || len(opts.SQLTables) > 0
+}
+
+// partialReplicationKeyPrefixes returns the key prefixes to replicate as byte slices
+func (opts *dbOptions) partialReplicationKeyPrefixes() [][]byte {
+	if len(opts.KeyPrefixes) == 0 {
+		return nil
+	}
+
+	prefixes := make([][]byte, len(opts.KeyPrefixes))
+	for i, prefix := range opts.KeyPrefixes {
+		prefixes[i] = []byte(prefix)
+	}
+
+	return prefixes
 }
 
 func (opts *dbOptions) isDataRetentionEnabled() bool {
@@ -904,12 +1209,23 @@ func (s *ImmuServer) logDBOptions(database string, opts *dbOptions) {
 	s.Logger.Infof("%s.AllowTxDiscarding: %v", database, opts.AllowTxDiscarding)
 	s.Logger.Infof("%s.SkipIntegrityCheck: %v", database, opts.SkipIntegrityCheck)
 	s.Logger.Infof("%s.WaitForIndexing: %v", database, opts.WaitForIndexing)
+	s.Logger.Infof("%s.PrimaryTLS: %v", database, opts.PrimaryTLS)
+	s.Logger.Infof("%s.PrimaryTLSServerName: %v", database, opts.PrimaryTLSServerName)
+	s.Logger.Infof("%s.PrimaryTLSCACert: %v", database, opts.PrimaryTLSCACert)
+	s.Logger.Infof("%s.PrimaryTLSCert: %v", database, opts.PrimaryTLSCert)
+	s.Logger.Infof("%s.KeyPrefixes: %v", database, opts.KeyPrefixes)
+	s.Logger.Infof("%s.SQLTables: %v", database, opts.SQLTables)
+	s.Logger.Infof("%s.LogShipping: %v", database, opts.LogShipping)
+	s.Logger.Infof("%s.ReplicationEpoch: %v", database, opts.ReplicationEpoch)
 	s.Logger.Infof("%s.FileSize: %v", database, opts.FileSize)
 	s.Logger.Infof("%s.MaxKeyLen: %v", database, opts.MaxKeyLen)
 	s.Logger.Infof("%s.MaxValueLen: %v", database, opts.MaxValueLen)
 	s.Logger.Infof("%s.MaxTxEntries: %v", database, opts.MaxTxEntries)
 	s.Logger.Infof("%s.EmbeddedValues: %v", database, opts.EmbeddedValues)
 	s.Logger.Infof("%s.PreallocFiles: %v", database, opts.PreallocFiles)
+	s.Logger.Infof("%s.CompressionFormat: %v", database, opts.CompressionFormat)
+	s.Logger.Infof("%s.CompressionLevel: %v", database, opts.CompressionLevel)
+	s.Logger.Infof("%s.CompressionDictionarySize: %v", database, len(opts.CompressionDictionary))
 	s.Logger.Infof("%s.ExcludeCommitTime: %v", database, opts.ExcludeCommitTime)
 	s.Logger.Infof("%s.MaxActiveTransactions: %v", database, opts.MaxActiveTransactions)
 	s.Logger.Infof("%s.MVCCReadSetLimit: %v", database, opts.MVCCReadSetLimit)
@@ -925,6 +1241,8 @@ func (s *ImmuServer) logDBOptions(database string, opts *dbOptions) {
 	s.Logger.Infof("%s.ReadTxPoolSize: %v", database, opts.ReadTxPoolSize)
 	s.Logger.Infof("%s.TruncationFrequency: %v", database, opts.TruncationFrequency)
 	s.Logger.Infof("%s.RetentionPeriod: %v", database, opts.RetentionPeriod)
+	s.Logger.Infof("%s.CompactLiveValues: %v", database, opts.CompactLiveValues)
+	s.Logger.Infof("%s.CompactionMaxBytesPerSec: %v", database, opts.CompactionMaxBytesPerSec)
 	s.Logger.Infof("%s.IndexOptions.FlushThreshold: %v", database, opts.IndexOptions.FlushThreshold)
 	s.Logger.Infof("%s.IndexOptions.SyncThreshold: %v", database, opts.IndexOptions.SyncThreshold)
 	s.Logger.Infof("%s.IndexOptions.FlushBufferSize: %v", database, opts.IndexOptions.FlushBufferSize)
@@ -940,6 +1258,7 @@ func (s *ImmuServer) logDBOptions(database string, opts *dbOptions) {
 	s.Logger.Infof("%s.IndexOptions.CommitLogMaxOpenedFiles: %v", database, opts.IndexOptions.CommitLogMaxOpenedFiles)
 	s.Logger.Infof("%s.IndexOptions.MaxBulkSize: %v", database, opts.IndexOptions.MaxBulkSize)
 	s.Logger.Infof("%s.IndexOptions.BulkPreparationTimeout: %v", database, opts.IndexOptions.BulkPreparationTimeout)
+	s.Logger.Infof("%s.IndexOptions.RebuildParallelism: %v", database, opts.IndexOptions.RebuildParallelism)
 	s.Logger.Infof("%s.AHTOptions.SyncThreshold: %v", database, opts.AHTOptions.SyncThreshold)
 	s.Logger.Infof("%s.AHTOptions.WriteBufferSize: %v", database, opts.AHTOptions.WriteBufferSize)
 }
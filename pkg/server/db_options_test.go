@@ -17,10 +17,15 @@ limitations under the License.
 package server
 
 import (
+	"context"
 	"testing"
 
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/replication"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestDefaultOptions(t *testing.T) {
@@ -53,6 +58,23 @@ func TestReplicaOptions(t *testing.T) {
 
 	require.NoError(t, opts.Validate())
 
+	opts.PrimaryHost = "primary"
+	opts.PrimaryTLSCACert = "ca.pem"
+	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
+
+	opts.PrimaryTLS = true
+	require.NoError(t, opts.Validate())
+	require.Equal(t, "primary", opts.primaryTLSOptions().Servername)
+
+	opts.PrimaryTLSServerName = "primary.local"
+	require.Equal(t, "primary.local", opts.primaryTLSOptions().Servername)
+
+	opts.PrimaryTLSCert = "cert.pem"
+	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
+
+	opts.PrimaryTLSKey = "key.pem"
+	require.NoError(t, opts.Validate())
+
 	opts.SyncAcks = 1
 	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
 
@@ -63,6 +85,80 @@ func TestReplicaOptions(t *testing.T) {
 	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
 }
 
+func TestPartialReplicaOptions(t *testing.T) {
+	dir := t.TempDir()
+
+	s, closer := testServer(DefaultOptions().WithDir(dir))
+	defer closer()
+
+	opts := s.defaultDBOptions("db1")
+
+	opts.KeyPrefixes = []string{"app/"}
+	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
+
+	opts.Replica = true
+	opts.PrefetchTxBufferSize = replication.DefaultPrefetchTxBufferSize
+	opts.ReplicationCommitConcurrency = replication.DefaultReplicationCommitConcurrency
+	opts.SyncAcks = 0
+	opts.SQLTables = []string{"table1"}
+
+	require.NoError(t, opts.Validate())
+	require.True(t, opts.isPartialReplica())
+	require.Equal(t, [][]byte{[]byte("app/")}, opts.partialReplicationKeyPrefixes())
+
+	settings := opts.databaseNullableSettings().ReplicationSettings
+	require.Equal(t, []string{"app/"}, settings.KeyPrefixes.Values)
+	require.Equal(t, []string{"table1"}, settings.SqlTables.Values)
+
+	opts.SyncReplication = true
+	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
+
+	opts.SyncReplication = false
+	opts.KeyPrefixes = []string{""}
+	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
+
+	opts.asPrimary(false, 0)
+	require.False(t, opts.isPartialReplica())
+	require.NoError(t, opts.Validate())
+}
+
+func TestLogShippingOptions(t *testing.T) {
+	dir := t.TempDir()
+
+	s, closer := testServer(DefaultOptions().WithDir(dir))
+	defer closer()
+
+	opts := s.defaultDBOptions("db1")
+
+	opts.LogShipping = true
+	require.NoError(t, opts.Validate())
+	require.True(t, opts.isLogShippingRequired())
+	require.False(t, opts.isReplicatorRequired())
+
+	opts.Replica = true
+	opts.PrefetchTxBufferSize = replication.DefaultPrefetchTxBufferSize
+	opts.ReplicationCommitConcurrency = replication.DefaultReplicationCommitConcurrency
+	opts.SyncAcks = 0
+	require.NoError(t, opts.Validate())
+	require.False(t, opts.isLogShippingRequired())
+	require.False(t, opts.isReplicatorRequired())
+
+	opts.PrimaryDatabase = "primarydb"
+	require.True(t, opts.isReplicatorRequired())
+	require.True(t, opts.databaseNullableSettings().ReplicationSettings.LogShipping.Value)
+
+	opts.SyncReplication = true
+	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
+
+	opts.SyncReplication = false
+	opts.KeyPrefixes = []string{"app/"}
+	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
+
+	opts.KeyPrefixes = nil
+	opts.asPrimary(false, 0)
+	require.True(t, opts.isLogShippingRequired())
+}
+
 func TestPrimaryOptions(t *testing.T) {
 	dir := t.TempDir()
 
@@ -86,6 +182,9 @@ func TestPrimaryOptions(t *testing.T) {
 	opts.AllowTxDiscarding = true
 	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
 
+	opts.PrimaryTLS = true
+	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
+
 	opts.ReplicationCommitConcurrency = 1
 	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
 
@@ -115,4 +214,114 @@ func TestPrimaryOptions(t *testing.T) {
 
 	opts.RetentionPeriod = -1
 	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
+
+	opts.CompactionMaxBytesPerSec = -1
+	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
+}
+
+func TestCompressionOptions(t *testing.T) {
+	dir := t.TempDir()
+
+	s, closer := testServer(DefaultOptions().WithDir(dir))
+	defer closer()
+
+	opts := s.defaultDBOptions("db1")
+	require.Equal(t, "none", opts.CompressionFormat)
+
+	// databases created before compression settings were introduced are not compressed
+	opts.CompressionFormat = ""
+	require.NoError(t, opts.Validate())
+	require.Equal(t, appendable.NoCompression, opts.storeOptions().CompressionFormat)
+	require.Equal(t, "none", opts.databaseNullableSettings().CompressionSettings.Format.Value)
+
+	opts.CompressionFormat = "zstd"
+	opts.CompressionLevel = appendable.DefaultCompression
+	opts.CompressionDictionary = []byte("dictionary")
+
+	opts.EmbeddedValues = true
+	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
+
+	opts.EmbeddedValues = false
+	require.NoError(t, opts.Validate())
+
+	stOpts := opts.storeOptions()
+	require.Equal(t, appendable.ZstdCompression, stOpts.CompressionFormat)
+	require.Equal(t, appendable.DefaultCompression, stOpts.CompressionLevel)
+	require.Equal(t, []byte("dictionary"), stOpts.CompressionDictionary)
+
+	settings := opts.databaseNullableSettings().CompressionSettings
+	require.Equal(t, "zstd", settings.Format.Value)
+	require.Zero(t, settings.Level.Value)
+	require.Equal(t, []byte("dictionary"), settings.Dictionary)
+
+	opts.CompressionFormat = "snappy"
+	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
+
+	opts.CompressionFormat = "brotli"
+	opts.CompressionDictionary = nil
+	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
+}
+
+func TestCreateDatabaseWithCompression(t *testing.T) {
+	serverOptions := DefaultOptions().
+		WithDir(t.TempDir()).
+		WithMetricsServer(false).
+		WithAdminPassword(auth.SysAdminPassword)
+
+	s, closer := testServer(serverOptions)
+	defer closer()
+
+	err := s.Initialize()
+	require.NoError(t, err)
+
+	lr, err := s.Login(context.Background(), &schema.LoginRequest{
+		User:     []byte(auth.SysAdminUsername),
+		Password: []byte(auth.SysAdminPassword),
+	})
+	require.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", lr.Token))
+
+	_, err = s.CreateDatabaseV2(ctx, &schema.CreateDatabaseRequest{
+		Name: "db1",
+		Settings: &schema.DatabaseNullableSettings{
+			EmbeddedValues: &schema.NullableBool{Value: true},
+			CompressionSettings: &schema.CompressionNullableSettings{
+				Format: &schema.NullableString{Value: "zstd"},
+			},
+		},
+	})
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	res, err := s.CreateDatabaseV2(ctx, &schema.CreateDatabaseRequest{
+		Name: "db1",
+		Settings: &schema.DatabaseNullableSettings{
+			EmbeddedValues: &schema.NullableBool{Value: false},
+			CompressionSettings: &schema.CompressionNullableSettings{
+				Format:     &schema.NullableString{Value: "zstd"},
+				Level:      &schema.NullableUint32{Value: 3},
+				Dictionary: []byte("dictionary"),
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "zstd", res.Settings.CompressionSettings.Format.Value)
+	require.Equal(t, uint32(3), res.Settings.CompressionSettings.Level.Value)
+	require.Equal(t, []byte("dictionary"), res.Settings.CompressionSettings.Dictionary)
+
+	db, err := s.dbList.GetByName("db1")
+	require.NoError(t, err)
+
+	_, err = db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key"), Value: []byte("value")}}})
+	require.NoError(t, err)
+
+	_, err = s.UpdateDatabaseV2(ctx, &schema.UpdateDatabaseRequest{
+		Database: "db1",
+		Settings: &schema.DatabaseNullableSettings{
+			CompressionSettings: &schema.CompressionNullableSettings{
+				Format: &schema.NullableString{Value: "snappy"},
+			},
+		},
+	})
+	require.ErrorIs(t, err, ErrIllegalArguments)
 }
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/codenotary/immudb/embedded/document"
 	"github.com/codenotary/immudb/pkg/api/protomodel"
@@ -30,7 +31,7 @@ import (
 )
 
 func (s *ImmuServer) CreateCollection(ctx context.Context, req *protomodel.CreateCollectionRequest) (*protomodel.CreateCollectionResponse, error) {
-	db, err := s.getDBFromCtx(ctx, "CreateCollection")
+	ctx, db, err := s.getSQLDBFromCtx(ctx, "CreateCollection")
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +43,7 @@ func (s *ImmuServer) CreateCollection(ctx context.Context, req *protomodel.Creat
 }
 
 func (s *ImmuServer) UpdateCollection(ctx context.Context, req *protomodel.UpdateCollectionRequest) (*protomodel.UpdateCollectionResponse, error) {
-	db, err := s.getDBFromCtx(ctx, "UpdateCollection")
+	ctx, db, err := s.getSQLDBFromCtx(ctx, "UpdateCollection")
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +55,7 @@ func (s *ImmuServer) UpdateCollection(ctx context.Context, req *protomodel.Updat
 }
 
 func (s *ImmuServer) GetCollection(ctx context.Context, req *protomodel.GetCollectionRequest) (*protomodel.GetCollectionResponse, error) {
-	db, err := s.getDBFromCtx(ctx, "GetCollection")
+	ctx, db, err := s.getSQLDBFromCtx(ctx, "GetCollection")
 	if err != nil {
 		return nil, err
 	}
@@ -66,7 +67,7 @@ func (s *ImmuServer) GetCollection(ctx context.Context, req *protomodel.GetColle
 }
 
 func (s *ImmuServer) GetCollections(ctx context.Context, req *protomodel.GetCollectionsRequest) (*protomodel.GetCollectionsResponse, error) {
-	db, err := s.getDBFromCtx(ctx, "GetCollections")
+	ctx, db, err := s.getSQLDBFromCtx(ctx, "GetCollections")
 	if err != nil {
 		return nil, err
 	}
@@ -78,7 +79,7 @@ func (s *ImmuServer) GetCollections(ctx context.Context, req *protomodel.GetColl
 }
 
 func (s *ImmuServer) DeleteCollection(ctx context.Context, req *protomodel.DeleteCollectionRequest) (*protomodel.DeleteCollectionResponse, error) {
-	db, err := s.getDBFromCtx(ctx, "DeleteCollection")
+	ctx, db, err := s.getSQLDBFromCtx(ctx, "DeleteCollection")
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +91,7 @@ func (s *ImmuServer) DeleteCollection(ctx context.Context, req *protomodel.Delet
 }
 
 func (s *ImmuServer) CreateIndex(ctx context.Context, req *protomodel.CreateIndexRequest) (*protomodel.CreateIndexResponse, error) {
-	db, err := s.getDBFromCtx(ctx, "CreateIndex")
+	ctx, db, err := s.getSQLDBFromCtx(ctx, "CreateIndex")
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +103,7 @@ func (s *ImmuServer) CreateIndex(ctx context.Context, req *protomodel.CreateInde
 }
 
 func (s *ImmuServer) DeleteIndex(ctx context.Context, req *protomodel.DeleteIndexRequest) (*protomodel.DeleteIndexResponse, error) {
-	db, err := s.getDBFromCtx(ctx, "DeleteIndex")
+	ctx, db, err := s.getSQLDBFromCtx(ctx, "DeleteIndex")
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +115,7 @@ func (s *ImmuServer) DeleteIndex(ctx context.Context, req *protomodel.DeleteInde
 }
 
 func (s *ImmuServer) InsertDocuments(ctx context.Context, req *protomodel.InsertDocumentsRequest) (*protomodel.InsertDocumentsResponse, error) {
-	db, err := s.getDBFromCtx(ctx, "InsertDocuments")
+	ctx, db, err := s.getSQLDBFromCtx(ctx, "InsertDocuments")
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +127,7 @@ func (s *ImmuServer) InsertDocuments(ctx context.Context, req *protomodel.Insert
 }
 
 func (s *ImmuServer) ReplaceDocuments(ctx context.Context, req *protomodel.ReplaceDocumentsRequest) (*protomodel.ReplaceDocumentsResponse, error) {
-	db, err := s.getDBFromCtx(ctx, "ReplaceDocuments")
+	ctx, db, err := s.getSQLDBFromCtx(ctx, "ReplaceDocuments")
 	if err != nil {
 		return nil, err
 	}
@@ -138,7 +139,7 @@ func (s *ImmuServer) ReplaceDocuments(ctx context.Context, req *protomodel.Repla
 }
 
 func (s *ImmuServer) AuditDocument(ctx context.Context, req *protomodel.AuditDocumentRequest) (*protomodel.AuditDocumentResponse, error) {
-	db, err := s.getDBFromCtx(ctx, "AuditDocument")
+	ctx, db, err := s.getSQLDBFromCtx(ctx, "AuditDocument")
 	if err != nil {
 		return nil, err
 	}
@@ -151,7 +152,7 @@ func (s *ImmuServer) AuditDocument(ctx context.Context, req *protomodel.AuditDoc
 }
 
 func (s *ImmuServer) SearchDocuments(ctx context.Context, req *protomodel.SearchDocumentsRequest) (*protomodel.SearchDocumentsResponse, error) {
-	db, err := s.getDBFromCtx(ctx, "SearchDocuments")
+	ctx, db, err := s.getSQLDBFromCtx(ctx, "SearchDocuments")
 	if err != nil {
 		return nil, err
 	}
@@ -186,6 +187,7 @@ func (s *ImmuServer) SearchDocuments(ctx context.Context, req *protomodel.Search
 
 	searchID := req.SearchId
 	query := req.Query
+	projection := req.Projection
 
 	var pgreader *sessions.PaginatedDocumentReader
 
@@ -210,6 +212,7 @@ func (s *ImmuServer) SearchDocuments(ctx context.Context, req *protomodel.Search
 			}
 
 			query = pgreader.Query
+			projection = pgreader.Projection
 			pgreader = nil
 		}
 	}
@@ -218,7 +221,7 @@ func (s *ImmuServer) SearchDocuments(ctx context.Context, req *protomodel.Search
 		// create a new reader and add it to the session
 		offset := int64((req.Page - 1) * req.PageSize)
 
-		docReader, err := db.SearchDocuments(ctx, query, offset)
+		docReader, err := db.SearchDocuments(ctx, query, projection, offset)
 		if err != nil {
 			return nil, err
 		}
@@ -227,6 +230,7 @@ func (s *ImmuServer) SearchDocuments(ctx context.Context, req *protomodel.Search
 		pgreader = &sessions.PaginatedDocumentReader{
 			Reader:         docReader,
 			Query:          query,
+			Projection:     projection,
 			LastPageNumber: req.Page,
 			LastPageSize:   req.PageSize,
 		}
@@ -262,7 +266,7 @@ func (s *ImmuServer) SearchDocuments(ctx context.Context, req *protomodel.Search
 }
 
 func (s *ImmuServer) CountDocuments(ctx context.Context, req *protomodel.CountDocumentsRequest) (*protomodel.CountDocumentsResponse, error) {
-	db, err := s.getDBFromCtx(ctx, "CountDocuments")
+	ctx, db, err := s.getSQLDBFromCtx(ctx, "CountDocuments")
 	if err != nil {
 		return nil, err
 	}
@@ -276,7 +280,7 @@ func (s *ImmuServer) CountDocuments(ctx context.Context, req *protomodel.CountDo
 }
 
 func (s *ImmuServer) DeleteDocuments(ctx context.Context, req *protomodel.DeleteDocumentsRequest) (*protomodel.DeleteDocumentsResponse, error) {
-	db, err := s.getDBFromCtx(ctx, "DeleteDocuments")
+	ctx, db, err := s.getSQLDBFromCtx(ctx, "DeleteDocuments")
 	if err != nil {
 		return nil, err
 	}
@@ -290,7 +294,7 @@ func (s *ImmuServer) DeleteDocuments(ctx context.Context, req *protomodel.Delete
 }
 
 func (s *ImmuServer) ProofDocument(ctx context.Context, req *protomodel.ProofDocumentRequest) (*protomodel.ProofDocumentResponse, error) {
-	db, err := s.getDBFromCtx(ctx, "ProofDocument")
+	ctx, db, err := s.getSQLDBFromCtx(ctx, "ProofDocument")
 	if err != nil {
 		return nil, err
 	}
@@ -320,3 +324,68 @@ func (s *ImmuServer) ProofDocument(ctx context.Context, req *protomodel.ProofDoc
 
 	return res, nil
 }
+
+func (s *ImmuServer) WatchCollection(req *protomodel.WatchCollectionRequest, stream protomodel.DocumentService_WatchCollectionServer) error {
+	ctx, db, err := s.getSQLDBFromCtx(stream.Context(), "WatchCollection")
+	if err != nil {
+		return err
+	}
+
+	return db.WatchCollection(ctx, req, stream.Send)
+}
+
+func (s *ImmuServer) ImportDocuments(stream protomodel.DocumentService_ImportDocumentsServer) error {
+	ctx, db, err := s.getSQLDBFromCtx(stream.Context(), "ImportDocuments")
+	if err != nil {
+		return err
+	}
+
+	req, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return fmt.Errorf("%w: no collection specified", ErrIllegalArguments)
+	}
+	if err != nil {
+		return err
+	}
+
+	res, err := db.ImportDocuments(ctx, req.CollectionName, &importDocumentsReader{
+		ndjson: req.Ndjson,
+		stream: stream,
+	})
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(res)
+}
+
+// importDocumentsReader reads the newline-delimited JSON chunks sent by the client
+type importDocumentsReader struct {
+	ndjson []byte
+	stream protomodel.DocumentService_ImportDocumentsServer
+}
+
+func (r *importDocumentsReader) Read(p []byte) (int, error) {
+	for len(r.ndjson) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		r.ndjson = req.Ndjson
+	}
+
+	n := copy(p, r.ndjson)
+	r.ndjson = r.ndjson[n:]
+
+	return n, nil
+}
+
+func (s *ImmuServer) ExportDocuments(req *protomodel.ExportDocumentsRequest, stream protomodel.DocumentService_ExportDocumentsServer) error {
+	ctx, db, err := s.getSQLDBFromCtx(stream.Context(), "ExportDocuments")
+	if err != nil {
+		return err
+	}
+
+	return db.ExportDocuments(ctx, req, s.Options.StreamChunkSize, stream.Send)
+}
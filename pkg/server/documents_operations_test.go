@@ -19,12 +19,16 @@ package server
 import (
 	"context"
 	"fmt"
+	"io"
+	"strings"
 	"testing"
 
+	"github.com/codenotary/immudb/embedded/document"
 	"github.com/codenotary/immudb/pkg/api/protomodel"
 	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/server/sessions"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/structpb"
 )
@@ -892,3 +896,356 @@ func TestDocuments(t *testing.T) {
 		}
 	})
 }
+
+func TestSearchDocumentsWithProjection(t *testing.T) {
+	dir := t.TempDir()
+
+	serverOptions := DefaultOptions().
+		WithDir(dir).
+		WithPort(0).
+		WithMetricsServer(false).
+		WithAdminPassword(auth.SysAdminPassword).
+		WithSigningKey("./../../test/signer/ec1.key")
+
+	s := DefaultServer().WithOptions(serverOptions).(*ImmuServer)
+	require.NoError(t, s.Initialize())
+
+	authServiceImp := &authenticationServiceImp{server: s}
+
+	logged, err := authServiceImp.OpenSession(context.Background(), &protomodel.OpenSessionRequest{
+		Username: "immudb",
+		Password: "immudb",
+		Database: "defaultdb",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, logged.SessionID)
+
+	md := metadata.Pairs("sessionid", logged.SessionID)
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	collectionName := "mycollection"
+
+	_, err = s.CreateCollection(ctx, &protomodel.CreateCollectionRequest{
+		Name: collectionName,
+		Fields: []*protomodel.Field{
+			{Name: "idx", Type: protomodel.FieldType_INTEGER},
+		},
+		Indexes: []*protomodel.Index{
+			{Fields: []string{"idx"}},
+		},
+	})
+	require.NoError(t, err)
+
+	for i := 1.0; i <= 10; i++ {
+		_, err = s.InsertDocuments(ctx, &protomodel.InsertDocumentsRequest{
+			CollectionName: collectionName,
+			Documents: []*structpb.Struct{
+				{
+					Fields: map[string]*structpb.Value{
+						"idx":     structpb.NewNumberValue(i),
+						"name":    structpb.NewStringValue(fmt.Sprintf("name-%d", int(i))),
+						"payload": structpb.NewStringValue("a large value not needed for listing"),
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	query := &protomodel.Query{
+		CollectionName: collectionName,
+		OrderBy: []*protomodel.OrderByClause{
+			{Field: "idx"},
+		},
+	}
+
+	t.Run("search with an invalid projection should fail", func(t *testing.T) {
+		_, err := s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{
+			Query:      query,
+			Page:       1,
+			PageSize:   5,
+			Projection: &protomodel.Projection{Include: []string{"1name"}},
+		})
+		require.ErrorIs(t, err, document.ErrIllegalArguments)
+	})
+
+	t.Run("projection should be kept when resuming a search", func(t *testing.T) {
+		resp, err := s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{
+			Query:      query,
+			Page:       1,
+			PageSize:   5,
+			KeepOpen:   true,
+			Projection: &protomodel.Projection{Exclude: []string{"payload"}},
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.SearchId)
+		require.Len(t, resp.Revisions, 5)
+
+		// requesting a non-consecutive page forces the reader to be re-created
+		resp, err = s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{
+			SearchId: resp.SearchId,
+			Page:     1,
+			PageSize: 10,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Revisions, 10)
+
+		for i, rev := range resp.Revisions {
+			require.Equal(t, float64(i+1), rev.Document.Fields["idx"].GetNumberValue())
+			require.NotContains(t, rev.Document.Fields, "payload")
+		}
+	})
+
+	t.Run("proofs should be obtainable for projected documents", func(t *testing.T) {
+		resp, err := s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{
+			Query:      query,
+			Page:       1,
+			PageSize:   1,
+			Projection: &protomodel.Projection{Include: []string{"name"}},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Revisions, 1)
+
+		rev := resp.Revisions[0]
+		require.NotEmpty(t, rev.DocumentId)
+		require.Len(t, rev.Document.Fields, 2)
+		require.Equal(t, "name-1", rev.Document.Fields["name"].GetStringValue())
+
+		proof, err := s.ProofDocument(ctx, &protomodel.ProofDocumentRequest{
+			CollectionName: collectionName,
+			DocumentId:     rev.DocumentId,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, proof.VerifiableTx)
+		require.NotEmpty(t, proof.EncodedDocument)
+	})
+}
+
+type documentServiceWatchCollectionServer struct {
+	grpc.ServerStream
+	ctx     context.Context
+	changes []*protomodel.WatchCollectionResponse
+	limit   int
+}
+
+func (s *documentServiceWatchCollectionServer) Send(change *protomodel.WatchCollectionResponse) error {
+	s.changes = append(s.changes, change)
+
+	if len(s.changes) == s.limit {
+		return io.EOF
+	}
+
+	return nil
+}
+
+func (s *documentServiceWatchCollectionServer) Context() context.Context {
+	return s.ctx
+}
+
+func TestWatchCollection(t *testing.T) {
+	dir := t.TempDir()
+
+	serverOptions := DefaultOptions().
+		WithDir(dir).
+		WithPort(0).
+		WithMetricsServer(false).
+		WithAdminPassword(auth.SysAdminPassword).
+		WithSigningKey("./../../test/signer/ec1.key")
+
+	s := DefaultServer().WithOptions(serverOptions).(*ImmuServer)
+	require.NoError(t, s.Initialize())
+
+	authServiceImp := &authenticationServiceImp{server: s}
+
+	logged, err := authServiceImp.OpenSession(context.Background(), &protomodel.OpenSessionRequest{
+		Username: "immudb",
+		Password: "immudb",
+		Database: "defaultdb",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, logged.SessionID)
+
+	md := metadata.Pairs("sessionid", logged.SessionID)
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	collectionName := "mycollection"
+
+	_, err = s.CreateCollection(ctx, &protomodel.CreateCollectionRequest{
+		Name: collectionName,
+		Fields: []*protomodel.Field{
+			{Name: "idx", Type: protomodel.FieldType_INTEGER},
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("watching without a query should fail", func(t *testing.T) {
+		err := s.WatchCollection(&protomodel.WatchCollectionRequest{}, &documentServiceWatchCollectionServer{ctx: ctx})
+		require.ErrorIs(t, err, document.ErrIllegalArguments)
+	})
+
+	insertRes, err := s.InsertDocuments(ctx, &protomodel.InsertDocumentsRequest{
+		CollectionName: collectionName,
+		Documents: []*structpb.Struct{
+			{
+				Fields: map[string]*structpb.Value{
+					"idx": structpb.NewNumberValue(1),
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = s.DeleteDocuments(ctx, &protomodel.DeleteDocumentsRequest{
+		Query: &protomodel.Query{
+			CollectionName: collectionName,
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("changes should be streamed from the specified transaction", func(t *testing.T) {
+		stream := &documentServiceWatchCollectionServer{ctx: ctx, limit: 2}
+
+		err := s.WatchCollection(&protomodel.WatchCollectionRequest{
+			Query: &protomodel.Query{
+				CollectionName: collectionName,
+			},
+			SinceTransactionId: insertRes.TransactionId - 1,
+		}, stream)
+		require.ErrorIs(t, err, io.EOF)
+		require.Len(t, stream.changes, 2)
+
+		require.Equal(t, protomodel.DocumentChangeType_INSERTED, stream.changes[0].ChangeType)
+		require.Equal(t, insertRes.TransactionId, stream.changes[0].Revision.TransactionId)
+		require.Equal(t, insertRes.DocumentIds[0], stream.changes[0].Revision.DocumentId)
+
+		require.Equal(t, protomodel.DocumentChangeType_DELETED, stream.changes[1].ChangeType)
+		require.Equal(t, insertRes.DocumentIds[0], stream.changes[1].Revision.DocumentId)
+		require.True(t, stream.changes[1].Revision.Metadata.Deleted)
+	})
+
+	t.Run("watching should stop when the stream is closed", func(t *testing.T) {
+		cancelledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		err := s.WatchCollection(&protomodel.WatchCollectionRequest{
+			Query: &protomodel.Query{
+				CollectionName: collectionName,
+			},
+		}, &documentServiceWatchCollectionServer{ctx: cancelledCtx})
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+type documentServiceImportDocumentsServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	reqs []*protomodel.ImportDocumentsRequest
+	res  *protomodel.ImportDocumentsResponse
+}
+
+func (s *documentServiceImportDocumentsServer) Recv() (*protomodel.ImportDocumentsRequest, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+
+	return req, nil
+}
+
+func (s *documentServiceImportDocumentsServer) SendAndClose(res *protomodel.ImportDocumentsResponse) error {
+	s.res = res
+	return nil
+}
+
+func (s *documentServiceImportDocumentsServer) Context() context.Context {
+	return s.ctx
+}
+
+type documentServiceExportDocumentsServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	chunks []*protomodel.ExportDocumentsResponse
+}
+
+func (s *documentServiceExportDocumentsServer) Send(chunk *protomodel.ExportDocumentsResponse) error {
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+func (s *documentServiceExportDocumentsServer) Context() context.Context {
+	return s.ctx
+}
+
+func TestImportAndExportDocuments(t *testing.T) {
+	dir := t.TempDir()
+
+	serverOptions := DefaultOptions().
+		WithDir(dir).
+		WithPort(0).
+		WithMetricsServer(false).
+		WithAdminPassword(auth.SysAdminPassword).
+		WithSigningKey("./../../test/signer/ec1.key").
+		WithStreamChunkSize(4096)
+
+	s := DefaultServer().WithOptions(serverOptions).(*ImmuServer)
+	require.NoError(t, s.Initialize())
+
+	authServiceImp := &authenticationServiceImp{server: s}
+
+	logged, err := authServiceImp.OpenSession(context.Background(), &protomodel.OpenSessionRequest{
+		Username: "immudb",
+		Password: "immudb",
+		Database: "defaultdb",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, logged.SessionID)
+
+	md := metadata.Pairs("sessionid", logged.SessionID)
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	collectionName := "mycollection"
+
+	_, err = s.CreateCollection(ctx, &protomodel.CreateCollectionRequest{
+		Name: collectionName,
+		Fields: []*protomodel.Field{
+			{Name: "idx", Type: protomodel.FieldType_INTEGER},
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("importing without specifying the collection should fail", func(t *testing.T) {
+		err := s.ImportDocuments(&documentServiceImportDocumentsServer{ctx: ctx})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	t.Run("documents spanning several chunks should be imported", func(t *testing.T) {
+		stream := &documentServiceImportDocumentsServer{
+			ctx: ctx,
+			reqs: []*protomodel.ImportDocumentsRequest{
+				{CollectionName: collectionName, Ndjson: []byte(`{"idx": 1}`)},
+				{Ndjson: []byte("\n{\"id")},
+				{},
+				{Ndjson: []byte("x\": 2}\n")},
+			},
+		}
+
+		err := s.ImportDocuments(stream)
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), stream.res.DocumentCount)
+		require.Equal(t, stream.res.FirstTransactionId, stream.res.LastTransactionId)
+	})
+
+	t.Run("documents should be exported in chunks", func(t *testing.T) {
+		stream := &documentServiceExportDocumentsServer{ctx: ctx}
+
+		err := s.ExportDocuments(&protomodel.ExportDocumentsRequest{CollectionName: collectionName}, stream)
+		require.NoError(t, err)
+		require.Len(t, stream.chunks, 1)
+
+		lines := strings.Split(strings.TrimSpace(string(stream.chunks[0].Ndjson)), "\n")
+		require.Len(t, lines, 2)
+		require.Contains(t, lines[0], `"idx"`)
+	})
+}
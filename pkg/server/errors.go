@@ -57,6 +57,14 @@ var (
 	ErrTruncatorNotNeeded          = errors.New("truncator is not needed")
 	ErrTruncatorNotInProgress      = errors.New("truncation is not in progress")
 	ErrTruncatorDoesNotExist       = errors.New("truncator does not exist")
+	ErrBackupNotNeeded             = errors.New("backup is not needed")
+	ErrBackupNotInProgress         = errors.New("backup is not in progress")
+	ErrLogShippingNotNeeded        = errors.New("log shipping is not needed")
+	ErrLogShippingNotInProgress    = errors.New("log shipping is not in progress")
+	ErrLogShippingNotConfigured    = errors.New("log shipping storage is not configured")
+	ErrRoleAlreadyExists           = errors.New("role already exists")
+	ErrRoleNotFound                = errors.New("role not found")
+	ErrRoleInUse                   = errors.New("role is granted to users")
 )
 
 func mapServerError(err error) error {
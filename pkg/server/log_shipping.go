@@ -0,0 +1,100 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"github.com/codenotary/immudb/embedded/remotestorage"
+	"github.com/codenotary/immudb/pkg/backup"
+	"github.com/codenotary/immudb/pkg/database"
+)
+
+func (s *ImmuServer) createLogShippingStorageInstance() (remotestorage.Storage, error) {
+	if s.Options.LogShippingOptions == nil || s.Options.LogShippingOptions.URL == "" {
+		return nil, nil
+	}
+
+	return OpenRemoteStorageURL(
+		s.Options.LogShippingOptions.URL,
+		s.Options.LogShippingOptions.AccessKeyID,
+		s.Options.LogShippingOptions.SecretKey,
+	)
+}
+
+// startLogShippingFor periodically uploads the transactions of a primary database with log shipping enabled.
+// Transactions are shipped as backup segments, log shipping replicas poll and replicate them
+func (s *ImmuServer) startLogShippingFor(db database.DB, dbOpts *dbOptions) error {
+	if !dbOpts.isLogShippingRequired() {
+		return ErrLogShippingNotNeeded
+	}
+
+	if s.logShippingStorage == nil {
+		return ErrLogShippingNotConfigured
+	}
+
+	s.logShippingMutex.Lock()
+	defer s.logShippingMutex.Unlock()
+
+	if _, ok := s.logShippers[db.GetName()]; ok {
+		return backup.ErrBackupAlreadyRunning
+	}
+
+	// states are left unsigned when no signing key is configured
+	b := backup.NewBackup(db, s.logShippingStorage, s.StateSigner, s.Options.LogShippingOptions.Frequency, s.Logger).
+		WithMaxSegmentTxs(s.Options.LogShippingOptions.MaxSegmentTxs)
+
+	err := b.Start()
+	if err != nil {
+		return err
+	}
+
+	s.logShippers[db.GetName()] = b
+
+	return nil
+}
+
+func (s *ImmuServer) stopLogShippingFor(db string) error {
+	s.logShippingMutex.Lock()
+	defer s.logShippingMutex.Unlock()
+
+	b, ok := s.logShippers[db]
+	if !ok {
+		return ErrLogShippingNotInProgress
+	}
+
+	err := b.Stop()
+	if err != nil && err != backup.ErrBackupAlreadyStopped {
+		return err
+	}
+
+	delete(s.logShippers, db)
+
+	return nil
+}
+
+func (s *ImmuServer) stopLogShipping() {
+	s.logShippingMutex.Lock()
+	defer s.logShippingMutex.Unlock()
+
+	for db, b := range s.logShippers {
+		err := b.Stop()
+		if err != nil {
+			s.Logger.Warningf("Error stopping log shipping for '%s'. Reason: %v", db, err)
+		} else {
+			delete(s.logShippers, db)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/remotestorage/memory"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/replication"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestServerLogShipping(t *testing.T) {
+	opts := DefaultOptions().
+		WithDir(t.TempDir()).
+		WithPort(0).
+		WithLogShippingOptions(DefaultLogShippingOptions().WithFrequency(10 * time.Millisecond))
+
+	s, closer := testServer(opts)
+	defer closer()
+
+	s.logShippingStorage = memory.Open()
+
+	err := s.Initialize()
+	require.NoError(t, err)
+
+	resp, err := s.OpenSession(context.Background(), &schema.OpenSessionRequest{
+		Username:     []byte(auth.SysAdminUsername),
+		Password:     []byte(auth.SysAdminPassword),
+		DatabaseName: DefaultDBName,
+	})
+	require.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"sessionid": resp.GetSessionID()}))
+
+	_, err = s.CreateDatabaseV2(ctx, &schema.CreateDatabaseRequest{
+		Name: "primarydb",
+		Settings: &schema.DatabaseNullableSettings{
+			ReplicationSettings: &schema.ReplicationNullableSettings{
+				LogShipping: &schema.NullableBool{Value: true},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = s.CreateDatabaseV2(ctx, &schema.CreateDatabaseRequest{
+		Name: "replicadb",
+		Settings: &schema.DatabaseNullableSettings{
+			ReplicationSettings: &schema.ReplicationNullableSettings{
+				Replica:         &schema.NullableBool{Value: true},
+				PrimaryDatabase: &schema.NullableString{Value: "primarydb"},
+				LogShipping:     &schema.NullableBool{Value: true},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	primary, err := s.dbList.GetByName("primarydb")
+	require.NoError(t, err)
+
+	for i := 0; i < 5; i++ {
+		_, err = primary.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{
+			{Key: []byte("key1"), Value: []byte{byte(i)}},
+		}})
+		require.NoError(t, err)
+	}
+
+	primaryState, err := primary.CurrentState()
+	require.NoError(t, err)
+
+	replica, err := s.dbList.GetByName("replicadb")
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		state, err := replica.CurrentState()
+		return err == nil && state.TxId == primaryState.TxId
+	}, 10*time.Second, 10*time.Millisecond)
+
+	replicaState, err := replica.CurrentState()
+	require.NoError(t, err)
+	require.Equal(t, primaryState.TxHash, replicaState.TxHash)
+
+	res, err := s.ReplicationStatus(ctx, &schema.ReplicationStatusRequest{Database: "replicadb"})
+	require.NoError(t, err)
+	require.True(t, res.Replica)
+	require.NotNil(t, res.PrimaryStatus)
+	require.Equal(t, replication.StateReplicating, res.PrimaryStatus.State)
+
+	_, err = s.UnloadDatabase(ctx, &schema.UnloadDatabaseRequest{Database: "primarydb"})
+	require.NoError(t, err)
+
+	_, ok := s.logShippers["primarydb"]
+	require.False(t, ok)
+
+	err = s.stopLogShippingFor("primarydb")
+	require.ErrorIs(t, err, ErrLogShippingNotInProgress)
+}
+
+func TestServerLogShippingNotConfigured(t *testing.T) {
+	s, closer := testServer(DefaultOptions().WithDir(t.TempDir()).WithPort(0))
+	defer closer()
+
+	err := s.Initialize()
+	require.NoError(t, err)
+
+	resp, err := s.OpenSession(context.Background(), &schema.OpenSessionRequest{
+		Username:     []byte(auth.SysAdminUsername),
+		Password:     []byte(auth.SysAdminPassword),
+		DatabaseName: DefaultDBName,
+	})
+	require.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"sessionid": resp.GetSessionID()}))
+
+	_, err = s.CreateDatabaseV2(ctx, &schema.CreateDatabaseRequest{
+		Name: "primarydb",
+		Settings: &schema.DatabaseNullableSettings{
+			ReplicationSettings: &schema.ReplicationNullableSettings{
+				LogShipping: &schema.NullableBool{Value: true},
+			},
+		},
+	})
+	require.ErrorIs(t, err, ErrLogShippingNotConfigured)
+}
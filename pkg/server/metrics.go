@@ -59,6 +59,18 @@ type MetricsCollection struct {
 	LastMessageAtPerClientGauges *prometheus.GaugeVec
 
 	RemoteStorageKind *prometheus.GaugeVec
+
+	computeReplicationLags      func() []ReplicationLag
+	ReplicationLagTxsGauges     *prometheus.GaugeVec
+	ReplicationLagSecondsGauges *prometheus.GaugeVec
+}
+
+// ReplicationLag is the replication lag of a replica fetching transactions from a database
+type ReplicationLag struct {
+	DB      string
+	Replica string
+	Txs     uint64
+	Seconds float64
 }
 
 var metricsNamespace = "immudb"
@@ -97,6 +109,11 @@ func (mc *MetricsCollection) WithComputeDBEntries(f func() map[string]float64) {
 	mc.computeDBEntries = f
 }
 
+// WithComputeReplicationLags ...
+func (mc *MetricsCollection) WithComputeReplicationLags(f func() []ReplicationLag) {
+	mc.computeReplicationLags = f
+}
+
 // UpdateDBMetrics ...
 func (mc *MetricsCollection) UpdateDBMetrics() {
 	if mc.computeDBSizes != nil {
@@ -109,6 +126,16 @@ func (mc *MetricsCollection) UpdateDBMetrics() {
 			mc.DBEntriesGauges.WithLabelValues(db).Set(nbEntries)
 		}
 	}
+	if mc.computeReplicationLags != nil {
+		// replicas no longer connected must not be reported
+		mc.ReplicationLagTxsGauges.Reset()
+		mc.ReplicationLagSecondsGauges.Reset()
+
+		for _, lag := range mc.computeReplicationLags() {
+			mc.ReplicationLagTxsGauges.WithLabelValues(lag.DB, lag.Replica).Set(float64(lag.Txs))
+			mc.ReplicationLagSecondsGauges.WithLabelValues(lag.DB, lag.Replica).Set(lag.Seconds)
+		}
+	}
 }
 
 // Metrics immudb Prometheus metrics collection
@@ -153,6 +180,22 @@ var Metrics = MetricsCollection{
 		},
 		[]string{"db", "kind"},
 	),
+	ReplicationLagTxsGauges: promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "replication_replica_lag_txs",
+			Help:      "Number of committed transactions not yet acknowledged by the replica.",
+		},
+		[]string{"db", "replica"},
+	),
+	ReplicationLagSecondsGauges: promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "replication_replica_lag_seconds",
+			Help:      "Seconds elapsed since the oldest transaction not yet acknowledged by the replica was committed.",
+		},
+		[]string{"db", "replica"},
+	),
 }
 
 // StartMetrics listens and servers the HTTP metrics server in a new goroutine.
@@ -164,12 +207,14 @@ func StartMetrics(
 	uptimeCounter func() float64,
 	computeDBSizes func() map[string]float64,
 	computeDBEntries func() map[string]float64,
+	computeReplicationLags func() []ReplicationLag,
 	addPProf bool,
 ) *http.Server {
 
 	Metrics.WithUptimeCounter(uptimeCounter)
 	Metrics.WithComputeDBSizes(computeDBSizes)
 	Metrics.WithComputeDBEntries(computeDBEntries)
+	Metrics.WithComputeReplicationLags(computeReplicationLags)
 
 	go func() {
 		Metrics.UpdateDBMetrics()
@@ -17,6 +17,7 @@ limitations under the License.
 package server
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -24,6 +25,7 @@ import (
 	"time"
 
 	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/database"
 )
 
 func (s *ImmuServer) metricFuncServerUptimeCounter() float64 {
@@ -136,3 +138,54 @@ func (s *ImmuServer) metricFuncComputeDBEntries() (nbEntriesPerDB map[string]flo
 
 	return
 }
+
+func (s *ImmuServer) metricFuncComputeReplicationLags() (lags []ReplicationLag) {
+	var dbs []database.DB
+
+	if s.dbList != nil {
+		for i := 0; i < s.dbList.Length(); i++ {
+			db, err := s.dbList.GetByIndex(i)
+			if err != nil {
+				continue
+			}
+			dbs = append(dbs, db)
+		}
+	}
+
+	if s.sysDB != nil {
+		dbs = append(dbs, s.sysDB)
+	}
+
+	for _, db := range dbs {
+		dbName := db.GetName()
+
+		replicas := s.connectedReplicas.list(dbName)
+		if len(replicas) == 0 {
+			continue
+		}
+
+		state, err := db.CurrentState()
+		if errors.Is(err, store.ErrAlreadyClosed) {
+			continue
+		}
+		if err != nil {
+			s.Logger.Errorf(
+				"error getting current state of db %s to update the replication lag metrics: %v",
+				dbName, err)
+			continue
+		}
+
+		for _, r := range replicas {
+			lagTxs, lagSeconds := replicationLag(context.Background(), db, state.TxId, r.lastAckTxID)
+
+			lags = append(lags, ReplicationLag{
+				DB:      dbName,
+				Replica: r.id,
+				Txs:     lagTxs,
+				Seconds: lagSeconds,
+			})
+		}
+	}
+
+	return
+}
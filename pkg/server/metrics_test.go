@@ -39,6 +39,7 @@ func TestStartMetrics(t *testing.T) {
 		func() float64 { return 0 },
 		func() map[string]float64 { return make(map[string]float64) },
 		func() map[string]float64 { return make(map[string]float64) },
+		func() []ReplicationLag { return nil },
 		false,
 	)
 	time.Sleep(200 * time.Millisecond)
@@ -59,6 +60,7 @@ func TestStartMetricsFail(t *testing.T) {
 		func() float64 { return 0 },
 		func() map[string]float64 { return make(map[string]float64) },
 		func() map[string]float64 { return make(map[string]float64) },
+		func() []ReplicationLag { return nil },
 		false,
 	)
 	time.Sleep(200 * time.Millisecond)
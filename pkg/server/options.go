@@ -22,7 +22,10 @@ import (
 	"net"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/codenotary/immudb/embedded/appendable/encrypted"
+	"github.com/codenotary/immudb/pkg/backup"
 	"github.com/codenotary/immudb/pkg/logger"
 	"github.com/codenotary/immudb/pkg/replication"
 	"github.com/codenotary/immudb/pkg/server/sessions"
@@ -64,6 +67,11 @@ type Options struct {
 	SigningKey                  string
 	synced                      bool
 	RemoteStorageOptions        *RemoteStorageOptions
+	BackupOptions               *BackupOptions
+	LogShippingOptions          *LogShippingOptions
+	EncryptionOptions           *EncryptionOptions
+	JWTOptions                  *auth.JWTOptions
+	LDAPOptions                 *auth.LDAPOptions
 	StreamChunkSize             int
 	TokenExpiryTimeMin          int
 	PgsqlServer                 bool
@@ -76,6 +84,10 @@ type Options struct {
 }
 
 type RemoteStorageOptions struct {
+	URL         string // the scheme selects the backend: s3, azblob, gs or file
+	AccessKeyID string
+	SecretKey   string `json:"-"`
+
 	S3Storage            bool
 	S3Endpoint           string
 	S3AccessKeyID        string
@@ -83,7 +95,39 @@ type RemoteStorageOptions struct {
 	S3BucketName         string
 	S3Location           string
 	S3PathPrefix         string
-	S3ExternalIdentifier bool
+	S3ExternalIdentifier bool // also applies when the storage is selected by URL
+
+	LocalChunks    int   // number of most recent chunks kept on local disk
+	LocalSize      int64 // number of bytes of most recent chunks kept on local disk
+	CacheSize      int64 // size in bytes of the local disk cache for older chunks
+	PrefetchChunks int   // number of chunks fetched ahead on sequential reads
+}
+
+type BackupOptions struct {
+	URL           string // backups are disabled when empty, see RemoteStorageOptions.URL
+	AccessKeyID   string
+	SecretKey     string `json:"-"`
+	Frequency     time.Duration
+	MaxSegmentTxs int
+}
+
+// LogShippingOptions configure the storage where primary databases with log shipping enabled
+// periodically upload their transactions and where log shipping replicas poll for them.
+// Frequency applies to both uploads and polling
+type LogShippingOptions struct {
+	URL           string // log shipping is disabled when empty, see RemoteStorageOptions.URL
+	AccessKeyID   string
+	SecretKey     string `json:"-"`
+	Frequency     time.Duration
+	MaxSegmentTxs int
+}
+
+// EncryptionOptions configure the keys the data of every database is encrypted with.
+// Databases created without encryption can not be opened once it's enabled and vice versa
+type EncryptionOptions struct {
+	KeyFile   string // file holding the keys, one "<key id>:<hex encoded key>" per line
+	KeyEnv    string // environment variable holding comma-separated keys, used if KeyFile is empty
+	BlockSize int    // number of plaintext bytes encrypted and authenticated together
 }
 
 type ReplicationOptions struct {
@@ -99,6 +143,11 @@ type ReplicationOptions struct {
 	AllowTxDiscarding            bool   // only if IsReplica
 	SkipIntegrityCheck           bool   // only if IsReplica
 	WaitForIndexing              bool   // only if IsReplica
+	PrimaryTLS                   bool   // only if IsReplica
+	PrimaryTLSServerName         string // only if IsReplica && PrimaryTLS
+	PrimaryTLSCACert             string // only if IsReplica && PrimaryTLS
+	PrimaryTLSCert               string // only if IsReplica && PrimaryTLS
+	PrimaryTLSKey                string // only if IsReplica && PrimaryTLS
 }
 
 // DefaultOptions returns default server options
@@ -129,6 +178,11 @@ func DefaultOptions() *Options {
 		maintenance:                 false,
 		synced:                      true,
 		RemoteStorageOptions:        DefaultRemoteStorageOptions(),
+		BackupOptions:               DefaultBackupOptions(),
+		LogShippingOptions:          DefaultLogShippingOptions(),
+		EncryptionOptions:           DefaultEncryptionOptions(),
+		JWTOptions:                  auth.DefaultJWTOptions(),
+		LDAPOptions:                 auth.DefaultLDAPOptions(),
 		StreamChunkSize:             stream.DefaultChunkSize,
 		TokenExpiryTimeMin:          1440,
 		PgsqlServer:                 false,
@@ -146,6 +200,26 @@ func DefaultRemoteStorageOptions() *RemoteStorageOptions {
 	}
 }
 
+func DefaultBackupOptions() *BackupOptions {
+	return &BackupOptions{
+		Frequency:     time.Hour,
+		MaxSegmentTxs: backup.DefaultMaxSegmentTxs,
+	}
+}
+
+func DefaultLogShippingOptions() *LogShippingOptions {
+	return &LogShippingOptions{
+		Frequency:     10 * time.Second,
+		MaxSegmentTxs: backup.DefaultMaxSegmentTxs,
+	}
+}
+
+func DefaultEncryptionOptions() *EncryptionOptions {
+	return &EncryptionOptions{
+		BlockSize: encrypted.DefaultBlockSize,
+	}
+}
+
 func DefaultReplicationOptions() *ReplicationOptions {
 	return &ReplicationOptions{
 		IsReplica:                    false,
@@ -315,6 +389,17 @@ func (o *Options) String() string {
 	if o.SigningKey != "" {
 		opts = append(opts, rightPad("Signing key", o.SigningKey))
 	}
+	if o.RemoteStorageOptions.URL != "" {
+		opts = append(opts, "Remote storage")
+		opts = append(opts, rightPad("   url", o.RemoteStorageOptions.URL))
+		opts = append(opts, rightPad("   external id", o.RemoteStorageOptions.S3ExternalIdentifier))
+	}
+	if (o.RemoteStorageOptions.URL != "" || o.RemoteStorageOptions.S3Storage) &&
+		(o.RemoteStorageOptions.LocalChunks > 0 || o.RemoteStorageOptions.LocalSize > 0 || o.RemoteStorageOptions.CacheSize > 0) {
+		opts = append(opts, rightPad("   local chunks", o.RemoteStorageOptions.LocalChunks))
+		opts = append(opts, rightPad("   local size", o.RemoteStorageOptions.LocalSize))
+		opts = append(opts, rightPad("   cache size", o.RemoteStorageOptions.CacheSize))
+	}
 	if o.RemoteStorageOptions.S3Storage {
 		opts = append(opts, "S3 storage")
 		opts = append(opts, rightPad("   endpoint", o.RemoteStorageOptions.S3Endpoint))
@@ -325,6 +410,47 @@ func (o *Options) String() string {
 		opts = append(opts, rightPad("   prefix", o.RemoteStorageOptions.S3PathPrefix))
 		opts = append(opts, rightPad("   external id", o.RemoteStorageOptions.S3ExternalIdentifier))
 	}
+	if o.BackupOptions != nil && o.BackupOptions.URL != "" {
+		opts = append(opts, "Backups")
+		opts = append(opts, rightPad("   url", o.BackupOptions.URL))
+		opts = append(opts, rightPad("   frequency", o.BackupOptions.Frequency))
+	}
+	if o.LogShippingOptions != nil && o.LogShippingOptions.URL != "" {
+		opts = append(opts, "Log shipping")
+		opts = append(opts, rightPad("   url", o.LogShippingOptions.URL))
+		opts = append(opts, rightPad("   frequency", o.LogShippingOptions.Frequency))
+	}
+	if o.EncryptionOptions.Enabled() {
+		opts = append(opts, "Encryption")
+		if o.EncryptionOptions.KeyFile != "" {
+			opts = append(opts, rightPad("   key file", o.EncryptionOptions.KeyFile))
+		} else {
+			opts = append(opts, rightPad("   key env", o.EncryptionOptions.KeyEnv))
+		}
+		opts = append(opts, rightPad("   block size", o.EncryptionOptions.BlockSize))
+	}
+	if o.JWTOptions.Enabled() {
+		opts = append(opts, "JWT authentication")
+		if o.JWTOptions.JWKSURL != "" {
+			opts = append(opts, rightPad("   jwks url", o.JWTOptions.JWKSURL))
+		} else {
+			opts = append(opts, rightPad("   jwks file", o.JWTOptions.JWKSFile))
+		}
+		if o.JWTOptions.Issuer != "" {
+			opts = append(opts, rightPad("   issuer", o.JWTOptions.Issuer))
+		}
+		if o.JWTOptions.Audience != "" {
+			opts = append(opts, rightPad("   audience", o.JWTOptions.Audience))
+		}
+	}
+	if o.LDAPOptions.Enabled() {
+		opts = append(opts, "LDAP authentication")
+		opts = append(opts, rightPad("   url", o.LDAPOptions.URL))
+		opts = append(opts, rightPad("   user base dn", o.LDAPOptions.UserBaseDN))
+		if o.LDAPOptions.GroupBaseDN != "" {
+			opts = append(opts, rightPad("   group base dn", o.LDAPOptions.GroupBaseDN))
+		}
+	}
 	if o.AdminPassword == auth.SysAdminPassword {
 		opts = append(opts, "----------------------------------------")
 		opts = append(opts, "Superadmin default credentials")
@@ -451,6 +577,31 @@ func (o *Options) WithRemoteStorageOptions(remoteStorageOptions *RemoteStorageOp
 	return o
 }
 
+func (o *Options) WithBackupOptions(backupOptions *BackupOptions) *Options {
+	o.BackupOptions = backupOptions
+	return o
+}
+
+func (o *Options) WithLogShippingOptions(logShippingOptions *LogShippingOptions) *Options {
+	o.LogShippingOptions = logShippingOptions
+	return o
+}
+
+func (o *Options) WithEncryptionOptions(encryptionOptions *EncryptionOptions) *Options {
+	o.EncryptionOptions = encryptionOptions
+	return o
+}
+
+func (o *Options) WithJWTOptions(jwtOptions *auth.JWTOptions) *Options {
+	o.JWTOptions = jwtOptions
+	return o
+}
+
+func (o *Options) WithLDAPOptions(ldapOptions *auth.LDAPOptions) *Options {
+	o.LDAPOptions = ldapOptions
+	return o
+}
+
 func (o *Options) WithReplicationOptions(replicationOptions *ReplicationOptions) *Options {
 	o.ReplicationOptions = replicationOptions
 	return o
@@ -474,6 +625,21 @@ func (o *Options) WithGRPCReflectionServerEnabled(enabled bool) *Options {
 
 // RemoteStorageOptions
 
+func (opts *RemoteStorageOptions) WithURL(url string) *RemoteStorageOptions {
+	opts.URL = url
+	return opts
+}
+
+func (opts *RemoteStorageOptions) WithAccessKeyID(accessKeyID string) *RemoteStorageOptions {
+	opts.AccessKeyID = accessKeyID
+	return opts
+}
+
+func (opts *RemoteStorageOptions) WithSecretKey(secretKey string) *RemoteStorageOptions {
+	opts.SecretKey = secretKey
+	return opts
+}
+
 func (opts *RemoteStorageOptions) WithS3Storage(S3Storage bool) *RemoteStorageOptions {
 	opts.S3Storage = S3Storage
 	return opts
@@ -514,6 +680,109 @@ func (opts *RemoteStorageOptions) WithS3ExternalIdentifier(s3ExternalIdentifier
 	return opts
 }
 
+func (opts *RemoteStorageOptions) WithLocalChunks(localChunks int) *RemoteStorageOptions {
+	opts.LocalChunks = localChunks
+	return opts
+}
+
+func (opts *RemoteStorageOptions) WithLocalSize(localSize int64) *RemoteStorageOptions {
+	opts.LocalSize = localSize
+	return opts
+}
+
+func (opts *RemoteStorageOptions) WithCacheSize(cacheSize int64) *RemoteStorageOptions {
+	opts.CacheSize = cacheSize
+	return opts
+}
+
+func (opts *RemoteStorageOptions) WithPrefetchChunks(prefetchChunks int) *RemoteStorageOptions {
+	opts.PrefetchChunks = prefetchChunks
+	return opts
+}
+
+// BackupOptions
+
+func (opts *BackupOptions) WithURL(url string) *BackupOptions {
+	opts.URL = url
+	return opts
+}
+
+func (opts *BackupOptions) WithAccessKeyID(accessKeyID string) *BackupOptions {
+	opts.AccessKeyID = accessKeyID
+	return opts
+}
+
+func (opts *BackupOptions) WithSecretKey(secretKey string) *BackupOptions {
+	opts.SecretKey = secretKey
+	return opts
+}
+
+func (opts *BackupOptions) WithFrequency(frequency time.Duration) *BackupOptions {
+	opts.Frequency = frequency
+	return opts
+}
+
+func (opts *BackupOptions) WithMaxSegmentTxs(maxSegmentTxs int) *BackupOptions {
+	opts.MaxSegmentTxs = maxSegmentTxs
+	return opts
+}
+
+// LogShippingOptions
+
+func (opts *LogShippingOptions) WithURL(url string) *LogShippingOptions {
+	opts.URL = url
+	return opts
+}
+
+func (opts *LogShippingOptions) WithAccessKeyID(accessKeyID string) *LogShippingOptions {
+	opts.AccessKeyID = accessKeyID
+	return opts
+}
+
+func (opts *LogShippingOptions) WithSecretKey(secretKey string) *LogShippingOptions {
+	opts.SecretKey = secretKey
+	return opts
+}
+
+func (opts *LogShippingOptions) WithFrequency(frequency time.Duration) *LogShippingOptions {
+	opts.Frequency = frequency
+	return opts
+}
+
+func (opts *LogShippingOptions) WithMaxSegmentTxs(maxSegmentTxs int) *LogShippingOptions {
+	opts.MaxSegmentTxs = maxSegmentTxs
+	return opts
+}
+
+// EncryptionOptions
+
+func (opts *EncryptionOptions) Enabled() bool {
+	return opts != nil && (opts.KeyFile != "" || opts.KeyEnv != "")
+}
+
+// KeyProvider loads the configured keys
+func (opts *EncryptionOptions) KeyProvider() (encrypted.KeyProvider, error) {
+	if opts.KeyFile != "" {
+		return encrypted.NewKeyFileProvider(opts.KeyFile)
+	}
+	return encrypted.NewEnvKeyProvider(opts.KeyEnv)
+}
+
+func (opts *EncryptionOptions) WithKeyFile(keyFile string) *EncryptionOptions {
+	opts.KeyFile = keyFile
+	return opts
+}
+
+func (opts *EncryptionOptions) WithKeyEnv(keyEnv string) *EncryptionOptions {
+	opts.KeyEnv = keyEnv
+	return opts
+}
+
+func (opts *EncryptionOptions) WithBlockSize(blockSize int) *EncryptionOptions {
+	opts.BlockSize = blockSize
+	return opts
+}
+
 // ReplicationOptions
 
 func (opts *ReplicationOptions) WithIsReplica(isReplica bool) *ReplicationOptions {
@@ -575,3 +844,28 @@ func (opts *ReplicationOptions) WithWaitForIndexing(waitForIndexingç bool) *Rep
 	opts.WaitForIndexing = waitForIndexingç
 	return opts
 }
+
+func (opts *ReplicationOptions) WithPrimaryTLS(primaryTLS bool) *ReplicationOptions {
+	opts.PrimaryTLS = primaryTLS
+	return opts
+}
+
+func (opts *ReplicationOptions) WithPrimaryTLSServerName(primaryTLSServerName string) *ReplicationOptions {
+	opts.PrimaryTLSServerName = primaryTLSServerName
+	return opts
+}
+
+func (opts *ReplicationOptions) WithPrimaryTLSCACert(primaryTLSCACert string) *ReplicationOptions {
+	opts.PrimaryTLSCACert = primaryTLSCACert
+	return opts
+}
+
+func (opts *ReplicationOptions) WithPrimaryTLSCert(primaryTLSCert string) *ReplicationOptions {
+	opts.PrimaryTLSCert = primaryTLSCert
+	return opts
+}
+
+func (opts *ReplicationOptions) WithPrimaryTLSKey(primaryTLSKey string) *ReplicationOptions {
+	opts.PrimaryTLSKey = primaryTLSKey
+	return opts
+}
@@ -19,6 +19,7 @@ package server
 import (
 	"crypto/tls"
 	"testing"
+	"time"
 
 	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/logger"
@@ -302,6 +303,42 @@ Superadmin default credentials
 	require.Equal(t, expected, op.String())
 }
 
+func TestOptionsStringWithRemoteStorageURL(t *testing.T) {
+	expected := `================ Config ================
+Data dir         : ./data
+Address          : 0.0.0.0:3322
+Metrics address  : 0.0.0.0:9497/metrics
+Sync replication : false
+Config file      : configs/immudb.toml
+PID file         : immu.pid
+Log file         : immu.log
+Max recv msg size: 33554432
+Auth enabled     : true
+Dev mode         : false
+Default database : defaultdb
+Maintenance mode : false
+Synced mode      : true
+Remote storage
+   url           : gs://bucket/prefix
+   external id   : false
+----------------------------------------
+Superadmin default credentials
+   Username      : immudb
+   Password      : immudb
+========================================`
+
+	op := DefaultOptions().
+		WithPidfile("immu.pid").
+		WithLogfile("immu.log").
+		WithRemoteStorageOptions(
+			DefaultRemoteStorageOptions().
+				WithURL("gs://bucket/prefix").
+				WithSecretKey("token"),
+		)
+
+	require.Equal(t, expected, op.String())
+}
+
 func TestOptionsStringWithPProf(t *testing.T) {
 	expected := `================ Config ================
 Data dir         : ./data
@@ -331,3 +368,77 @@ Superadmin default credentials
 
 	require.Equal(t, expected, op.String())
 }
+
+func TestOptionsStringWithRemoteStorageTiering(t *testing.T) {
+	op := DefaultOptions().
+		WithRemoteStorageOptions(
+			DefaultRemoteStorageOptions().
+				WithURL("gs://bucket/prefix").
+				WithLocalChunks(3).
+				WithLocalSize(1 << 30).
+				WithCacheSize(1 << 20).
+				WithPrefetchChunks(2),
+		)
+
+	s := op.String()
+	require.Contains(t, s, "   local chunks  : 3\n")
+	require.Contains(t, s, "   local size    : 1073741824\n")
+	require.Contains(t, s, "   cache size    : 1048576\n")
+}
+
+func TestOptionsStringWithBackups(t *testing.T) {
+	op := DefaultOptions()
+	require.NotContains(t, op.String(), "Backups")
+
+	op.WithBackupOptions(
+		DefaultBackupOptions().
+			WithURL("s3://bucket/backups").
+			WithSecretKey("secret").
+			WithFrequency(30 * time.Minute),
+	)
+
+	s := op.String()
+	require.Contains(t, s, "   url           : s3://bucket/backups\n")
+	require.Contains(t, s, "   frequency     : 30m0s\n")
+	require.NotContains(t, s, "secret")
+}
+
+func TestOptionsStringWithLogShipping(t *testing.T) {
+	op := DefaultOptions()
+	require.NotContains(t, op.String(), "Log shipping")
+
+	op.WithLogShippingOptions(
+		DefaultLogShippingOptions().
+			WithURL("s3://bucket/shipping").
+			WithAccessKeyID("key").
+			WithSecretKey("secret").
+			WithFrequency(5 * time.Second).
+			WithMaxSegmentTxs(100),
+	)
+
+	require.Equal(t, 100, op.LogShippingOptions.MaxSegmentTxs)
+	require.Equal(t, "key", op.LogShippingOptions.AccessKeyID)
+
+	s := op.String()
+	require.Contains(t, s, "Log shipping\n")
+	require.Contains(t, s, "   url           : s3://bucket/shipping\n")
+	require.Contains(t, s, "   frequency     : 5s\n")
+	require.NotContains(t, s, "secret")
+}
+
+func TestOptionsStringWithEncryption(t *testing.T) {
+	op := DefaultOptions()
+	require.NotContains(t, op.String(), "Encryption")
+	require.False(t, op.EncryptionOptions.Enabled())
+
+	op.WithEncryptionOptions(DefaultEncryptionOptions().WithKeyEnv("IMMUDB_KEYS").WithBlockSize(1024))
+	require.True(t, op.EncryptionOptions.Enabled())
+
+	s := op.String()
+	require.Contains(t, s, "Encryption\n")
+	require.Contains(t, s, "   key env       : IMMUDB_KEYS\n")
+	require.Contains(t, s, "   block size    : 1024\n")
+
+	op.EncryptionOptions.WithKeyFile("/etc/immudb/keys")
+	require.Contains(t, op.String(), "   key file      : /etc/immudb/keys\n")
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+)
+
+// ExportPartialTx exports the entries of a transaction matching the filters of a partial replica.
+// It requires the same permissions as exporting whole transactions
+func (s *ImmuServer) ExportPartialTx(ctx context.Context, req *schema.ExportPartialTxRequest) (*schema.PartialTx, error) {
+	if req == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	db, err := s.getDBFromCtx(ctx, "ExportTx")
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Tx > 0 {
+		s.connectedReplicas.track(ctx, db.GetName(), req.Tx-1, false, true, false)
+	}
+
+	return db.ExportPartialTx(ctx, req)
+}
+
+// PartialTxProof returns the primary transaction header and inclusion proofs of the entries
+// replicated in a transaction of a partial replica
+func (s *ImmuServer) PartialTxProof(ctx context.Context, req *schema.PartialTxProofRequest) (*schema.PartialTx, error) {
+	if req == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	db, err := s.getDBFromCtx(ctx, "PartialTxProof")
+	if err != nil {
+		return nil, err
+	}
+
+	return db.PartialTxProof(ctx, req)
+}
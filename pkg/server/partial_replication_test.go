@@ -0,0 +1,92 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/database"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestServerPartialReplication(t *testing.T) {
+	s, closer := testServer(DefaultOptions().WithDir(t.TempDir()).WithPort(0))
+	defer closer()
+
+	err := s.Initialize()
+	require.NoError(t, err)
+
+	resp, err := s.OpenSession(context.Background(), &schema.OpenSessionRequest{
+		Username:     []byte(auth.SysAdminUsername),
+		Password:     []byte(auth.SysAdminPassword),
+		DatabaseName: DefaultDBName,
+	})
+	require.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"sessionid": resp.GetSessionID()}))
+
+	hdr, err := s.Set(ctx, &schema.SetRequest{KVs: []*schema.KeyValue{
+		{Key: []byte("app/key1"), Value: []byte("value1")},
+		{Key: []byte("other/key1"), Value: []byte("value1")},
+	}})
+	require.NoError(t, err)
+
+	t.Run("invalid requests should fail", func(t *testing.T) {
+		_, err := s.ExportPartialTx(ctx, nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = s.PartialTxProof(ctx, nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	t.Run("only matching entries should be exported", func(t *testing.T) {
+		ptx, err := s.ExportPartialTx(ctx, &schema.ExportPartialTxRequest{
+			Tx:          hdr.Id,
+			KeyPrefixes: [][]byte{[]byte("app/")},
+		})
+		require.NoError(t, err)
+		require.Equal(t, hdr.Id, ptx.Header.Id)
+		require.Len(t, ptx.Entries, 1)
+		require.Equal(t, []byte("value1"), ptx.Entries[0].Entry.Value[1:])
+		require.NoError(t, database.VerifyPartialTxEntry(ptx.Header, ptx.Entries[0]))
+	})
+
+	t.Run("fully written transactions have no partial proof", func(t *testing.T) {
+		_, err := s.PartialTxProof(ctx, &schema.PartialTxProofRequest{Tx: hdr.Id})
+		require.ErrorIs(t, err, database.ErrPartialTxProvenanceNotFound)
+	})
+
+	t.Run("partial replicas should not be promoted", func(t *testing.T) {
+		_, err := s.CreateDatabaseV2(ctx, &schema.CreateDatabaseRequest{
+			Name: "partialdb",
+			Settings: &schema.DatabaseNullableSettings{
+				ReplicationSettings: &schema.ReplicationNullableSettings{
+					Replica:     &schema.NullableBool{Value: true},
+					KeyPrefixes: &schema.NullableStringList{Values: []string{"app/"}},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = s.PromoteReplica(ctx, &schema.PromoteReplicaRequest{Database: "partialdb"})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+}
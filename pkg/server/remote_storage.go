@@ -19,15 +19,21 @@ package server
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io/ioutil"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/encrypted"
 	"github.com/codenotary/immudb/embedded/appendable/multiapp"
 	"github.com/codenotary/immudb/embedded/appendable/remoteapp"
 	"github.com/codenotary/immudb/embedded/remotestorage"
+	"github.com/codenotary/immudb/embedded/remotestorage/azure"
+	"github.com/codenotary/immudb/embedded/remotestorage/gcs"
+	"github.com/codenotary/immudb/embedded/remotestorage/local"
 	"github.com/codenotary/immudb/embedded/remotestorage/s3"
 	"github.com/codenotary/immudb/embedded/store"
 	"github.com/codenotary/immudb/pkg/errors"
@@ -42,7 +48,25 @@ var (
 	ErrNoRemoteIdentifier        = errors.New("remote storage does not have expected identifier")
 )
 
+var (
+	ErrInvalidRemoteStorageURL      = errors.New("invalid remote storage url")
+	ErrUnsupportedRemoteStorage     = errors.New("unsupported remote storage")
+	ErrConflictingRemoteStorageOpts = errors.New("remote storage url and s3 storage can not be used together")
+)
+
 func (s *ImmuServer) createRemoteStorageInstance() (remotestorage.Storage, error) {
+	if s.Options.RemoteStorageOptions.URL != "" {
+		if s.Options.RemoteStorageOptions.S3Storage {
+			return nil, ErrConflictingRemoteStorageOpts
+		}
+
+		return OpenRemoteStorageURL(
+			s.Options.RemoteStorageOptions.URL,
+			s.Options.RemoteStorageOptions.AccessKeyID,
+			s.Options.RemoteStorageOptions.SecretKey,
+		)
+	}
+
 	if s.Options.RemoteStorageOptions.S3Storage {
 		// S3 storage
 		return s3.Open(
@@ -58,6 +82,91 @@ func (s *ImmuServer) createRemoteStorageInstance() (remotestorage.Storage, error
 	return nil, nil
 }
 
+// OpenRemoteStorageURL opens the remote storage selected by the scheme of the url:
+//
+//	s3://bucket/prefix?endpoint=http://localhost:9000&location=us-east-1
+//	azblob://container/prefix?endpoint=http://127.0.0.1:10000/devstoreaccount1
+//	gs://bucket/prefix?endpoint=http://localhost:4443&credentials=/etc/immudb/service-account.json
+//	file:///mnt/nfs/immudb
+//
+// Credentials are not part of the url, the access key id is used as the s3 access key id
+// or the azure account name, the secret key as the s3 secret key, the azure account key
+// or the gcs OAuth2 access token. The gcs credentials parameter sets the path of a service
+// account key, access tokens are then obtained and refreshed using it.
+func OpenRemoteStorageURL(storageURL, accessKeyID, secretKey string) (remotestorage.Storage, error) {
+	u, err := url.Parse(storageURL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRemoteStorageURL, err)
+	}
+
+	query := u.Query()
+	prefix := strings.TrimPrefix(u.Path, "/")
+
+	switch u.Scheme {
+	case "s3":
+		if query.Get("endpoint") == "" {
+			return nil, fmt.Errorf("%w: s3 endpoint must be specified", ErrInvalidRemoteStorageURL)
+		}
+
+		return s3.Open(
+			query.Get("endpoint"),
+			accessKeyID,
+			secretKey,
+			u.Host,
+			query.Get("location"),
+			prefix,
+		)
+	case "azblob":
+		return azure.Open(
+			query.Get("endpoint"),
+			accessKeyID,
+			secretKey,
+			u.Host,
+			prefix,
+		)
+	case "gs":
+		if query.Get("credentials") == "" {
+			return gcs.Open(
+				query.Get("endpoint"),
+				secretKey,
+				u.Host,
+				prefix,
+			)
+		}
+
+		credentials, err := ioutil.ReadFile(query.Get("credentials"))
+		if err != nil {
+			return nil, err
+		}
+
+		tokenSource, err := gcs.NewServiceAccountTokenSource(credentials)
+		if err != nil {
+			return nil, err
+		}
+
+		return gcs.OpenWithTokenSource(
+			query.Get("endpoint"),
+			tokenSource,
+			u.Host,
+			prefix,
+		)
+	case "file":
+		dir := u.Opaque // relative paths, e.g. file:remote
+		if dir == "" {
+			dir = u.Host + u.Path
+		}
+
+		st, err := local.Open(filepath.FromSlash(dir), store.DefaultFileMode)
+		if err != nil {
+			return nil, err
+		}
+
+		return st, nil
+	}
+
+	return nil, fmt.Errorf("%w: '%s'", ErrUnsupportedRemoteStorage, u.Scheme)
+}
+
 func (s *ImmuServer) initializeRemoteStorage(storage remotestorage.Storage) error {
 	if storage == nil {
 		if s.Options.RemoteStorageOptions.S3ExternalIdentifier {
@@ -143,6 +252,11 @@ func (s *ImmuServer) storeOptionsForDB(name string, remoteStorage remotestorage.
 
 			remoteAppOpts := remoteapp.DefaultOptions()
 			remoteAppOpts.Options = *opts
+			remoteAppOpts.
+				WithLocalChunks(s.Options.RemoteStorageOptions.LocalChunks).
+				WithLocalSize(s.Options.RemoteStorageOptions.LocalSize).
+				WithCacheSize(s.Options.RemoteStorageOptions.CacheSize).
+				WithPrefetchChunks(s.Options.RemoteStorageOptions.PrefetchChunks)
 
 			fsPath, err := filepath.Abs(filepath.Join(rootPath, subPath))
 			if err != nil {
@@ -174,5 +288,12 @@ func (s *ImmuServer) storeOptionsForDB(name string, remoteStorage remotestorage.
 		Metrics.RemoteStorageKind.WithLabelValues(name, "none").Set(1)
 	}
 
+	if s.encryptionKeys != nil {
+		stOpts.WithEncryption(
+			s.encryptionKeys,
+			encrypted.DefaultOptions().WithBlockSize(s.Options.EncryptionOptions.BlockSize),
+		)
+	}
+
 	return stOpts
 }
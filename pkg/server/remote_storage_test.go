@@ -18,6 +18,11 @@ package server
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"io"
 	"io/ioutil"
@@ -27,6 +32,9 @@ import (
 	"testing"
 
 	"github.com/codenotary/immudb/embedded/remotestorage"
+	"github.com/codenotary/immudb/embedded/remotestorage/azure"
+	"github.com/codenotary/immudb/embedded/remotestorage/gcs"
+	"github.com/codenotary/immudb/embedded/remotestorage/local"
 	"github.com/codenotary/immudb/embedded/remotestorage/memory"
 	"github.com/codenotary/immudb/embedded/remotestorage/s3"
 	"github.com/codenotary/immudb/embedded/store"
@@ -117,6 +125,93 @@ func TestCreateRemoteStorage(t *testing.T) {
 	require.IsType(t, &s3.Storage{}, storage)
 }
 
+func TestCreateRemoteStorageFromURL(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, d := range []struct {
+		url     string
+		kind    interface{}
+		str     string
+		wantErr error
+	}{
+		{"s3://bucket/prefix?endpoint=http://localhost:9000", &s3.Storage{}, "s3:http://localhost:9000/bucket/prefix/", nil},
+		{"azblob://container/prefix?endpoint=http://127.0.0.1:10000/devstoreaccount1", &azure.Storage{}, "azure:http://127.0.0.1:10000/devstoreaccount1/container/prefix/", nil},
+		{"gs://bucket/prefix?endpoint=http://localhost:4443", &gcs.Storage{}, "gcs:http://localhost:4443/bucket/prefix/", nil},
+		{"file://" + filepath.ToSlash(filepath.Join(dir, "remote")), &local.Storage{}, "local:" + filepath.Join(dir, "remote") + string(filepath.Separator), nil},
+		{"s3://bucket/prefix", nil, "", ErrInvalidRemoteStorageURL},
+		{"ftp://host/path", nil, "", ErrUnsupportedRemoteStorage},
+		{"s3://bucket/%zz", nil, "", ErrInvalidRemoteStorageURL},
+		{"file://", nil, "", local.ErrInvalidArgumentsDirEmpty},
+	} {
+		t.Run(d.url, func(t *testing.T) {
+			s := DefaultServer()
+			s.WithOptions(DefaultOptions().WithDir(dir).WithRemoteStorageOptions(
+				DefaultRemoteStorageOptions().
+					WithURL(d.url).
+					WithAccessKeyID("devstoreaccount1").
+					WithSecretKey("c2VjcmV0"),
+			))
+
+			storage, err := s.createRemoteStorageInstance()
+			if d.wantErr != nil {
+				require.ErrorIs(t, err, d.wantErr)
+				require.Nil(t, storage)
+				return
+			}
+
+			require.NoError(t, err)
+			require.IsType(t, d.kind, storage)
+			require.Equal(t, d.str, storage.String())
+		})
+	}
+
+	t.Run("url and s3 storage can not be used together", func(t *testing.T) {
+		s := DefaultServer()
+		s.WithOptions(DefaultOptions().WithDir(dir).WithRemoteStorageOptions(
+			DefaultRemoteStorageOptions().
+				WithURL("file://" + filepath.ToSlash(dir)).
+				WithS3Storage(true),
+		))
+
+		_, err := s.createRemoteStorageInstance()
+		require.ErrorIs(t, err, ErrConflictingRemoteStorageOpts)
+	})
+}
+
+func TestOpenGCSRemoteStorageWithServiceAccount(t *testing.T) {
+	dir := t.TempDir()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	credentials, err := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"client_email": "immudb@project.iam.gserviceaccount.com",
+		"private_key":  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+	})
+	require.NoError(t, err)
+
+	credentialsFile := filepath.Join(dir, "service-account.json")
+	require.NoError(t, ioutil.WriteFile(credentialsFile, credentials, 0600))
+
+	storage, err := OpenRemoteStorageURL("gs://bucket/prefix?credentials="+credentialsFile, "", "")
+	require.NoError(t, err)
+	require.IsType(t, &gcs.Storage{}, storage)
+	require.Equal(t, "gcs:https://storage.googleapis.com/bucket/prefix/", storage.String())
+
+	_, err = OpenRemoteStorageURL("gs://bucket/prefix?credentials="+filepath.Join(dir, "missing.json"), "", "")
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	invalidFile := filepath.Join(dir, "invalid.json")
+	require.NoError(t, ioutil.WriteFile(invalidFile, []byte("{}"), 0600))
+
+	_, err = OpenRemoteStorageURL("gs://bucket/prefix?credentials="+invalidFile, "", "")
+	require.ErrorIs(t, err, gcs.ErrInvalidCredentials)
+}
+
 func tmpFile(t *testing.T, data []byte) (fileName string, cleanup func()) {
 	fl, err := ioutil.TempFile("", "")
 	require.NoError(t, err)
@@ -533,3 +628,42 @@ func TestRemoteStorageUsedForNewDB(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, exists)
 }
+
+func TestLocalRemoteStorageUsedForNewDB(t *testing.T) {
+	dir := t.TempDir()
+	remoteDir := t.TempDir()
+
+	s := DefaultServer()
+
+	s.WithOptions(DefaultOptions().
+		WithDir(dir).
+		WithPort(0).
+		WithListener(bufconn.Listen(1024 * 1024)).
+		WithRemoteStorageOptions(
+			DefaultRemoteStorageOptions().
+				WithURL("file://" + filepath.ToSlash(remoteDir)),
+		),
+	)
+
+	err := s.Initialize()
+	require.NoError(t, err)
+	require.IsType(t, &local.Storage{}, s.remoteStorage)
+
+	r := &schema.LoginRequest{
+		User:     []byte(auth.SysAdminUsername),
+		Password: []byte(auth.SysAdminPassword),
+	}
+	ctx := context.Background()
+	lr, err := s.Login(ctx, r)
+	require.NoError(t, err)
+
+	md := metadata.Pairs("authorization", lr.Token)
+	ctx = metadata.NewIncomingContext(context.Background(), md)
+
+	_, err = s.CreateDatabaseWith(ctx, &schema.DatabaseSettings{DatabaseName: "newdb"})
+	require.NoError(t, err)
+	err = s.CloseDatabases()
+	require.NoError(t, err)
+
+	require.FileExists(t, filepath.Join(remoteDir, "newdb", "tx", "00000000.tx"))
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/database"
+)
+
+// PromoteReplica turns a replica database into a primary one.
+// Precommitted transactions after the given one are discarded while the remaining ones get committed,
+// thus transactions not confirmed by a quorum of replicas are not kept by the promoted database.
+func (s *ImmuServer) PromoteReplica(ctx context.Context, req *schema.PromoteReplicaRequest) (res *schema.PromoteReplicaResponse, err error) {
+	if req == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	s.Logger.Infof("Promoting replica database '%s'...", req.Database)
+
+	defer func() {
+		if err == nil {
+			s.Logger.Infof("Database '%s' succesfully promoted", req.Database)
+		} else {
+			s.Logger.Infof("Database '%s' could not be promoted. Reason: %v", req.Database, err)
+		}
+	}()
+
+	if s.Options.GetMaintenance() {
+		return nil, ErrNotAllowedInMaintenanceMode
+	}
+
+	if !s.Options.GetAuth() {
+		return nil, ErrAuthMustBeEnabled
+	}
+
+	if req.Database == s.Options.defaultDBName || req.Database == s.Options.systemAdminDBName {
+		return nil, ErrReservedDatabase
+	}
+
+	if req.PrecommittedTxID > 0 && len(req.PrecommittedAlh) != sha256.Size {
+		return nil, fmt.Errorf("%w: invalid precommitted alh", ErrIllegalArguments)
+	}
+
+	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get loggedin user data")
+	}
+
+	//if the requesting user has admin permission on this database
+	if (!user.IsSysAdmin) &&
+		(!user.HasPermission(req.Database, auth.PermissionAdmin)) {
+		return nil, fmt.Errorf("the database '%s' does not exist or you do not have admin permission on this database", req.Database)
+	}
+
+	s.dbListMutex.Lock()
+	defer s.dbListMutex.Unlock()
+
+	dbOpts, err := s.loadDBOptions(req.Database, false)
+	if err == store.ErrKeyNotFound {
+		return nil, database.ErrDatabaseNotExists
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: while loading database settings", err)
+	}
+
+	if !dbOpts.Replica {
+		return nil, database.ErrNotReplica
+	}
+
+	if dbOpts.isPartialReplica() {
+		return nil, fmt.Errorf("%w: partial replica database '%s' can not be promoted", ErrIllegalArguments, req.Database)
+	}
+
+	db, err := s.dbList.GetByName(req.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	if db.IsClosed() {
+		return nil, store.ErrAlreadyClosed
+	}
+
+	// settings are restored and replication gets resumed when the replica can not be promoted
+	replicaOpts := *dbOpts
+
+	dbOpts.asPrimary(req.SyncReplication, int(req.SyncAcks))
+
+	// the promoted database starts a new replication epoch, primaries of previous epochs get fenced
+	dbOpts.ReplicationEpoch = req.ReplicationEpoch
+	if dbOpts.ReplicationEpoch <= replicaOpts.ReplicationEpoch {
+		dbOpts.ReplicationEpoch = replicaOpts.ReplicationEpoch + 1
+	}
+
+	dbOpts.UpdatedBy = user.Username
+	dbOpts.UpdatedAt = time.Now()
+
+	err = dbOpts.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	// replication must be stopped before discarding precommitted transactions
+	err = s.stopReplicationFor(req.Database)
+	if err != nil && err != ErrReplicationNotInProgress {
+		return nil, fmt.Errorf("%w: while stopping replication", err)
+	}
+
+	settingsSaved := false
+	promoted := false
+
+	defer func() {
+		if !promoted {
+			s.rollbackPromotion(db, &replicaOpts, settingsSaved)
+		}
+	}()
+
+	state, err := db.CurrentState()
+	if err != nil {
+		return nil, err
+	}
+
+	if req.PrecommittedTxID < state.TxId || req.PrecommittedTxID > state.PrecommittedTxId {
+		return nil, fmt.Errorf("%w: transaction %d is not precommitted on database '%s' (committed up to %d, precommitted up to %d)",
+			ErrIllegalArguments, req.PrecommittedTxID, req.Database, state.TxId, state.PrecommittedTxId)
+	}
+
+	if req.PrecommittedTxID == state.TxId && req.PrecommittedTxID > 0 && !bytes.Equal(req.PrecommittedAlh, state.TxHash) {
+		return nil, fmt.Errorf("%w: replica commit state diverged from the expected one", ErrIllegalState)
+	}
+
+	// settings are saved before any precommitted transaction gets discarded,
+	// thus the database does not resume replication from the previous primary after a restart
+	err = s.saveDBOptions(dbOpts)
+	if err != nil {
+		return nil, fmt.Errorf("%w: while saving updated settings", err)
+	}
+
+	settingsSaved = true
+
+	if req.PrecommittedTxID > state.TxId && db.IsSyncReplicationEnabled() {
+		var alh [sha256.Size]byte
+		copy(alh[:], req.PrecommittedAlh)
+
+		// the accumulative hash is validated before any transaction gets committed
+		err = db.AllowCommitUpto(req.PrecommittedTxID, alh)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	discardedTxs := state.PrecommittedTxId - req.PrecommittedTxID
+
+	if discardedTxs > 0 {
+		err = db.DiscardPrecommittedTxsSince(req.PrecommittedTxID + 1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.PrecommittedTxID > 0 {
+		err = db.WaitForTx(ctx, req.PrecommittedTxID, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	promoted = true
+
+	s.logDBOptions(db.GetName(), dbOpts)
+
+	db.AsReplica(dbOpts.Replica, dbOpts.SyncReplication, dbOpts.SyncAcks)
+	db.SetReplicationEpoch(dbOpts.ReplicationEpoch)
+
+	err = s.startLogShippingFor(db, dbOpts)
+	if err != nil && err != ErrLogShippingNotNeeded {
+		return nil, fmt.Errorf("%w: while starting log shipping", err)
+	}
+
+	s.Logger.Infof("%d precommitted transactions discarded from database '%s' while being promoted", discardedTxs, req.Database)
+
+	return &schema.PromoteReplicaResponse{
+		Database:         req.Database,
+		DiscardedTxs:     discardedTxs,
+		CommittedTxID:    req.PrecommittedTxID,
+		ReplicationEpoch: dbOpts.ReplicationEpoch,
+	}, nil
+}
+
+// rollbackPromotion restores the settings of a replica that could not be promoted and resumes its replication
+func (s *ImmuServer) rollbackPromotion(db database.DB, replicaOpts *dbOptions, settingsSaved bool) {
+	if settingsSaved {
+		err := s.saveDBOptions(replicaOpts)
+		if err != nil {
+			s.Logger.Errorf("settings of database '%s' could not be restored. Reason: %v", db.GetName(), err)
+		}
+	}
+
+	err := s.startReplicationFor(db, replicaOpts)
+	if err != nil && err != ErrReplicatorNotNeeded {
+		s.Logger.Errorf("replication of database '%s' could not be resumed. Reason: %v", db.GetName(), err)
+	}
+}
+
+// fenceStalePrimary turns a primary superseded by a failover into a replica without primary,
+// as done by the failover coordinator when the primary is reachable
+func (s *ImmuServer) fenceStalePrimary(db database.DB, epoch uint64) error {
+	s.dbListMutex.Lock()
+	defer s.dbListMutex.Unlock()
+
+	// writes are refused even if settings can not be saved
+	db.AsReplica(true, true, 0)
+	db.SetReplicationEpoch(epoch)
+
+	err := s.stopLogShippingFor(db.GetName())
+	if err != nil && err != ErrLogShippingNotInProgress {
+		return fmt.Errorf("%w: while stopping log shipping", err)
+	}
+
+	dbOpts, err := s.loadDBOptions(db.GetName(), false)
+	if err != nil {
+		return fmt.Errorf("%w: while loading database settings", err)
+	}
+
+	err = s.overwriteWith(dbOpts, &schema.DatabaseNullableSettings{
+		ReplicationSettings: &schema.ReplicationNullableSettings{
+			Replica:          &schema.NullableBool{Value: true},
+			SyncReplication:  &schema.NullableBool{Value: true},
+			ReplicationEpoch: &schema.NullableUint64{Value: epoch},
+		},
+	}, true)
+	if err != nil {
+		return err
+	}
+
+	err = s.saveDBOptions(dbOpts)
+	if err != nil {
+		return fmt.Errorf("%w: while saving updated settings", err)
+	}
+
+	s.logDBOptions(db.GetName(), dbOpts)
+
+	return nil
+}
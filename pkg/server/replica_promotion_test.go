@@ -0,0 +1,147 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/database"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestServerPromoteReplica(t *testing.T) {
+	s, closer := testServer(DefaultOptions().WithDir(t.TempDir()).WithPort(0))
+	defer closer()
+
+	err := s.Initialize()
+	require.NoError(t, err)
+
+	resp, err := s.OpenSession(context.Background(), &schema.OpenSessionRequest{
+		Username:     []byte(auth.SysAdminUsername),
+		Password:     []byte(auth.SysAdminPassword),
+		DatabaseName: DefaultDBName,
+	})
+	require.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"sessionid": resp.GetSessionID()}))
+
+	_, err = s.CreateDatabaseV2(ctx, &schema.CreateDatabaseRequest{
+		Name: "replicadb",
+		Settings: &schema.DatabaseNullableSettings{
+			ReplicationSettings: &schema.ReplicationNullableSettings{
+				Replica:         &schema.NullableBool{Value: true},
+				SyncReplication: &schema.NullableBool{Value: true},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("invalid requests should fail", func(t *testing.T) {
+		_, err := s.PromoteReplica(ctx, nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = s.PromoteReplica(ctx, &schema.PromoteReplicaRequest{Database: DefaultDBName})
+		require.ErrorIs(t, err, ErrReservedDatabase)
+
+		_, err = s.PromoteReplica(ctx, &schema.PromoteReplicaRequest{Database: "nonexistentdb"})
+		require.ErrorIs(t, err, database.ErrDatabaseNotExists)
+
+		_, err = s.PromoteReplica(ctx, &schema.PromoteReplicaRequest{Database: "replicadb", PrecommittedTxID: 1})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = s.PromoteReplica(ctx, &schema.PromoteReplicaRequest{
+			Database:         "replicadb",
+			PrecommittedTxID: 1,
+			PrecommittedAlh:  make([]byte, sha256.Size),
+		})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = s.PromoteReplica(ctx, &schema.PromoteReplicaRequest{Database: "replicadb", SyncReplication: true})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		dbOpts, err := s.loadDBOptions("replicadb", false)
+		require.NoError(t, err)
+		require.True(t, dbOpts.Replica)
+		require.Zero(t, dbOpts.ReplicationEpoch)
+	})
+
+	t.Run("replica should be promoted", func(t *testing.T) {
+		res, err := s.PromoteReplica(ctx, &schema.PromoteReplicaRequest{Database: "replicadb"})
+		require.NoError(t, err)
+		require.Equal(t, "replicadb", res.Database)
+		require.Zero(t, res.DiscardedTxs)
+		require.Zero(t, res.CommittedTxID)
+		require.Equal(t, uint64(1), res.ReplicationEpoch)
+
+		_, err = s.UseDatabase(ctx, &schema.Database{DatabaseName: "replicadb"})
+		require.NoError(t, err)
+
+		settings, err := s.GetDatabaseSettingsV2(ctx, &schema.DatabaseSettingsRequest{})
+		require.NoError(t, err)
+		require.False(t, settings.Settings.ReplicationSettings.Replica.Value)
+		require.False(t, settings.Settings.ReplicationSettings.SyncReplication.Value)
+		require.Equal(t, uint64(1), settings.Settings.ReplicationSettings.ReplicationEpoch.Value)
+
+		_, err = s.Set(ctx, &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key1"), Value: []byte("value1")}}})
+		require.NoError(t, err)
+	})
+
+	t.Run("primary database should not be promoted", func(t *testing.T) {
+		_, err := s.PromoteReplica(ctx, &schema.PromoteReplicaRequest{Database: "replicadb"})
+		require.ErrorIs(t, err, database.ErrNotReplica)
+	})
+
+	t.Run("replication epoch should not be decreased", func(t *testing.T) {
+		_, err := s.UpdateDatabaseV2(ctx, &schema.UpdateDatabaseRequest{
+			Database: "replicadb",
+			Settings: &schema.DatabaseNullableSettings{
+				ReplicationSettings: &schema.ReplicationNullableSettings{
+					ReplicationEpoch: &schema.NullableUint64{Value: 0},
+				},
+			},
+		})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	t.Run("primary should be fenced by a replica of a newer epoch", func(t *testing.T) {
+		err := s.ExportTx(&schema.ExportTxRequest{Tx: 1, ReplicationEpoch: 2}, &exportTxServerWithContext{ctx: ctx})
+		require.ErrorIs(t, err, database.ErrStaleReplicationEpoch)
+
+		_, err = s.Set(ctx, &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key2"), Value: []byte("value2")}}})
+		require.ErrorIs(t, err, database.ErrIsReplica)
+
+		settings, err := s.GetDatabaseSettingsV2(ctx, &schema.DatabaseSettingsRequest{})
+		require.NoError(t, err)
+		require.True(t, settings.Settings.ReplicationSettings.Replica.Value)
+		require.Empty(t, settings.Settings.ReplicationSettings.PrimaryHost.Value)
+		require.Equal(t, uint64(2), settings.Settings.ReplicationSettings.ReplicationEpoch.Value)
+	})
+}
+
+type exportTxServerWithContext struct {
+	immuServiceExportTxServer
+	ctx context.Context
+}
+
+func (s *exportTxServerWithContext) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,280 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/database"
+	"github.com/codenotary/immudb/pkg/replication"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// replicaStatusTTL is the time replicas not fetching transactions through an open stream
+// are still reported after their last request
+const replicaStatusTTL = time.Minute
+
+type connectedReplica struct {
+	id          string
+	uuid        string
+	database    string
+	address     string
+	lastAckTxID uint64
+	connectedAt time.Time
+	lastSeenAt  time.Time
+	sync        bool
+	partial     bool
+
+	// stream is the context of the bidirectional stream used by the replica, nil if not streaming
+	stream context.Context
+}
+
+func (r *connectedReplica) isConnected(now time.Time) bool {
+	if r.stream != nil {
+		return r.stream.Err() == nil
+	}
+
+	return now.Sub(r.lastSeenAt) < replicaStatusTTL
+}
+
+// connectedReplicas keeps track of the replicas fetching transactions from each database
+type connectedReplicas struct {
+	mutex    sync.Mutex
+	replicas map[string]map[string]*connectedReplica
+}
+
+// track registers a request from a replica, replicas are identified by the metadata sent along
+// with their requests or by their network address when not provided
+func (cr *connectedReplicas) track(ctx context.Context, db string, lastAckTxID uint64, sync, partial, streaming bool) {
+	var uuid, replicaDB, address string
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		if v := md.Get(replication.ReplicaUUIDMetadataKey); len(v) > 0 {
+			uuid = v[0]
+		}
+		if v := md.Get(replication.ReplicaDatabaseMetadataKey); len(v) > 0 {
+			replicaDB = v[0]
+		}
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if ok && p != nil {
+		address = p.Addr.String()
+	}
+
+	id := address
+	if uuid != "" {
+		id = fmt.Sprintf("%s/%s", uuid, replicaDB)
+	}
+
+	now := time.Now()
+
+	cr.mutex.Lock()
+	defer cr.mutex.Unlock()
+
+	if cr.replicas == nil {
+		cr.replicas = make(map[string]map[string]*connectedReplica)
+	}
+
+	dbReplicas, ok := cr.replicas[db]
+	if !ok {
+		dbReplicas = make(map[string]*connectedReplica)
+		cr.replicas[db] = dbReplicas
+	}
+
+	r, ok := dbReplicas[id]
+	if !ok || !r.isConnected(now) || (streaming && r.stream != ctx) {
+		r = &connectedReplica{
+			id:          id,
+			uuid:        uuid,
+			database:    replicaDB,
+			connectedAt: now,
+		}
+		dbReplicas[id] = r
+	}
+
+	r.address = address
+	r.lastAckTxID = lastAckTxID
+	r.lastSeenAt = now
+	r.sync = sync
+	r.partial = partial
+
+	if streaming {
+		r.stream = ctx
+	}
+}
+
+// list returns the replicas currently connected to the given database
+func (cr *connectedReplicas) list(db string) []connectedReplica {
+	now := time.Now()
+
+	cr.mutex.Lock()
+	defer cr.mutex.Unlock()
+
+	var replicas []connectedReplica
+
+	for id, r := range cr.replicas[db] {
+		if !r.isConnected(now) {
+			delete(cr.replicas[db], id)
+			continue
+		}
+
+		replicas = append(replicas, *r)
+	}
+
+	return replicas
+}
+
+// ReplicationStatus returns the replicas fetching transactions from the database and,
+// if the database is a replica, the status of the replication from its primary
+func (s *ImmuServer) ReplicationStatus(ctx context.Context, req *schema.ReplicationStatusRequest) (*schema.ReplicationStatusResponse, error) {
+	if req == nil || req.Database == "" {
+		return nil, ErrIllegalArguments
+	}
+
+	if s.Options.GetAuth() {
+		_, user, err := s.getLoggedInUserdataFromCtx(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get loggedin user data")
+		}
+
+		//if the requesting user has admin permission on this database
+		if (!user.IsSysAdmin) &&
+			(!user.HasPermission(req.Database, auth.PermissionAdmin)) {
+			return nil, fmt.Errorf("the database '%s' does not exist or you do not have admin permission on this database", req.Database)
+		}
+	}
+
+	var db database.DB
+
+	if req.Database == s.Options.systemAdminDBName {
+		db = s.sysDB
+	} else {
+		var err error
+
+		db, err = s.dbList.GetByName(req.Database)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if db.IsClosed() {
+		return nil, store.ErrAlreadyClosed
+	}
+
+	state, err := db.CurrentState()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &schema.ReplicationStatusResponse{
+		Database:      req.Database,
+		Replica:       db.IsReplica(),
+		CommittedTxID: state.TxId,
+	}
+
+	if db.IsReplica() {
+		res.PrimaryStatus = s.primaryReplicationStatus(req.Database)
+	}
+
+	for _, r := range s.connectedReplicas.list(req.Database) {
+		lagTxs, lagSeconds := replicationLag(ctx, db, state.TxId, r.lastAckTxID)
+
+		res.Replicas = append(res.Replicas, &schema.ReplicaStatus{
+			UUID:            r.uuid,
+			Database:        r.database,
+			Address:         r.address,
+			LastAckTxID:     r.lastAckTxID,
+			LagTxs:          lagTxs,
+			LagSeconds:      lagSeconds,
+			ConnectedAt:     r.connectedAt.Unix(),
+			LastSeenAt:      r.lastSeenAt.Unix(),
+			SyncReplication: r.sync,
+			Partial:         r.partial,
+		})
+	}
+
+	return res, nil
+}
+
+func (s *ImmuServer) primaryReplicationStatus(db string) *schema.PrimaryReplicationStatus {
+	s.replicationMutex.Lock()
+	replicator, ok := s.replicators[db]
+	s.replicationMutex.Unlock()
+
+	if !ok {
+		st := &schema.PrimaryReplicationStatus{State: replication.StateStopped}
+
+		dbOpts, err := s.loadDBOptions(db, false)
+		if err == nil && dbOpts.PrimaryHost != "" {
+			st.Primary = fmt.Sprintf("%s@%s:%d", dbOpts.PrimaryDatabase, dbOpts.PrimaryHost, dbOpts.PrimaryPort)
+		}
+
+		return st
+	}
+
+	status := replicator.Status()
+
+	st := &schema.PrimaryReplicationStatus{
+		Primary:              status.Primary,
+		State:                status.State,
+		PrimaryCommittedTxID: status.PrimaryCommittedTxID,
+	}
+
+	if status.LastError != nil {
+		st.LastError = status.LastError.Error()
+		st.LastErrorAt = status.LastErrorAt.Unix()
+	}
+
+	return st
+}
+
+// replicationLag returns the number of committed transactions not yet acknowledged by a replica
+// and the seconds elapsed since the oldest of them was committed
+func replicationLag(ctx context.Context, db database.DB, committedTxID, lastAckTxID uint64) (uint64, float64) {
+	if lastAckTxID >= committedTxID {
+		return 0, 0
+	}
+
+	lagTxs := committedTxID - lastAckTxID
+
+	tx, err := db.TxByID(ctx, &schema.TxRequest{Tx: lastAckTxID + 1, KeepReferencesUnresolved: true})
+	if err != nil || tx.Header.Ts == 0 {
+		// commit time is not available when excluded from the transaction
+		return lagTxs, 0
+	}
+
+	return lagTxs, time.Since(time.Unix(tx.Header.Ts, 0)).Seconds()
+}
+
+// replicaAckTxID returns the last transaction acknowledged by a replica requesting the next one
+func replicaAckTxID(req *schema.ExportTxRequest) uint64 {
+	if req.ReplicaState != nil {
+		// synchronous replicas acknowledge precommitted transactions
+		return req.ReplicaState.PrecommittedTxID
+	}
+
+	return req.Tx - 1
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/replication"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestServerReplicationStatus(t *testing.T) {
+	s, closer := testServer(DefaultOptions().WithDir(t.TempDir()).WithPort(0))
+	defer closer()
+
+	err := s.Initialize()
+	require.NoError(t, err)
+
+	resp, err := s.OpenSession(context.Background(), &schema.OpenSessionRequest{
+		Username:     []byte(auth.SysAdminUsername),
+		Password:     []byte(auth.SysAdminPassword),
+		DatabaseName: DefaultDBName,
+	})
+	require.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"sessionid": resp.GetSessionID()}))
+
+	for i := 0; i < 3; i++ {
+		_, err = s.Set(ctx, &schema.SetRequest{KVs: []*schema.KeyValue{
+			{Key: []byte("app/key1"), Value: []byte("value1")},
+		}})
+		require.NoError(t, err)
+	}
+
+	state, err := s.CurrentState(ctx, nil)
+	require.NoError(t, err)
+
+	t.Run("invalid requests should fail", func(t *testing.T) {
+		_, err := s.ReplicationStatus(ctx, nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = s.ReplicationStatus(ctx, &schema.ReplicationStatusRequest{})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = s.ReplicationStatus(ctx, &schema.ReplicationStatusRequest{Database: "nonexistent"})
+		require.Error(t, err)
+	})
+
+	t.Run("no replica should be listed before any transaction is fetched", func(t *testing.T) {
+		res, err := s.ReplicationStatus(ctx, &schema.ReplicationStatusRequest{Database: DefaultDBName})
+		require.NoError(t, err)
+		require.Equal(t, DefaultDBName, res.Database)
+		require.False(t, res.Replica)
+		require.Equal(t, state.TxId, res.CommittedTxID)
+		require.Nil(t, res.PrimaryStatus)
+		require.Empty(t, res.Replicas)
+	})
+
+	t.Run("replicas fetching transactions should be listed with their lag", func(t *testing.T) {
+		replicaCtx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
+			"sessionid":                            resp.GetSessionID(),
+			replication.ReplicaUUIDMetadataKey:     "replica-uuid",
+			replication.ReplicaDatabaseMetadataKey: "replicadb",
+		}))
+
+		_, err := s.ExportPartialTx(replicaCtx, &schema.ExportPartialTxRequest{
+			Tx:          state.TxId - 1,
+			KeyPrefixes: [][]byte{[]byte("app/")},
+		})
+		require.NoError(t, err)
+
+		res, err := s.ReplicationStatus(ctx, &schema.ReplicationStatusRequest{Database: DefaultDBName})
+		require.NoError(t, err)
+		require.Len(t, res.Replicas, 1)
+
+		r := res.Replicas[0]
+		require.Equal(t, "replica-uuid", r.UUID)
+		require.Equal(t, "replicadb", r.Database)
+		require.Equal(t, state.TxId-2, r.LastAckTxID)
+		require.Equal(t, uint64(2), r.LagTxs)
+		require.GreaterOrEqual(t, r.LagSeconds, float64(0))
+		require.NotZero(t, r.ConnectedAt)
+		require.True(t, r.Partial)
+		require.False(t, r.SyncReplication)
+
+		lags := s.metricFuncComputeReplicationLags()
+		require.Len(t, lags, 1)
+		require.Equal(t, DefaultDBName, lags[0].DB)
+		require.Equal(t, "replica-uuid/replicadb", lags[0].Replica)
+		require.Equal(t, uint64(2), lags[0].Txs)
+	})
+
+	t.Run("replicas should show the replication from their primary", func(t *testing.T) {
+		_, err := s.CreateDatabaseV2(ctx, &schema.CreateDatabaseRequest{
+			Name: "replicadb",
+			Settings: &schema.DatabaseNullableSettings{
+				ReplicationSettings: &schema.ReplicationNullableSettings{
+					Replica: &schema.NullableBool{Value: true},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		res, err := s.ReplicationStatus(ctx, &schema.ReplicationStatusRequest{Database: "replicadb"})
+		require.NoError(t, err)
+		require.True(t, res.Replica)
+		require.NotNil(t, res.PrimaryStatus)
+		require.Equal(t, replication.StateStopped, res.PrimaryStatus.State)
+		require.Empty(t, res.PrimaryStatus.LastError)
+	})
+}
+
+func TestConnectedReplicas(t *testing.T) {
+	var cr connectedReplicas
+
+	require.Empty(t, cr.list(DefaultDBName))
+
+	cr.track(context.Background(), DefaultDBName, 10, true, false, false)
+
+	replicas := cr.list(DefaultDBName)
+	require.Len(t, replicas, 1)
+	require.Equal(t, uint64(10), replicas[0].lastAckTxID)
+	require.True(t, replicas[0].sync)
+
+	connectedAt := replicas[0].connectedAt
+
+	cr.track(context.Background(), DefaultDBName, 11, true, false, false)
+
+	replicas = cr.list(DefaultDBName)
+	require.Len(t, replicas, 1)
+	require.Equal(t, uint64(11), replicas[0].lastAckTxID)
+	require.Equal(t, connectedAt, replicas[0].connectedAt)
+
+	t.Run("replicas should not be listed once their stream is closed", func(t *testing.T) {
+		streamCtx, cancel := context.WithCancel(context.Background())
+
+		cr.track(streamCtx, "streamdb", 1, false, false, true)
+		require.Len(t, cr.list("streamdb"), 1)
+
+		cancel()
+		require.Empty(t, cr.list("streamdb"))
+	})
+
+	t.Run("replicas should not be listed after their last request expires", func(t *testing.T) {
+		for _, r := range cr.replicas[DefaultDBName] {
+			r.lastSeenAt = time.Now().Add(-replicaStatusTTL)
+		}
+
+		require.Empty(t, cr.list(DefaultDBName))
+	})
+}
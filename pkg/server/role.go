@@ -0,0 +1,445 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateRole creates a new role, roles are shared by all databases and granted to users per database
+func (s *ImmuServer) CreateRole(ctx context.Context, r *schema.CreateRoleRequest) (*empty.Empty, error) {
+	s.Logger.Debugf("CreateRole")
+
+	if r == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	if s.Options.GetMaintenance() {
+		return nil, ErrNotAllowedInMaintenanceMode
+	}
+
+	if !s.Options.GetAuth() {
+		return nil, fmt.Errorf("this command is available only with authentication on")
+	}
+
+	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsSysAdmin {
+		return nil, ErrPermissionDenied
+	}
+
+	role := &auth.Role{
+		Name:      r.Name,
+		CreatedBy: user.Username,
+		CreatedAt: time.Now(),
+	}
+
+	for _, p := range r.Privileges {
+		role.Privileges = append(role.Privileges, auth.RolePrivilege{
+			Privilege: auth.Privilege(p.Privilege),
+			Tables:    p.Tables,
+		})
+	}
+
+	err = role.Validate()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	_, err = s.getRole(ctx, r.Name)
+	if err == nil {
+		return nil, ErrRoleAlreadyExists
+	}
+	if !errors.Is(err, ErrRoleNotFound) {
+		return nil, err
+	}
+
+	err = s.saveRole(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infof("role %s was created by user %s", r.Name, user.Username)
+
+	return &empty.Empty{}, nil
+}
+
+// DeleteRole deletes a role which is not granted to any user
+func (s *ImmuServer) DeleteRole(ctx context.Context, r *schema.DeleteRoleRequest) (*empty.Empty, error) {
+	s.Logger.Debugf("DeleteRole")
+
+	if r == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	if s.Options.GetMaintenance() {
+		return nil, ErrNotAllowedInMaintenanceMode
+	}
+
+	if !s.Options.GetAuth() {
+		return nil, fmt.Errorf("this command is available only with authentication on")
+	}
+
+	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsSysAdmin {
+		return nil, ErrPermissionDenied
+	}
+
+	_, err = s.getRole(ctx, r.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := s.sysDB.Scan(ctx, &schema.ScanRequest{
+		Prefix: []byte{KeyPrefixUser},
+		NoWait: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range users.Entries {
+		var u auth.User
+
+		err = json.Unmarshal(e.Value, &u)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, g := range u.Roles {
+			if g.Role == r.Name {
+				return nil, fmt.Errorf("%w: role %s is granted to user %s on database %s", ErrRoleInUse, r.Name, u.Username, g.Database)
+			}
+		}
+	}
+
+	_, err = s.sysDB.Delete(ctx, &schema.DeleteKeysRequest{
+		Keys: [][]byte{roleKey(r.Name)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infof("role %s was deleted by user %s", r.Name, user.Username)
+
+	return &empty.Empty{}, nil
+}
+
+// ListRoles returns all the roles, available to system admins and database admins
+func (s *ImmuServer) ListRoles(ctx context.Context, _ *empty.Empty) (*schema.RoleList, error) {
+	s.Logger.Debugf("ListRoles")
+
+	if !s.Options.GetAuth() {
+		return nil, fmt.Errorf("this command is available only with authentication on")
+	}
+
+	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsSysAdmin && !user.HasAtLeastOnePermission(auth.PermissionAdmin) {
+		return nil, ErrPermissionDenied
+	}
+
+	entries, err := s.sysDB.Scan(ctx, &schema.ScanRequest{
+		Prefix: []byte{KeyPrefixRole},
+		NoWait: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	roles := &schema.RoleList{}
+
+	for _, e := range entries.Entries {
+		var role auth.Role
+
+		err = json.Unmarshal(e.Value, &role)
+		if err != nil {
+			return nil, err
+		}
+
+		r := &schema.Role{
+			Name:      role.Name,
+			Createdby: role.CreatedBy,
+			Createdat: role.CreatedAt.String(),
+		}
+
+		for _, p := range role.Privileges {
+			r.Privileges = append(r.Privileges, &schema.RolePrivilege{
+				Privilege: string(p.Privilege),
+				Tables:    p.Tables,
+			})
+		}
+
+		roles.Roles = append(roles.Roles, r)
+	}
+
+	return roles, nil
+}
+
+// ChangeRole grant or revoke user roles on databases
+func (s *ImmuServer) ChangeRole(ctx context.Context, r *schema.ChangeRoleRequest) (*empty.Empty, error) {
+	s.Logger.Debugf("ChangeRole %+v", r)
+
+	if r == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	if s.Options.GetMaintenance() {
+		return nil, ErrNotAllowedInMaintenanceMode
+	}
+
+	//sanitize input
+	{
+		if len(r.Username) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "username can not be empty")
+		}
+		if len(r.Database) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "database can not be empty")
+		}
+		if len(r.Role) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "role can not be empty")
+		}
+		if (r.Action != schema.PermissionAction_GRANT) &&
+			(r.Action != schema.PermissionAction_REVOKE) {
+			return nil, status.Errorf(codes.InvalidArgument, "action not recognized")
+		}
+	}
+
+	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Username == user.Username {
+		return nil, status.Errorf(codes.InvalidArgument, "changing your own roles is not allowed")
+	}
+
+	if r.Username == auth.SysAdminUsername {
+		return nil, status.Errorf(codes.InvalidArgument, "changing sysadmin roles is not allowed")
+	}
+
+	if r.Database == SystemDBName {
+		return nil, ErrPermissionDenied
+	}
+
+	if s.dbList.GetId(r.Database) < 0 {
+		return nil, fmt.Errorf("database %s does not exist", r.Database)
+	}
+
+	targetUser, err := s.getUser(ctx, []byte(r.Username))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "user %s not found", r.Username)
+	}
+
+	if !targetUser.Active {
+		return nil, status.Errorf(codes.FailedPrecondition, "user %s is not active", r.Username)
+	}
+
+	if !user.IsSysAdmin && !user.HasPermission(r.Database, auth.PermissionAdmin) {
+		return nil, status.Errorf(codes.PermissionDenied, "you do not have permission on this database")
+	}
+
+	if r.Action == schema.PermissionAction_REVOKE {
+		targetUser.RevokeRole(r.Database, r.Role)
+	} else {
+		_, err = s.getRole(ctx, r.Role)
+		if err != nil {
+			return nil, err
+		}
+
+		targetUser.GrantRole(r.Database, r.Role)
+	}
+
+	targetUser.CreatedBy = user.Username
+	targetUser.CreatedAt = time.Now()
+
+	err = s.saveUser(ctx, targetUser)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infof("roles of user %s for database %s were changed by user %s", targetUser.Username, r.Database, user.Username)
+
+	//remove user from loggedin users
+	s.removeUserFromLoginList(targetUser.Username)
+
+	return new(empty.Empty), nil
+}
+
+func userRolesToProto(grants []auth.RoleGrant) []*schema.UserRole {
+	var roles []*schema.UserRole
+	for _, g := range grants {
+		roles = append(roles, &schema.UserRole{Database: g.Database, Role: g.Role})
+	}
+	return roles
+}
+
+func roleKey(name string) []byte {
+	key := make([]byte, 1+len(name))
+	key[0] = KeyPrefixRole
+	copy(key[1:], name)
+	return key
+}
+
+func (s *ImmuServer) getRole(ctx context.Context, name string) (*auth.Role, error) {
+	item, err := s.sysDB.Get(ctx, &schema.KeyRequest{Key: roleKey(name)})
+	if errors.Is(err, store.ErrKeyNotFound) {
+		return nil, fmt.Errorf("%w: %s", ErrRoleNotFound, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var role auth.Role
+
+	err = json.Unmarshal(item.Value, &role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &role, nil
+}
+
+func (s *ImmuServer) saveRole(ctx context.Context, role *auth.Role) error {
+	roleData, err := json.Marshal(role)
+	if err != nil {
+		return logErr(s.Logger, "error saving role: %v", err)
+	}
+
+	_, err = s.sysDB.Set(ctx, &schema.SetRequest{KVs: []*schema.KeyValue{{Key: roleKey(role.Name), Value: roleData}}})
+
+	return logErr(s.Logger, "error saving role: %v", err)
+}
+
+// getUserRoles returns the roles granted to the user on the database,
+// roles are read on every check so that privilege changes apply to logged in users
+func (s *ImmuServer) getUserRoles(ctx context.Context, user *auth.User, database string) ([]*auth.Role, error) {
+	var roles []*auth.Role
+
+	for _, name := range user.WhichRoles(database) {
+		role, err := s.getRole(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+
+		roles = append(roles, role)
+	}
+
+	return roles, nil
+}
+
+var sqlRolePrivileges = map[sql.SQLPrivilege]auth.Privilege{
+	sql.SQLPrivilegeSelect: auth.PrivilegeSQLSelect,
+	sql.SQLPrivilegeInsert: auth.PrivilegeSQLDML,
+	sql.SQLPrivilegeUpdate: auth.PrivilegeSQLDML,
+	sql.SQLPrivilegeDelete: auth.PrivilegeSQLDML,
+	sql.SQLPrivilegeCreate: auth.PrivilegeSQLDDL,
+	sql.SQLPrivilegeAlter:  auth.PrivilegeSQLDDL,
+	sql.SQLPrivilegeDrop:   auth.PrivilegeSQLDDL,
+	sql.SQLPrivilegeGrant:  auth.PrivilegeAdmin,
+}
+
+// checkSQLPrivileges checks that the user is allowed to access the tables involved in a SQL operation.
+// Users with a permission granting access to methodName are not restricted, otherwise the roles granted
+// to the user on the database must grant every required privilege
+func (s *ImmuServer) checkSQLPrivileges(ctx context.Context, user *auth.User, database string, methodName string, privileges func() ([]sql.TablePrivilege, error)) error {
+	if user.IsSysAdmin || auth.HasPermissionForMethod(user.WhichPermission(database), methodName) {
+		return nil
+	}
+
+	roles, err := s.getUserRoles(ctx, user, database)
+	if err != nil {
+		return err
+	}
+
+	required, err := privileges()
+	if err != nil {
+		return err
+	}
+
+	for _, p := range required {
+		if auth.HasTablePrivilege(roles, sqlRolePrivileges[p.Privilege], p.Table) {
+			continue
+		}
+
+		if p.Table == "" {
+			return fmt.Errorf("%w: %s privilege required", ErrPermissionDenied, p.Privilege)
+		}
+
+		return fmt.Errorf("%w: %s privilege required on table '%s'", ErrPermissionDenied, p.Privilege, p.Table)
+	}
+
+	return nil
+}
+
+// checkSQLPrivilegesFromCtx checks SQL privileges of the user logged in, it must be called after getDBFromCtx
+func (s *ImmuServer) checkSQLPrivilegesFromCtx(ctx context.Context, db string, methodName string, privileges func() ([]sql.TablePrivilege, error)) error {
+	if !s.Options.auth && !s.multidbmode && !s.Options.GetMaintenance() {
+		return nil
+	}
+
+	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.checkSQLPrivileges(ctx, user, db, methodName, privileges)
+}
+
+func stmtsPrivileges(sqlStmts string) func() ([]sql.TablePrivilege, error) {
+	return func() ([]sql.TablePrivilege, error) {
+		stmts, err := sql.ParseString(sqlStmts)
+		if err != nil {
+			return nil, err
+		}
+
+		var privileges []sql.TablePrivilege
+		for _, stmt := range stmts {
+			privileges = append(privileges, sql.RequiredPrivileges(stmt)...)
+		}
+
+		return privileges, nil
+	}
+}
+
+func tablePrivilege(privilege sql.SQLPrivilege, table string) func() ([]sql.TablePrivilege, error) {
+	return func() ([]sql.TablePrivilege, error) {
+		return []sql.TablePrivilege{{Privilege: privilege, Table: table}}, nil
+	}
+}
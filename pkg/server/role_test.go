@@ -0,0 +1,250 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestServerRoles(t *testing.T) {
+	s, closer := testServer(DefaultOptions().WithDir(t.TempDir()).WithPort(0))
+	defer closer()
+
+	err := s.Initialize()
+	require.NoError(t, err)
+
+	openSession := func(username, password, db string) context.Context {
+		resp, err := s.OpenSession(context.Background(), &schema.OpenSessionRequest{
+			Username:     []byte(username),
+			Password:     []byte(password),
+			DatabaseName: db,
+		})
+		require.NoError(t, err)
+
+		return metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"sessionid": resp.GetSessionID()}))
+	}
+
+	ctx := openSession(auth.SysAdminUsername, auth.SysAdminPassword, DefaultDBName)
+
+	_, err = s.CreateDatabaseV2(ctx, &schema.CreateDatabaseRequest{Name: "db1"})
+	require.NoError(t, err)
+
+	dbCtx := openSession(auth.SysAdminUsername, auth.SysAdminPassword, "db1")
+
+	_, err = s.SQLExec(dbCtx, &schema.SQLExecRequest{Sql: `
+		CREATE TABLE orders(id INTEGER AUTO_INCREMENT, PRIMARY KEY id);
+		CREATE TABLE customers(id INTEGER AUTO_INCREMENT, PRIMARY KEY id);
+		INSERT INTO orders(id) VALUES (1);
+	`})
+	require.NoError(t, err)
+
+	_, err = s.Set(dbCtx, &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key1"), Value: []byte("value1")}}})
+	require.NoError(t, err)
+
+	t.Run("invalid roles should be rejected", func(t *testing.T) {
+		_, err := s.CreateRole(ctx, nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = s.CreateRole(ctx, &schema.CreateRoleRequest{
+			Name:       "invalid",
+			Privileges: []*schema.RolePrivilege{{Privilege: "drop-everything"}},
+		})
+		require.ErrorContains(t, err, "unknown privilege")
+
+		_, err = s.CreateRole(ctx, &schema.CreateRoleRequest{
+			Name:       "invalid",
+			Privileges: []*schema.RolePrivilege{{Privilege: string(auth.PrivilegeWriteKV), Tables: []string{"orders"}}},
+		})
+		require.ErrorContains(t, err, "can not be restricted to tables")
+	})
+
+	_, err = s.CreateRole(ctx, &schema.CreateRoleRequest{
+		Name: "analyst",
+		Privileges: []*schema.RolePrivilege{
+			{Privilege: string(auth.PrivilegeReadKV)},
+			{Privilege: string(auth.PrivilegeSQLSelect), Tables: []string{"orders"}},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = s.CreateRole(ctx, &schema.CreateRoleRequest{Name: "analyst"})
+	require.ErrorIs(t, err, ErrRoleAlreadyExists)
+
+	_, err = s.CreateUser(ctx, &schema.CreateUserRequest{
+		User:       []byte("user1"),
+		Password:   []byte("user1Pas@1"),
+		Permission: auth.PermissionR,
+		Database:   DefaultDBName,
+	})
+	require.NoError(t, err)
+
+	t.Run("users without permissions nor roles should not access the database", func(t *testing.T) {
+		_, err := s.OpenSession(context.Background(), &schema.OpenSessionRequest{
+			Username:     []byte("user1"),
+			Password:     []byte("user1Pas@1"),
+			DatabaseName: "db1",
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("invalid role changes should be rejected", func(t *testing.T) {
+		_, err := s.ChangeRole(ctx, &schema.ChangeRoleRequest{Action: schema.PermissionAction_GRANT, Username: "user1", Database: "db1"})
+		require.Error(t, err)
+
+		_, err = s.ChangeRole(ctx, &schema.ChangeRoleRequest{Action: schema.PermissionAction_GRANT, Username: "user1", Database: "db1", Role: "unknown"})
+		require.ErrorIs(t, err, ErrRoleNotFound)
+
+		_, err = s.ChangeRole(ctx, &schema.ChangeRoleRequest{Action: schema.PermissionAction_GRANT, Username: "user1", Database: SystemDBName, Role: "analyst"})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = s.ChangeRole(ctx, &schema.ChangeRoleRequest{Action: schema.PermissionAction_GRANT, Username: auth.SysAdminUsername, Database: "db1", Role: "analyst"})
+		require.Error(t, err)
+	})
+
+	_, err = s.ChangeRole(ctx, &schema.ChangeRoleRequest{
+		Action:   schema.PermissionAction_GRANT,
+		Username: "user1",
+		Database: "db1",
+		Role:     "analyst",
+	})
+	require.NoError(t, err)
+
+	userCtx := openSession("user1", "user1Pas@1", "db1")
+
+	t.Run("privileges granted by roles should be enforced", func(t *testing.T) {
+		_, err := s.CurrentState(userCtx, &empty.Empty{})
+		require.NoError(t, err)
+
+		_, err = s.Get(userCtx, &schema.KeyRequest{Key: []byte("key1")})
+		require.NoError(t, err)
+
+		_, err = s.Set(userCtx, &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key1"), Value: []byte("value2")}}})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		res, err := s.SQLQuery(userCtx, &schema.SQLQueryRequest{Sql: "SELECT * FROM orders"})
+		require.NoError(t, err)
+		require.Len(t, res.Rows, 1)
+
+		_, err = s.SQLQuery(userCtx, &schema.SQLQueryRequest{Sql: "SELECT * FROM customers"})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = s.SQLQuery(userCtx, &schema.SQLQueryRequest{Sql: "SELECT * FROM orders INNER JOIN customers ON orders.id = customers.id"})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = s.SQLExec(userCtx, &schema.SQLExecRequest{Sql: "INSERT INTO orders(id) VALUES (2)"})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = s.CreateCollection(userCtx, nil)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		tx, err := s.NewTx(userCtx, &schema.NewTxRequest{Mode: schema.TxMode_ReadWrite})
+		require.NoError(t, err)
+
+		md, _ := metadata.FromIncomingContext(userCtx)
+		md = md.Copy()
+		md.Set("transactionid", tx.TransactionID)
+		txCtx := metadata.NewIncomingContext(context.Background(), md)
+
+		_, err = s.TxSQLQuery(txCtx, &schema.SQLQueryRequest{Sql: "SELECT * FROM orders"})
+		require.NoError(t, err)
+
+		_, err = s.TxSQLQuery(txCtx, &schema.SQLQueryRequest{Sql: "SELECT * FROM customers"})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = s.TxSQLExec(txCtx, &schema.SQLExecRequest{Sql: "INSERT INTO orders(id) VALUES (2)"})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = s.Rollback(txCtx, &empty.Empty{})
+		require.NoError(t, err)
+	})
+
+	t.Run("role changes should apply to logged in users", func(t *testing.T) {
+		_, err := s.CreateRole(ctx, &schema.CreateRoleRequest{
+			Name:       "writer",
+			Privileges: []*schema.RolePrivilege{{Privilege: string(auth.PrivilegeSQLDML), Tables: []string{"orders"}}},
+		})
+		require.NoError(t, err)
+
+		_, err = s.ChangeRole(ctx, &schema.ChangeRoleRequest{Action: schema.PermissionAction_GRANT, Username: "user1", Database: "db1", Role: "writer"})
+		require.NoError(t, err)
+
+		userCtx := openSession("user1", "user1Pas@1", "db1")
+
+		_, err = s.SQLExec(userCtx, &schema.SQLExecRequest{Sql: "INSERT INTO orders(id) VALUES (2)"})
+		require.NoError(t, err)
+
+		_, err = s.SQLExec(userCtx, &schema.SQLExecRequest{Sql: "INSERT INTO customers(id) VALUES (1)"})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = s.SQLExec(userCtx, &schema.SQLExecRequest{Sql: "CREATE TABLE invoices(id INTEGER, PRIMARY KEY id)"})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("roles should be listed", func(t *testing.T) {
+		roles, err := s.ListRoles(ctx, &empty.Empty{})
+		require.NoError(t, err)
+		require.Len(t, roles.Roles, 2)
+		require.Equal(t, "analyst", roles.Roles[0].Name)
+		require.Equal(t, []string{"orders"}, roles.Roles[0].Privileges[1].Tables)
+
+		_, err = s.ListRoles(userCtx, &empty.Empty{})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		users, err := s.ListUsers(ctx, &empty.Empty{})
+		require.NoError(t, err)
+
+		for _, u := range users.Users {
+			if string(u.User) == "user1" {
+				require.Len(t, u.Roles, 2)
+				require.Equal(t, "db1", u.Roles[0].Database)
+				require.Equal(t, "analyst", u.Roles[0].Role)
+			}
+		}
+	})
+
+	t.Run("only unused roles should be deleted", func(t *testing.T) {
+		_, err := s.CreateRole(userCtx, &schema.CreateRoleRequest{Name: "other"})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = s.DeleteRole(userCtx, &schema.DeleteRoleRequest{Name: "analyst"})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = s.DeleteRole(ctx, &schema.DeleteRoleRequest{Name: "unknown"})
+		require.ErrorIs(t, err, ErrRoleNotFound)
+
+		_, err = s.DeleteRole(ctx, &schema.DeleteRoleRequest{Name: "analyst"})
+		require.ErrorIs(t, err, ErrRoleInUse)
+
+		_, err = s.ChangeRole(ctx, &schema.ChangeRoleRequest{Action: schema.PermissionAction_REVOKE, Username: "user1", Database: "db1", Role: "analyst"})
+		require.NoError(t, err)
+
+		_, err = s.DeleteRole(ctx, &schema.DeleteRoleRequest{Name: "analyst"})
+		require.NoError(t, err)
+
+		roles, err := s.ListRoles(ctx, &empty.Empty{})
+		require.NoError(t, err)
+		require.Len(t, roles.Roles, 1)
+		require.Equal(t, "writer", roles.Roles[0].Name)
+	})
+}
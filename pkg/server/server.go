@@ -67,6 +67,8 @@ const (
 	KeyPrefixUser = iota + 1
 	//KeyPrefixDBSettings is used for entries related to database settings
 	KeyPrefixDBSettings
+	//KeyPrefixRole is used for entries related to user roles
+	KeyPrefixRole
 )
 
 var startedAt time.Time
@@ -129,6 +131,47 @@ func (s *ImmuServer) Initialize() error {
 		return logErr(s.Logger, "Unable to initialize remote storage: %v", err)
 	}
 
+	if s.Options.SigningKey != "" {
+		if signer, err := signer.NewSigner(s.Options.SigningKey); err != nil {
+			return logErr(s.Logger, "Unable to configure the cryptographic signer: %v", err)
+		} else {
+			s.StateSigner = NewStateSigner(signer)
+		}
+	}
+
+	s.backupStorage, err = s.createBackupStorageInstance()
+	if err != nil {
+		return logErr(s.Logger, "Unable to open backup storage: %v", err)
+	}
+
+	if s.logShippingStorage == nil {
+		s.logShippingStorage, err = s.createLogShippingStorageInstance()
+		if err != nil {
+			return logErr(s.Logger, "Unable to open log shipping storage: %v", err)
+		}
+	}
+
+	if s.Options.EncryptionOptions.Enabled() {
+		s.encryptionKeys, err = s.Options.EncryptionOptions.KeyProvider()
+		if err != nil {
+			return logErr(s.Logger, "Unable to load encryption keys: %v", err)
+		}
+	}
+
+	if s.Options.JWTOptions.Enabled() {
+		s.jwtValidator, err = auth.NewJWTValidator(s.Options.JWTOptions)
+		if err != nil {
+			return logErr(s.Logger, "Unable to configure jwt authentication: %v", err)
+		}
+	}
+
+	if s.Options.LDAPOptions.Enabled() {
+		s.ldapAuthenticator, err = auth.NewLDAPAuthenticator(s.Options.LDAPOptions)
+		if err != nil {
+			return logErr(s.Logger, "Unable to configure ldap authentication: %v", err)
+		}
+	}
+
 	if err = s.loadSystemDatabase(dataDir, s.remoteStorage, adminPassword, s.Options.ForceAdminPassword); err != nil {
 		return logErr(s.Logger, "Unable to load system database: %v", err)
 	}
@@ -167,14 +210,6 @@ func (s *ImmuServer) Initialize() error {
 		grpcSrvOpts = []grpc.ServerOption{grpc.Creds(credentials.NewTLS(s.Options.TLSConfig))}
 	}
 
-	if s.Options.SigningKey != "" {
-		if signer, err := signer.NewSigner(s.Options.SigningKey); err != nil {
-			return logErr(s.Logger, "Unable to configure the cryptographic signer: %v", err)
-		} else {
-			s.StateSigner = NewStateSigner(signer)
-		}
-	}
-
 	if s.Options.usingCustomListener {
 		s.Logger.Infof("Using custom listener")
 		s.Listener = s.Options.listener
@@ -250,7 +285,7 @@ func (s *ImmuServer) Initialize() error {
 	protomodel.RegisterAuthorizationServiceServer(s.GrpcServer, &authenticationServiceImp{server: s})
 	grpc_prometheus.Register(s.GrpcServer)
 
-	s.PgsqlSrv = pgsqlsrv.New(pgsqlsrv.Address(s.Options.Address), pgsqlsrv.Port(s.Options.PgsqlServerPort), pgsqlsrv.DatabaseList(s.dbList), pgsqlsrv.SysDb(s.sysDB), pgsqlsrv.TlsConfig(s.Options.TLSConfig), pgsqlsrv.Logger(s.Logger))
+	s.PgsqlSrv = pgsqlsrv.New(pgsqlsrv.Address(s.Options.Address), pgsqlsrv.Port(s.Options.PgsqlServerPort), pgsqlsrv.DatabaseList(s.dbList), pgsqlsrv.SysDb(s.sysDB), pgsqlsrv.TlsConfig(s.Options.TLSConfig), pgsqlsrv.Logger(s.Logger), pgsqlsrv.JWTValidator(s.jwtValidator), pgsqlsrv.LDAPAuthenticator(s.ldapAuthenticator), pgsqlsrv.SQLUsers(s.sqlUser))
 	if s.Options.PgsqlServer {
 		if err = s.PgsqlSrv.Initialize(); err != nil {
 			return err
@@ -348,6 +383,7 @@ func (s *ImmuServer) setUpMetricsServer() error {
 		s.metricFuncServerUptimeCounter,
 		s.metricFuncComputeDBSizes,
 		s.metricFuncComputeDBEntries,
+		s.metricFuncComputeReplicationLags,
 		s.Options.PProf,
 	)
 	return nil
@@ -548,6 +584,16 @@ func (s *ImmuServer) loadDefaultDatabase(dataDir string, remoteStorage remotesto
 			}
 		}
 
+		err = s.startBackupFor(db)
+		if err != nil && err != ErrBackupNotNeeded {
+			s.Logger.Errorf("Error starting backup for database '%s'. Reason: %v", db.GetName(), err)
+		}
+
+		err = s.startLogShippingFor(db, dbOpts)
+		if err != nil && err != ErrLogShippingNotNeeded {
+			s.Logger.Errorf("Error starting log shipping for database '%s'. Reason: %v", db.GetName(), err)
+		}
+
 		s.dbList.Put(db)
 
 		return nil
@@ -569,6 +615,16 @@ func (s *ImmuServer) loadDefaultDatabase(dataDir string, remoteStorage remotesto
 		}
 	}
 
+	err = s.startBackupFor(db)
+	if err != nil && err != ErrBackupNotNeeded {
+		s.Logger.Errorf("Error starting backup for database '%s'. Reason: %v", db.GetName(), err)
+	}
+
+	err = s.startLogShippingFor(db, dbOpts)
+	if err != nil && err != ErrLogShippingNotNeeded {
+		s.Logger.Errorf("Error starting log shipping for database '%s'. Reason: %v", db.GetName(), err)
+	}
+
 	s.dbList.Put(db)
 
 	return nil
@@ -634,6 +690,16 @@ func (s *ImmuServer) loadUserDatabases(dataDir string, remoteStorage remotestora
 			}
 		}
 
+		err = s.startBackupFor(db)
+		if err != nil && err != ErrBackupNotNeeded {
+			s.Logger.Errorf("Error starting backup for database '%s'. Reason: %v", db.GetName(), err)
+		}
+
+		err = s.startLogShippingFor(db, dbOpts)
+		if err != nil && err != ErrLogShippingNotNeeded {
+			s.Logger.Errorf("Error starting log shipping for database '%s'. Reason: %v", db.GetName(), err)
+		}
+
 		s.dbList.Put(db)
 	}
 
@@ -668,8 +734,22 @@ func (s *ImmuServer) startReplicationFor(db database.DB, dbOpts *dbOptions) erro
 		WithAllowTxDiscarding(dbOpts.AllowTxDiscarding).
 		WithSkipIntegrityCheck(dbOpts.SkipIntegrityCheck).
 		WithWaitForIndexing(dbOpts.WaitForIndexing).
+		WithPrimaryTLS(dbOpts.PrimaryTLS).
+		WithPrimaryTLSOptions(dbOpts.primaryTLSOptions()).
+		WithKeyPrefixes(dbOpts.partialReplicationKeyPrefixes()).
+		WithSQLTables(dbOpts.SQLTables).
 		WithStreamChunkSize(s.Options.StreamChunkSize)
 
+	if dbOpts.LogShipping {
+		if s.logShippingStorage == nil {
+			return ErrLogShippingNotConfigured
+		}
+
+		replicatorOpts.
+			WithLogShippingStorage(s.logShippingStorage).
+			WithLogShippingPollInterval(s.Options.LogShippingOptions.Frequency)
+	}
+
 	f, err := replication.NewTxReplicator(s.UUID, db, replicatorOpts, s.Logger)
 	if err != nil {
 		return err
@@ -739,6 +819,10 @@ func (s *ImmuServer) Stop() error {
 
 	s.stopTruncation()
 
+	s.stopBackups()
+
+	s.stopLogShipping()
+
 	return s.CloseDatabases()
 }
 
@@ -959,6 +1043,16 @@ func (s *ImmuServer) CreateDatabaseV2(ctx context.Context, req *schema.CreateDat
 		return nil, fmt.Errorf("%w: while starting truncation", err)
 	}
 
+	err = s.startBackupFor(db)
+	if err != nil && err != ErrBackupNotNeeded {
+		return nil, fmt.Errorf("%w: while starting backup", err)
+	}
+
+	err = s.startLogShippingFor(db, dbOpts)
+	if err != nil && err != ErrLogShippingNotNeeded {
+		return nil, fmt.Errorf("%w: while starting log shipping", err)
+	}
+
 	return &schema.CreateDatabaseResponse{
 		Name:     req.Name,
 		Settings: dbOpts.databaseNullableSettings(),
@@ -1038,6 +1132,16 @@ func (s *ImmuServer) LoadDatabase(ctx context.Context, req *schema.LoadDatabaseR
 		return nil, fmt.Errorf("%w: while starting truncation", err)
 	}
 
+	err = s.startBackupFor(db)
+	if err != nil && err != ErrBackupNotNeeded {
+		return nil, fmt.Errorf("%w: while starting backup", err)
+	}
+
+	err = s.startLogShippingFor(db, dbOpts)
+	if err != nil && err != ErrLogShippingNotNeeded {
+		return nil, fmt.Errorf("%w: while starting log shipping", err)
+	}
+
 	return &schema.LoadDatabaseResponse{
 		Database: req.Database,
 	}, nil
@@ -1108,6 +1212,16 @@ func (s *ImmuServer) UnloadDatabase(ctx context.Context, req *schema.UnloadDatab
 		}
 	}
 
+	err = s.stopBackupFor(req.Database)
+	if err != nil && err != ErrBackupNotInProgress {
+		return nil, fmt.Errorf("%w: while stopping backup", err)
+	}
+
+	err = s.stopLogShippingFor(req.Database)
+	if err != nil && err != ErrLogShippingNotInProgress {
+		return nil, fmt.Errorf("%w: while stopping log shipping", err)
+	}
+
 	err = db.Close()
 	if err != nil {
 		return nil, err
@@ -1252,6 +1366,11 @@ func (s *ImmuServer) UpdateDatabaseV2(ctx context.Context, req *schema.UpdateDat
 		if err != nil && err != ErrReplicationNotInProgress {
 			return nil, fmt.Errorf("%w: while stopping replication", err)
 		}
+
+		err = s.stopLogShippingFor(req.Database)
+		if err != nil && err != ErrLogShippingNotInProgress {
+			return nil, fmt.Errorf("%w: while stopping log shipping", err)
+		}
 	}
 
 	if req.Settings.TruncationSettings != nil && !db.IsClosed() {
@@ -1277,6 +1396,7 @@ func (s *ImmuServer) UpdateDatabaseV2(ctx context.Context, req *schema.UpdateDat
 
 	if !db.IsClosed() {
 		db.AsReplica(dbOpts.Replica, dbOpts.SyncReplication, dbOpts.SyncAcks)
+		db.SetReplicationEpoch(dbOpts.ReplicationEpoch)
 	}
 
 	if req.Settings.ReplicationSettings != nil && !db.IsClosed() {
@@ -1284,6 +1404,11 @@ func (s *ImmuServer) UpdateDatabaseV2(ctx context.Context, req *schema.UpdateDat
 		if err != nil && err != ErrReplicatorNotNeeded {
 			return nil, fmt.Errorf("%w: while staring replication", err)
 		}
+
+		err = s.startLogShippingFor(db, dbOpts)
+		if err != nil && err != ErrLogShippingNotNeeded {
+			return nil, fmt.Errorf("%w: while starting log shipping", err)
+		}
 	}
 
 	if req.Settings.TruncationSettings != nil && !db.IsClosed() {
@@ -1498,7 +1623,8 @@ func (s *ImmuServer) UseDatabase(ctx context.Context, req *schema.Database) (*sc
 	if (!user.IsSysAdmin) &&
 		(!user.HasPermission(req.DatabaseName, auth.PermissionAdmin)) &&
 		(!user.HasPermission(req.DatabaseName, auth.PermissionR)) &&
-		(!user.HasPermission(req.DatabaseName, auth.PermissionRW)) {
+		(!user.HasPermission(req.DatabaseName, auth.PermissionRW)) &&
+		(len(user.WhichRoles(req.DatabaseName)) == 0) {
 
 		return nil, status.Errorf(codes.PermissionDenied, "Logged in user does not have permission on this database")
 	}
@@ -1573,13 +1699,76 @@ func (s *ImmuServer) getDBFromCtx(ctx context.Context, methodName string) (datab
 		return db, nil
 	}
 
-	if ok := auth.HasPermissionForMethod(usr.WhichPermission(db.GetName()), methodName); !ok {
+	if auth.HasPermissionForMethod(usr.WhichPermission(db.GetName()), methodName) {
+		return db, nil
+	}
+
+	roles, err := s.getUserRoles(ctx, usr, db.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	if !auth.HasPrivilegeForMethod(roles, methodName) {
 		return nil, ErrPermissionDenied
 	}
 
 	return db, nil
 }
 
+// getSQLDBFromCtx is like getDBFromCtx but it also returns a context carrying the logged in user,
+// so that privileges granted on tables and collections are enforced by the database
+func (s *ImmuServer) getSQLDBFromCtx(ctx context.Context, methodName string) (context.Context, database.DB, error) {
+	db, err := s.getDBFromCtx(ctx, methodName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !s.Options.auth && !s.multidbmode && !s.Options.GetMaintenance() {
+		return ctx, db, nil
+	}
+
+	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, err = s.withSQLUser(ctx, user, db.GetName())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ctx, db, nil
+}
+
+// withSQLUser returns a context carrying the user on behalf of whom SQL statements and document
+// operations are executed
+func (s *ImmuServer) withSQLUser(ctx context.Context, user *auth.User, db string) (context.Context, error) {
+	sqlUser, err := s.sqlUser(ctx, user, db)
+	if err != nil {
+		return nil, err
+	}
+
+	return database.WithSQLUser(ctx, sqlUser), nil
+}
+
+// sqlUser returns the user on behalf of whom SQL statements and document operations are executed,
+// both over grpc and pgsql. Database admins, including users granted a role with the admin privilege,
+// are not restricted by privileges granted on tables
+func (s *ImmuServer) sqlUser(ctx context.Context, user *auth.User, db string) (*database.SQLUser, error) {
+	admin := user.IsSysAdmin || user.WhichPermission(db) == auth.PermissionAdmin
+
+	if !admin {
+		roles, err := s.getUserRoles(ctx, user, db)
+		if err != nil {
+			return nil, err
+		}
+
+		admin = auth.HasPrivilege(roles, auth.PrivilegeAdmin)
+	}
+
+	return &database.SQLUser{Name: user.Username, Admin: admin}, nil
+}
+
 // isValidDBName checks if the provided database name meets the requirements
 func isValidDBName(dbName string) error {
 	if len(dbName) < 1 || len(dbName) > 128 {
@@ -357,6 +357,78 @@ func TestServerErrChunkSizeTooSmall(t *testing.T) {
 	require.ErrorContains(t, err, stream.ErrChunkTooSmall)
 }
 
+func TestServerWithEncryption(t *testing.T) {
+	dir := t.TempDir()
+
+	keyFile := filepath.Join(t.TempDir(), "keys")
+	err := ioutil.WriteFile(keyFile, []byte("key1:"+strings.Repeat("ab", 32)+"\n"), 0600)
+	require.NoError(t, err)
+
+	serverOptions := DefaultOptions().
+		WithDir(dir).
+		WithMetricsServer(false).
+		WithEncryptionOptions(DefaultEncryptionOptions().WithKeyFile(keyFile))
+
+	t.Run("missing keys should fail", func(t *testing.T) {
+		s, closer := testServer(DefaultOptions().
+			WithDir(t.TempDir()).
+			WithMetricsServer(false).
+			WithEncryptionOptions(DefaultEncryptionOptions().WithKeyFile(filepath.Join(t.TempDir(), "missing"))))
+		defer closer()
+
+		err := s.Initialize()
+		require.Error(t, err)
+	})
+
+	login := func(s *ImmuServer) context.Context {
+		lr, err := s.Login(context.Background(), &schema.LoginRequest{
+			User:     []byte(auth.SysAdminUsername),
+			Password: []byte(auth.SysAdminPassword),
+		})
+		require.NoError(t, err)
+
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", lr.Token))
+	}
+
+	s, closer := testServer(serverOptions)
+
+	err = s.Initialize()
+	require.NoError(t, err)
+
+	_, err = s.Set(login(s), &schema.SetRequest{
+		KVs: []*schema.KeyValue{{Key: testKey, Value: testValue}},
+	})
+	require.NoError(t, err)
+
+	closer()
+
+	// database files do not hold the data in plain text
+	err = filepath.Walk(filepath.Join(dir, DefaultDBName), func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		require.False(t, bytes.Contains(content, testValue), path)
+		return nil
+	})
+	require.NoError(t, err)
+
+	s, closer = testServer(serverOptions)
+	defer closer()
+
+	err = s.Initialize()
+	require.NoError(t, err)
+
+	entry, err := s.Get(login(s), &schema.KeyRequest{Key: testKey})
+	require.NoError(t, err)
+	require.Equal(t, testValue, entry.Value)
+}
+
 func TestServerCreateDatabase(t *testing.T) {
 	serverOptions := DefaultOptions().
 		WithDir(t.TempDir()).
@@ -80,6 +80,10 @@ func (s *ServerMock) StreamExecAll(allServer schema.ImmuService_StreamExecAllSer
 	return s.Srv.StreamExecAll(allServer)
 }
 
+func (s *ServerMock) StreamBulkLoad(bulkLoadServer schema.ImmuService_StreamBulkLoadServer) error {
+	return s.Srv.StreamBulkLoad(bulkLoadServer)
+}
+
 func (s *ServerMock) StreamGet(request *schema.KeyRequest, getServer schema.ImmuService_StreamGetServer) error {
 	return s.Srv.StreamGet(request, getServer)
 }
@@ -386,3 +390,35 @@ func (s *ServerMock) VerifiableSQLGet(ctx context.Context, req *schema.Verifiabl
 func (s *ServerMock) TruncateDatabase(ctx context.Context, req *schema.TruncateDatabaseRequest) (*schema.TruncateDatabaseResponse, error) {
 	return s.Srv.TruncateDatabase(ctx, req)
 }
+
+func (s *ServerMock) PromoteReplica(ctx context.Context, req *schema.PromoteReplicaRequest) (*schema.PromoteReplicaResponse, error) {
+	return s.Srv.PromoteReplica(ctx, req)
+}
+
+func (s *ServerMock) ExportPartialTx(ctx context.Context, req *schema.ExportPartialTxRequest) (*schema.PartialTx, error) {
+	return s.Srv.ExportPartialTx(ctx, req)
+}
+
+func (s *ServerMock) PartialTxProof(ctx context.Context, req *schema.PartialTxProofRequest) (*schema.PartialTx, error) {
+	return s.Srv.PartialTxProof(ctx, req)
+}
+
+func (s *ServerMock) ReplicationStatus(ctx context.Context, req *schema.ReplicationStatusRequest) (*schema.ReplicationStatusResponse, error) {
+	return s.Srv.ReplicationStatus(ctx, req)
+}
+
+func (s *ServerMock) CreateRole(ctx context.Context, req *schema.CreateRoleRequest) (*empty.Empty, error) {
+	return s.Srv.CreateRole(ctx, req)
+}
+
+func (s *ServerMock) DeleteRole(ctx context.Context, req *schema.DeleteRoleRequest) (*empty.Empty, error) {
+	return s.Srv.DeleteRole(ctx, req)
+}
+
+func (s *ServerMock) ListRoles(ctx context.Context, req *empty.Empty) (*schema.RoleList, error) {
+	return s.Srv.ListRoles(ctx, req)
+}
+
+func (s *ServerMock) ChangeRole(ctx context.Context, req *schema.ChangeRoleRequest) (*empty.Empty, error) {
+	return s.Srv.ChangeRole(ctx, req)
+}
@@ -59,7 +59,8 @@ func (s *ImmuServer) OpenSession(ctx context.Context, r *schema.OpenSessionReque
 	if (!u.IsSysAdmin) &&
 		(!u.HasPermission(r.DatabaseName, auth.PermissionAdmin)) &&
 		(!u.HasPermission(r.DatabaseName, auth.PermissionR)) &&
-		(!u.HasPermission(r.DatabaseName, auth.PermissionRW)) {
+		(!u.HasPermission(r.DatabaseName, auth.PermissionRW)) &&
+		(len(u.WhichRoles(r.DatabaseName)) == 0) {
 
 		return nil, status.Errorf(codes.PermissionDenied, "Logged in user does not have permission on this database")
 	}
@@ -45,6 +45,7 @@ var (
 type PaginatedDocumentReader struct {
 	Reader         document.DocumentReader // reader to read from
 	Query          *protomodel.Query
+	Projection     *protomodel.Projection
 	LastPageNumber uint32 // last read page number
 	LastPageSize   uint32 // number of items per page
 }
@@ -25,7 +25,12 @@ import (
 )
 
 func (s *ImmuServer) VerifiableSQLGet(ctx context.Context, req *schema.VerifiableSQLGetRequest) (*schema.VerifiableSQLEntry, error) {
-	db, err := s.getDBFromCtx(ctx, "VerifiableSQLGet")
+	ctx, db, err := s.getSQLDBFromCtx(ctx, "VerifiableSQLGet")
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkSQLPrivilegesFromCtx(ctx, db.GetName(), "VerifiableSQLGet", tablePrivilege(sql.SQLPrivilegeSelect, req.GetSqlGetRequest().GetTable()))
 	if err != nil {
 		return nil, err
 	}
@@ -61,7 +66,12 @@ func (s *ImmuServer) SQLExec(ctx context.Context, req *schema.SQLExecRequest) (*
 		return nil, ErrNotAllowedInMaintenanceMode
 	}
 
-	db, err := s.getDBFromCtx(ctx, "SQLExec")
+	ctx, db, err := s.getSQLDBFromCtx(ctx, "SQLExec")
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkSQLPrivilegesFromCtx(ctx, db.GetName(), "SQLExec", stmtsPrivileges(req.Sql))
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +120,12 @@ func (s *ImmuServer) SQLExec(ctx context.Context, req *schema.SQLExecRequest) (*
 }
 
 func (s *ImmuServer) SQLQuery(ctx context.Context, req *schema.SQLQueryRequest) (*schema.SQLQueryResult, error) {
-	db, err := s.getDBFromCtx(ctx, "SQLQuery")
+	ctx, db, err := s.getSQLDBFromCtx(ctx, "SQLQuery")
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkSQLPrivilegesFromCtx(ctx, db.GetName(), "SQLQuery", stmtsPrivileges(req.Sql))
 	if err != nil {
 		return nil, err
 	}
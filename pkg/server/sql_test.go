@@ -21,12 +21,14 @@ import (
 	"testing"
 
 	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/pkg/api/protomodel"
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/database"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func TestSQLInteraction(t *testing.T) {
@@ -184,3 +186,103 @@ func TestSQLExecCreateDatabase(t *testing.T) {
 	_, err = s.SQLExec(ctx, &schema.SQLExecRequest{Sql: "CREATE DATABASE db2;"})
 	require.ErrorContains(t, err, sql.ErrDatabaseAlreadyExists.Error())
 }
+
+func TestSQLGrants(t *testing.T) {
+	s, closer := testServer(DefaultOptions().WithDir(t.TempDir()).WithPort(0))
+	defer closer()
+
+	err := s.Initialize()
+	require.NoError(t, err)
+
+	openSession := func(username, password, db string) context.Context {
+		resp, err := s.OpenSession(context.Background(), &schema.OpenSessionRequest{
+			Username:     []byte(username),
+			Password:     []byte(password),
+			DatabaseName: db,
+		})
+		require.NoError(t, err)
+
+		return metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"sessionid": resp.GetSessionID()}))
+	}
+
+	ctx := openSession(auth.SysAdminUsername, auth.SysAdminPassword, DefaultDBName)
+
+	_, err = s.SQLExec(ctx, &schema.SQLExecRequest{Sql: `
+		CREATE TABLE orders(id INTEGER AUTO_INCREMENT, PRIMARY KEY id);
+		CREATE TABLE customers(id INTEGER AUTO_INCREMENT, PRIMARY KEY id);
+		INSERT INTO orders(id) VALUES (1);
+	`})
+	require.NoError(t, err)
+
+	for _, collectionName := range []string{"invoices", "payments"} {
+		_, err = s.CreateCollection(ctx, &protomodel.CreateCollectionRequest{Name: collectionName})
+		require.NoError(t, err)
+	}
+
+	_, err = s.CreateUser(ctx, &schema.CreateUserRequest{
+		User:       []byte("analytics"),
+		Password:   []byte("analytics1Pas@1"),
+		Permission: auth.PermissionRW,
+		Database:   DefaultDBName,
+	})
+	require.NoError(t, err)
+
+	userCtx := openSession("analytics", "analytics1Pas@1", DefaultDBName)
+
+	t.Run("only admins should grant privileges", func(t *testing.T) {
+		_, err := s.SQLExec(userCtx, &schema.SQLExecRequest{Sql: "GRANT SELECT ON customers TO analytics"})
+		require.ErrorIs(t, err, sql.ErrPermissionDenied)
+	})
+
+	_, err = s.SQLExec(ctx, &schema.SQLExecRequest{Sql: "GRANT SELECT ON orders TO analytics"})
+	require.NoError(t, err)
+
+	_, err = s.SQLExec(ctx, &schema.SQLExecRequest{Sql: "GRANT SELECT ON COLLECTION invoices TO analytics"})
+	require.NoError(t, err)
+
+	t.Run("table privileges should be enforced", func(t *testing.T) {
+		res, err := s.SQLQuery(userCtx, &schema.SQLQueryRequest{Sql: "SELECT id FROM orders"})
+		require.NoError(t, err)
+		require.Len(t, res.Rows, 1)
+
+		_, err = s.SQLQuery(userCtx, &schema.SQLQueryRequest{Sql: "SELECT id FROM customers"})
+		require.ErrorIs(t, err, sql.ErrPermissionDenied)
+
+		_, err = s.SQLExec(userCtx, &schema.SQLExecRequest{Sql: "INSERT INTO orders(id) VALUES (2)"})
+		require.ErrorIs(t, err, sql.ErrPermissionDenied)
+	})
+
+	t.Run("table privileges should be enforced within transactions", func(t *testing.T) {
+		tx, err := s.NewTx(userCtx, &schema.NewTxRequest{Mode: schema.TxMode_ReadOnly})
+		require.NoError(t, err)
+
+		md, _ := metadata.FromIncomingContext(userCtx)
+		md = md.Copy()
+		md.Set("transactionid", tx.TransactionID)
+
+		txCtx := metadata.NewIncomingContext(context.Background(), md)
+
+		_, err = s.TxSQLQuery(txCtx, &schema.SQLQueryRequest{Sql: "SELECT id FROM orders"})
+		require.NoError(t, err)
+
+		_, err = s.TxSQLQuery(txCtx, &schema.SQLQueryRequest{Sql: "SELECT id FROM customers"})
+		require.ErrorIs(t, err, sql.ErrPermissionDenied)
+
+		_, err = s.Rollback(txCtx, &emptypb.Empty{})
+		require.NoError(t, err)
+	})
+
+	t.Run("collection privileges should be enforced", func(t *testing.T) {
+		_, err := s.CountDocuments(userCtx, &protomodel.CountDocumentsRequest{Query: &protomodel.Query{CollectionName: "invoices"}})
+		require.NoError(t, err)
+
+		_, err = s.CountDocuments(userCtx, &protomodel.CountDocumentsRequest{Query: &protomodel.Query{CollectionName: "payments"}})
+		require.ErrorIs(t, err, sql.ErrPermissionDenied)
+
+		_, err = s.InsertDocuments(userCtx, &protomodel.InsertDocumentsRequest{
+			CollectionName: "invoices",
+			Documents:      []*structpb.Struct{{Fields: map[string]*structpb.Value{}}},
+		})
+		require.ErrorIs(t, err, sql.ErrPermissionDenied)
+	})
+}
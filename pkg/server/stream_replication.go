@@ -19,9 +19,11 @@ package server
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"strconv"
 
 	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/database"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -54,7 +56,18 @@ func (s *ImmuServer) exportTx(req *schema.ExportTxRequest, txsServer schema.Immu
 		return err
 	}
 
+	// replicas using bidirectional streaming remain connected while the stream is open
+	s.connectedReplicas.track(txsServer.Context(), db.GetName(), replicaAckTxID(req), req.ReplicaState != nil, false, !setTrailer)
+
 	txbs, mayCommitUpToTxID, mayCommitUpToAlh, err := db.ExportTxByID(txsServer.Context(), req)
+	if errors.Is(err, database.ErrStaleReplicationEpoch) {
+		s.Logger.Warningf("database '%s' was superseded by a failover, it's being fenced. Reason: %v", db.GetName(), err)
+
+		ferr := s.fenceStalePrimary(db, req.ReplicationEpoch)
+		if ferr != nil {
+			s.Logger.Errorf("settings of fenced database '%s' could not be saved. Reason: %v", db.GetName(), ferr)
+		}
+	}
 	if err != nil {
 		return err
 	}
@@ -71,10 +84,14 @@ func (s *ImmuServer) exportTx(req *schema.ExportTxRequest, txsServer schema.Immu
 			binary.BigEndian.PutUint64(bCommittedTxID[:], state.TxId)
 		}
 
+		var bReplicationEpoch [8]byte
+		binary.BigEndian.PutUint64(bReplicationEpoch[:], db.ReplicationEpoch())
+
 		streamMetadata = map[string][]byte{
 			"may-commit-up-to-txid-bin": bMayCommitUpToTxID[:],
 			"may-commit-up-to-alh-bin":  mayCommitUpToAlh[:],
 			"committed-txid-bin":        bCommittedTxID[:],
+			"replication-epoch-bin":     bReplicationEpoch[:],
 		}
 
 		if setTrailer {
@@ -85,6 +102,7 @@ func (s *ImmuServer) exportTx(req *schema.ExportTxRequest, txsServer schema.Immu
 				"may-commit-up-to-txid-bin", string(bMayCommitUpToTxID[:]),
 				"may-commit-up-to-alh-bin", string(mayCommitUpToAlh[:]),
 				"committed-txid-bin", string(bCommittedTxID[:]),
+				"replication-epoch-bin", string(bReplicationEpoch[:]),
 			)
 			txsServer.SetTrailer(md)
 		}
@@ -479,3 +479,39 @@ func (s *ImmuServer) StreamExecAll(str schema.ImmuService_StreamExecAllServer) e
 
 	return nil
 }
+
+// StreamBulkLoad writes a stream of key-values into the database using a bulk load
+func (s *ImmuServer) StreamBulkLoad(str schema.ImmuService_StreamBulkLoadServer) error {
+	if s.Options.GetMaintenance() {
+		return ErrNotAllowedInMaintenanceMode
+	}
+
+	db, err := s.getDBFromCtx(str.Context(), "StreamBulkLoad")
+	if err != nil {
+		return err
+	}
+
+	kvsr := s.StreamServiceFactory.NewKvStreamReceiver(s.StreamServiceFactory.NewMsgReceiver(str))
+
+	res, err := db.BulkLoad(str.Context(), func() (*schema.KeyValue, error) {
+		key, vr, err := kvsr.Next()
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := stream.ReadValue(vr, s.Options.StreamChunkSize)
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+
+		return &schema.KeyValue{Key: key, Value: value}, nil
+	})
+	if err == store.ErrMaxValueLenExceeded {
+		return errors.Wrap(err, stream.ErrMaxValueLenExceeded)
+	}
+	if err != nil {
+		return err
+	}
+
+	return str.SendAndClose(res)
+}
@@ -123,6 +123,11 @@ func (s *ImmuServer) TxSQLExec(ctx context.Context, request *schema.SQLExecReque
 		return new(empty.Empty), err
 	}
 
+	ctx, err = s.txSQLContext(ctx, "SQLExec", request.Sql)
+	if err != nil {
+		return new(empty.Empty), err
+	}
+
 	res := tx.SQLExec(ctx, request)
 
 	if tx.IsClosed() {
@@ -145,5 +150,28 @@ func (s *ImmuServer) TxSQLQuery(ctx context.Context, request *schema.SQLQueryReq
 		return nil, err
 	}
 
+	ctx, err = s.txSQLContext(ctx, "SQLQuery", request.Sql)
+	if err != nil {
+		return nil, err
+	}
+
 	return tx.SQLQuery(ctx, request)
 }
+
+// txSQLContext checks the SQL privileges of the session user and returns a context carrying it,
+// so that privileges granted on tables are enforced by the database
+func (s *ImmuServer) txSQLContext(ctx context.Context, methodName string, sqlStmts string) (context.Context, error) {
+	sess, err := s.SessManager.GetSessionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, db := sess.GetUser(), sess.GetDatabase().GetName()
+
+	err = s.checkSQLPrivileges(ctx, user, db, methodName, stmtsPrivileges(sqlStmts))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.withSQLUser(ctx, user, db)
+}
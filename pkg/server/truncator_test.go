@@ -94,6 +94,79 @@ func TestServerTruncator(t *testing.T) {
 	require.ErrorIs(t, ErrTruncatorDoesNotExist, err)
 }
 
+func TestServerTruncatorWithLiveValueCompaction(t *testing.T) {
+	serverOptions := DefaultOptions().
+		WithDir(t.TempDir()).
+		WithMetricsServer(false).
+		WithAdminPassword(auth.SysAdminPassword).
+		WithAuth(true).
+		WithPort(0)
+
+	s, closer := testServer(serverOptions)
+	defer closer()
+
+	err := s.Initialize()
+	require.NoError(t, err)
+
+	lr, err := s.Login(context.Background(), &schema.LoginRequest{
+		User:     []byte(auth.SysAdminUsername),
+		Password: []byte(auth.SysAdminPassword),
+	})
+	require.NoError(t, err)
+
+	md := metadata.Pairs("authorization", lr.Token)
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	dt := 24 * time.Hour
+
+	_, err = s.CreateDatabaseV2(ctx, &schema.CreateDatabaseRequest{
+		Name: "db",
+		Settings: &schema.DatabaseNullableSettings{
+			TruncationSettings: &schema.TruncationNullableSettings{
+				RetentionPeriod:          &schema.NullableMilliseconds{Value: dt.Milliseconds()},
+				TruncationFrequency:      &schema.NullableMilliseconds{Value: dt.Milliseconds()},
+				CompactLiveValues:        &schema.NullableBool{Value: true},
+				CompactionMaxBytesPerSec: &schema.NullableUint32{Value: 1 << 20},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	dbOpts, err := s.loadDBOptions("db", false)
+	require.NoError(t, err)
+	require.True(t, dbOpts.CompactLiveValues)
+	require.Equal(t, 1<<20, dbOpts.CompactionMaxBytesPerSec)
+
+	db, err := s.dbList.GetByName("db")
+	require.NoError(t, err)
+	require.True(t, db.GetOptions().CompactLiveValues)
+	require.Equal(t, 1<<20, db.GetOptions().CompactionMaxBytesPerSec)
+
+	_, err = s.getTruncatorFor(db.GetName())
+	require.NoError(t, err)
+
+	t.Run("live value compaction should be disabled on update", func(t *testing.T) {
+		_, err := s.UpdateDatabaseV2(ctx, &schema.UpdateDatabaseRequest{
+			Database: "db",
+			Settings: &schema.DatabaseNullableSettings{
+				TruncationSettings: &schema.TruncationNullableSettings{
+					CompactLiveValues: &schema.NullableBool{Value: false},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		dbOpts, err := s.loadDBOptions("db", false)
+		require.NoError(t, err)
+		require.False(t, dbOpts.CompactLiveValues)
+
+		_, err = s.getTruncatorFor(db.GetName())
+		require.NoError(t, err)
+	})
+
+	s.stopTruncation()
+}
+
 func TestServerLoadDatabaseWithRetention(t *testing.T) {
 	dir := t.TempDir()
 
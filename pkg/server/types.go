@@ -23,9 +23,11 @@ import (
 	"os"
 	"sync"
 
+	"github.com/codenotary/immudb/pkg/backup"
 	"github.com/codenotary/immudb/pkg/server/sessions"
 	"github.com/codenotary/immudb/pkg/truncator"
 
+	"github.com/codenotary/immudb/embedded/appendable/encrypted"
 	"github.com/codenotary/immudb/embedded/remotestorage"
 	pgsqlsrv "github.com/codenotary/immudb/pkg/pgsql/server"
 	"github.com/codenotary/immudb/pkg/replication"
@@ -61,9 +63,17 @@ type ImmuServer struct {
 	replicators      map[string]*replication.TxReplicator
 	replicationMutex sync.Mutex
 
+	connectedReplicas connectedReplicas
+
 	truncators     map[string]*truncator.Truncator
 	truncatorMutex sync.Mutex
 
+	backups     map[string]*backup.Backup
+	backupMutex sync.Mutex
+
+	logShippers      map[string]*backup.Backup
+	logShippingMutex sync.Mutex
+
 	Logger      logger.Logger
 	Options     *Options
 	Listener    net.Listener
@@ -84,6 +94,19 @@ type ImmuServer struct {
 	PgsqlSrv             pgsqlsrv.Server
 
 	remoteStorage remotestorage.Storage
+	backupStorage remotestorage.Storage
+
+	// logShippingStorage is opened from the log shipping url unless provided before initialization
+	logShippingStorage remotestorage.Storage
+
+	// encryptionKeys encrypt the data of every database, only if encryption is configured
+	encryptionKeys encrypted.KeyProvider
+
+	// jwtValidator validates externally issued tokens, only if jwt authentication is configured
+	jwtValidator *auth.JWTValidator
+
+	// ldapAuthenticator authenticates users against a directory, only if ldap authentication is configured
+	ldapAuthenticator *auth.LDAPAuthenticator
 
 	SessManager sessions.Manager
 }
@@ -95,6 +118,8 @@ func DefaultServer() *ImmuServer {
 		dbList:               database.NewDatabaseList(),
 		replicators:          make(map[string]*replication.TxReplicator),
 		truncators:           make(map[string]*truncator.Truncator),
+		backups:              make(map[string]*backup.Backup),
+		logShippers:          make(map[string]*backup.Backup),
 		Logger:               logger.NewSimpleLogger("immudb ", os.Stderr),
 		Options:              DefaultOptions(),
 		quit:                 make(chan struct{}),
@@ -19,9 +19,11 @@ package server
 import (
 	"context"
 	"encoding/json"
+	goerrors "errors"
 	"fmt"
 	"time"
 
+	"github.com/codenotary/immudb/embedded/store"
 	"github.com/codenotary/immudb/pkg/database"
 	"github.com/codenotary/immudb/pkg/server/sessions"
 
@@ -226,6 +228,7 @@ func (s *ImmuServer) ListUsers(ctx context.Context, req *empty.Empty) (*schema.U
 				Createdby:   user.CreatedBy,
 				Permissions: permissions,
 				Active:      user.Active,
+				Roles:       userRolesToProto(user.Roles),
 			}
 
 			userlist.Users = append(userlist.Users, &u)
@@ -271,6 +274,7 @@ func (s *ImmuServer) ListUsers(ctx context.Context, req *empty.Empty) (*schema.U
 					Createdby:   user.CreatedBy,
 					Permissions: permissions,
 					Active:      user.Active,
+					Roles:       userRolesToProto(user.Roles),
 				}
 
 				userlist.Users = append(userlist.Users, &u)
@@ -297,6 +301,7 @@ func (s *ImmuServer) ListUsers(ctx context.Context, req *empty.Empty) (*schema.U
 			Createdby:   loggedInuser.CreatedBy,
 			Permissions: permissions,
 			Active:      loggedInuser.Active,
+			Roles:       userRolesToProto(loggedInuser.Roles),
 		}
 
 		userlist.Users = append(userlist.Users, &u)
@@ -560,7 +565,17 @@ func (s *ImmuServer) insertNewUser(ctx context.Context, username []byte, plainPa
 }
 
 func (s *ImmuServer) getValidatedUser(ctx context.Context, username []byte, password []byte) (*auth.User, error) {
+	if s.jwtValidator != nil && auth.IsJWT(string(password)) {
+		return s.getJWTUser(ctx, username, string(password))
+	}
+
 	userdata, err := s.getUser(ctx, username)
+
+	// local users, like the immudb sysadmin, are only authenticated against the local store,
+	// the directory only authenticates users without a local account
+	if s.ldapAuthenticator != nil && string(username) != auth.SysAdminUsername && goerrors.Is(err, store.ErrKeyNotFound) {
+		return s.getLDAPUser(ctx, username, password)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -573,6 +588,52 @@ func (s *ImmuServer) getValidatedUser(ctx context.Context, username []byte, pass
 	return userdata, nil
 }
 
+// getJWTUser returns the user identified by an externally issued token
+func (s *ImmuServer) getJWTUser(ctx context.Context, username []byte, token string) (*auth.User, error) {
+	jwtUser, err := s.jwtValidator.Validate(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(username) > 0 && string(username) != jwtUser.Username {
+		return nil, fmt.Errorf("%w: token issued to a different user", auth.ErrInvalidJWT)
+	}
+
+	return s.getExternalUser(ctx, jwtUser)
+}
+
+// getLDAPUser returns the user authenticated against the ldap directory
+func (s *ImmuServer) getLDAPUser(ctx context.Context, username []byte, password []byte) (*auth.User, error) {
+	ldapUser, err := s.ldapAuthenticator.Authenticate(string(username), string(password))
+	if err != nil {
+		if !goerrors.Is(err, auth.ErrLDAPUserNotFound) && !goerrors.Is(err, auth.ErrInvalidLDAPCredentials) {
+			s.Logger.Warningf("ldap authentication of user '%s' failed: %v", username, err)
+		}
+		return nil, err
+	}
+
+	return s.getExternalUser(ctx, ldapUser)
+}
+
+// getExternalUser returns the user authenticated by an external identity provider, with the permissions
+// mapped from the provider groups. External identities are never merged with local users: usernames of
+// local users, like the immudb sysadmin, are rejected
+func (s *ImmuServer) getExternalUser(ctx context.Context, externalUser *auth.User) (*auth.User, error) {
+	if externalUser.Username == auth.SysAdminUsername {
+		return nil, auth.ErrLocalUsername
+	}
+
+	_, err := s.getUser(ctx, []byte(externalUser.Username))
+	if err == nil {
+		return nil, auth.ErrLocalUsername
+	}
+	if !goerrors.Is(err, store.ErrKeyNotFound) {
+		return nil, err
+	}
+
+	return externalUser, nil
+}
+
 // getUser returns userdata (username,hashed password, permission, active) from username
 func (s *ImmuServer) getUser(ctx context.Context, username []byte) (*auth.User, error) {
 	key := make([]byte, 1+len(username))
@@ -18,10 +18,21 @@ package server
 
 import (
 	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/auth/ldaptest"
 	"github.com/codenotary/immudb/pkg/database"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/metadata"
@@ -333,3 +344,317 @@ func testServerChangePassword(ctx context.Context, s *ImmuServer, t *testing.T)
 	})
 	require.NoError(t, err)
 }
+
+func signTestJWT(t *testing.T, key *rsa.PrivateKey, claims map[string]interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "kid": "test", "typ": "JWT"})
+	require.NoError(t, err)
+
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	signedContent := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signedContent))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	return signedContent + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func writeTestJWKS(t *testing.T, key *rsa.PrivateKey) string {
+	jwks, err := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "test",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	})
+	require.NoError(t, err)
+
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(jwksFile, jwks, 0600))
+
+	return jwksFile
+}
+
+func TestServerJWTAuthentication(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	mappings, err := auth.ParseRoleMappings("writers:defaultdb:readwrite,readers:defaultdb:read")
+	require.NoError(t, err)
+
+	jwtOptions := auth.DefaultJWTOptions().
+		WithJWKSFile(writeTestJWKS(t, key)).
+		WithIssuer("https://idp.example.com").
+		WithAudience("immudb").
+		WithRoleMappings(mappings)
+
+	s, closer := testServer(DefaultOptions().WithDir(t.TempDir()).WithPort(0).WithJWTOptions(jwtOptions))
+	defer closer()
+
+	err = s.Initialize()
+	require.NoError(t, err)
+
+	token := func(sub string, groups ...string) string {
+		return signTestJWT(t, key, map[string]interface{}{
+			"iss":    "https://idp.example.com",
+			"aud":    "immudb",
+			"sub":    sub,
+			"groups": groups,
+			"exp":    time.Now().Add(time.Hour).Unix(),
+		})
+	}
+
+	openSession := func(username string, password string) (context.Context, error) {
+		resp, err := s.OpenSession(context.Background(), &schema.OpenSessionRequest{
+			Username:     []byte(username),
+			Password:     []byte(password),
+			DatabaseName: DefaultDBName,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"sessionid": resp.GetSessionID()})), nil
+	}
+
+	t.Run("users without a local account get the mapped permissions", func(t *testing.T) {
+		ctx, err := openSession("alice", token("alice", "writers"))
+		require.NoError(t, err)
+
+		_, err = s.Set(ctx, &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("k1"), Value: []byte("v1")}}})
+		require.NoError(t, err)
+
+		ctx, err = openSession("", token("bob", "readers"))
+		require.NoError(t, err)
+
+		_, err = s.Get(ctx, &schema.KeyRequest{Key: []byte("k1")})
+		require.NoError(t, err)
+
+		_, err = s.Set(ctx, &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("k2"), Value: []byte("v2")}}})
+		require.Error(t, err)
+
+		_, err = openSession("carol", token("carol", "guests"))
+		require.Error(t, err)
+	})
+
+	t.Run("tokens issued to local users are rejected", func(t *testing.T) {
+		_, err := openSession(auth.SysAdminUsername, token(auth.SysAdminUsername, "writers"))
+		require.ErrorContains(t, err, ErrInvalidUsernameOrPassword)
+
+		_, err = openSession("", token(auth.SysAdminUsername))
+		require.ErrorContains(t, err, ErrInvalidUsernameOrPassword)
+
+		_, err = s.Login(context.Background(), &schema.LoginRequest{
+			User:     []byte(auth.SysAdminUsername),
+			Password: []byte(token(auth.SysAdminUsername)),
+		})
+		require.ErrorContains(t, err, ErrInvalidUsernameOrPassword)
+
+		adminCtx, err := openSession(auth.SysAdminUsername, auth.SysAdminPassword)
+		require.NoError(t, err)
+
+		_, err = s.CreateUser(adminCtx, &schema.CreateUserRequest{
+			User:       []byte("dave"),
+			Password:   []byte("Dave1234!"),
+			Permission: auth.PermissionAdmin,
+			Database:   DefaultDBName,
+		})
+		require.NoError(t, err)
+
+		// external identities are not merged with local users
+		_, err = openSession("dave", token("dave", "writers"))
+		require.ErrorContains(t, err, ErrInvalidUsernameOrPassword)
+
+		ctx, err := openSession("dave", "Dave1234!")
+		require.NoError(t, err)
+
+		_, err = s.ListUsers(ctx, &emptypb.Empty{})
+		require.NoError(t, err)
+	})
+
+	t.Run("legacy login", func(t *testing.T) {
+		resp, err := s.Login(context.Background(), &schema.LoginRequest{
+			User:     []byte("alice"),
+			Password: []byte(token("alice", "writers")),
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.Token)
+	})
+
+	t.Run("invalid tokens", func(t *testing.T) {
+		_, err := openSession("mallory", token("alice", "writers"))
+		require.ErrorContains(t, err, ErrInvalidUsernameOrPassword)
+
+		otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		forged := signTestJWT(t, otherKey, map[string]interface{}{
+			"iss":    "https://idp.example.com",
+			"aud":    "immudb",
+			"sub":    "alice",
+			"groups": []string{"writers"},
+			"exp":    time.Now().Add(time.Hour).Unix(),
+		})
+
+		_, err = openSession("alice", forged)
+		require.ErrorContains(t, err, ErrInvalidUsernameOrPassword)
+	})
+}
+
+func TestServerLDAPAuthentication(t *testing.T) {
+	directory, err := ldaptest.NewServer(
+		&ldaptest.Entry{
+			DN:         "uid=alice,ou=people,dc=example,dc=com",
+			Password:   "alice-secret",
+			Attributes: map[string][]string{"uid": {"alice"}},
+		},
+		&ldaptest.Entry{
+			DN:         "uid=bob,ou=people,dc=example,dc=com",
+			Password:   "bob-secret",
+			Attributes: map[string][]string{"uid": {"bob"}},
+		},
+		&ldaptest.Entry{
+			DN:         "uid=carol,ou=people,dc=example,dc=com",
+			Password:   "carol-secret",
+			Attributes: map[string][]string{"uid": {"carol"}},
+		},
+		&ldaptest.Entry{
+			DN:         "uid=immudb,ou=people,dc=example,dc=com",
+			Password:   "directory-secret",
+			Attributes: map[string][]string{"uid": {"immudb"}},
+		},
+		&ldaptest.Entry{
+			DN:         "uid=dave,ou=people,dc=example,dc=com",
+			Password:   "directory-secret",
+			Attributes: map[string][]string{"uid": {"dave"}},
+		},
+		&ldaptest.Entry{
+			DN: "cn=writers,ou=groups,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"cn":     {"writers"},
+				"member": {"uid=alice,ou=people,dc=example,dc=com", "uid=immudb,ou=people,dc=example,dc=com", "uid=dave,ou=people,dc=example,dc=com"},
+			},
+		},
+		&ldaptest.Entry{
+			DN: "cn=readers,ou=groups,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"cn":     {"readers"},
+				"member": {"uid=bob,ou=people,dc=example,dc=com"},
+			},
+		},
+	)
+	require.NoError(t, err)
+	defer directory.Close()
+
+	mappings, err := auth.ParseRoleMappings("writers:defaultdb:readwrite,readers:defaultdb:read")
+	require.NoError(t, err)
+
+	ldapOptions := auth.DefaultLDAPOptions().
+		WithURL(directory.URL()).
+		WithUserBaseDN("ou=people,dc=example,dc=com").
+		WithGroupBaseDN("ou=groups,dc=example,dc=com").
+		WithRoleMappings(mappings)
+
+	s, closer := testServer(DefaultOptions().WithDir(t.TempDir()).WithPort(0).WithLDAPOptions(ldapOptions))
+	defer closer()
+
+	err = s.Initialize()
+	require.NoError(t, err)
+
+	openSession := func(username string, password string) (context.Context, error) {
+		resp, err := s.OpenSession(context.Background(), &schema.OpenSessionRequest{
+			Username:     []byte(username),
+			Password:     []byte(password),
+			DatabaseName: DefaultDBName,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"sessionid": resp.GetSessionID()})), nil
+	}
+
+	t.Run("directory users get the permissions mapped from their groups", func(t *testing.T) {
+		ctx, err := openSession("alice", "alice-secret")
+		require.NoError(t, err)
+
+		_, err = s.Set(ctx, &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("k1"), Value: []byte("v1")}}})
+		require.NoError(t, err)
+
+		ctx, err = openSession("bob", "bob-secret")
+		require.NoError(t, err)
+
+		_, err = s.Get(ctx, &schema.KeyRequest{Key: []byte("k1")})
+		require.NoError(t, err)
+
+		_, err = s.Set(ctx, &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("k2"), Value: []byte("v2")}}})
+		require.Error(t, err)
+
+		// carol is not a member of any mapped group
+		_, err = openSession("carol", "carol-secret")
+		require.Error(t, err)
+	})
+
+	t.Run("invalid credentials", func(t *testing.T) {
+		_, err := openSession("alice", "bob-secret")
+		require.ErrorContains(t, err, ErrInvalidUsernameOrPassword)
+
+		_, err = openSession("mallory", "mallory-secret")
+		require.Error(t, err)
+	})
+
+	t.Run("legacy login", func(t *testing.T) {
+		resp, err := s.Login(context.Background(), &schema.LoginRequest{
+			User:     []byte("alice"),
+			Password: []byte("alice-secret"),
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.Token)
+	})
+
+	t.Run("local users are only authenticated against the local store", func(t *testing.T) {
+		_, err := openSession(auth.SysAdminUsername, "directory-secret")
+		require.ErrorContains(t, err, ErrInvalidUsernameOrPassword)
+
+		_, err = s.Login(context.Background(), &schema.LoginRequest{
+			User:     []byte(auth.SysAdminUsername),
+			Password: []byte("directory-secret"),
+		})
+		require.ErrorContains(t, err, ErrInvalidUsernameOrPassword)
+
+		adminCtx, err := openSession(auth.SysAdminUsername, auth.SysAdminPassword)
+		require.NoError(t, err)
+
+		_, err = s.ListUsers(adminCtx, &emptypb.Empty{})
+		require.NoError(t, err)
+
+		_, err = s.CreateUser(adminCtx, &schema.CreateUserRequest{
+			User:       []byte("dave"),
+			Password:   []byte("Dave1234!"),
+			Permission: auth.PermissionR,
+			Database:   DefaultDBName,
+		})
+		require.NoError(t, err)
+
+		_, err = openSession("dave", "directory-secret")
+		require.ErrorContains(t, err, ErrInvalidUsernameOrPassword)
+
+		ctx, err := openSession("dave", "Dave1234!")
+		require.NoError(t, err)
+
+		_, err = s.Set(ctx, &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("k3"), Value: []byte("v3")}}})
+		require.Error(t, err)
+	})
+
+	t.Run("local users are authenticated when the directory is unreachable", func(t *testing.T) {
+		directory.Close()
+
+		_, err := openSession(auth.SysAdminUsername, auth.SysAdminPassword)
+		require.NoError(t, err)
+
+		_, err = openSession("alice", "alice-secret")
+		require.Error(t, err)
+	})
+}